@@ -17,6 +17,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/klauspost/compress/zstd"
 	"github.com/vmihailenco/msgpack"
 )
 
@@ -53,8 +54,9 @@ var (
 	magicTar  = detect{offset: 257, sig: []byte("ustar"), mime: cos.ExtTar}
 	magicGzip = detect{sig: []byte{0x1f, 0x8b}, mime: cos.ExtTarTgz}
 	magicZip  = detect{sig: []byte{0x50, 0x4b}, mime: cos.ExtZip}
+	magicZstd = detect{sig: []byte{0x28, 0xb5, 0x2f, 0xfd}, mime: cos.ExtTarZst}
 
-	allMagics = []detect{magicTar, magicGzip, magicZip} // NOTE: must contain all
+	allMagics = []detect{magicTar, magicGzip, magicZip, magicZstd} // NOTE: must contain all
 )
 
 func (csl *cslLimited) Size() int64 { return csl.N }
@@ -84,6 +86,8 @@ func (goi *getObjInfo) freadArch(file *os.File, mime string) (cos.ReadCloseSizer
 		return freadTar(file, filename, archname)
 	case cos.ExtTarTgz, cos.ExtTgz:
 		return freadTgz(file, filename, archname)
+	case cos.ExtTarZst:
+		return freadTzst(file, filename, archname)
 	case cos.ExtZip:
 		return freadZip(file, filename, archname, goi.lom.SizeBytes())
 	case cos.ExtMsgpack:
@@ -158,6 +162,22 @@ func freadTgz(reader io.Reader, filename, archname string) (csc *cslClose, err e
 	return
 }
 
+func freadTzst(reader io.Reader, filename, archname string) (csc *cslClose, err error) {
+	var (
+		zsr *zstd.Decoder
+		csl *cslLimited
+	)
+	if zsr, err = zstd.NewReader(reader); err != nil {
+		return
+	}
+	if csl, err = freadTar(zsr, filename, archname); err != nil {
+		zsr.Close()
+		return
+	}
+	csc = &cslClose{gzr: zsr.IOReadCloser() /*to close*/, R: csl /*to read from*/, N: csl.N /*size*/}
+	return
+}
+
 func freadZip(readerAt cos.ReadReaderAt, filename, archname string, size int64) (csf *cslFile, err error) {
 	var zr *zip.Reader
 	if zr, err = zip.NewReader(readerAt, size); err != nil {
@@ -17,6 +17,7 @@ import (
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cluster"
 	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/fs"
@@ -27,11 +28,13 @@ import (
 
 // TODO:
 // - include `appliedCfgVer` in the GetInfo* response (to synchronize p._remais, etc.)
-// - periodically refresh remote Smap
 // - use m.remote[uuid].smap to load balance and retry disconnects
 
 const ua = "aisnode/backend"
 
+// how often to probe attached remote clusters (see Probe)
+const RemAisProbeIval = time.Minute
+
 type (
 	remAis struct {
 		smap *cluster.Smap
@@ -39,6 +42,9 @@ type (
 		url  string
 		uuid string
 		bp   api.BaseParams
+		// liveness (cached)
+		lastContact atomic.Int64
+		online      atomic.Bool
 	}
 	AISBackendProvider struct {
 		t             cluster.TargetPut
@@ -167,6 +173,7 @@ func (m *AISBackendProvider) GetInfoInternal() (res cluster.Remotes) {
 				break
 			}
 		}
+		out.Online, out.LastContact = remAis.online.Load(), remAis.lastContact.Load()
 		res.A = append(res.A, out)
 	}
 	res.Ver = m.appliedCfgVer
@@ -174,31 +181,15 @@ func (m *AISBackendProvider) GetInfoInternal() (res cluster.Remotes) {
 	return
 }
 
-// At the same time a cluster may have registered both types of remote AIS
-// clusters(HTTP and HTTPS). So, the method must use both kinds of clients and
-// select the correct one at the moment it sends a request.
+// Connect to (i.e., probe) each remote cluster to return the most recent
+// remote Smaps and connectivity status
 // See also: GetInfoInternal()
 // TODO: ditto
 func (m *AISBackendProvider) GetInfo(clusterConf cmn.BackendConfAIS) (res cluster.Remotes) {
-	var (
-		cfg         = cmn.GCO.Get()
-		httpClient  = cmn.NewClient(cmn.TransportArgs{Timeout: cfg.Client.Timeout.D()})
-		httpsClient = cmn.NewClient(cmn.TransportArgs{
-			Timeout:    cfg.Client.Timeout.D(),
-			UseHTTPS:   true,
-			SkipVerify: cfg.Net.HTTP.SkipVerify,
-		})
-	)
 	m.mu.RLock()
 	res.A = make([]*cluster.RemAis, 0, len(m.remote))
 	for uuid, remAis := range m.remote {
-		var (
-			out    = &cluster.RemAis{UUID: uuid, URL: remAis.url}
-			client = httpClient
-		)
-		if cos.IsHTTPS(remAis.url) {
-			client = httpsClient
-		}
+		out := &cluster.RemAis{UUID: uuid, URL: remAis.url}
 		for a, u := range m.alias {
 			if uuid == u {
 				out.Alias = a
@@ -207,17 +198,16 @@ func (m *AISBackendProvider) GetInfo(clusterConf cmn.BackendConfAIS) (res cluste
 		}
 
 		// online?
-		if smap, err := api.GetClusterMap(api.BaseParams{Client: client, URL: remAis.url, UA: ua}); err == nil {
-			if smap.UUID != uuid {
-				glog.Errorf("%s: UUID has changed %q", remAis, smap.UUID)
-				continue
-			}
+		if smap, err := remAis.probe(); err == nil {
 			if smap.Version < remAis.smap.Version {
 				glog.Errorf("%s: detected older Smap %s - proceeding to override anyway", remAis, smap)
 			}
 			remAis.smap = smap
+		} else {
+			glog.Warningln(err)
 		}
 		out.Smap = remAis.smap
+		out.Online, out.LastContact = remAis.online.Load(), remAis.lastContact.Load()
 		res.A = append(res.A, out)
 	}
 	// defunct (cluster config not updated yet locally?)
@@ -234,6 +224,32 @@ func (m *AISBackendProvider) GetInfo(clusterConf cmn.BackendConfAIS) (res cluste
 	return
 }
 
+// Periodically probe all attached remote clusters, to refresh their cached
+// liveness (status, last contact) and Smaps - and to catch broken attachments
+// before cross-cluster operations fail (housekeeping callback)
+func (m *AISBackendProvider) Probe() time.Duration {
+	m.mu.RLock()
+	all := make([]*remAis, 0, len(m.remote))
+	for _, remAis := range m.remote {
+		all = append(all, remAis)
+	}
+	m.mu.RUnlock()
+
+	for _, remAis := range all {
+		smap, err := remAis.probe()
+		if err != nil {
+			glog.Warningln(err)
+			continue
+		}
+		m.mu.Lock()
+		if smap.Version >= remAis.smap.Version {
+			remAis.smap = smap
+		}
+		m.mu.Unlock()
+	}
+	return RemAisProbeIval
+}
+
 // A list of remote AIS URLs can contains both HTTP and HTTPS links at the
 // same time. So, the method must use both kind of clients and select the
 // correct one at the moment it sends a request. First successful request
@@ -283,9 +299,26 @@ func (r *remAis) init(alias string, confURLs []string, cfg *cmn.ClusterConfig) (
 		r.bp = api.BaseParams{Client: httpClient, URL: url, UA: ua}
 	}
 	r.uuid = remSmap.UUID
+	r.online.Store(true)
+	r.lastContact.Store(time.Now().UnixNano())
 	return
 }
 
+// get remote Smap and update liveness
+func (r *remAis) probe() (smap *cluster.Smap, err error) {
+	smap, err = api.GetClusterMap(r.bp)
+	if err == nil && smap.UUID != r.uuid {
+		err = fmt.Errorf("remote cluster %s (%s): UUID has changed %q", r.uuid, r.url, smap.UUID)
+	}
+	if err != nil {
+		r.online.Store(false)
+		return nil, err
+	}
+	r.online.Store(true)
+	r.lastContact.Store(time.Now().UnixNano())
+	return smap, nil
+}
+
 // NOTE: supporting remote attachments both by alias and by UUID interchangeably,
 // with mappings: 1(uuid) to 1(cluster) and 1(alias) to 1(cluster)
 func (m *AISBackendProvider) add(newAis *remAis, newAlias string) (err error) {
@@ -57,7 +57,10 @@ var (
 )
 
 // interface guard
-var _ cluster.BackendProvider = (*awsProvider)(nil)
+var (
+	_ cluster.BackendProvider  = (*awsProvider)(nil)
+	_ cluster.VersionedBackend = (*awsProvider)(nil)
+)
 
 func NewAWS(t cluster.TargetPut) (cluster.BackendProvider, error) {
 	clients = make(map[string]map[string]*s3.S3, 2)
@@ -355,10 +358,14 @@ func (*awsProvider) GetObjReader(ctx context.Context, lom *cluster.LOM) (r io.Re
 	if err != nil && verbose {
 		glog.Warning(err)
 	}
-	obj, err = svc.GetObjectWithContext(ctx, &s3.GetObjectInput{
+	input := &s3.GetObjectInput{
 		Bucket: aws.String(cloudBck.Name),
 		Key:    aws.String(lom.ObjName),
-	})
+	}
+	if vid, ok := ctx.Value(cos.CtxVersionID).(string); ok && vid != "" {
+		input.VersionId = aws.String(vid)
+	}
+	obj, err = svc.GetObjectWithContext(ctx, input)
 	if err != nil {
 		errCode, err = awsErrorToAISError(err, cloudBck)
 		return
@@ -378,6 +385,59 @@ func (*awsProvider) GetObjReader(ctx context.Context, lom *cluster.LOM) (r io.Re
 	return wrapReader(ctx, obj.Body), expCksum, 0, nil
 }
 
+///////////////////////
+// LIST OBJ VERSIONS //
+///////////////////////
+
+func (*awsProvider) ListObjVersions(ctx context.Context, lom *cluster.LOM) (vers cmn.ObjVersions, errCode int, err error) {
+	var (
+		svc      *s3.S3
+		h        = cmn.BackendHelpers.Amazon
+		cloudBck = lom.Bck().RemoteBck()
+	)
+	svc, _, err = newClient(sessConf{bck: cloudBck}, "[list_obj_versions]")
+	if err != nil && verbose {
+		glog.Warning(err)
+	}
+	input := &s3.ListObjectVersionsInput{
+		Bucket: aws.String(cloudBck.Name),
+		Prefix: aws.String(lom.ObjName),
+	}
+	for {
+		resp, errV := svc.ListObjectVersionsWithContext(ctx, input)
+		if errV != nil {
+			errCode, err = awsErrorToAISError(errV, cloudBck)
+			return
+		}
+		for _, v := range resp.Versions {
+			// (prefix match may include other objects, e.g. "a" and "abc")
+			if *v.Key != lom.ObjName {
+				continue
+			}
+			id, ok := h.EncodeVersion(v.VersionId)
+			if !ok {
+				continue
+			}
+			ver := &cmn.ObjVersion{ID: id, Size: *v.Size, IsLatest: *v.IsLatest}
+			if v.LastModified != nil {
+				ver.Mtime = fmtTime(*v.LastModified)
+			}
+			vers = append(vers, ver)
+		}
+		if resp.IsTruncated == nil || !*resp.IsTruncated {
+			break
+		}
+		input.KeyMarker, input.VersionIdMarker = resp.NextKeyMarker, resp.NextVersionIdMarker
+	}
+	if len(vers) == 0 {
+		errCode, err = http.StatusNotFound, cmn.NewErrNotFound("%s: object %s", cloudBck, lom.ObjName)
+	}
+	if verbose {
+		glog.Infof("[list_obj_versions] %s: %d", cloudBck.Cname(lom.ObjName), len(vers))
+	}
+	return
+}
+
 func getobjCustom(lom *cluster.LOM, obj *s3.GetObjectOutput) (expCksum *cos.Cksum) {
 	h := cmn.BackendHelpers.Amazon
 	if v, ok := h.EncodeVersion(obj.VersionId); ok {
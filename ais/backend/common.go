@@ -22,11 +22,21 @@ func Init() {
 	verbose = bool(glog.FastV(4, glog.SmoduleBackend))
 }
 
+// install caller's read wrapper (if any); in addition, when the context
+// can be canceled (or has a deadline), abort reading once it is done
 func wrapReader(ctx context.Context, r io.ReadCloser) io.ReadCloser {
+	var wrap cos.ReadWrapperFunc
 	if v := ctx.Value(cos.CtxReadWrapper); v != nil {
-		return v.(cos.ReadWrapperFunc)(r)
+		wrap = v.(cos.ReadWrapperFunc)
 	}
-	return r
+	if ctx.Done() == nil {
+		if wrap != nil {
+			return wrap(r)
+		}
+		return r
+	}
+	wrapCtx := func(r io.ReadCloser) io.ReadCloser { return cos.NewCtxReader(ctx, r) }
+	return cos.ChainReadWrappers(wrapCtx, wrap)(r)
 }
 
 func setSize(ctx context.Context, size int64) {
@@ -12,8 +12,10 @@ import (
 	"os"
 	"path/filepath"
 	"strconv"
+	"strings"
 	"sync"
-	"unsafe"
+	ratomic "sync/atomic"
+	"time"
 
 	"github.com/NVIDIA/aistore/3rdparty/glog"
 	"github.com/NVIDIA/aistore/api/apc"
@@ -56,12 +58,30 @@ type (
 		cluster.Smap
 	}
 	smapOwner struct {
-		smap    atomic.Pointer
+		smap    ratomic.Pointer[smapX]
 		sls     *sls
+		dups    dupNodes
+		hist    smapHist
 		fpath   string
 		immSize int64
 		mu      sync.Mutex
 	}
+	// node-by-node outcome of smapX.merge
+	mergeResult struct {
+		added    []*cluster.Snode
+		skipped  []*mergeSkip        // same DaemonID already present in the destination
+		resolved []*cluster.DupNodes // auto-resolved (IP, port) duplicates (old node removed)
+		dups     []*cluster.DupNodes // (IP, port) duplicates that remain unresolved
+	}
+	mergeSkip struct {
+		si     *cluster.Snode
+		reason string
+	}
+	// (IP, port) and DaemonID conflicts pending operator's resolution
+	dupNodes struct {
+		all []*cluster.DupNodes
+		mu  sync.Mutex
+	}
 	sls struct {
 		listeners map[string]cluster.Slistener
 		postCh    chan int64
@@ -82,6 +102,7 @@ type (
 		nid         string         // DaemonID of candidate primary to vote
 		sid         string         // DaemonID of node to modify
 		flags       cos.BitFlags   // enum cmn.Snode* to set or clear
+		until       int64          // maintenance auto-resume time (Unix nanoseconds)
 		status      int            // http.Status* of operation
 		exists      bool           // node (nsi) that's being added already exists in Smap
 		interrupted bool           // target reports interrupted rebalance or cold restart (powercycle)
@@ -102,6 +123,12 @@ type (
 
 const clusterMap = "Smap"
 
+// Smap.Cause other than apc.Act* actions
+const (
+	smapCauseMerge = "merge" // primary startup: merging loaded (local) Smap with the one being built
+	smapCauseVote  = "vote"  // election result: new primary
+)
+
 // interface guard
 var (
 	_ revs                  = (*smapX)(nil)
@@ -219,18 +246,49 @@ func (m *smapX) validate() error {
 	if m == nil {
 		return errors.New(clusterMap + " is <nil>")
 	}
-	if m.version() == 0 {
-		return errors.New(clusterMap + " v0")
+	if err := m.Smap.Validate(); err != nil {
+		return err
 	}
-	if m.Primary == nil {
-		return errors.New(clusterMap + ": primary <nil>")
+	return m.checkInvariants()
+}
+
+// Smap invariants (see also GET /daemon?what=smap_check)
+var (
+	errSmapNoUUID       = errors.New("invalid UUID")
+	errSmapNoPrimary    = errors.New("primary not present")
+	errSmapNonElectable = errors.New("primary is non-electable")
+	errSmapIDCollision  = errors.New("node ID present in both Tmap and Pmap")
+	errSmapDupURL       = errors.New("duplicate URL")
+)
+
+func (m *smapX) checkInvariants() error {
+	if !cos.IsValidUUID(m.UUID) {
+		return fmt.Errorf("%s: %w %q", m, errSmapNoUUID, m.UUID)
 	}
-	if !m.isPresent(m.Primary) {
-		return errors.New(clusterMap + ": primary not present")
+	if m.Primary == nil || m.GetProxy(m.Primary.ID()) == nil {
+		return fmt.Errorf("%s: %w", m, errSmapNoPrimary)
 	}
-	cos.Assert(m.Primary.ID() != "")
-	if !cos.IsValidUUID(m.UUID) {
-		return fmt.Errorf(clusterMap+": invalid UUID %q", m.UUID)
+	if m.Primary.Flags.IsSet(cluster.SnodeNonElectable) {
+		return fmt.Errorf("%s: %w (%s)", m, errSmapNonElectable, m.Primary.StringEx())
+	}
+	for id := range m.Tmap {
+		if _, ok := m.Pmap[id]; ok {
+			return fmt.Errorf("%s: %w: %s", m, errSmapIDCollision, id)
+		}
+	}
+	urls := make(map[string]string, 3*(len(m.Tmap)+len(m.Pmap))) // URL => node ID
+	for _, nmap := range []cluster.NodeMap{m.Pmap, m.Tmap} {
+		for id, si := range nmap {
+			for _, u := range []string{si.PubNet.URL, si.ControlNet.URL, si.DataNet.URL} {
+				if u == "" {
+					continue
+				}
+				if other, ok := urls[u]; ok && other != id {
+					return fmt.Errorf("%s: %w %q shared by %s and %s", m, errSmapDupURL, u, other, id)
+				}
+				urls[u] = id
+			}
+		}
 	}
 	return nil
 }
@@ -304,6 +362,9 @@ func (m *smapX) putNode(nsi *cluster.Snode, flags cos.BitFlags) (exists bool) {
 			exists = true
 		}
 		m.addTarget(nsi)
+		if flags.IsSet(cluster.NodeFlagProbation) {
+			glog.Warningf("%s joined in probation (won't store data until promoted)", nsi)
+		}
 	}
 	glog.Infof("joined %s (p %d, t %d)", nsi, m.CountProxies(), m.CountTargets())
 	return
@@ -321,33 +382,52 @@ func (m *smapX) clone() *smapX {
 		dst.Pmap[id] = v.Clone()
 	}
 	dst.Primary = dst.GetProxy(m.Primary.ID())
+	dst.Cause = "" // (to be set by whoever modifies the clone)
 	dst._sgl = nil
 	return dst
 }
 
-func (m *smapX) merge(dst *smapX, override bool) (added int, err error) {
-	for id, si := range m.Tmap {
-		err = dst.handleDuplicateNode(si, override)
-		if err != nil {
-			return
-		}
-		if _, ok := dst.Tmap[id]; !ok {
-			if _, ok = dst.Pmap[id]; !ok {
-				dst.Tmap[id] = si
-				added++
+// merge `m` into `dst`; (IP, port) duplicates are handled as follows:
+//   - !override:                 fail
+//   - override and auto-resolve: remove the (presumably) old node from `dst`
+//   - override otherwise:        keep the old node, skip the new one, and return the conflict
+func (m *smapX) merge(dst *smapX, override bool) (added int, dups []*cluster.DupNodes, err error) {
+	res, err := m.mergeEx(dst, override)
+	return len(res.added), res.dups, err
+}
+
+// same as above but reporting each individual decision (see mergeResult)
+func (m *smapX) mergeEx(dst *smapX, override bool) (res *mergeResult, err error) {
+	autoResolve := !cmn.GCO.Get().Cluster.ManualResolveDupNodes
+	res = &mergeResult{}
+	for _, nmap := range []cluster.NodeMap{m.Tmap, m.Pmap} {
+		for id, si := range nmap {
+			osi, errDup := dst.handleDuplicateNode(si, override && autoResolve)
+			if errDup != nil {
+				if !override {
+					err = errDup
+					return
+				}
+				res.dups = append(res.dups, newDupNodes(osi, si, errDup))
+				continue
 			}
-		}
-	}
-	for id, si := range m.Pmap {
-		err = dst.handleDuplicateNode(si, override)
-		if err != nil {
-			return
-		}
-		if _, ok := dst.Pmap[id]; !ok {
-			if _, ok = dst.Tmap[id]; !ok {
+			if osi != nil {
+				res.resolved = append(res.resolved, &cluster.DupNodes{Osi: osi, Nsi: si, Time: time.Now().UnixNano()})
+			}
+			if _, ok := dst.Tmap[id]; ok {
+				res.skip(si, !si.IsTarget(), apc.Target)
+				continue
+			}
+			if _, ok := dst.Pmap[id]; ok {
+				res.skip(si, !si.IsProxy(), apc.Proxy)
+				continue
+			}
+			if si.IsProxy() {
 				dst.Pmap[id] = si
-				added++
+			} else {
+				dst.Tmap[id] = si
 			}
+			res.added = append(res.added, si)
 		}
 	}
 	if m.UUID != "" && dst.UUID == "" {
@@ -358,9 +438,9 @@ func (m *smapX) merge(dst *smapX, override bool) (added int, err error) {
 }
 
 // detect duplicate URLs and/or IPs; if del == true we delete an old one
-// so that the caller can add an updated Snode info instead
-func (m *smapX) handleDuplicateNode(nsi *cluster.Snode, del bool) (err error) {
-	var osi *cluster.Snode
+// so that the caller can add an updated Snode info instead;
+// otherwise, return the conflicting (old) node along with the error
+func (m *smapX) handleDuplicateNode(nsi *cluster.Snode, del bool) (osi *cluster.Snode, err error) {
 	if osi, err = m.IsDuplicate(nsi); err == nil {
 		return
 	}
@@ -368,7 +448,7 @@ func (m *smapX) handleDuplicateNode(nsi *cluster.Snode, del bool) (err error) {
 	if !del {
 		return
 	}
-	// TODO: more diligence in determining old-ness
+	// auto-resolution (see cmn.ClusterConf) - no diligence in determining old-ness
 	glog.Errorf("%v: removing old (?) %s from the current %s and future Smaps", err, osi, m)
 	err = nil
 	if osi.IsProxy() {
@@ -427,6 +507,9 @@ func (m *smapX) setNodeFlags(sid string, flags cos.BitFlags) {
 // Must be called under lock
 func (m *smapX) clearNodeFlags(id string, flags cos.BitFlags) {
 	si := m.GetNode(id)
+	if flags.IsSet(cluster.NodeFlagMaint) {
+		si.MaintUntil = 0
+	}
 	m._applyFlags(si, si.Flags.Clear(flags))
 }
 
@@ -434,11 +517,13 @@ func (m *smapX) clearNodeFlags(id string, flags cos.BitFlags) {
 // smapOwner //
 ///////////////
 
-func newSmapOwner(config *cmn.Config) *smapOwner {
-	return &smapOwner{
+func newSmapOwner(config *cmn.Config, sid string) *smapOwner {
+	r := &smapOwner{
 		sls:   newSmapListeners(),
 		fpath: filepath.Join(config.ConfigDir, fname.Smap),
 	}
+	r.hist.init(config, sid)
+	return r
 }
 
 func (r *smapOwner) load(smap *smapX) (loaded bool, err error) {
@@ -465,12 +550,15 @@ func (r *smapOwner) Listeners() cluster.SmapListeners { return r.sls }
 func (r *smapOwner) put(smap *smapX) {
 	smap.InitDigests()
 	smap.vstr = strconv.FormatInt(smap.Version, 10)
-	r.smap.Store(unsafe.Pointer(smap))
+	r.hist.add(r.get(), smap)
+	r.smap.Store(smap)
 	r.sls.notify(smap.version())
 }
 
+// NOTE: typed atomic pointer - pointer-size loads and stores are atomic and need no special
+// alignment on all supported platforms, including 32-bit ARM and x86; puts are serialized via r.mu
 func (r *smapOwner) get() (smap *smapX) {
-	return (*smapX)(r.smap.Load())
+	return r.smap.Load()
 }
 
 func (r *smapOwner) synchronize(si *cluster.Snode, newSmap *smapX, payload msPayload) (err error) {
@@ -545,6 +633,7 @@ func (r *smapOwner) _runPre(ctx *smapModifier) (clone *smapX, err error) {
 	if err = ctx.pre(ctx, clone); err != nil {
 		return
 	}
+	clone.Cause = ctx.cause()
 	clone._sgl = clone._encode(r.immSize)
 	r.immSize = cos.MaxI64(r.immSize, clone._sgl.Len())
 	if err := r.persist(clone); err != nil {
@@ -572,6 +661,118 @@ func (r *smapOwner) modify(ctx *smapModifier) error {
 	return nil
 }
 
+//////////////////
+// smapModifier //
+//////////////////
+
+// what produced the new Smap version: the action (apc.Act*) carried by the modifier's message,
+// or else the message's free-form description (see e.g. palive._pre)
+func (ctx *smapModifier) cause() string {
+	if ctx.msg == nil {
+		return ""
+	}
+	if ctx.msg.Action != "" {
+		return ctx.msg.Action
+	}
+	if s, ok := ctx.msg.Value.(string); ok {
+		return s
+	}
+	return ""
+}
+
+/////////////////
+// mergeResult //
+/////////////////
+
+const mergeSkipPresent = "already present"
+
+func (res *mergeResult) skip(si *cluster.Snode, collision bool, dstType string) {
+	reason := mergeSkipPresent
+	if collision {
+		reason = "DaemonID collision: " + dstType + " with the same ID already present"
+	}
+	res.skipped = append(res.skipped, &mergeSkip{si: si, reason: reason})
+}
+
+// anything other than adding new and skipping already present nodes
+func (res *mergeResult) notable() bool {
+	if len(res.resolved) > 0 || len(res.dups) > 0 {
+		return true
+	}
+	for _, s := range res.skipped {
+		if s.reason != mergeSkipPresent {
+			return true
+		}
+	}
+	return false
+}
+
+func (res *mergeResult) String() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "merge: added %d, skipped %d, resolved %d, conflicts %d",
+		len(res.added), len(res.skipped), len(res.resolved), len(res.dups))
+	for _, s := range res.skipped {
+		if s.reason != mergeSkipPresent {
+			fmt.Fprintf(&sb, "; skipped %s (%s)", s.si.StringEx(), s.reason)
+		}
+	}
+	for _, dup := range res.resolved {
+		fmt.Fprintf(&sb, "; removed %s in favor of %s", dup.Osi.StringEx(), dup.Nsi.StringEx())
+	}
+	for _, dup := range res.dups {
+		fmt.Fprintf(&sb, "; conflict %s vs %s", dup.Osi.StringEx(), dup.Nsi.StringEx())
+	}
+	return sb.String()
+}
+
+//////////////
+// dupNodes //
+//////////////
+
+func newDupNodes(osi, nsi *cluster.Snode, err error) *cluster.DupNodes {
+	return &cluster.DupNodes{Osi: osi, Nsi: nsi, Err: err.Error(), Time: time.Now().UnixNano()}
+}
+
+func (d *dupNodes) add(dups ...*cluster.DupNodes) {
+	d.mu.Lock()
+outer:
+	for _, dup := range dups {
+		for i, e := range d.all {
+			if e.Osi.ID() == dup.Osi.ID() && e.Nsi.ID() == dup.Nsi.ID() && e.Nsi.PubNet.URL == dup.Nsi.PubNet.URL {
+				d.all[i] = dup // refresh
+				continue outer
+			}
+		}
+		glog.Warningf("duplicate nodes %s and %s pending resolution: %s", dup.Osi.StringEx(), dup.Nsi.StringEx(), dup.Err)
+		d.all = append(d.all, dup)
+	}
+	d.mu.Unlock()
+}
+
+func (d *dupNodes) list() (all []*cluster.DupNodes) {
+	d.mu.Lock()
+	all = make([]*cluster.DupNodes, len(d.all))
+	copy(all, d.all)
+	d.mu.Unlock()
+	return
+}
+
+// remove and return all conflicts involving a given node
+func (d *dupNodes) del(sid string) (dups []*cluster.DupNodes) {
+	d.mu.Lock()
+	all := d.all[:0]
+	for _, e := range d.all {
+		if e.Osi.ID() == sid || e.Nsi.ID() == sid {
+			dups = append(dups, e)
+		} else {
+			all = append(all, e)
+		}
+	}
+	d.all = all
+	d.mu.Unlock()
+	return
+}
+
 /////////
 // sls //
 /////////
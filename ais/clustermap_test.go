@@ -0,0 +1,332 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"errors"
+	"strconv"
+	"sync"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Smap duplicate nodes", func() {
+	var (
+		autoResolve bool
+		owner       *smapOwner
+	)
+
+	newTarget := func(id, port string) *cluster.Snode {
+		ni := *cluster.NewNetInfo("http", "127.0.0.1", port)
+		return cluster.NewSnode(id, apc.Target, ni, ni, ni)
+	}
+	newProxy := func(id, port string) *cluster.Snode {
+		ni := *cluster.NewNetInfo("http", "127.0.0.1", port)
+		return cluster.NewSnode(id, apc.Proxy, ni, ni, ni)
+	}
+	setAutoResolve := func(v bool) {
+		config := cmn.GCO.BeginUpdate()
+		config.Cluster.ManualResolveDupNodes = !v
+		cmn.GCO.CommitUpdate(config)
+	}
+
+	BeforeEach(func() {
+		autoResolve = !cmn.GCO.Get().Cluster.ManualResolveDupNodes
+		owner = newSmapOwner(cmn.GCO.Get(), "")
+	})
+	AfterEach(func() {
+		setAutoResolve(autoResolve)
+	})
+
+	Describe("same IP, different ID", func() {
+		var (
+			loaded, joined *smapX
+			osi, nsi       *cluster.Snode
+		)
+		BeforeEach(func() {
+			osi, nsi = newTarget("t-old", "9080"), newTarget("t-new", "9080")
+			loaded, joined = newSmap(), newSmap()
+			loaded.addTarget(osi)
+			joined.addTarget(nsi)
+		})
+
+		It("should remove old node when auto-resolving", func() {
+			setAutoResolve(true)
+			added, dups, err := joined.merge(loaded, true /*override*/)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(dups).To(BeEmpty())
+			Expect(added).To(Equal(1))
+			Expect(loaded.GetTarget("t-old")).To(BeNil())
+			Expect(loaded.GetTarget("t-new")).NotTo(BeNil())
+		})
+
+		It("should record conflict and keep old node otherwise", func() {
+			setAutoResolve(false)
+			added, dups, err := joined.merge(loaded, true /*override*/)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(added).To(Equal(0))
+			Expect(dups).To(HaveLen(1))
+			Expect(dups[0].Osi.ID()).To(Equal("t-old"))
+			Expect(dups[0].Nsi.ID()).To(Equal("t-new"))
+			Expect(loaded.GetTarget("t-old")).NotTo(BeNil())
+			Expect(loaded.GetTarget("t-new")).To(BeNil())
+
+			owner.dups.add(dups...)
+			owner.dups.add(dups...) // (idempotent)
+			Expect(owner.dups.list()).To(HaveLen(1))
+			Expect(owner.dups.del("t-other")).To(BeEmpty())
+			Expect(owner.dups.del("t-new")).To(HaveLen(1))
+			Expect(owner.dups.list()).To(BeEmpty())
+		})
+
+		It("should fail when not overriding", func() {
+			setAutoResolve(true)
+			_, _, err := joined.merge(loaded, false /*override*/)
+			Expect(err).To(HaveOccurred())
+			Expect(loaded.GetTarget("t-old")).NotTo(BeNil())
+		})
+	})
+
+	Describe("merge result", func() {
+		It("should report proxy vs target DaemonID collision", func() {
+			var (
+				tsi, psi       = newTarget("n-1", "9080"), newProxy("n-1", "9081")
+				loaded, joined = newSmap(), newSmap()
+			)
+			loaded.addTarget(tsi)
+			joined.addProxy(psi)
+			joined.addTarget(newTarget("t-2", "9082"))
+			res, err := joined.mergeEx(loaded, true /*override*/)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(res.added).To(HaveLen(1))
+			Expect(res.added[0].ID()).To(Equal("t-2"))
+			Expect(res.skipped).To(HaveLen(1))
+			Expect(res.skipped[0].si).To(Equal(psi))
+			Expect(res.skipped[0].reason).To(ContainSubstring("collision"))
+			Expect(res.notable()).To(BeTrue())
+			Expect(loaded.GetProxy("n-1")).To(BeNil())
+			Expect(loaded.GetTarget("n-1")).To(Equal(tsi))
+
+			// simple wrapper
+			added, dups, err := joined.merge(loaded, true /*override*/)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(added).To(Equal(0))
+			Expect(dups).To(BeEmpty())
+		})
+
+		It("should report auto-resolved duplicates", func() {
+			setAutoResolve(true)
+			var (
+				osi, nsi       = newTarget("t-old", "9080"), newTarget("t-new", "9080")
+				loaded, joined = newSmap(), newSmap()
+			)
+			loaded.addTarget(osi)
+			joined.addTarget(nsi)
+			res, err := joined.mergeEx(loaded, true /*override*/)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(res.added).To(HaveLen(1))
+			Expect(res.resolved).To(HaveLen(1))
+			Expect(res.resolved[0].Osi.ID()).To(Equal("t-old"))
+			Expect(res.skipped).To(BeEmpty())
+			Expect(res.String()).To(ContainSubstring("removed"))
+		})
+	})
+
+	Describe("same ID, different URL", func() {
+		It("should not be merged or auto-resolved", func() {
+			setAutoResolve(true)
+			var (
+				osi, nsi       = newTarget("t-dup", "9080"), newTarget("t-dup", "9081")
+				loaded, joined = newSmap(), newSmap()
+			)
+			loaded.addTarget(osi)
+			joined.addTarget(nsi)
+			added, dups, err := joined.merge(loaded, true /*override*/)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(dups).To(BeEmpty())
+			Expect(added).To(Equal(0))
+			Expect(loaded.GetTarget("t-dup").PubNet.URL).To(Equal(osi.PubNet.URL))
+		})
+
+		It("should be recorded and resolved by ID", func() {
+			var (
+				osi, nsi = newTarget("t-dup", "9080"), newTarget("t-dup", "9081")
+				nsi2     = newTarget("t-dup", "9082")
+			)
+			owner.dups.add(&cluster.DupNodes{Osi: osi, Nsi: nsi}, &cluster.DupNodes{Osi: osi, Nsi: nsi2})
+			Expect(owner.dups.list()).To(HaveLen(2))
+			dups := owner.dups.del("t-dup")
+			Expect(dups).To(HaveLen(2))
+			Expect(dups[0].Osi.ID()).To(Equal(dups[0].Nsi.ID()))
+			Expect(owner.dups.list()).To(BeEmpty())
+		})
+	})
+
+	Describe("invariants", func() {
+		var smap *smapX
+		BeforeEach(func() {
+			smap = newSmap()
+			smap.addProxy(newProxy("p-1", "8080"))
+			smap.addTarget(newTarget("t-1", "9080"))
+			smap.addTarget(newTarget("t-2", "9081"))
+			smap.Primary = smap.GetProxy("p-1")
+			smap.UUID = cos.GenUUID()
+		})
+		expectErr := func(sentinel error) {
+			err := smap.checkInvariants()
+			Expect(err).To(HaveOccurred())
+			Expect(errors.Is(err, sentinel)).To(BeTrue(), err.Error())
+			Expect(errors.Is(smap.validate(), sentinel)).To(BeTrue())
+		}
+
+		It("should pass", func() {
+			Expect(smap.checkInvariants()).NotTo(HaveOccurred())
+			Expect(smap.validate()).NotTo(HaveOccurred())
+		})
+		It("should detect node ID in both maps", func() {
+			smap.Tmap["p-1"] = newTarget("p-1", "9082")
+			expectErr(errSmapIDCollision)
+		})
+		It("should detect missing primary", func() {
+			smap.Primary = newProxy("p-2", "8081")
+			Expect(errors.Is(smap.checkInvariants(), errSmapNoPrimary)).To(BeTrue())
+		})
+		It("should detect non-electable primary", func() {
+			smap.Primary.Flags = smap.Primary.Flags.Set(cluster.SnodeNonElectable)
+			expectErr(errSmapNonElectable)
+		})
+		It("should detect invalid UUID", func() {
+			smap.UUID = ""
+			Expect(errors.Is(smap.checkInvariants(), errSmapNoUUID)).To(BeTrue())
+		})
+		It("should detect duplicate URL", func() {
+			smap.Tmap["t-3"] = newTarget("t-3", "9081")
+			expectErr(errSmapDupURL)
+		})
+	})
+
+	Describe("cause", func() {
+		It("should derive cause from the modifier's message", func() {
+			Expect((&smapModifier{}).cause()).To(BeEmpty())
+			Expect((&smapModifier{msg: &apc.ActMsg{Action: apc.ActSelfJoinTarget}}).cause()).To(Equal(apc.ActSelfJoinTarget))
+			Expect((&smapModifier{msg: &apc.ActMsg{Value: "keepalive: removing"}}).cause()).To(Equal("keepalive: removing"))
+		})
+	})
+
+	Describe("history", func() {
+		It("should retain the configured number of Smaps and record changes", func() {
+			config := cmn.GCO.BeginUpdate()
+			keep := config.Cluster.SmapHistory
+			config.Cluster.SmapHistory = 2
+			cmn.GCO.CommitUpdate(config)
+			defer func() {
+				config := cmn.GCO.BeginUpdate()
+				config.Cluster.SmapHistory = keep
+				cmn.GCO.CommitUpdate(config)
+			}()
+
+			owner.hist = smapHist{sid: "p-1"} // primary only; in memory (other tests may have configured ConfigDir)
+			smap := newSmap()
+			smap.addProxy(newProxy("p-1", "8080"))
+			smap.Primary = smap.GetProxy("p-1")
+			owner.put(smap)
+			for i := 0; i < 3; i++ {
+				clone := owner.get().clone()
+				clone.addTarget(newTarget("t-"+strconv.Itoa(i), strconv.Itoa(9080+i)))
+				owner.put(clone)
+			}
+			clone := owner.get().clone()
+			clone.Tmap["t-0"].Flags = clone.Tmap["t-0"].Flags.Set(cluster.NodeFlagMaint)
+			clone.Version++
+			clone.Cause = apc.ActStartMaintenance
+			owner.put(clone)
+			owner.put(clone) // (same version - ignored)
+			Expect(clone.clone().Cause).To(BeEmpty())
+
+			hist := owner.hist.list()
+			Expect(hist).To(HaveLen(5))
+			Expect(hist[1].Diff).To(Equal([]string{"+ t[t-0]"}))
+			Expect(hist[4].Diff).To(Equal([]string{"flags t[t-0]: none => maintenance"}))
+			Expect(hist[4].Cause).To(Equal(apc.ActStartMaintenance))
+			Expect(hist[3].Cause).To(BeEmpty())
+			Expect(hist[2].Retained).To(BeFalse())
+			Expect(hist[3].Retained).To(BeTrue())
+			Expect(owner.hist.get(hist[2].Version)).To(BeNil())
+			Expect(owner.hist.get(hist[4].Version)).To(Equal(clone))
+
+			nonPrimary := smapHist{sid: "p-2"}
+			nonPrimary.add(nil, clone)
+			Expect(nonPrimary.list()).To(BeEmpty())
+		})
+	})
+
+	Describe("concurrent access", func() {
+		It("should always get a complete Smap while another goroutine keeps putting new versions", func() {
+			const (
+				numReaders = 8
+				numPuts    = 500
+			)
+			owner.hist = smapHist{}
+			smap := newSmap()
+			smap.addProxy(newProxy("p-1", "8080"))
+			smap.Primary = smap.GetProxy("p-1")
+			owner.put(smap)
+			base := owner.get().version()
+
+			var (
+				wg   sync.WaitGroup
+				errs = make(chan error, numReaders)
+				stop = make(chan struct{})
+			)
+			for i := 0; i < numReaders; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					var prev int64
+					for {
+						select {
+						case <-stop:
+							return
+						default:
+						}
+						smap := owner.get()
+						ver := smap.version()
+						switch {
+						case ver < prev:
+							errs <- errors.New("version went back: " + strconv.FormatInt(prev, 10) + " => " + smap.vstr)
+							return
+						case smap.vstr != strconv.FormatInt(ver, 10):
+							errs <- errors.New("incomplete Smap v" + strconv.FormatInt(ver, 10) + ": vstr " + smap.vstr)
+							return
+						case int64(smap.CountTargets()) != ver-base:
+							errs <- errors.New("Smap v" + smap.vstr + ": unexpected number of targets " + strconv.Itoa(smap.CountTargets()))
+							return
+						}
+						prev = ver
+					}
+				}()
+			}
+			for i := 0; i < numPuts; i++ {
+				owner.mu.Lock()
+				clone := owner.get().clone()
+				clone.addTarget(newTarget("t-"+strconv.Itoa(i), strconv.Itoa(10000+i)))
+				owner.put(clone)
+				owner.mu.Unlock()
+			}
+			close(stop)
+			wg.Wait()
+			close(errs)
+			for err := range errs {
+				Expect(err).NotTo(HaveOccurred())
+			}
+			Expect(owner.get().CountTargets()).To(Equal(numPuts))
+		})
+	})
+})
@@ -28,6 +28,7 @@ type dpq struct {
 	fltPresence         string // QparamFltPresence
 	dontAddRemote       string // QparamDontAddRemote
 	etlName             string // QparamETLName
+	versionID, lsVers   string // QparamVersionID, QparamListVersions
 }
 
 var (
@@ -108,6 +109,12 @@ func (dpq *dpq) fromRawQ(rawQuery string) (err error) {
 			dpq.dontAddRemote = value
 		case apc.QparamETLName:
 			dpq.etlName = value
+		case apc.QparamVersionID:
+			if dpq.versionID, err = url.QueryUnescape(value); err != nil {
+				return
+			}
+		case apc.QparamListVersions:
+			dpq.lsVers = value
 
 		case s3.QparamMptUploadID, s3.QparamMptUploads, s3.QparamMptPartNo:
 			// TODO: ignore for now
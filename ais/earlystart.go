@@ -242,10 +242,18 @@ func (p *proxy) primaryStartup(loadedSmap *smapX, config *cmn.Config, ntargets i
 		p.owner.smap.mu.Lock()
 		clone := p.owner.smap.get().clone()
 		if loadedSmap != nil {
-			added, _ = clone.merge(loadedSmap, true /*override (IP, port) duplicates*/)
+			res, _ := clone.mergeEx(loadedSmap, true /*override (IP, port) duplicates*/)
+			if len(res.dups) > 0 {
+				p.owner.smap.dups.add(res.dups...)
+			}
+			if res.notable() {
+				glog.Warningln(p.String()+":", res)
+			}
+			added = len(res.added)
 			clone = loadedSmap
 			if added > 0 {
 				clone.Version = clone.Version + int64(added) + 1
+				clone.Cause = smapCauseMerge
 			}
 		}
 		// NOTE: use regpool to try to upgrade all the four revs: Smap, BMD, RMD, and global Config
@@ -593,7 +601,7 @@ merge:
 	clone := p.owner.smap.get().clone()
 	if !eq {
 		glog.Infof("%s: merge local %s <== %s", p.si.StringEx(), clone, svm.Smap)
-		_, err := svm.Smap.merge(clone, false /*err if detected (IP, port) duplicates*/)
+		_, _, err := svm.Smap.merge(clone, false /*err if detected (IP, port) duplicates*/)
 		if err != nil {
 			cos.ExitLogf("%s: %v vs %s", p.si, err, svm.Smap.StringEx())
 		}
@@ -12,6 +12,9 @@ import (
 	"net/url"
 	"os"
 	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
 	"sync"
 	"time"
 
@@ -23,6 +26,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn/fname"
 	"github.com/NVIDIA/aistore/cmn/jsp"
 	"github.com/NVIDIA/aistore/memsys"
+	jsoniter "github.com/json-iterator/go"
 )
 
 type (
@@ -220,13 +224,17 @@ func (co *configOwner) setDaemonConfig(toUpdate *cmn.ConfigToUpdate, transient b
 	return
 }
 
+// all or nothing: apply and validate the entire update first and only then
+// proceed to side effects (such as log level); the caller discards `config` upon error
 func setConfigInMem(toUpdate *cmn.ConfigToUpdate, config *cmn.Config, asType string) (err error) {
+	if err = config.UpdateClusterConfig(toUpdate, asType); err != nil {
+		return
+	}
 	if toUpdate.Log != nil && toUpdate.Log.Level != nil {
 		if err := cmn.SetLogLevel(*toUpdate.Log.Level); err != nil {
 			return fmt.Errorf("failed to set log level = %s, err: %v", *toUpdate.Log.Level, err)
 		}
 	}
-	err = config.UpdateClusterConfig(toUpdate, asType)
 	return
 }
 
@@ -248,3 +256,68 @@ func (co *configOwner) resetDaemonConfig() (err error) {
 	co.Unlock()
 	return
 }
+
+// names of the cluster config sections that can be individually reset
+// (all updatable sections except node-local "fspaths")
+func confSections() (sections []string) {
+	t := reflect.TypeOf(cmn.ConfigToUpdate{})
+	for i := 0; i < t.NumField(); i++ {
+		tag, _, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+		if tag != "" && tag != "fspaths" {
+			sections = append(sections, tag)
+		}
+	}
+	sort.Strings(sections)
+	return
+}
+
+// given the current and the initial cluster config, returns the update that reverts
+// a single named section to its initial values along with the reverted keys
+// (name => initial value); empty `reverted` means nothing to do
+func resetConfSection(current, initial *cmn.ClusterConfig, section string) (toUpdate *cmn.ConfigToUpdate,
+	reverted cos.StrKVs, err error) {
+	sections := confSections()
+	if i := sort.SearchStrings(sections, section); i == len(sections) || sections[i] != section {
+		err = fmt.Errorf("invalid config section %q (expecting one of: %v)", section, sections)
+		return
+	}
+	var (
+		all map[string]jsoniter.RawMessage
+		b   = cos.MustMarshal(initial)
+	)
+	if err = jsoniter.Unmarshal(b, &all); err != nil {
+		return
+	}
+	toUpdate = &cmn.ConfigToUpdate{}
+	b = cos.MustMarshal(map[string]jsoniter.RawMessage{section: all[section]})
+	if err = jsoniter.Unmarshal(b, toUpdate); err != nil {
+		return
+	}
+
+	// diff
+	flatten := func(config *cmn.ClusterConfig) cos.StrKVs {
+		flat := make(cos.StrKVs, 16)
+		cmn.IterFields(config, func(tag string, field cmn.IterField) (error, bool) {
+			if tag == section || strings.HasPrefix(tag, section+".") {
+				flat[tag] = fmt.Sprintf("%v", field.Value())
+			}
+			return nil, false
+		})
+		return flat
+	}
+	var (
+		cur = flatten(current)
+		ini = flatten(initial)
+	)
+	reverted = make(cos.StrKVs, 4)
+	for name, v := range ini {
+		if cur[name] == v {
+			continue
+		}
+		if cmn.IsConfigSecret(name) {
+			v = "" // reverted but never returned (e.g., "auth.secret")
+		}
+		reverted[name] = v
+	}
+	return
+}
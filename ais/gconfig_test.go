@@ -0,0 +1,63 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+func TestResetConfSection(t *testing.T) {
+	var (
+		initial = &cmn.ClusterConfig{}
+		current = &cmn.ClusterConfig{}
+	)
+	initial.LRU.Enabled, initial.LRU.DontEvictTime = true, cos.Duration(2*time.Hour)
+	initial.Space.HighWM = 90
+	*current = *initial
+	current.LRU.Enabled, current.LRU.DontEvictTime = false, cos.Duration(time.Minute)
+	current.Space.HighWM = 80
+
+	toUpdate, reverted, err := resetConfSection(current, initial, "lru")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if toUpdate.LRU == nil || toUpdate.Space != nil {
+		t.Fatalf("expected update of the 'lru' section only, got %+v", toUpdate)
+	}
+	if len(reverted) != 2 || reverted["lru.enabled"] != "true" || reverted["lru.dont_evict_time"] != "2h0m" {
+		t.Fatalf("unexpected reverted keys: %v", reverted)
+	}
+	if err := current.Apply(toUpdate, apc.Cluster); err != nil {
+		t.Fatal(err)
+	}
+	if current.LRU != initial.LRU || current.Space.HighWM != 80 {
+		t.Fatalf("expected 'lru' reset and 'space' intact, got %+v, %+v", current.LRU, current.Space)
+	}
+
+	// nothing to revert
+	if _, reverted, err = resetConfSection(current, initial, "lru"); err != nil || len(reverted) != 0 {
+		t.Fatalf("expected nothing to revert, got %v (err %v)", reverted, err)
+	}
+	// secrets are reverted but not returned
+	initial.Auth.Secret, current.Auth.Secret = "initial-secret", "current-secret"
+	toUpdate, reverted, err = resetConfSection(current, initial, "auth")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, ok := reverted["auth.secret"]; !ok || v != "" || toUpdate.Auth == nil || *toUpdate.Auth.Secret != "initial-secret" {
+		t.Fatalf("expected 'auth.secret' reverted with its value withheld, got %v", reverted)
+	}
+
+	for _, section := range []string{"", "lr", "fspaths", "lru.enabled", "uuid"} {
+		if _, _, err := resetConfSection(current, initial, section); err == nil {
+			t.Fatalf("expected invalid section %q to fail", section)
+		}
+	}
+}
@@ -98,6 +98,7 @@ type (
 		nodes             []cluster.NodeMap // broadcast destinations - map(s)
 		selected          cluster.Nodes     // broadcast destinations - slice of selected few
 		timeout           time.Duration     // call timeout
+		pace              time.Duration     // space out (rather than burst) sends by so much plus jitter
 		to                int               // (all targets, all proxies, all nodes) enum
 		nodeCount         int               // m.b. greater or equal destination count
 		ignoreMaintenance bool              // do not skip nodes under maintenance
@@ -421,6 +422,7 @@ type (
 	cresEM struct{} // -> etl.CPUMemUsed
 	cresIC struct{} // -> icBundle
 	cresBM struct{} // -> bucketMD
+	cresDV struct{} // -> apc.DecommVerifyResult
 
 	cresLso   struct{} // -> cmn.LsoResult
 	cresBsumm struct{} // -> cmn.AllBsummResults
@@ -437,6 +439,7 @@ var (
 	_ cresv = cresEM{}
 	_ cresv = cresIC{}
 	_ cresv = cresBM{}
+	_ cresv = cresDV{}
 	_ cresv = cresBsumm{}
 )
 
@@ -466,6 +469,9 @@ func (c cresND) read(res *callResult, body io.Reader) { res.v = c.newV(); res.jr
 func (cresBA) newV() any                              { return &cluster.Remotes{} }
 func (c cresBA) read(res *callResult, body io.Reader) { res.v = c.newV(); res.jread(body) }
 
+func (cresDV) newV() any                              { return &apc.DecommVerifyResult{} }
+func (c cresDV) read(res *callResult, body io.Reader) { res.v = c.newV(); res.jread(body) }
+
 func (cresEI) newV() any                              { return &etl.InfoList{} }
 func (c cresEI) read(res *callResult, body io.Reader) { res.v = c.newV(); res.jread(body) }
 
@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"io"
 	"log"
+	"math/rand"
 	"net/http"
 	"net/url"
 	"os"
@@ -41,6 +42,8 @@ import (
 
 const ciePrefix = "cluster integrity error cie#"
 
+const logFollowInterval = time.Second // see `followLog`
+
 type htrun struct {
 	si        *cluster.Snode
 	keepalive keepaliver
@@ -287,7 +290,7 @@ func (h *htrun) init(config *cmn.Config) {
 		h.netServ.data = &netServer{muxers: muxers, sndRcvBufSize: tcpbuf}
 	}
 
-	h.owner.smap = newSmapOwner(config)
+	h.owner.smap = newSmapOwner(config, h.si.ID())
 	h.owner.rmd = newRMDOwner()
 	h.owner.rmd.load()
 
@@ -795,6 +798,10 @@ func (h *htrun) bcastNodes(bargs *bcastArgs) sliceResults {
 	if !bargs.async {
 		results.s = allocBcastRes(len(bargs.nodes))
 	}
+	var pacer *bcastPacer
+	if bargs.pace > 0 {
+		pacer = newBcastPacer(bargs.pace)
+	}
 	for _, nodeMap := range bargs.nodes {
 		for _, si := range nodeMap {
 			if si.ID() == h.si.ID() {
@@ -803,6 +810,9 @@ func (h *htrun) bcastNodes(bargs *bcastArgs) sliceResults {
 			if !bargs.ignoreMaintenance && si.InMaintOrDecomm() {
 				continue
 			}
+			if pacer != nil {
+				pacer.wait()
+			}
 			wg.Add(1)
 			go f(si)
 		}
@@ -811,6 +821,25 @@ func (h *htrun) bcastNodes(bargs *bcastArgs) sliceResults {
 	return results.s
 }
 
+// space out consecutive sends by `pace` plus random jitter (up to pace/4)
+type bcastPacer struct {
+	rnd  *rand.Rand
+	pace time.Duration
+	cnt  int
+}
+
+func newBcastPacer(pace time.Duration) *bcastPacer {
+	return &bcastPacer{rnd: cos.NowRand(), pace: pace}
+}
+
+func (bp *bcastPacer) wait() {
+	if bp.cnt > 0 {
+		jitter := time.Duration(bp.rnd.Int63n(int64(bp.pace)/4 + 1))
+		time.Sleep(bp.pace + jitter)
+	}
+	bp.cnt++
+}
+
 func (h *htrun) bcastSelected(bargs *bcastArgs) sliceResults {
 	var (
 		results bcastResults
@@ -1000,6 +1029,30 @@ func (h *htrun) httpdaeget(w http.ResponseWriter, r *http.Request, query url.Val
 		body = cmn.GCO.Get()
 	case apc.WhatSmap:
 		body = h.owner.smap.get()
+	case apc.WhatSmapCheck:
+		smap := h.owner.smap.get()
+		if err := smap.checkInvariants(); err != nil {
+			h.writeErr(w, r, err, http.StatusInternalServerError)
+			return
+		}
+		body = smap.StringEx()
+	case apc.WhatSmapHist:
+		sver := query.Get(apc.QparamSmapVersion)
+		if sver == "" {
+			body = h.owner.smap.hist.list()
+			break
+		}
+		ver, err := strconv.ParseInt(sver, 10, 64)
+		if err != nil {
+			h.writeErrf(w, r, "invalid %s=%q: %v", apc.QparamSmapVersion, sver, err)
+			return
+		}
+		smap := h.owner.smap.hist.get(ver)
+		if smap == nil {
+			h.writeErrStatusf(w, r, http.StatusNotFound, "%s: Smap v%d is not retained", h.si, ver)
+			return
+		}
+		body = smap
 	case apc.WhatBMD:
 		body = h.owner.bmd.get()
 	case apc.WhatSmapVote:
@@ -1042,8 +1095,16 @@ func (h *htrun) sendlog(w http.ResponseWriter, r *http.Request, query url.Values
 		h.writeErr(w, r, err, errCode)
 		return
 	}
+	follow := cos.IsParseBool(query.Get(apc.QparamLogFlw))
 	soff := query.Get(apc.QparamLogOff)
-	if soff != "" {
+	if soff == "" && follow {
+		// tail: start from the end
+		if _, err := fh.Seek(0, io.SeekEnd); err != nil {
+			cos.Close(fh)
+			h.writeErr(w, r, err)
+			return
+		}
+	} else if soff != "" {
 		var (
 			off   int64
 			err   error
@@ -1067,15 +1128,60 @@ func (h *htrun) sendlog(w http.ResponseWriter, r *http.Request, query url.Values
 			return
 		}
 	}
+	if follow {
+		if off, err := fh.Seek(0, io.SeekCurrent); err == nil {
+			w.Header().Set(apc.HdrLogOffset, strconv.FormatInt(off, 10))
+		}
+	}
 	buf, slab := h.gmm.Alloc()
 	if written, err := io.CopyBuffer(w, fh, buf); err != nil {
 		// at this point, http err must be already on its way
 		glog.Errorf("failed to read %s: %v (written=%d)", log, err, written)
+	} else if follow {
+		fh = h.followLog(w, r, fh, log, buf)
 	}
 	cos.Close(fh)
 	slab.Free(buf)
 }
 
+// keep sending log updates until the client goes away (or this node stops);
+// upon rotation, continue with the new log (from the beginning)
+func (h *htrun) followLog(w http.ResponseWriter, r *http.Request, fh *os.File, log string, buf []byte) *os.File {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		return fh
+	}
+	flusher.Flush()
+	ticker := time.NewTicker(logFollowInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-r.Context().Done():
+			return fh
+		case <-ticker.C:
+		}
+		if daemon.stopping.Load() {
+			return fh
+		}
+		if finfo, err := fh.Stat(); err == nil {
+			if linfo, err := os.Stat(log); err == nil && !os.SameFile(finfo, linfo) {
+				if nfh, err := os.Open(log); err == nil {
+					cos.Close(fh)
+					fh = nfh
+				}
+			}
+		}
+		written, err := io.CopyBuffer(w, fh, buf)
+		if err != nil {
+			glog.Errorf("failed to follow %s: %v", log, err)
+			return fh
+		}
+		if written > 0 {
+			flusher.Flush()
+		}
+	}
+}
+
 func _sev2logname(sev string) (log string, err error) {
 	dir := cmn.GCO.Get().LogDir
 	if sev == "" {
@@ -1687,10 +1793,73 @@ func (h *htrun) sendKalive(smap *smapX, timeout time.Duration) (pid string, stat
 		return
 	}
 	debug.Assert(len(res.bytes) == 0)
+	if s := res.header.Get(apc.HdrRevsVersions); s != "" {
+		h.updMetasyncLag(s)
+	}
 	freeCR(res)
 	return
 }
 
+// (primary's) versions of the cluster-level metadata, e.g. "Smap:12,BMD:3,RMD:4,Conf:7,EtlMD:1"
+func (h *htrun) revsVersions() string {
+	var (
+		sb  strings.Builder
+		vv  = h._revsVersions()
+		sep string
+	)
+	for _, tag := range []string{revsSmapTag, revsBMDTag, revsRMDTag, revsConfTag, revsEtlMDTag} {
+		sb.WriteString(sep)
+		sb.WriteString(tag)
+		sb.WriteByte(':')
+		sb.WriteString(strconv.FormatInt(vv[tag], 10))
+		sep = ","
+	}
+	return sb.String()
+}
+
+func (h *htrun) _revsVersions() map[string]int64 {
+	vv := make(map[string]int64, 5)
+	vv[revsSmapTag] = h.owner.smap.get().version()
+	vv[revsBMDTag] = h.owner.bmd.get().version()
+	vv[revsRMDTag] = h.owner.rmd.get().version()
+	vv[revsConfTag] = h.owner.config.version()
+	if etlMD := h.owner.etl.get(); etlMD != nil {
+		vv[revsEtlMDTag] = etlMD.version()
+	}
+	return vv
+}
+
+// compare primary's versions (see `revsVersions`) with the local ones and update metasync lag stats
+func (h *htrun) updMetasyncLag(s string) {
+	vv := h._revsVersions()
+	for _, kv := range strings.Split(s, ",") {
+		tag, sver, ok := strings.Cut(kv, ":")
+		if !ok {
+			continue
+		}
+		pver, err := strconv.ParseInt(sver, 10, 64)
+		if err != nil {
+			continue
+		}
+		var name string
+		switch tag {
+		case revsSmapTag:
+			name = stats.MetasyncLagSmap
+		case revsBMDTag:
+			name = stats.MetasyncLagBMD
+		case revsRMDTag:
+			name = stats.MetasyncLagRMD
+		case revsConfTag:
+			name = stats.MetasyncLagConfig
+		case revsEtlMDTag:
+			name = stats.MetasyncLagEtlMD
+		default:
+			continue
+		}
+		h.statsT.SetGauge(name, cos.MaxI64(pver-vv[tag], 0))
+	}
+}
+
 func (h *htrun) getPrimaryURLAndSI(smap *smapX) (url string, psi *cluster.Snode) {
 	if smap == nil {
 		smap = h.owner.smap.get()
@@ -1969,6 +2138,8 @@ func (h *htrun) _status(smap *smapX) (daeStatus string) {
 		daeStatus = apc.NodeMaintenance
 	case self.Flags.IsSet(cluster.NodeFlagDecomm):
 		daeStatus = apc.NodeDecommission
+	case self.Flags.IsSet(cluster.NodeFlagProbation):
+		daeStatus = apc.NodeProbation
 	}
 	return
 }
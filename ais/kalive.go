@@ -189,6 +189,9 @@ func (pkr *palive) do() (stopped bool) {
 		return
 	}
 	if smap.isPrimary(pkr.p.si) {
+		if pkr.p.inPrimaryTransition.Load() {
+			return // handing over - no Smap changes
+		}
 		return pkr.updateSmap()
 	}
 	if !pkr.isTimeToPing(smap.Primary.ID()) {
@@ -554,6 +554,7 @@ func (y *metasyncer) handlePending() (failedCnt int) {
 	args.req = cmn.HreqArgs{Method: http.MethodPut, Path: urlPath, BodyR: body}
 	args.network = cmn.NetIntraControl
 	args.timeout = cmn.Timeout.MaxKeepalive()
+	args.pace = cmn.GCO.Get().Metasync.RetransmitInterval.D() // avoid bursts (e.g., when many nodes are lagging)
 	args.nodes = []cluster.NodeMap{pending}
 	args.nodeCount = len(pending)
 	defer body.Free()
@@ -70,7 +70,7 @@ func newPrimary() *proxy {
 		smap    = newSmap()
 	)
 
-	p.owner.smap = newSmapOwner(cmn.GCO.Get())
+	p.owner.smap = newSmapOwner(cmn.GCO.Get(), "")
 	p.si = cluster.NewSnode("primary", apc.Proxy, cluster.NetInfo{}, cluster.NetInfo{}, cluster.NetInfo{})
 
 	smap.addProxy(p.si)
@@ -109,7 +109,7 @@ func newPrimary() *proxy {
 func newSecondary(name string) *proxy {
 	p := &proxy{}
 	p.si = cluster.NewSnode(name, apc.Proxy, cluster.NetInfo{}, cluster.NetInfo{}, cluster.NetInfo{})
-	p.owner.smap = newSmapOwner(cmn.GCO.Get())
+	p.owner.smap = newSmapOwner(cmn.GCO.Get(), "")
 	p.owner.smap.put(newSmap())
 	p.client.data = &http.Client{}
 	p.client.control = &http.Client{}
@@ -669,6 +669,81 @@ func TestMetasyncData(t *testing.T) {
 	syncer.sync(revsPair{bmd, msg})
 }
 
+// TestMetasyncPacedRetransmit checks that retransmissions to lagging nodes
+// are spaced out by (at least) the configured interval
+func TestMetasyncPacedRetransmit(t *testing.T) {
+	const (
+		numNodes = 4
+		interval = 100 * time.Millisecond
+	)
+	primary := newPrimary()
+	config := cmn.GCO.BeginUpdate()
+	config.Metasync.RetransmitInterval = cos.Duration(interval)
+	cmn.GCO.CommitUpdate(config)
+	defer func() {
+		config := cmn.GCO.BeginUpdate()
+		config.Metasync.RetransmitInterval = 0
+		cmn.GCO.CommitUpdate(config)
+	}()
+
+	syncer := testSyncer(primary)
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func(wg *sync.WaitGroup) {
+		defer wg.Done()
+		syncer.Run()
+	}(&wg)
+
+	var (
+		mu      sync.Mutex
+		retrans []time.Time
+		ch      = make(chan struct{}, numNodes)
+	)
+	for i := 0; i < numNodes; i++ {
+		var cnt atomic.Int32
+		s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if cnt.Inc() == 1 { // fail the initial sync
+				http.Error(w, "fail first call", http.StatusForbidden)
+				return
+			}
+			mu.Lock()
+			retrans = append(retrans, time.Now())
+			mu.Unlock()
+			ch <- struct{}{}
+		}))
+		defer s.Close()
+
+		id := "t" + strconv.Itoa(i)
+		addrInfo := serverTCPAddr(s.URL)
+		clone := primary.owner.smap.get().clone()
+		clone.Tmap[id] = cluster.NewSnode(id, apc.Target, addrInfo, addrInfo, addrInfo)
+		clone.Version++
+		primary.owner.smap.put(clone)
+	}
+
+	smap := primary.owner.smap.get()
+	syncer.sync(revsPair{smap, primary.newAmsgStr("", nil)})
+	for i := 0; i < numNodes; i++ {
+		select {
+		case <-ch:
+		case <-time.After(10 * time.Second):
+			t.Fatalf("timed out waiting for retransmissions (%d out of %d)", i, numNodes)
+		}
+	}
+	syncer.Stop(nil)
+	wg.Wait()
+
+	mu.Lock()
+	defer mu.Unlock()
+	sort.Slice(retrans, func(i, j int) bool { return retrans[i].Before(retrans[j]) })
+	for i := 1; i < len(retrans); i++ {
+		// allowing for scheduling and network delays
+		if d := retrans[i].Sub(retrans[i-1]); d < interval/2 {
+			t.Errorf("retransmissions %d and %d are only %v apart (expecting at least %v)", i-1, i, d, interval)
+		}
+	}
+}
+
 // TestMetasyncMembership tests metasync's logic when accessing proxy's smap directly
 func TestMetasyncMembership(t *testing.T) {
 	{
@@ -54,7 +54,7 @@ func newDiscoverServerPrimary() *proxy {
 	config.Cksum.Type = cos.ChecksumXXHash
 	cmn.GCO.CommitUpdate(config)
 
-	p.owner.smap = newSmapOwner(config)
+	p.owner.smap = newSmapOwner(config, "")
 	p.owner.smap.put(newSmap())
 	owner := newBMDOwnerPrx(config)
 	owner.put(newBucketMD())
@@ -35,6 +35,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn/fname"
 	"github.com/NVIDIA/aistore/cmn/mono"
 	"github.com/NVIDIA/aistore/ext/dsort"
+	"github.com/NVIDIA/aistore/hk"
 	"github.com/NVIDIA/aistore/memsys"
 	"github.com/NVIDIA/aistore/nl"
 	"github.com/NVIDIA/aistore/stats"
@@ -186,6 +187,7 @@ func (p *proxy) Run() error {
 	p.notifs.init(p)
 	p.ic.init(p)
 	p.qm.init()
+	hk.Reg("maint-auto-resume"+hk.NameSuffix, p.maintAutoResume, maintResumeIval)
 
 	//
 	// REST API: register proxy handlers and start listening
@@ -1017,6 +1019,10 @@ func (p *proxy) httpbckput(w http.ResponseWriter, r *http.Request) {
 			p.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, p.si, msg.Action, msg.Value, err)
 			return
 		}
+		if err := archMsg.ErrLimits.Validate(); err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
 		bckTo := cluster.CloneBck(&archMsg.ToBck)
 		if bckTo.IsEmpty() {
 			bckTo = bckFrom
@@ -1028,10 +1034,16 @@ func (p *proxy) httpbckput(w http.ResponseWriter, r *http.Request) {
 				return
 			}
 		}
-		if _, err := cos.Mime(archMsg.Mime, archMsg.ArchName); err != nil {
+		mime, err := cos.Mime(archMsg.Mime, archMsg.ArchName)
+		if err != nil {
 			p.writeErr(w, r, err)
 			return
 		}
+		if archMsg.AllowAppendToExisting && mime != cos.ExtTar {
+			p.writeErrf(w, r, "cannot append to %s: appending is supported only for %s archives (not %s)",
+				bckTo.Cname(archMsg.ArchName), cos.ExtTar, mime)
+			return
+		}
 		xid, err := p.createArchMultiObj(bckFrom, bckTo, msg)
 		if err == nil {
 			w.Write([]byte(xid))
@@ -1263,12 +1275,43 @@ func (p *proxy) _bckpost(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg
 			p.writeErrf(w, r, fmtNotRemote, bucket)
 			return
 		}
+		prfmsg := &cmn.PrefetchMsg{}
+		if err = cos.MorphMarshal(msg.Value, prfmsg); err != nil {
+			p.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, p.si, msg.Action, msg.Value, err)
+			return
+		}
+		if err = prfmsg.Validate(); err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
 		var xid string
 		if xid, err = p.listrange(r.Method, bucket, msg, query); err != nil {
 			p.writeErr(w, r, err)
 			return
 		}
 		w.Write([]byte(xid))
+	case apc.ActRechecksum:
+		var (
+			xid    string
+			rcsmsg = &cmn.RechecksumMsg{}
+		)
+		if err = cos.MorphMarshal(msg.Value, rcsmsg); err != nil {
+			p.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, p.si, msg.Action, msg.Value, err)
+			return
+		}
+		if err = cos.ValidateCksumType(rcsmsg.CksumType); err != nil || rcsmsg.CksumType == cos.ChecksumNone {
+			p.writeErrf(w, r, "%s %s: invalid checksum type %q", msg.Action, bck, rcsmsg.CksumType)
+			return
+		}
+		if rcsmsg.IsList() && rcsmsg.HasTemplate() {
+			p.writeErrf(w, r, "%s %s: list and template are mutually exclusive", msg.Action, bck)
+			return
+		}
+		if xid, err = p.listrange(r.Method, bucket, msg, query); err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
+		w.Write([]byte(xid))
 	case apc.ActInvalListCache:
 		p.qm.c.invalidate(bck.Bucket())
 	case apc.ActMakeNCopies:
@@ -1547,7 +1590,7 @@ func (p *proxy) httpobjpost(w http.ResponseWriter, r *http.Request) {
 	}
 	apireq := apiReqAlloc(1, apc.URLPathObjects.L, false /*dpq*/)
 	defer apiReqFree(apireq)
-	if msg.Action == apc.ActRenameObject {
+	if msg.Action == apc.ActRenameObject || msg.Action == apc.ActCopyObject || msg.Action == apc.ActExtract {
 		apireq.after = 2
 	}
 	if err := p.parseReq(w, r, apireq); err != nil {
@@ -1575,6 +1618,16 @@ func (p *proxy) httpobjpost(w http.ResponseWriter, r *http.Request) {
 		}
 		p.objMv(w, r, bck, apireq.items[1], msg)
 		return
+	case apc.ActCopyObject:
+		if err := p.checkAccess(w, r, bck, apc.AcePUT); err != nil {
+			return
+		}
+		if bck.IsRemote() {
+			p.writeErrActf(w, r, msg.Action, "not supported for remote buckets (%s)", bck)
+			return
+		}
+		p.objMv(w, r, bck, apireq.items[1], msg)
+		return
 	case apc.ActPromote:
 		if err := p.checkAccess(w, r, bck, apc.AcePromote); err != nil {
 			return
@@ -1608,6 +1661,29 @@ func (p *proxy) httpobjpost(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 		w.Write([]byte(xid))
+	case apc.ActExtract:
+		if err := p.checkAccess(w, r, bck, apc.AceGET); err != nil {
+			return
+		}
+		extMsg := &cmn.ExtractMsg{}
+		if err := cos.MorphMarshal(msg.Value, extMsg); err != nil {
+			p.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, p.si, msg.Action, msg.Value, err)
+			return
+		}
+		bckTo := cluster.CloneBck(&extMsg.ToBck)
+		if err := bckTo.Init(p.owner.bmd); err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
+		if err := p.checkAccess(w, r, bckTo, apc.AcePUT); err != nil {
+			return
+		}
+		xid, err := p.extract(r, bck, apireq.items[1], msg)
+		if err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
+		w.Write([]byte(xid))
 	default:
 		p.writeErrAct(w, r, msg.Action)
 	}
@@ -2239,6 +2315,7 @@ func (p *proxy) lsObjsR(bck *cluster.Bck, lsmsg *apc.LsoMsg, smap *smapX, tsi *c
 	return cmn.MergeLso(resLists, 0), nil
 }
 
+// rename or copy (apc.ActCopyObject) - redirect to the target that stores the source
 func (p *proxy) objMv(w http.ResponseWriter, r *http.Request, bck *cluster.Bck, objName string, msg *apc.ActMsg) {
 	started := time.Now()
 	if objName == msg.Name {
@@ -2259,7 +2336,44 @@ func (p *proxy) objMv(w http.ResponseWriter, r *http.Request, bck *cluster.Bck,
 	redirectURL := p.redirectURL(r, si, started, cmn.NetIntraControl)
 	http.Redirect(w, r, redirectURL, http.StatusTemporaryRedirect)
 
-	p.statsT.Inc(stats.RenameCount)
+	if msg.Action == apc.ActRenameObject {
+		p.statsT.Inc(stats.RenameCount)
+	}
+}
+
+// extract archived files into individual objects - the work is done by
+// a single target: the one that stores the archive
+func (p *proxy) extract(r *http.Request, bck *cluster.Bck, archName string, msg *apc.ActMsg) (xid string, err error) {
+	smap := p.owner.smap.get()
+	tsi, err := cluster.HrwTarget(bck.MakeUname(archName), &smap.Smap)
+	if err != nil {
+		return
+	}
+	aisMsg := p.newAmsg(msg, nil, cos.GenUUID())
+	nlb := xact.NewXactNL(aisMsg.UUID, aisMsg.Action, &smap.Smap, cluster.NodeMap{tsi.ID(): tsi}, bck.Bucket())
+	nlb.SetOwner(equalIC)
+	p.ic.registerEqual(regIC{smap: smap, query: r.URL.Query(), nl: nlb})
+
+	cargs := allocCargs()
+	{
+		cargs.si = tsi
+		cargs.req = cmn.HreqArgs{
+			Method: http.MethodPost,
+			Path:   apc.URLPathObjects.Join(bck.Name, archName),
+			Query:  bck.AddToQuery(nil),
+			Body:   cos.MustMarshal(aisMsg),
+		}
+		cargs.timeout = apc.DefaultTimeout
+	}
+	res := p.call(cargs)
+	freeCargs(cargs)
+	if res.err != nil {
+		err = res.toErr()
+	} else {
+		xid = aisMsg.UUID
+	}
+	freeCR(res)
+	return
 }
 
 func (p *proxy) listrange(method, bucket string, msg *apc.ActMsg, query url.Values) (xid string, err error) {
@@ -2436,7 +2550,7 @@ func (p *proxy) httpdaeget(w http.ResponseWriter, r *http.Request) {
 			p.handlePendingRenamedLB(renamedBucket)
 		}
 		fallthrough // fallthrough
-	case apc.WhatConfig, apc.WhatSmapVote, apc.WhatSnode, apc.WhatLog,
+	case apc.WhatConfig, apc.WhatSmapVote, apc.WhatSmapCheck, apc.WhatSmapHist, apc.WhatSnode, apc.WhatLog,
 		apc.WhatNodeStats, apc.WhatMetricNames:
 		p.htrun.httpdaeget(w, r, query)
 	case apc.WhatSysInfo:
@@ -2777,7 +2891,10 @@ func (p *proxy) daeSetPrimary(w http.ResponseWriter, r *http.Request) {
 		}
 		return
 	}
-	ctx := &smapModifier{pre: func(_ *smapModifier, clone *smapX) error { clone.Primary = psi; return nil }}
+	ctx := &smapModifier{
+		pre: func(_ *smapModifier, clone *smapX) error { clone.Primary = psi; return nil },
+		msg: &apc.ActMsg{Action: apc.ActNewPrimary},
+	}
 	err = p.owner.smap.modify(ctx)
 	debug.AssertNoErr(err)
 }
@@ -2787,6 +2904,7 @@ func (p *proxy) becomeNewPrimary(proxyIDToRemove string) {
 		pre:   p._becomePre,
 		final: p._becomeFinal,
 		sid:   proxyIDToRemove,
+		msg:   &apc.ActMsg{Action: apc.ActNewPrimary},
 	}
 	err := p.owner.smap.modify(ctx)
 	cos.AssertNoErr(err)
@@ -19,12 +19,16 @@ import (
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/jsp"
+	"github.com/NVIDIA/aistore/cmn/mono"
 	"github.com/NVIDIA/aistore/nl"
 	"github.com/NVIDIA/aistore/stats"
 	"github.com/NVIDIA/aistore/xact"
 	jsoniter "github.com/json-iterator/go"
 )
 
+const maintResumeIval = time.Minute // hk interval: auto-resume from time-limited maintenance (`--duration`)
+
 //
 // v1/cluster handlers
 //
@@ -103,6 +107,11 @@ func (p *proxy) httpcluget(w http.ResponseWriter, r *http.Request) {
 		}
 		w.Write(buf.Bytes())
 
+	case apc.WhatDupNodes:
+		if p.forwardCP(w, r, nil, what) {
+			return
+		}
+		p.writeJSON(w, r, p.owner.smap.dups.list(), what)
 	case apc.WhatClusterConfig:
 		config := cmn.GCO.Get()
 		p.writeJSON(w, r, &config.ClusterConfig, what)
@@ -388,6 +397,8 @@ func (p *proxy) httpclupost(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 		nsi = regReq.SI
+		// for the node to compute its metasync lag
+		w.Header().Set(apc.HdrRevsVersions, p.revsVersions())
 	default:
 		p.writeErrURL(w, r)
 		return
@@ -436,11 +447,14 @@ func (p *proxy) httpclupost(w http.ResponseWriter, r *http.Request) {
 	// set node flags
 	smap := p.owner.smap.get()
 	if osi := smap.GetNode(nsi.ID()); osi != nil {
-		nsi.Flags = osi.Flags
+		nsi.Flags, nsi.MaintUntil = osi.Flags, osi.MaintUntil
 	}
 	if nonElectable {
 		nsi.Flags = nsi.Flags.Set(cluster.SnodeNonElectable)
 	}
+	if apiOp == apc.AdminJoin && nsi.IsTarget() && cos.IsParseBool(r.URL.Query().Get(apc.QparamProbation)) {
+		nsi.Flags = nsi.Flags.Set(cluster.NodeFlagProbation)
+	}
 	if apiOp == apc.AdminJoin {
 		// handshake: call the node with cluster-metadata included
 		if errCode, err := p.adminJoinHandshake(nsi, apiOp); err != nil {
@@ -582,8 +596,9 @@ func (p *proxy) handleJoinKalive(nsi *cluster.Snode, regSmap *smapX, apiOp strin
 		p.owner.smap.put(clone)
 		return
 	}
-	if _, err = smap.IsDuplicate(nsi); err != nil {
-		err = errors.New(p.si.String() + ": " + err.Error())
+	if dsi, errDup := smap.IsDuplicate(nsi); errDup != nil {
+		p.owner.smap.dups.add(newDupNodes(dsi, nsi, errDup))
+		err = errors.New(p.si.String() + ": " + errDup.Error())
 	}
 	upd = err == nil
 	return
@@ -597,7 +612,9 @@ func (p *proxy) kalive(nsi, osi *cluster.Snode) bool {
 			return false
 		}
 		if duplicate {
-			glog.Errorf("%s: %s(%s) is trying to keepalive with duplicate ID", p, nsi.StringEx(), nsi.PubNet.URL)
+			err := fmt.Errorf("%s(%s) is trying to keepalive with duplicate ID", nsi.StringEx(), nsi.PubNet.URL)
+			glog.Errorf("%s: %v", p, err)
+			p.owner.smap.dups.add(newDupNodes(osi, nsi, err))
 			return false
 		}
 		glog.Warningf("%s: renewing registration %s (info changed!)", p, nsi.StringEx())
@@ -810,7 +827,11 @@ func (p *proxy) cluputJSON(w http.ResponseWriter, r *http.Request) {
 			p.setCluCfgPersistent(w, r, toUpdate, msg)
 		}
 	case apc.ActResetConfig:
-		p.resetCluCfgPersistent(w, r, msg)
+		if section, ok := msg.Value.(string); ok && section != "" {
+			p.resetCluCfgSection(w, r, section, msg)
+		} else {
+			p.resetCluCfgPersistent(w, r, msg)
+		}
 	case apc.ActShutdown, apc.ActDecommission:
 		args := allocBcArgs()
 		args.req = cmn.HreqArgs{Method: http.MethodPut, Path: apc.URLPathDae.S, Body: cos.MustMarshal(msg)}
@@ -836,6 +857,10 @@ func (p *proxy) cluputJSON(w http.ResponseWriter, r *http.Request) {
 		p.rmNode(w, r, msg)
 	case apc.ActStopMaintenance:
 		p.stopMaintenance(w, r, msg)
+	case apc.ActEndProbation:
+		p.endProbation(w, r, msg)
+	case apc.ActResolveDupNode:
+		p.resolveDupNode(w, r, msg)
 	default:
 		p.writeErrAct(w, r, msg.Action)
 	}
@@ -887,6 +912,35 @@ func (p *proxy) resetCluCfgPersistent(w http.ResponseWriter, r *http.Request, ms
 	freeBcArgs(args)
 }
 
+// reset a single named section of the cluster config to its initial values (from the
+// plain-text config the cluster was deployed with); respond with the reverted keys
+func (p *proxy) resetCluCfgSection(w http.ResponseWriter, r *http.Request, section string, msg *apc.ActMsg) {
+	initial := &globalConfig{}
+	if _, err := jsp.Load(cmn.GCO.GetInitialGconfPath(), initial, jsp.Plain()); err != nil {
+		p.writeErrf(w, r, "%s: failed to load initial config: %v", p, err)
+		return
+	}
+	toUpdate, reverted, err := resetConfSection(&cmn.GCO.Get().ClusterConfig, &initial.ClusterConfig, section)
+	if err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	if len(reverted) > 0 {
+		ctx := &configModifier{
+			pre:      _setConfPre,
+			final:    p._syncConfFinal,
+			msg:      msg,
+			toUpdate: toUpdate,
+			wait:     true,
+		}
+		if _, err := p.owner.config.modify(ctx); err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
+	}
+	p.writeJSON(w, r, reverted, "reset-config-section")
+}
+
 func (p *proxy) setCluCfgTransient(w http.ResponseWriter, r *http.Request, toUpdate *cmn.ConfigToUpdate, msg *apc.ActMsg) {
 	if err := p.owner.config.setDaemonConfig(toUpdate, true /* transient */); err != nil {
 		p.writeErr(w, r, err)
@@ -936,9 +990,9 @@ func (p *proxy) xstart(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg)
 
 	xargs.ID = cos.GenUUID() // common for all targets
 
-	// cluster-wide resilver
-	if xargs.Kind == apc.ActResilver && xargs.DaemonID != "" {
-		p.resilverOne(w, r, msg, xargs)
+	// resilver or LRU-evict a single target
+	if (xargs.Kind == apc.ActResilver || xargs.Kind == apc.ActLRU) && xargs.DaemonID != "" {
+		p.xstartOne(w, r, msg, xargs)
 		return
 	}
 
@@ -1015,11 +1069,11 @@ func (p *proxy) rebalanceCluster(w http.ResponseWriter, r *http.Request) {
 	w.Write([]byte(xact.RebID2S(rmdClone.version())))
 }
 
-func (p *proxy) resilverOne(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg, xargs xact.ArgsMsg) {
+func (p *proxy) xstartOne(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg, xargs xact.ArgsMsg) {
 	smap := p.owner.smap.get()
 	si := smap.GetTarget(xargs.DaemonID)
 	if si == nil {
-		p.writeErrf(w, r, "cannot resilver %v: node must exist and be a target", si)
+		p.writeErrf(w, r, "cannot start %s on %q: node must exist and be a target", xargs.Kind, xargs.DaemonID)
 		return
 	}
 
@@ -1034,7 +1088,7 @@ func (p *proxy) resilverOne(w http.ResponseWriter, r *http.Request, msg *apc.Act
 	if res.err != nil {
 		p.writeErr(w, r, res.toErr())
 	} else {
-		nl := xact.NewXactNL(xargs.ID, xargs.Kind, &smap.Smap, nil)
+		nl := xact.NewXactNL(xargs.ID, xargs.Kind, &smap.Smap, cluster.NodeMap{si.ID(): si})
 		p.ic.registerEqual(regIC{smap: smap, nl: nl})
 		w.Write([]byte(xargs.ID))
 	}
@@ -1104,6 +1158,10 @@ func (p *proxy) rmNode(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg)
 		return
 	}
 	glog.Warningf("%s: %s %+v", p, msg.Action, opts)
+	if opts.Duration < 0 || (opts.Duration > 0 && msg.Action != apc.ActStartMaintenance) {
+		p.writeErrf(w, r, "%s: invalid maintenance duration %v (action %q)", p.si, time.Duration(opts.Duration), msg.Action)
+		return
+	}
 	si := smap.GetNode(opts.DaemonID)
 	if si == nil {
 		err := cmn.NewErrNotFound("%s: node %q", p.si, opts.DaemonID)
@@ -1111,6 +1169,17 @@ func (p *proxy) rmNode(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg)
 		return
 	}
 	if smap.InMaintOrDecomm(si) {
+		if msg.Action == apc.ActDecommissionNode && si.Flags.IsSet(cluster.NodeFlagDecomm) && si.IsTarget() &&
+			opts.Verify && !opts.SkipRebalance {
+			// retry decommissioning that failed verification (see `removeAfterRebalance`)
+			rebID, err := p.rebalanceAndRmSelf(msg, si)
+			if err != nil {
+				p.writeErr(w, r, cmn.NewErrFailedTo(p, msg.Action, si, err))
+			} else if rebID != "" {
+				w.Write(cos.UnsafeB(rebID))
+			}
+			return
+		}
 		p.writeErrf(w, r, "node %q is already in maintenance", opts.DaemonID)
 		return
 	}
@@ -1120,7 +1189,7 @@ func (p *proxy) rmNode(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg)
 	}
 	// proxy
 	if si.IsProxy() {
-		if err := p.markMaintenance(msg, si); err != nil {
+		if err := p.markMaintenance(msg, si, &opts); err != nil {
 			p.writeErr(w, r, cmn.NewErrFailedTo(p, msg.Action, si, err))
 			return
 		}
@@ -1194,6 +1263,122 @@ func (p *proxy) stopMaintenance(w http.ResponseWriter, r *http.Request, msg *apc
 	}
 }
 
+// primary only: take out of maintenance the nodes whose `--duration` has expired;
+// the deadline is part of the (replicated and persisted) Smap and, therefore, survives
+// primary change; nodes that are not (yet) reachable are skipped until the next time around
+func (p *proxy) maintAutoResume() time.Duration {
+	smap := p.owner.smap.get()
+	if !smap.isPrimary(p.si) || !p.ClusterStarted() || p.inPrimaryTransition.Load() {
+		return maintResumeIval
+	}
+	now := time.Now().UnixNano()
+	for _, nm := range []cluster.NodeMap{smap.Tmap, smap.Pmap} {
+		for _, si := range nm {
+			if si.MaintUntil == 0 || si.MaintUntil > now || !si.Flags.IsSet(cluster.NodeFlagMaint) {
+				continue
+			}
+			timeout := cmn.GCO.Get().Timeout.CplaneOperation.D()
+			if _, _, err := p.Health(si, timeout, nil); err != nil {
+				glog.Warningf("%s: postponing auto-resume of %s (maintenance expired): %v", p, si.StringEx(), err)
+				continue
+			}
+			var (
+				opts = &apc.ActValRmNode{DaemonID: si.ID()}
+				msg  = &apc.ActMsg{Action: apc.ActStopMaintenance, Value: opts}
+			)
+			rebID, err := p.cancelMaintenance(msg, opts)
+			if err != nil {
+				glog.Errorf("%s: failed to auto-resume %s: %v", p, si.StringEx(), err)
+				continue
+			}
+			glog.Infof("%s: %s auto-resumed (maintenance expired), rebalance %q", p, si.StringEx(), rebID)
+		}
+	}
+	return maintResumeIval
+}
+
+// promote probationary target to a full member: clear the flag and rebalance
+func (p *proxy) endProbation(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg) {
+	var (
+		opts apc.ActValRmNode
+		smap = p.owner.smap.get()
+	)
+	if err := cos.MorphMarshal(msg.Value, &opts); err != nil {
+		p.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, p.si, msg.Action, msg.Value, err)
+		return
+	}
+	si := smap.GetNode(opts.DaemonID)
+	if si == nil {
+		err := cmn.NewErrNotFound("%s: node %q", p.si, opts.DaemonID)
+		p.writeErr(w, r, err, http.StatusNotFound)
+		return
+	}
+	if !si.InProbation() {
+		p.writeErrf(w, r, "node %s is not in probation", si.StringEx())
+		return
+	}
+	ctx := &smapModifier{
+		pre:     p._endProbationPre,
+		post:    p._newRebRMD,
+		final:   p._syncFinal,
+		sid:     opts.DaemonID,
+		skipReb: opts.SkipRebalance,
+		msg:     msg,
+		flags:   cluster.NodeFlagProbation,
+	}
+	if err := p.owner.smap.modify(ctx); err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	if ctx.rmd != nil {
+		w.Write(cos.UnsafeB(xact.RebID2S(ctx.rmd.Version)))
+	}
+}
+
+func (p *proxy) _endProbationPre(ctx *smapModifier, clone *smapX) error {
+	if !clone.isPrimary(p.si) {
+		return newErrNotPrimary(p.si, clone, fmt.Sprintf("cannot end probation for %s", ctx.sid))
+	}
+	clone.clearNodeFlags(ctx.sid, ctx.flags)
+	return nil
+}
+
+// keep the specified node and remove from Smap all nodes that conflict with it;
+// same-ID conflicts (see p.kalive) are resolved in favor of the registered node
+func (p *proxy) resolveDupNode(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg) {
+	var opts apc.ActValRmNode
+	if err := cos.MorphMarshal(msg.Value, &opts); err != nil {
+		p.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, p.si, msg.Action, msg.Value, err)
+		return
+	}
+	dups := p.owner.smap.dups.del(opts.DaemonID)
+	if len(dups) == 0 {
+		err := cmn.NewErrNotFound("%s: no pending duplicates of node %q", p.si, opts.DaemonID)
+		p.writeErr(w, r, err, http.StatusNotFound)
+		return
+	}
+	for i, dup := range dups {
+		rsi := dup.Osi
+		if rsi.ID() == opts.DaemonID {
+			rsi = dup.Nsi
+		}
+		if rsi.ID() == opts.DaemonID {
+			continue
+		}
+		// NOTE: not asking the node to remove itself - it shares (IP, port) with the one we keep
+		smap := p.owner.smap.get()
+		if smap.GetNode(rsi.ID()) == nil {
+			continue
+		}
+		glog.Infof("%s: %q - keeping %s, removing %s", p, msg.Action, opts.DaemonID, rsi.StringEx())
+		if errCode, err := p.unregNode(msg, rsi, opts.SkipRebalance); err != nil {
+			p.owner.smap.dups.add(dups[i:]...)
+			p.writeErr(w, r, err, errCode)
+			return
+		}
+	}
+}
+
 func (p *proxy) cluputQuery(w http.ResponseWriter, r *http.Request, action string) {
 	if p.forwardCP(w, r, &apc.ActMsg{Action: action}, "") {
 		return
@@ -1346,11 +1531,48 @@ func (p *proxy) removeAfterRebalance(nl nl.Listener, msg *apc.ActMsg, si *cluste
 	if glog.FastV(4, glog.SmoduleAIS) {
 		glog.Infof("Rebalance(%s) finished. Removing node %s", nl.UUID(), si)
 	}
+	if msg.Action == apc.ActDecommissionNode {
+		var opts apc.ActValRmNode
+		if err := cos.MorphMarshal(msg.Value, &opts); err == nil && opts.Verify {
+			if err := p.verifyDecomm(si); err != nil {
+				if !opts.Force {
+					glog.Errorf("%v - not removing %s from the cluster map", err, si)
+					return
+				}
+				glog.Warningf("%v - removing %s anyway (forced)", err, si)
+			}
+		}
+	}
 	if _, err := p.callRmSelf(msg, si, true /*skipReb*/); err != nil {
 		glog.Errorf("Failed to remove node (%s) after rebalance, err: %v", si, err)
 	}
 }
 
+// ask the target that is being decommissioned whether all its objects have been migrated
+func (p *proxy) verifyDecomm(si *cluster.Snode) (err error) {
+	cargs := allocCargs()
+	{
+		cargs.si = si
+		cargs.req = cmn.HreqArgs{
+			Method: http.MethodGet,
+			Path:   apc.URLPathDae.S,
+			Query:  url.Values{apc.QparamWhat: []string{apc.WhatDecommVerify}},
+		}
+		cargs.timeout = apc.LongTimeout // NOTE: walks all local objects
+		cargs.cresv = cresDV{}
+	}
+	res := p.call(cargs)
+	if res.err != nil {
+		err = res.errorf("%s: failed to verify decommissioning of %s", p, si)
+	} else if dv := res.v.(*apc.DecommVerifyResult); dv.NumUnplaced > 0 {
+		err = fmt.Errorf("%s: %d (out of %d) object%s not migrated from %s, e.g. %v", p,
+			dv.NumUnplaced, dv.NumObjs, cos.Plural(int(dv.NumUnplaced)), si, dv.Unplaced[0])
+	}
+	freeCargs(cargs)
+	freeCR(res)
+	return
+}
+
 // Run rebalance if needed; remove self from the cluster when rebalance finishes
 // the method handles msg.Action == apc.ActStartMaintenance | apc.ActDecommission | apc.ActShutdownNode
 func (p *proxy) rebalanceAndRmSelf(msg *apc.ActMsg, si *cluster.Snode) (rebID string, err error) {
@@ -1475,6 +1697,21 @@ func (p *proxy) cluSetPrimary(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// (I.0) Optionally, drain: make sure the candidate has the current Smap
+	if s := r.URL.Query().Get(apc.QparamDrainTimeout); s != "" {
+		timeout, err := time.ParseDuration(s)
+		if err != nil {
+			p.writeErrf(w, r, "%s: invalid drain timeout %q: %v", p, s, err)
+			return
+		}
+		p.inPrimaryTransition.Store(true) // pause: reject new cluster-level requests and ignore keepalives
+		defer p.inPrimaryTransition.Store(false)
+		if err := p.drainSmap(psi, timeout); err != nil {
+			p.writeErr(w, r, err, http.StatusServiceUnavailable)
+			return
+		}
+	}
+
 	// (I.1) Prepare phase - inform other nodes.
 	urlPath := apc.URLPathDaeProxy.Join(proxyid)
 	q := url.Values{}
@@ -1504,14 +1741,19 @@ func (p *proxy) cluSetPrimary(w http.ResponseWriter, r *http.Request) {
 	freeBcastRes(results)
 
 	// (I.2) Prepare phase - local changes.
-	p.inPrimaryTransition.Store(true)
-	defer p.inPrimaryTransition.Store(false)
+	if !p.inPrimaryTransition.Load() {
+		p.inPrimaryTransition.Store(true)
+		defer p.inPrimaryTransition.Store(false)
+	}
 
-	err = p.owner.smap.modify(&smapModifier{pre: func(_ *smapModifier, clone *smapX) error {
-		clone.Primary = psi
-		p.metasyncer.becomeNonPrimary()
-		return nil
-	}})
+	err = p.owner.smap.modify(&smapModifier{
+		pre: func(_ *smapModifier, clone *smapX) error {
+			clone.Primary = psi
+			p.metasyncer.becomeNonPrimary()
+			return nil
+		},
+		msg: &apc.ActMsg{Action: apc.ActNewPrimary},
+	})
 	debug.AssertNoErr(err)
 
 	// (II) Commit phase.
@@ -1535,6 +1777,39 @@ func (p *proxy) cluSetPrimary(w http.ResponseWriter, r *http.Request) {
 	freeBcastRes(results)
 }
 
+// wait for the designated primary to receive (via metasync) the current Smap;
+// the caller must pause Smap modifications (see `inPrimaryTransition`)
+func (p *proxy) drainSmap(psi *cluster.Snode, timeout time.Duration) error {
+	// wait for the modification (if any) that's already in progress
+	p.owner.smap.mu.Lock()
+	ver := p.owner.smap.get().version()
+	p.owner.smap.mu.Unlock()
+
+	var (
+		sleep    = cos.ProbingFrequency(timeout)
+		deadline = mono.NanoTime() + timeout.Nanoseconds()
+		cver     int64
+	)
+	for {
+		smap, err := p.smapFromURL(psi.ControlNet.URL)
+		if err == nil {
+			cver = smap.version()
+			if cver >= ver {
+				glog.Infof("%s: drained - new primary %s has Smap v%d", p, psi, cver)
+				return nil
+			}
+		} else {
+			glog.Warningf("%s: draining %s: %v", p, psi, err)
+		}
+		if mono.NanoTime() > deadline {
+			break
+		}
+		time.Sleep(sleep)
+	}
+	return fmt.Errorf("%s: timed out (%v) waiting for %s to catch up: Smap v%d vs v%d (current) - not changing primary",
+		p, timeout, psi, cver, ver)
+}
+
 /////////////////////////////////////////
 // DELET /v1/cluster - self-unregister //
 /////////////////////////////////////////
@@ -1709,17 +1984,19 @@ func mustRunRebalance(ctx *smapModifier, cur *smapX) bool {
 		ctx._mustReb = true
 		goto ret
 	}
+	// NOTE: probationary targets do not store data - joining in probation is not a reason
+	// to rebalance while ending probation (see `endProbation`) is
 	for _, si := range cur.Tmap {
-		if si.IsProxy() || si.InMaintOrDecomm() {
+		if si.IsProxy() || si.InMaintOrDecomm() || si.InProbation() {
 			continue
 		}
-		if prev.GetActiveNode(si.ID()) == nil { // added or activated
+		if psi := prev.GetActiveNode(si.ID()); psi == nil || psi.InProbation() { // added, activated, or promoted
 			ctx._mustReb = true
 			goto ret
 		}
 	}
 	for _, si := range prev.Tmap {
-		if si.IsProxy() || si.InMaintOrDecomm() {
+		if si.IsProxy() || si.InMaintOrDecomm() || si.InProbation() {
 			continue
 		}
 		if cur.GetActiveNode(si.ID()) == nil { // deleted or deactivated
@@ -94,6 +94,10 @@ func (p *proxy) httpdlpost(w http.ResponseWriter, r *http.Request) {
 	if !ok {
 		return
 	}
+	// the callback (below) is invoked by the IC member that starts the job and registers its listener
+	if dlBase.NotifyURL != "" && p.ic.redirectToIC(w, r) {
+		return
+	}
 
 	var progressInterval = dload.DownloadProgressInterval
 	if dlBase.ProgressInterval != "" {
@@ -113,6 +117,9 @@ func (p *proxy) httpdlpost(w http.ResponseWriter, r *http.Request) {
 	smap := p.owner.smap.get()
 	nl := dload.NewDownloadNL(jobID, string(dlb.Type), &smap.Smap, progressInterval)
 	nl.SetOwner(equalIC)
+	if dlBase.NotifyURL != "" {
+		nl.F = dload.NotifyCallback(dlBase.NotifyURL)
+	}
 	p.ic.registerEqual(regIC{nl: nl, smap: smap})
 
 	w.Header().Set(cos.HdrContentType, cos.ContentJSON)
@@ -221,25 +228,7 @@ func (p *proxy) dladm(method, path string, msg *dload.AdminBody) ([]byte, int, e
 func (p *proxy) dlstatus(nl nl.Listener) ([]byte, int, error) {
 	// bcast
 	p.notifs.bcastGetStats(nl, cmn.GCO.Get().Periodic.NotifTime.D())
-	stats := nl.NodeStats()
-
-	var resp *dload.StatusResp
-	stats.Range(func(_ string, status any) bool {
-		var (
-			dlStatus *dload.StatusResp
-			ok       bool
-		)
-		if dlStatus, ok = status.(*dload.StatusResp); !ok {
-			dlStatus = &dload.StatusResp{}
-			if err := cos.MorphMarshal(status, dlStatus); err != nil {
-				debug.AssertNoErr(err)
-				return false
-			}
-		}
-		resp = resp.Aggregate(*dlStatus)
-		return true
-	})
-
+	resp := dload.AggregateStats(nl.NodeStats())
 	respJSON := cos.MustMarshal(resp)
 	return respJSON, http.StatusOK, nil
 }
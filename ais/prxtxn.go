@@ -843,7 +843,7 @@ func (p *proxy) startMaintenance(si *cluster.Snode, msg *apc.ActMsg, opts *apc.A
 	}
 
 	// 2. Put node under maintenance
-	if err = p.markMaintenance(msg, si); err != nil {
+	if err = p.markMaintenance(msg, si, opts); err != nil {
 		c.bcastAbort(si, err)
 		return
 	}
@@ -877,8 +877,8 @@ func (p *proxy) startMaintenance(si *cluster.Snode, msg *apc.ActMsg, opts *apc.A
 	return
 }
 
-// Put node under maintenance
-func (p *proxy) markMaintenance(msg *apc.ActMsg, si *cluster.Snode) error {
+// Put node under maintenance (indefinitely, unless `opts.Duration` is specified)
+func (p *proxy) markMaintenance(msg *apc.ActMsg, si *cluster.Snode, opts *apc.ActValRmNode) error {
 	var flags cos.BitFlags
 	switch msg.Action {
 	case apc.ActDecommissionNode:
@@ -898,6 +898,9 @@ func (p *proxy) markMaintenance(msg *apc.ActMsg, si *cluster.Snode) error {
 		flags: flags,
 		msg:   msg,
 	}
+	if opts.Duration > 0 {
+		ctx.until = time.Now().UnixNano() + opts.Duration
+	}
 	return p.owner.smap.modify(ctx)
 }
 
@@ -906,6 +909,9 @@ func (p *proxy) _markMaint(ctx *smapModifier, clone *smapX) error {
 		return newErrNotPrimary(p.si, clone, fmt.Sprintf("cannot put %s in maintenance", ctx.sid))
 	}
 	clone.setNodeFlags(ctx.sid, ctx.flags)
+	if ctx.until != 0 {
+		clone.GetNode(ctx.sid).MaintUntil = ctx.until
+	}
 	clone.staffIC()
 	return nil
 }
@@ -1135,7 +1141,12 @@ func (p *proxy) makeNewBckProps(bck *cluster.Bck, propsToUpdate *cmn.BucketProps
 	if bprops.EC.Enabled && nprops.EC.Enabled {
 		sameSlices := bprops.EC.DataSlices == nprops.EC.DataSlices && bprops.EC.ParitySlices == nprops.EC.ParitySlices
 		sameLimit := bprops.EC.ObjSizeLimit == nprops.EC.ObjSizeLimit
-		if !sameSlices || (!sameLimit && !propsToUpdate.Force) {
+		switch {
+		case !sameSlices && !propsToUpdate.Reencode:
+			err = fmt.Errorf("%s: once enabled, EC configuration can be only disabled, or changed with re-encoding "+
+				"of the existing objects (%s: %s => %s)", p.si, bck, bprops.EC.String(), nprops.EC.String())
+			return
+		case sameSlices && !sameLimit && !propsToUpdate.Force:
 			err = fmt.Errorf("%s: once enabled, EC configuration can be only disabled but cannot change", p.si)
 			return
 		}
@@ -1168,9 +1179,15 @@ func (p *proxy) makeNewBckProps(bck *cluster.Bck, propsToUpdate *cmn.BucketProps
 		return
 	}
 	err = nprops.Validate(targetCnt)
-	if cmn.IsErrSoft(err) && propsToUpdate.Force {
-		glog.Warningf("Ignoring soft error: %v", err)
-		err = nil
+	if cmn.IsErrSoft(err) {
+		switch {
+		case reec && propsToUpdate.Reencode:
+			// not overridable: re-encoding would fail for each and every (existing) object
+			err = fmt.Errorf("%s: cannot re-encode %s: %s", p.si, bck, err.Error())
+		case propsToUpdate.Force:
+			glog.Warningf("Ignoring soft error: %v", err)
+			err = nil
+		}
 	}
 	return
 }
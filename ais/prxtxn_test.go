@@ -0,0 +1,71 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cmn"
+)
+
+func TestMakeNewBckPropsEC(t *testing.T) {
+	const numTargets = 4
+	p := &proxy{}
+	p.si = cluster.NewSnode("primary", apc.Proxy, cluster.NetInfo{}, cluster.NetInfo{}, cluster.NetInfo{})
+	p.owner.smap = newSmapOwner(cmn.GCO.Get(), "")
+	smap := newSmap()
+	smap.addProxy(p.si)
+	smap.Primary = p.si
+	for i := 0; i < numTargets; i++ {
+		smap.addTarget(cluster.NewSnode(fmt.Sprintf("t%d", i), apc.Target, cluster.NetInfo{}, cluster.NetInfo{}, cluster.NetInfo{}))
+	}
+	p.owner.smap.put(smap)
+
+	bck := cluster.NewBck("ec-bck", apc.AIS, cmn.NsGlobal)
+	bck.Props = defaultBckProps(bckPropsArgs{bck: bck})
+	bck.Props.EC.Enabled = true
+	bck.Props.EC.DataSlices, bck.Props.EC.ParitySlices = 1, 1
+
+	ecSlices := func(data, parity int) *cmn.BucketPropsToUpdate {
+		return &cmn.BucketPropsToUpdate{EC: &cmn.ECConfToUpdate{DataSlices: &data, ParitySlices: &parity}}
+	}
+	tests := []struct {
+		name     string
+		update   *cmn.BucketPropsToUpdate
+		force    bool
+		reencode bool
+		fail     bool
+	}{
+		{name: "change slices", update: ecSlices(2, 1), fail: true},
+		{name: "change slices, force", update: ecSlices(2, 1), force: true, fail: true},
+		{name: "change slices, reencode", update: ecSlices(2, 1), reencode: true},
+		{name: "not enough targets", update: ecSlices(2, 2), fail: true},
+		{name: "not enough targets, force", update: ecSlices(2, 2), force: true, fail: true},
+		{name: "not enough targets, reencode", update: ecSlices(2, 2), reencode: true, fail: true},
+		{name: "not enough targets, force and reencode", update: ecSlices(2, 2), force: true, reencode: true, fail: true},
+		{name: "same slices", update: ecSlices(1, 1)},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.update.Force, test.update.Reencode = test.force, test.reencode
+			nprops, err := p.makeNewBckProps(bck, test.update)
+			if test.fail {
+				if err == nil {
+					t.Fatalf("expected error, got %s", nprops.EC.String())
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if nprops.EC.DataSlices != *test.update.EC.DataSlices || nprops.EC.ParitySlices != *test.update.EC.ParitySlices {
+				t.Fatalf("expected %d:%d, got %s", *test.update.EC.DataSlices, *test.update.EC.ParitySlices, nprops.EC.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,154 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/3rdparty/glog"
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/fname"
+	"github.com/NVIDIA/aistore/cmn/jsp"
+)
+
+// Smap history for post-mortem: the primary remembers (and persists) up to maxSmapHist
+// most recent transitions - versions, times, and changes - while retaining in memory
+// only the last `cluster.smap_history` entire Smaps. Non-primary nodes record nothing
+// (other than what they may have recorded while being primary).
+
+const maxSmapHist = 256
+
+type smapHist struct {
+	entries  []*cluster.SmapHistEntry // oldest first
+	copies   []*smapX                 // the most recent retained Smaps, oldest first
+	sid      string                   // this node
+	fpath    string
+	mu       sync.Mutex
+	dirty    bool // not persisted yet
+	flushing bool // persisting in progress (see flush)
+}
+
+func (h *smapHist) init(config *cmn.Config, sid string) {
+	h.sid = sid
+	if config.ConfigDir == "" {
+		return // (unit tests)
+	}
+	h.fpath = filepath.Join(config.ConfigDir, fname.SmapHist)
+	if _, err := jsp.Load(h.fpath, &h.entries, jsp.Plain()); err != nil && !os.IsNotExist(err) {
+		glog.Errorf("failed to load Smap history %q: %v", h.fpath, err)
+	}
+	for _, e := range h.entries {
+		e.Retained = false
+	}
+}
+
+// (called upon installing new Smap - under smapOwner lock; no disk IO here)
+func (h *smapHist) add(prev, smap *smapX) {
+	if smap.Primary == nil || smap.Primary.ID() != h.sid {
+		return
+	}
+	var (
+		keep  = cmn.GCO.Get().Cluster.SmapHistory
+		entry = &cluster.SmapHistEntry{
+			Version:  smap.Version,
+			Time:     time.Now().UnixNano(),
+			Cause:    smap.Cause,
+			Retained: keep > 0,
+		}
+	)
+	if prev != nil {
+		entry.Diff = smap.Diff(&prev.Smap)
+	} else {
+		entry.Diff = smap.Diff(nil)
+	}
+
+	h.mu.Lock()
+	if l := len(h.entries); l > 0 && h.entries[l-1].Version >= smap.Version {
+		h.mu.Unlock()
+		return
+	}
+	h.entries = append(h.entries, entry)
+	if l := len(h.entries); l > maxSmapHist {
+		h.entries = append(h.entries[:0], h.entries[l-maxSmapHist:]...)
+	}
+	if keep > 0 {
+		h.copies = append(h.copies, smap)
+	}
+	if l := len(h.copies); l > keep {
+		for _, old := range h.copies[:l-keep] {
+			if e := h._find(old.Version); e != nil {
+				e.Retained = false
+			}
+		}
+		h.copies = append(h.copies[:0], h.copies[l-keep:]...)
+	}
+	if h.fpath != "" {
+		h.dirty = true
+		if !h.flushing {
+			h.flushing = true
+			go h.flush()
+		}
+	}
+	h.mu.Unlock()
+}
+
+// persist asynchronously; transitions that arrive in the meantime are batched
+// and saved by the same goroutine
+func (h *smapHist) flush() {
+	for {
+		h.mu.Lock()
+		if !h.dirty {
+			h.flushing = false
+			h.mu.Unlock()
+			return
+		}
+		h.dirty = false
+		entries := make([]cluster.SmapHistEntry, len(h.entries))
+		for i, e := range h.entries {
+			entries[i] = *e
+		}
+		h.mu.Unlock()
+
+		if err := jsp.Save(h.fpath, entries, jsp.Plain(), nil); err != nil {
+			glog.Errorf("failed to persist Smap history %q: %v", h.fpath, err)
+		}
+	}
+}
+
+func (h *smapHist) _find(ver int64) *cluster.SmapHistEntry {
+	for i := len(h.entries) - 1; i >= 0; i-- {
+		if h.entries[i].Version == ver {
+			return h.entries[i]
+		}
+	}
+	return nil
+}
+
+func (h *smapHist) list() []cluster.SmapHistEntry {
+	h.mu.Lock()
+	out := make([]cluster.SmapHistEntry, len(h.entries))
+	for i, e := range h.entries {
+		out[i] = *e
+	}
+	h.mu.Unlock()
+	return out
+}
+
+// retained Smap of a given version, if any
+func (h *smapHist) get(ver int64) (smap *smapX) {
+	h.mu.Lock()
+	for _, m := range h.copies {
+		if m.Version == ver {
+			smap = m
+			break
+		}
+	}
+	h.mu.Unlock()
+	return
+}
@@ -38,13 +38,16 @@ import (
 	"github.com/NVIDIA/aistore/ext/etl"
 	"github.com/NVIDIA/aistore/fs"
 	"github.com/NVIDIA/aistore/fs/health"
+	"github.com/NVIDIA/aistore/hk"
 	"github.com/NVIDIA/aistore/memsys"
 	"github.com/NVIDIA/aistore/mirror"
+	"github.com/NVIDIA/aistore/nl"
 	"github.com/NVIDIA/aistore/reb"
 	"github.com/NVIDIA/aistore/res"
 	"github.com/NVIDIA/aistore/stats"
 	"github.com/NVIDIA/aistore/transport"
 	"github.com/NVIDIA/aistore/volume"
+	"github.com/NVIDIA/aistore/xact"
 	"github.com/NVIDIA/aistore/xact/xreg"
 )
 
@@ -95,6 +98,7 @@ func (t *target) initBackends() {
 			glog.Infof("%s: remote-ais %v", t, aisConf)
 		}
 	}
+	hk.Reg("remais-probe"+hk.NameSuffix, t.probeRemAis, backend.RemAisProbeIval)
 
 	if err := t._initBuiltin(); err != nil {
 		cos.ExitLogf("%v", err)
@@ -156,6 +160,9 @@ func (t *target) aisBackend() *backend.AISBackendProvider {
 	return bendp.(*backend.AISBackendProvider)
 }
 
+// housekeeping: resolve the provider each time as it may get re-created upon config change
+func (t *target) probeRemAis() time.Duration { return t.aisBackend().Probe() }
+
 func (t *target) init(config *cmn.Config) {
 	t.initNetworks()
 	tid, generated := initTID(config)
@@ -614,6 +621,10 @@ func (t *target) getObject(w http.ResponseWriter, r *http.Request, dpq *dpq, bck
 		t.doETL(w, r, dpq.etlName, bck, lom.ObjName)
 		return lom
 	}
+	if dpq.versionID != "" || cos.IsParseBool(dpq.lsVers) {
+		t.getObjVersion(w, r, dpq, lom)
+		return lom
+	}
 
 	filename := dpq.archpath // apc.QparamArchpath
 	if strings.HasPrefix(filename, lom.ObjName) {
@@ -660,6 +671,51 @@ func (t *target) getObject(w http.ResponseWriter, r *http.Request, dpq *dpq, bck
 	return lom
 }
 
+// GET a specific version of a remote object or, alternatively, the list of all its versions
+// - versioning-capable remote backends only
+// - reads directly from the backend and does not update (or use) in-cluster copy
+func (t *target) getObjVersion(w http.ResponseWriter, r *http.Request, dpq *dpq, lom *cluster.LOM) {
+	bck := lom.Bck()
+	vb, ok := t.Backend(bck).(cluster.VersionedBackend)
+	if !ok || !bck.IsRemote() || !bck.Props.Versioning.Enabled {
+		err := cmn.NewErrUnsupp("access versions of", lom.Cname()+" (versioning not available)")
+		t.writeErr(w, r, err, http.StatusNotImplemented)
+		return
+	}
+	ctx := context.Background()
+	if cos.IsParseBool(dpq.lsVers) {
+		vers, errCode, err := vb.ListObjVersions(ctx, lom)
+		if err != nil {
+			t.writeErr(w, r, err, errCode)
+			return
+		}
+		t.writeJSON(w, r, vers, "list-versions")
+		return
+	}
+
+	ctx = context.WithValue(ctx, cos.CtxVersionID, dpq.versionID)
+	ctx = context.WithValue(ctx, cos.CtxSetSize, cos.SetSizeFunc(lom.SetSize))
+	reader, _, errCode, err := t.Backend(bck).GetObjReader(ctx, lom)
+	if err != nil {
+		t.writeErr(w, r, err, errCode)
+		return
+	}
+	cmn.ToHeader(lom.ObjAttrs(), w.Header())
+	buf, slab := t.gmm.AllocSize(lom.SizeBytes(true))
+	written, err := io.CopyBuffer(cos.WriterOnly{Writer: w}, reader, buf)
+	slab.Free(buf)
+	cos.Close(reader)
+	if err != nil {
+		glog.Error(cmn.NewErrFailedTo(t, "GET", lom.Cname()+" version "+dpq.versionID, err))
+		t.statsT.IncErr(stats.GetCount)
+		return
+	}
+	t.statsT.AddMany(
+		cos.NamedVal64{Name: stats.GetThroughput, Value: written},
+		cos.NamedVal64{Name: stats.GetCount, Value: 1},
+	)
+}
+
 // PUT /v1/objects/bucket-name/object-name
 func (t *target) httpobjput(w http.ResponseWriter, r *http.Request) {
 	apireq := apiReqAlloc(2, apc.URLPathObjects.L, true /*dpq*/)
@@ -795,11 +851,16 @@ func (t *target) httpobjdelete(w http.ResponseWriter, r *http.Request) {
 
 // POST /v1/objects/bucket-name/object-name
 func (t *target) httpobjpost(w http.ResponseWriter, r *http.Request) {
-	msg, err := t.readActionMsg(w, r)
+	msg, err := t.readAisMsg(w, r)
 	if err != nil {
 		return
 	}
-	if msg.Action != apc.ActRenameObject {
+	switch msg.Action {
+	case apc.ActRenameObject, apc.ActCopyObject:
+	case apc.ActExtract:
+		t.extract(w, r, msg)
+		return
+	default:
 		t.writeErrAct(w, r, msg.Action)
 		return
 	}
@@ -815,8 +876,18 @@ func (t *target) httpobjpost(w http.ResponseWriter, r *http.Request) {
 
 	lom := cluster.AllocLOM(apireq.items[1])
 	err = lom.InitBck(apireq.bck.Bucket())
+	if msg.Action == apc.ActCopyObject {
+		if err == nil {
+			err = t.objCopy(lom, msg.Name)
+		}
+		if err != nil {
+			t.writeErr(w, r, err)
+		}
+		cluster.FreeLOM(lom)
+		return
+	}
 	if err == nil {
-		err = t.objMv(lom, msg)
+		err = t.objMv(lom, &msg.ActMsg)
 	}
 	if err == nil {
 		t.statsT.Inc(stats.RenameCount)
@@ -827,6 +898,41 @@ func (t *target) httpobjpost(w http.ResponseWriter, r *http.Request) {
 	cluster.FreeLOM(lom)
 }
 
+// (intra-cluster) start x-extract on the target that owns the archive
+func (t *target) extract(w http.ResponseWriter, r *http.Request, msg *aisMsg) {
+	apireq := apiReqAlloc(2, apc.URLPathObjects.L, false /*useDpq*/)
+	defer apiReqFree(apireq)
+	if t.parseReq(w, r, apireq) != nil {
+		return
+	}
+	extMsg := &cmn.ExtractMsg{}
+	if err := cos.MorphMarshal(msg.Value, extMsg); err != nil {
+		t.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, t.si, msg.Action, msg.Value, err)
+		return
+	}
+	bckTo := cluster.CloneBck(&extMsg.ToBck)
+	if err := bckTo.Init(t.owner.bmd); err != nil {
+		t.writeErr(w, r, err)
+		return
+	}
+	args := &xreg.ExtractArgs{Msg: extMsg, BckTo: bckTo, ArchName: apireq.items[1]}
+	rns := xreg.RenewExtract(msg.UUID, t, apireq.bck, args)
+	if rns.Err != nil {
+		t.writeErr(w, r, rns.Err)
+		return
+	}
+	xctn := rns.Entry.Get()
+	xctn.AddNotif(&xact.NotifXact{
+		Base: nl.Base{
+			When: cluster.UponTerm,
+			Dsts: []string{equalIC},
+			F:    t.callerNotifyFin,
+		},
+		Xact: xctn,
+	})
+	go xctn.Run(nil)
+}
+
 // HEAD /v1/objects/<bucket-name>/<object-name>
 func (t *target) httpobjhead(w http.ResponseWriter, r *http.Request) {
 	apireq := apiReqAlloc(2, apc.URLPathObjects.L, false)
@@ -932,6 +1038,11 @@ func (t *target) objhead(hdr http.Header, query url.Values, bck *cluster.Bck, lo
 				op.EC.Generation = md.Generation
 			}
 		}
+		if bck.IsRemote() && cos.IsParseBool(query.Get(apc.QparamLatestVer)) {
+			if errCode, err = t.objLatest(hdr, lom); err != nil {
+				return
+			}
+		}
 	} else {
 		// cold HEAD
 		var oa *cmn.ObjAttrs
@@ -979,6 +1090,23 @@ func (t *target) objhead(hdr http.Header, query url.Values, bck *cluster.Bck, lo
 	return
 }
 
+// compare the in-cluster copy with its remote origin and mark it stale if the two differ
+// (including the case when the remote object does not exist anymore)
+func (t *target) objLatest(hdr http.Header, lom *cluster.LOM) (int, error) {
+	oa, errCode, err := t.Backend(lom.Bck()).HeadObj(context.Background(), lom)
+	switch {
+	case err == nil:
+		if !lom.ObjAttrs().Equal(oa) {
+			hdr.Set(apc.HdrObjStale, "true")
+		}
+	case errCode == http.StatusNotFound:
+		hdr.Set(apc.HdrObjStale, "true")
+	default:
+		return errCode, cmn.NewErrFailedTo(t, "HEAD", lom, err)
+	}
+	return 0, nil
+}
+
 // PATCH /v1/objects/<bucket-name>/<object-name>
 // By default, adds or updates existing custom keys. Will remove all existing keys and
 // replace them with the specified ones _iff_ `apc.QparamNewCustom` is set.
@@ -1309,7 +1437,27 @@ func (t *target) objMv(lom *cluster.LOM, msg *apc.ActMsg) error {
 	if msg.Name == lom.ObjName {
 		return fmt.Errorf("%s: cannot rename/move object %s onto itself", t.si, lom)
 	}
+	if err := t.objCopy(lom, msg.Name /* new object name */); err != nil {
+		return err
+	}
 
+	// TODO: combine copy+delete under a single write lock
+	lom.Lock(true)
+	if err := lom.Remove(); err != nil {
+		glog.Warningf("%s: failed to delete renamed object %s (new name %s): %v", t, lom, msg.Name, err)
+	}
+	lom.Unlock(true)
+	return nil
+}
+
+// copy object within its (ais) bucket; the destination may (and likely will) belong to a different target
+func (t *target) objCopy(lom *cluster.LOM, objNameTo string) error {
+	if lom.Bck().IsRemote() {
+		return fmt.Errorf("%s: cannot copy object %s from a remote bucket", t.si, lom)
+	}
+	if objNameTo == lom.ObjName {
+		return fmt.Errorf("%s: cannot copy object %s onto itself", t.si, lom)
+	}
 	buf, slab := t.gmm.Alloc()
 	coi := allocCopyObjInfo()
 	{
@@ -1318,20 +1466,10 @@ func (t *target) objMv(lom *cluster.LOM, msg *apc.ActMsg) error {
 		coi.owt = cmn.OwtMigrate
 		coi.finalize = true
 	}
-	_, err := coi.copyObject(lom, msg.Name /* new object name */)
+	_, err := coi.copyObject(lom, objNameTo)
 	slab.Free(buf)
 	freeCopyObjInfo(coi)
-	if err != nil {
-		return err
-	}
-
-	// TODO: combine copy+delete under a single write lock
-	lom.Lock(true)
-	if err := lom.Remove(); err != nil {
-		glog.Warningf("%s: failed to delete renamed object %s (new name %s): %v", t, lom, msg.Name, err)
-	}
-	lom.Unlock(true)
-	return nil
+	return err
 }
 
 func (t *target) fsErr(err error, filepath string) {
@@ -418,21 +418,42 @@ func (t *target) httpbckpost(w http.ResponseWriter, r *http.Request) {
 	switch msg.Action {
 	case apc.ActPrefetchObjects:
 		var (
-			err   error
-			lrMsg = &cmn.ListRange{}
+			err    error
+			prfMsg = &cmn.PrefetchMsg{}
 		)
 		if !apireq.bck.IsRemote() {
 			t.writeErrf(w, r, "%s: expecting remote bucket, got %s, action=%s",
 				t.si, apireq.bck, msg.Action)
 			return
 		}
-		if err = cos.MorphMarshal(msg.Value, lrMsg); err != nil {
+		if err = cos.MorphMarshal(msg.Value, prfMsg); err != nil {
 			t.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, t.si, msg.Action, msg.Value, err)
 			return
 		}
-		rns := xreg.RenewPrefetch(msg.UUID, t, apireq.bck, lrMsg)
+		rns := xreg.RenewPrefetch(msg.UUID, t, apireq.bck, prfMsg)
 		xctn := rns.Entry.Get()
 		go xctn.Run(nil)
+	case apc.ActRechecksum:
+		rcsmsg := &cmn.RechecksumMsg{}
+		if err := cos.MorphMarshal(msg.Value, rcsmsg); err != nil {
+			t.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, t.si, msg.Action, msg.Value, err)
+			return
+		}
+		rns := xreg.RenewRechecksum(msg.UUID, t, apireq.bck, rcsmsg)
+		if rns.Err != nil {
+			t.writeErr(w, r, rns.Err)
+			return
+		}
+		xctn := rns.Entry.Get()
+		xctn.AddNotif(&xact.NotifXact{
+			Base: nl.Base{
+				When: cluster.UponTerm,
+				Dsts: []string{equalIC},
+				F:    t.callerNotifyFin,
+			},
+			Xact: xctn,
+		})
+		go xctn.Run(nil)
 	default:
 		t.writeErrAct(w, r, msg.Action)
 	}
@@ -225,7 +225,7 @@ func (t *target) daeSetPrimary(w http.ResponseWriter, r *http.Request, apiItems
 		}
 		return
 	}
-	ctx := &smapModifier{pre: t._setPrim, sid: proxyID}
+	ctx := &smapModifier{pre: t._setPrim, sid: proxyID, msg: &apc.ActMsg{Action: apc.ActNewPrimary}}
 	err = t.owner.smap.modify(ctx)
 	if err != nil {
 		t.writeErr(w, r, err)
@@ -251,7 +251,7 @@ func (t *target) httpdaeget(w http.ResponseWriter, r *http.Request) {
 		httpdaeWhat = "httpdaeget-" + getWhat
 	)
 	switch getWhat {
-	case apc.WhatConfig, apc.WhatSmap, apc.WhatBMD, apc.WhatSmapVote,
+	case apc.WhatConfig, apc.WhatSmap, apc.WhatBMD, apc.WhatSmapVote, apc.WhatSmapCheck, apc.WhatSmapHist,
 		apc.WhatSnode, apc.WhatLog, apc.WhatNodeStats, apc.WhatMetricNames:
 		t.htrun.httpdaeget(w, r, query)
 	case apc.WhatSysInfo:
@@ -259,6 +259,12 @@ func (t *target) httpdaeget(w http.ResponseWriter, r *http.Request) {
 		t.writeJSON(w, r, tsysinfo, httpdaeWhat)
 	case apc.WhatMountpaths:
 		t.writeJSON(w, r, fs.MountpathsToLists(), httpdaeWhat)
+	case apc.WhatDecommVerify:
+		if !t.owner.smap.get().InMaintOrDecomm(t.si) {
+			t.writeErrf(w, r, "%s is not being decommissioned", t)
+			return
+		}
+		t.writeJSON(w, r, t.verifyDecomm(), httpdaeWhat)
 	case apc.WhatNodeStatsAndStatus:
 		var rebSnap *cluster.Snap
 		if entry := xreg.GetLatest(xreg.Flt{Kind: apc.ActRebalance}); entry != nil {
@@ -290,6 +296,9 @@ func (t *target) httpdaeget(w http.ResponseWriter, r *http.Request) {
 		diskStats := make(ios.AllDiskStats)
 		fs.FillDiskStats(diskStats)
 		t.writeJSON(w, r, diskStats, httpdaeWhat)
+	case apc.WhatCapHistory:
+		tstats := t.statsT.(*stats.Trunner)
+		t.writeJSON(w, r, tstats.CapHistory(), httpdaeWhat)
 	case apc.WhatRemoteAIS:
 		var (
 			aisBackend = t.aisBackend()
@@ -431,6 +440,73 @@ func (t *target) unreg(action string, opts *apc.ActValRmNode) {
 	}
 }
 
+// Walk all local objects and check that each one is present on its (new) HRW target -
+// the one selected from the remaining (active) targets. In addition, check
+// the number of copies (mirrored buckets) and EC metadata (erasure-coded buckets).
+func (t *target) verifyDecomm() *apc.DecommVerifyResult {
+	var (
+		res   = &apc.DecommVerifyResult{}
+		smap  = t.owner.smap.get()
+		bmd   = t.owner.bmd.get()
+		avail = fs.GetAvail()
+	)
+	bmd.Range(nil, nil, func(bck *cluster.Bck) bool {
+		var (
+			props   = bck.Props
+			nbck    = bck.Clone()
+			hdrCopy = cmn.PropToHeader("mirror.copies")
+			hdrEC   = cmn.PropToHeader("ec.generation")
+		)
+		cb := func(fqn string, _ fs.DirEntry) error {
+			lom := cluster.AllocLOM("")
+			defer cluster.FreeLOM(lom)
+			if err := lom.InitFQN(fqn, &nbck); err != nil {
+				return nil
+			}
+			if err := lom.Load(false /*cache it*/, false /*locked*/); err != nil || lom.IsCopy() {
+				return nil
+			}
+			res.NumObjs++
+			tsi, _, err := lom.HrwTarget(&smap.Smap)
+			if err == nil && tsi.ID() != t.SID() {
+				hdr, ok := t.headObjHdr(lom, tsi)
+				switch {
+				case !ok:
+				case props.Mirror.Enabled && props.Mirror.Copies > 1:
+					copies, _ := strconv.ParseInt(hdr.Get(hdrCopy), 10, 64)
+					if copies >= props.Mirror.Copies {
+						return nil
+					}
+				case props.EC.Enabled:
+					if hdr.Get(hdrEC) != "" {
+						return nil
+					}
+				default:
+					return nil
+				}
+			}
+			res.NumUnplaced++
+			if len(res.Unplaced) < apc.MaxDecommUnplaced {
+				res.Unplaced = append(res.Unplaced, lom.Cname())
+			}
+			return nil
+		}
+		for _, mi := range avail {
+			opts := &fs.WalkOpts{Mi: mi, Bck: nbck, CTs: []string{fs.ObjectType}, Callback: cb, Sorted: false}
+			if err := fs.Walk(opts); err != nil {
+				glog.Errorf("%s: failed to verify %s: %v", t, mi, err)
+			}
+		}
+		return false
+	})
+	if res.NumUnplaced > 0 {
+		glog.Errorf("%s: %d (out of %d) object%s not migrated", t, res.NumUnplaced, res.NumObjs, cos.Plural(int(res.NumUnplaced)))
+	} else {
+		glog.Infof("%s: verified %d object%s", t, res.NumObjs, cos.Plural(int(res.NumObjs)))
+	}
+	return res
+}
+
 func (t *target) handleMountpathReq(w http.ResponseWriter, r *http.Request) {
 	msg, err := t.readActionMsg(w, r)
 	if err != nil {
@@ -1072,6 +1148,12 @@ func (t *target) enable() error {
 // HeadObjT2T checks with a given target to see if it has the object.
 // (compare with api.HeadObject)
 func (t *target) HeadObjT2T(lom *cluster.LOM, tsi *cluster.Snode) (ok bool) {
+	_, ok = t.headObjHdr(lom, tsi)
+	return
+}
+
+// same as above, plus returns object properties (in the response header)
+func (t *target) headObjHdr(lom *cluster.LOM, tsi *cluster.Snode) (hdr http.Header, ok bool) {
 	q := lom.Bck().AddToQuery(nil)
 	q.Set(apc.QparamSilent, "true")
 	q.Set(apc.QparamFltPresence, strconv.Itoa(apc.FltPresent))
@@ -1091,7 +1173,7 @@ func (t *target) HeadObjT2T(lom *cluster.LOM, tsi *cluster.Snode) (ok bool) {
 		cargs.timeout = cmn.Timeout.CplaneOperation()
 	}
 	res := t.call(cargs)
-	ok = res.err == nil
+	ok, hdr = res.err == nil, res.header
 	freeCargs(cargs)
 	freeCR(res)
 	return
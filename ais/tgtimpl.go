@@ -6,6 +6,7 @@ package ais
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"net/url"
 	"os"
@@ -278,7 +279,7 @@ func (t *target) _promLocal(params *cluster.PromoteParams, lom *cluster.LOM) (fi
 
 		fileSize = fi.Size()
 		workFQN = params.SrcFQN
-		if params.Cksum != nil {
+		if params.Cksum != nil && !params.ComputeCksum {
 			lom.SetCksum(params.Cksum) // already computed somewhere else, use it
 		} else {
 			clone := lom.CloneMD(params.SrcFQN)
@@ -311,6 +312,21 @@ func (t *target) _promLocal(params *cluster.PromoteParams, lom *cluster.LOM) (fi
 	lom.SetSize(fileSize)
 	errCode, err = poi.finalize()
 	freePutObjInfo(poi)
+	if err == nil && params.VerifyCksum {
+		err = t._promVerify(params, lom)
+	}
+	return
+}
+
+// post-promotion: re-read the object and validate its content against the checksum
+// computed (and stored) while promoting
+func (t *target) _promVerify(params *cluster.PromoteParams, lom *cluster.LOM) (err error) {
+	lom.Lock(false)
+	err = lom.ValidateContentChecksum()
+	lom.Unlock(false)
+	if err != nil {
+		err = fmt.Errorf("%s: failed to verify %s (promoted from %q): %w", t, lom, params.SrcFQN, err)
+	}
 	return
 }
 
@@ -323,6 +339,16 @@ func (t *target) _promRemote(params *cluster.PromoteParams, lom *cluster.LOM, ts
 	if !params.OverwriteDst && t.HeadObjT2T(lom, tsi) {
 		return -1, nil
 	}
+	// compute locally and send it along for the remote target to store (and validate)
+	if params.ComputeCksum || params.VerifyCksum {
+		cksum, err := lom.ComputeCksum(lom.CksumType())
+		if err != nil {
+			return 0, err
+		}
+		if cksum != nil {
+			lom.SetCksum(cksum.Clone())
+		}
+	}
 
 	coi := allocCopyObjInfo()
 	{
@@ -333,9 +359,33 @@ func (t *target) _promRemote(params *cluster.PromoteParams, lom *cluster.LOM, ts
 	}
 	size, err := coi.sendRemote(lom, lom.ObjName, tsi)
 	freeCopyObjInfo(coi)
+	if err == nil && params.VerifyCksum {
+		err = t._promVerifyRemote(params, lom, tsi)
+	}
 	return size, err
 }
 
+// HEAD the promoted object on its (remote) target and compare checksums
+func (t *target) _promVerifyRemote(params *cluster.PromoteParams, lom *cluster.LOM, tsi *cluster.Snode) (err error) {
+	cksum := lom.Checksum()
+	if cksum.IsEmpty() {
+		return nil // (checksumming disabled for the bucket)
+	}
+	hdr, ok := t.headObjHdr(lom, tsi)
+	if !ok {
+		err = fmt.Errorf("failed to HEAD %s at %s", lom, tsi)
+	} else {
+		oa := &cmn.ObjAttrs{}
+		if remote := oa.FromHeader(hdr); !cksum.Equal(remote) {
+			err = cos.NewBadDataCksumError(cksum, remote, lom.String())
+		}
+	}
+	if err != nil {
+		err = fmt.Errorf("%s: failed to verify %s promoted to %s (from %q): %w", t, lom, tsi, params.SrcFQN, err)
+	}
+	return
+}
+
 //
 // implements health.fspathDispatcher interface
 //
@@ -86,6 +86,7 @@ type (
 		partialCksum *cos.CksumHash
 		nodeID       string
 		filePath     string
+		base         string // mtime of the object the session started with (empty if none)
 	}
 
 	appendObjInfo struct {
@@ -1035,6 +1036,15 @@ func (aoi *appendObjInfo) appendObject() (newHandle string, errCode int, err err
 				return
 			}
 			aoi.hi.partialCksum = cos.NewCksumHash(aoi.lom.CksumType())
+			// new session: start with the current content of the object (if exists)
+			if aoi.hi.base, err = aoi.seed(f); err != nil {
+				cos.Close(f)
+				if errRemove := cos.RemoveFile(filePath); errRemove != nil {
+					glog.Errorf(fmtNested, aoi.t, err, "remove", filePath, errRemove)
+				}
+				errCode = http.StatusInternalServerError
+				return
+			}
 		} else {
 			f, err = os.OpenFile(filePath, os.O_APPEND|os.O_WRONLY, cos.PermRWR)
 			if err != nil {
@@ -1064,7 +1074,7 @@ func (aoi *appendObjInfo) appendObject() (newHandle string, errCode int, err err
 			return
 		}
 
-		newHandle = combineAppendHandle(aoi.t.si.ID(), filePath, aoi.hi.partialCksum)
+		newHandle = combineAppendHandle(aoi.t.si.ID(), filePath, aoi.hi.partialCksum, aoi.hi.base)
 	case apc.FlushOp:
 		if filePath == "" {
 			err = fmt.Errorf("failed to finalize append-file operation: empty source in the %+v handle", aoi.hi)
@@ -1072,6 +1082,14 @@ func (aoi *appendObjInfo) appendObject() (newHandle string, errCode int, err err
 			return
 		}
 		debug.Assert(aoi.hi.partialCksum != nil)
+		if base := aoi.curBase(); base != aoi.hi.base {
+			err = fmt.Errorf("%s: failed to flush, %s was modified since the append session started", aoi.t, aoi.lom)
+			if errRemove := cos.RemoveFile(filePath); errRemove != nil {
+				glog.Errorf(fmtNested, aoi.t, err, "remove", filePath, errRemove)
+			}
+			errCode = http.StatusConflict
+			return
+		}
 		aoi.hi.partialCksum.Finalize()
 		partialCksum := aoi.hi.partialCksum.Clone()
 		if !aoi.cksum.IsEmpty() && !partialCksum.Equal(aoi.cksum) {
@@ -1107,12 +1125,55 @@ func (aoi *appendObjInfo) appendObject() (newHandle string, errCode int, err err
 	return
 }
 
+// copy the existing object (if any) into the newly created workfile;
+// return its mtime to be later validated upon flush
+func (aoi *appendObjInfo) seed(f *os.File) (string, error) {
+	lom := aoi.lom
+	lom.Lock(false)
+	defer lom.Unlock(false)
+	if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+		if cmn.IsObjNotExist(err) {
+			return "", nil
+		}
+		return "", err
+	}
+	src, err := os.Open(lom.FQN)
+	if err != nil {
+		return "", err
+	}
+	finfo, err := src.Stat()
+	if err != nil {
+		cos.Close(src)
+		return "", err
+	}
+	buf, slab := aoi.t.gmm.AllocSize(finfo.Size())
+	_, err = io.CopyBuffer(cos.NewWriterMulti(f, aoi.hi.partialCksum.H), src, buf)
+	slab.Free(buf)
+	cos.Close(src)
+	if err != nil {
+		return "", err
+	}
+	return strconv.FormatInt(finfo.ModTime().UnixNano(), 10), nil
+}
+
+// current mtime of the object (empty if it doesn't exist)
+func (aoi *appendObjInfo) curBase() string {
+	lom := aoi.lom
+	lom.Lock(false)
+	finfo, err := os.Stat(lom.FQN)
+	lom.Unlock(false)
+	if err != nil {
+		return ""
+	}
+	return strconv.FormatInt(finfo.ModTime().UnixNano(), 10)
+}
+
 func parseAppendHandle(handle string) (hi handleInfo, err error) {
 	if handle == "" {
 		return
 	}
-	p := strings.SplitN(handle, "|", 4)
-	if len(p) != 4 {
+	p := strings.SplitN(handle, "|", 5)
+	if len(p) != 4 && len(p) != 5 {
 		return hi, fmt.Errorf("invalid APPEND handle: %q", handle)
 	}
 	hi.partialCksum = cos.NewCksumHash(p[2])
@@ -1126,15 +1187,18 @@ func parseAppendHandle(handle string) (hi handleInfo, err error) {
 	}
 	hi.nodeID = p[0]
 	hi.filePath = p[1]
+	if len(p) == 5 {
+		hi.base = p[4]
+	}
 	return
 }
 
-func combineAppendHandle(nodeID, filePath string, partialCksum *cos.CksumHash) string {
+func combineAppendHandle(nodeID, filePath string, partialCksum *cos.CksumHash, base string) string {
 	buf, err := partialCksum.H.(encoding.BinaryMarshaler).MarshalBinary()
 	debug.AssertNoErr(err)
 	cksumTy := partialCksum.Type()
 	cksumBinary := base64.StdEncoding.EncodeToString(buf)
-	return nodeID + "|" + filePath + "|" + cksumTy + "|" + cksumBinary
+	return nodeID + "|" + filePath + "|" + cksumTy + "|" + cksumBinary + "|" + base
 }
 
 //
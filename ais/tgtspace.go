@@ -38,15 +38,15 @@ func (t *target) OOS(csRefreshed *fs.CapStatus) (cs fs.CapStatus) {
 	}
 	// run serially, cleanup first and LRU iff out-of-space persists
 	go func() {
-		cs := t.runStoreCleanup("" /*uuid*/, nil /*wg*/)
+		cs, xid := t.runStoreCleanup("" /*uuid*/, nil /*wg*/, nil /*xargs*/)
 		if cs.Err != nil {
-			t.runLRU("" /*uuid*/, nil /*wg*/, false)
+			t.runLRU("" /*uuid*/, xid /*parent*/, nil /*wg*/, nil /*xargs*/)
 		}
 	}()
 	return
 }
 
-func (t *target) runLRU(id string, wg *sync.WaitGroup, force bool, bcks ...cmn.Bck) {
+func (t *target) runLRU(id, parent string, wg *sync.WaitGroup, xargs *xact.ArgsMsg) {
 	regToIC := id == ""
 	if regToIC {
 		id = cos.GenUUID()
@@ -60,6 +60,9 @@ func (t *target) runLRU(id string, wg *sync.WaitGroup, force bool, bcks ...cmn.B
 		return
 	}
 	xlru := rns.Entry.Get()
+	if parent != "" {
+		xlru.(*space.XactLRU).SetParent(parent)
+	}
 	if regToIC && xlru.ID() == id {
 		// pre-existing UUID: notify IC members
 		regMsg := xactRegMsg{UUID: id, Kind: apc.ActLRU, Srcs: []string{t.si.ID()}}
@@ -70,11 +73,13 @@ func (t *target) runLRU(id string, wg *sync.WaitGroup, force bool, bcks ...cmn.B
 		T:                   t,
 		Xaction:             xlru.(*space.XactLRU),
 		StatsT:              t.statsT,
-		Buckets:             bcks,
 		GetFSUsedPercentage: ios.GetFSUsedPercentage,
 		GetFSStats:          ios.GetFSStats,
 		WG:                  wg,
-		Force:               force,
+	}
+	if xargs != nil {
+		ini.Buckets, ini.Force = xargs.Buckets, xargs.Force
+		ini.LowWM, ini.HighWM = xargs.LowWM, xargs.HighWM
 	}
 	xlru.AddNotif(&xact.NotifXact{
 		Base: nl.Base{When: cluster.UponTerm, Dsts: []string{equalIC}, F: t.callerNotifyFin},
@@ -83,7 +88,8 @@ func (t *target) runLRU(id string, wg *sync.WaitGroup, force bool, bcks ...cmn.B
 	space.RunLRU(&ini)
 }
 
-func (t *target) runStoreCleanup(id string, wg *sync.WaitGroup, bcks ...cmn.Bck) fs.CapStatus {
+// returns the resulting capacity status and the ID of the store-cleanup xaction that has run (if any)
+func (t *target) runStoreCleanup(id string, wg *sync.WaitGroup, xargs *xact.ArgsMsg) (fs.CapStatus, string) {
 	regToIC := id == ""
 	if regToIC {
 		id = cos.GenUUID()
@@ -94,7 +100,7 @@ func (t *target) runStoreCleanup(id string, wg *sync.WaitGroup, bcks ...cmn.Bck)
 		if wg != nil {
 			wg.Done()
 		}
-		return fs.CapStatus{}
+		return fs.CapStatus{}, ""
 	}
 	xcln := rns.Entry.Get()
 	if regToIC && xcln.ID() == id {
@@ -107,12 +113,15 @@ func (t *target) runStoreCleanup(id string, wg *sync.WaitGroup, bcks ...cmn.Bck)
 		T:       t,
 		Xaction: xcln.(*space.XactCln),
 		StatsT:  t.statsT,
-		Buckets: bcks,
 		WG:      wg,
 	}
+	if xargs != nil {
+		ini.Buckets = xargs.Buckets
+		ini.OlderThan, ini.DryRun = xargs.OlderThan, xargs.DryRun
+	}
 	xcln.AddNotif(&xact.NotifXact{
 		Base: nl.Base{When: cluster.UponTerm, Dsts: []string{equalIC}, F: t.callerNotifyFin},
 		Xact: xcln,
 	})
-	return space.RunCleanup(&ini)
+	return space.RunCleanup(&ini), xcln.ID()
 }
@@ -11,6 +11,7 @@ import (
 	"net/url"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/NVIDIA/aistore/3rdparty/glog"
@@ -490,7 +491,7 @@ func (t *target) etlDP(msg *apc.TCBMsg) (cluster.DP, error) {
 	if err := msg.Validate(true); err != nil {
 		return nil, err
 	}
-	return etl.NewOfflineDP(msg, t.si)
+	return etl.NewOfflineDP(msg, t)
 }
 
 // common for both bucket copy and bucket transform - does the heavy lifting
@@ -1017,7 +1018,10 @@ func prmScan(dirFQN string, prmMsg *cluster.PromoteArgs) (fqns []string, totalN
 
 // synchronously wo/ xaction
 func (t *target) prmNumFiles(c *txnServerCtx, txnPrm *txnPromote, confirmedFshare bool) error {
-	smap := t.owner.smap.Get()
+	var (
+		smap   = t.owner.smap.Get()
+		failed []string // when continuing on error
+	)
 	for _, fqn := range txnPrm.fqns {
 		objName, err := cmn.PromotedObjDstName(fqn, txnPrm.dirFQN, txnPrm.msg.ObjName)
 		if err != nil {
@@ -1040,12 +1044,22 @@ func (t *target) prmNumFiles(c *txnServerCtx, txnPrm *txnPromote, confirmedFshar
 				ObjName:      objName,
 				OverwriteDst: txnPrm.msg.OverwriteDst,
 				DeleteSrc:    txnPrm.msg.DeleteSrc,
+				ComputeCksum: txnPrm.msg.ComputeCksum,
+				VerifyCksum:  txnPrm.msg.VerifyCksum,
 			},
 		}
 		if _, err := t.Promote(params); err != nil {
-			return err
+			if !txnPrm.msg.ContinueOnError {
+				return err
+			}
+			glog.Errorf("%s: failed to promote %q: %v - continuing...", t, fqn, err)
+			failed = append(failed, fmt.Sprintf("%q: %v", fqn, err))
 		}
 	}
+	if len(failed) > 0 {
+		return fmt.Errorf("%s: failed to promote %d file%s: [%s]", t, len(failed), cos.Plural(len(failed)),
+			strings.Join(failed, "; "))
+	}
 	return nil
 }
 
@@ -17,6 +17,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/nl"
 	"github.com/NVIDIA/aistore/res"
+	"github.com/NVIDIA/aistore/space"
 	"github.com/NVIDIA/aistore/xact"
 	"github.com/NVIDIA/aistore/xact/xreg"
 )
@@ -167,15 +168,18 @@ func (t *target) xstart(r *http.Request, args *xact.ArgsMsg, bck *cluster.Bck) e
 			glog.Errorf(erfmb, args.Kind, bck)
 		}
 		q := r.URL.Query()
-		force := cos.IsParseBool(q.Get(apc.QparamForce)) // NOTE: the only 'force' use case so far
+		args.Force = args.Force || cos.IsParseBool(q.Get(apc.QparamForce)) // NOTE: the only 'force' use case so far
+		if err := space.ValidateWMs(args.LowWM, args.HighWM, &cmn.GCO.Get().Space); err != nil {
+			return err
+		}
 		wg := &sync.WaitGroup{}
 		wg.Add(1)
-		go t.runLRU(args.ID, wg, force, args.Buckets...)
+		go t.runLRU(args.ID, "" /*parent*/, wg, args)
 		wg.Wait()
 	case apc.ActStoreCleanup:
 		wg := &sync.WaitGroup{}
 		wg.Add(1)
-		go t.runStoreCleanup(args.ID, wg, args.Buckets...)
+		go t.runStoreCleanup(args.ID, wg, args)
 		wg.Wait()
 	case apc.ActResilver:
 		if bck != nil {
@@ -195,7 +199,7 @@ func (t *target) xstart(r *http.Request, args *xact.ArgsMsg, bck *cluster.Bck) e
 	// 2. with bucket
 	case apc.ActPrefetchObjects:
 		var (
-			smsg = &cmn.ListRange{}
+			smsg = &cmn.PrefetchMsg{}
 		)
 		rns := xreg.RenewPrefetch(args.ID, t, bck, smsg)
 		if rns.Err != nil {
@@ -216,6 +220,21 @@ func (t *target) xstart(r *http.Request, args *xact.ArgsMsg, bck *cluster.Bck) e
 	case apc.ActLoadLomCache:
 		rns := xreg.RenewBckLoadLomCache(t, args.ID, bck)
 		return rns.Err
+	case apc.ActExpirySweep:
+		rns := xreg.RenewExpirySweep(args.ID, t, bck)
+		if rns.Err != nil {
+			return rns.Err
+		}
+		xctn := rns.Entry.Get()
+		xctn.AddNotif(&xact.NotifXact{
+			Base: nl.Base{
+				When: cluster.UponTerm,
+				Dsts: []string{equalIC},
+				F:    t.callerNotifyFin,
+			},
+			Xact: xctn,
+		})
+		go xctn.Run(nil)
 	// 3. cannot start
 	case apc.ActPutCopies:
 		return fmt.Errorf("cannot start %q (is driven by PUTs into a mirrored bucket)", args)
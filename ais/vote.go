@@ -519,6 +519,7 @@ func (h *htrun) httpsetprimaryproxy(w http.ResponseWriter, r *http.Request) {
 		pre: h._votedPrimary,
 		nid: vr.Candidate,
 		sid: vr.Primary,
+		msg: &apc.ActMsg{Action: smapCauseVote},
 	}
 	err := h.owner.smap.modify(ctx)
 	if err != nil {
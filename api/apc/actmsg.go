@@ -19,6 +19,7 @@ const (
 	ActDestroyBck     = "destroy-bck" // destroy bucket data and metadata
 	ActSummaryBck     = "summary-bck"
 	ActCopyBck        = "copy-bck"
+	ActCopyObject     = "copy-obj" // single object, within the same bucket
 	ActDownload       = "download"
 	ActECEncode       = "ec-encode" // erasure code a bucket
 	ActECGet          = "ec-get"    // erasure decode objects
@@ -56,7 +57,11 @@ const (
 	ActETLObjects      = "etl-listrange"
 	ActEvictObjects    = "evict-listrange"
 	ActPrefetchObjects = "prefetch-listrange"
-	ActArchive         = "archive" // see ArchiveMsg
+	ActRechecksum      = "rechecksum" // see RechecksumMsg
+	ActArchive         = "archive"    // see ArchiveMsg
+	ActExtract         = "extract"    // see ExtractMsg
+
+	ActExpirySweep = "expiry-sweep" // remove expired objects (see cmn.ExpiresObjMD)
 
 	ActAttachRemAis = "attach"
 	ActDetachRemAis = "detach"
@@ -64,6 +69,8 @@ const (
 	// Node maintenance & cluster membership (see the corresponding URL path words below)
 	ActStartMaintenance   = "start-maintenance"     // put into maintenance state
 	ActStopMaintenance    = "stop-maintenance"      // cancel maintenance state
+	ActEndProbation       = "end-probation"         // promote probationary target to a full (data-serving) member
+	ActResolveDupNode     = "resolve-dup-node"      // keep the specified node, remove its duplicate(s) from Smap
 	ActShutdownNode       = "shutdown-node"         // shutdown node
 	ActCallbackRmFromSmap = "callback-rm-from-smap" // set by primary when requested (internal use only)
 	ActDecommissionNode   = "decommission-node"     // start rebalance and, when done, remove node from Smap
@@ -113,6 +120,7 @@ const (
 const (
 	NodeMaintenance  = "maintenance"
 	NodeDecommission = "decommission"
+	NodeProbation    = "probation"
 )
 
 const (
@@ -132,6 +140,9 @@ type (
 		RmUserData        bool   `json:"rm_user_data"`        // decommission-only
 		KeepInitialConfig bool   `json:"keep_initial_config"` // ditto (to be able to restart a node from scratch)
 		NoShutdown        bool   `json:"no_shutdown"`
+		Verify            bool   `json:"verify"`                    // decommission-only: verify data migration prior to removing from Smap
+		Force             bool   `json:"force"`                     // ditto: remove even when verification fails
+		Duration          int64  `json:"duration,string,omitempty"` // maintenance-only: automatically stop after (nanoseconds)
 	}
 )
 
@@ -140,8 +151,17 @@ type (
 		DaemonID    string `json:"daemon_id"`
 		RebalanceID string `json:"rebalance_id"`
 	}
+	// target that is being decommissioned: objects that do not (yet) have
+	// their required copies (or EC) on the remaining targets
+	DecommVerifyResult struct {
+		Unplaced    []string `json:"unplaced"` // names (up to MaxDecommUnplaced)
+		NumObjs     int64    `json:"num_objs"`
+		NumUnplaced int64    `json:"num_unplaced"`
+	}
 )
 
+const MaxDecommUnplaced = 100
+
 // MountpathList contains two lists:
 //   - Available - list of local mountpaths available to the storage target
 //   - WaitingDD - waiting for resilvering completion to be detached or disabled (moved to `Disabled`)
@@ -41,6 +41,7 @@ const (
 	HdrObjAtime     = HeaderPrefix + "atime"          // Object access time.
 	HdrObjCustomMD  = HeaderPrefix + "custom-md"      // Object custom metadata.
 	HdrObjVersion   = HeaderPrefix + "version"        // Object version/generation - ais or cloud.
+	HdrObjStale     = HeaderPrefix + "obj-stale"      // In-cluster copy differs from (or no longer exists in) remote backend.
 
 	// Archive filename and format (mime type)
 	HdrArchpath = HeaderPrefix + "archpath"
@@ -59,6 +60,9 @@ const (
 	// uptimes, respectively
 	HdrNodeUptime    = HeaderPrefix + "node-uptime"
 	HdrClusterUptime = HeaderPrefix + "cluster-uptime"
+
+	// Log offset (the starting one) when following (tailing) node log (see also: QparamLogFlw)
+	HdrLogOffset = HeaderPrefix + "log-offset"
 )
 
 // AuthN consts
@@ -74,6 +78,9 @@ const (
 	HdrCallerName        = HeaderPrefix + "caller-name"
 	HdrCallerSmapVersion = HeaderPrefix + "caller-smap-ver"
 
+	// Primary's versions of cluster-level metadata (keepalive response; see also: stats.MetasyncLag*)
+	HdrRevsVersions = HeaderPrefix + "revs-versions"
+
 	HdrXactionID = HeaderPrefix + "xaction-id"
 
 	// Stream related headers.
@@ -97,9 +97,11 @@ const (
 	LocIsCopyMissingObj
 
 	// Flags
-	EntryIsCached = 1 << (EntryStatusBits + 1)
-	EntryInArch   = 1 << (EntryStatusBits + 2)
-	EntryIsDir    = 1 << (EntryStatusBits + 3)
+	EntryIsCached  = 1 << (EntryStatusBits + 1)
+	EntryInArch    = 1 << (EntryStatusBits + 2)
+	EntryIsDir     = 1 << (EntryStatusBits + 3)
+	EntryIsStale   = 1 << (EntryStatusBits + 4) // cached copy is out of date (client-side, via HEAD w/ QparamLatestVer)
+	EntryHeadCksum = 1 << (EntryStatusBits + 5) // checksum missing in the listing was filled in (client-side, via HEAD)
 )
 
 // ObjEntry.Flags field
@@ -121,6 +123,10 @@ const (
 	GetPropsEC       = "ec"
 	GetPropsCustom   = "custom"
 	GetPropsLocation = "location" // advanced usage
+
+	// HEAD(object) only: original source URL of an object in HTTP bucket
+	// (stored as `cmn.OrigURLObjMD` custom metadata; not listed)
+	GetPropsOrigURL = "orig_url"
 )
 
 // NOTE: update when changing any of the above :NOTE
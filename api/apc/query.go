@@ -17,6 +17,7 @@ const (
 
 	QparamRegex      = "regex"       // dsort: list regex
 	QparamOnlyActive = "only_active" // dsort: list only active
+	QparamCleanup    = "cleanup"     // dsort: (abort and) remove partially written output shards
 
 	// remove existing custom keys and store new custom metadata
 	// NOTE: making an s/_/-/ naming exception because of the namesake CLI usage
@@ -55,6 +56,7 @@ const (
 	// Log severity
 	QparamLogSev = "severity" // see { LogInfo, ...} enum
 	QparamLogOff = "offset"
+	QparamLogFlw = "follow" // true: keep streaming (tailing) the log until the client disconnects
 
 	// Archive filename and format (mime type)
 	QparamArchpath = "archpath"
@@ -67,6 +69,16 @@ const (
 	// - we simply don't care.
 	QparamSkipVC = "skip_vc"
 
+	// Object versions (versioning-capable remote backends only):
+	// - GET a specific version of the object (bypassing in-cluster copy)
+	// - GET the list of all available versions (JSON-encoded `cmn.ObjVersions`)
+	QparamVersionID    = "version_id"
+	QparamListVersions = "list_versions"
+
+	// HEAD(object) in-cluster copy and compare it with the remote origin
+	// (to tell whether the former is still the latest; see HdrObjStale)
+	QparamLatestVer = "latest_ver"
+
 	// force the operation; allows to overcome certain restrictions (e.g., shutdown primary and the entire cluster)
 	// or errors (e.g., attach invalid mountpath)
 	QparamForce = "frc"
@@ -124,6 +136,8 @@ const (
 	QparamPrimaryCandidate = "can" // ID of the candidate for the primary proxy.
 	QparamPrepare          = "prp" // true: request belongs to the "prepare" phase of the primary proxy election
 	QparamNonElectable     = "nel" // true: proxy is non-electable for the primary role
+	QparamProbation        = "prb" // true: (admin-)join target in probation (see NodeFlagProbation)
+	QparamDrainTimeout     = "drt" // set-primary: max time to wait for the candidate to catch up with the current Smap
 	QparamUnixTime         = "utm" // Unix time since 01/01/70 UTC (nanoseconds)
 	QparamIsGFNRequest     = "gfn" // true if the request is a Get-From-Neighbor
 	QparamSilent           = "sln" // true: destination should not log errors (HEAD request)
@@ -151,6 +165,9 @@ const (
 
 	// Notification target's node ID (usually, the node that initiates the operation).
 	QparamNotifyMe = "nft"
+
+	// GET ?what=smap_history: retained Smap of a given version
+	QparamSmapVersion = "smap_version"
 )
 
 // QparamWhat enum.
@@ -167,11 +184,16 @@ const (
 	WhatMetricNames        = "metrics"
 	WhatDiskStats          = "disk"
 	// assorted
-	WhatMountpaths = "mountpaths"
-	WhatRemoteAIS  = "remote"
-	WhatSmapVote   = "smapvote"
-	WhatSysInfo    = "sysinfo"
-	WhatTargetIPs  = "target_ips" // comma-separated list of all target IPs (compare w/ GetWhatSnode)
+	WhatMountpaths   = "mountpaths"
+	WhatRemoteAIS    = "remote"
+	WhatSmapVote     = "smapvote"
+	WhatSysInfo      = "sysinfo"
+	WhatTargetIPs    = "target_ips"    // comma-separated list of all target IPs (compare w/ GetWhatSnode)
+	WhatDupNodes     = "dup_nodes"     // (IP, port) and DaemonID conflicts pending resolution (see ActResolveDupNode)
+	WhatDecommVerify = "decomm_verify" // target being decommissioned: verify its data has migrated
+	WhatSmapCheck    = "smap_check"    // (debug) validate the node's current Smap invariants
+	WhatSmapHist     = "smap_history"  // the node's Smap history (see also QparamSmapVersion)
+	WhatCapHistory   = "cap_history"   // target's capacity samples over time (see stats.CapSample)
 	// log
 	WhatLog = "log"
 	// xactions
@@ -6,11 +6,15 @@ package apc
 
 import (
 	"errors"
+	"fmt"
 	"strings"
 
 	"github.com/NVIDIA/aistore/cmn/cos"
 )
 
+// max number of objects per (offline) ETL transform request
+const MaxETLBatchSize = 1024
+
 // copy & (offline) transform bucket to bucket
 type (
 	CopyBckMsg struct {
@@ -22,6 +26,15 @@ type (
 	Transform struct {
 		Name    string       `json:"id,omitempty"`
 		Timeout cos.Duration `json:"request_timeout,omitempty"`
+		// when > 1: transform up to so many objects per request
+		// (provided the ETL supports it - see etl.InitMsgBase.Batch)
+		BatchSize int `json:"batch_size,omitempty"`
+		// when non-empty: for each object that fails to transform, store a JSON record
+		// (object name, error, transformer's output) as ErrsTo/<object-name>.err;
+		// the format is "[provider://]bucket[/prefix]"
+		ErrsTo string `json:"errs_to,omitempty"`
+		// max number of (stored) error records; zero means no limit
+		MaxErrs int `json:"max_errs,omitempty"`
 	}
 	TCBMsg struct {
 		// NOTE: resulting object names will have this extension, if specified.
@@ -32,6 +45,7 @@ type (
 
 		Transform
 		CopyBckMsg
+		ContinueOnError bool `json:"coer"` // on err, keep running (copy or transform)
 	}
 )
 
@@ -43,6 +57,12 @@ func (msg *TCBMsg) Validate(isEtl bool) (err error) {
 	if isEtl && msg.Transform.Name == "" {
 		err = errors.New("ETL name can't be empty")
 	}
+	if msg.Transform.BatchSize < 0 || msg.Transform.BatchSize > MaxETLBatchSize {
+		err = fmt.Errorf("invalid ETL batch size %d (expecting 0 <= batch-size <= %d)", msg.Transform.BatchSize, MaxETLBatchSize)
+	}
+	if msg.Transform.MaxErrs < 0 {
+		err = fmt.Errorf("invalid max number of ETL error records %d (expecting non-negative)", msg.Transform.MaxErrs)
+	}
 	return
 }
 
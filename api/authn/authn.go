@@ -258,6 +258,30 @@ func DeleteRole(bp api.BaseParams, role string) error {
 	return reqParams.DoRequest()
 }
 
+// RefreshToken exchanges the given (still valid) token for a new one
+// with a new expiration time (nil: AuthN's default)
+func RefreshToken(bp api.BaseParams, token, clusterID string, expire *time.Duration) (*TokenMsg, error) {
+	bp.Method = http.MethodPost
+	bp.Token = token
+	rec := LoginMsg{ExpiresIn: expire, ClusterID: clusterID}
+	reqParams := api.AllocRp()
+	defer api.FreeRp(reqParams)
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathTokens.S
+		reqParams.Body = cos.MustMarshal(rec)
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+	}
+	msg := &TokenMsg{}
+	if _, err := reqParams.DoReqAny(msg); err != nil {
+		return nil, err
+	}
+	if msg.Token == "" {
+		return nil, errors.New("refresh failed: empty response from AuthN server")
+	}
+	return msg, nil
+}
+
 func RevokeToken(bp api.BaseParams, token string) error {
 	bp.Method = http.MethodDelete
 	msg := &TokenMsg{Token: token}
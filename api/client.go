@@ -6,6 +6,8 @@ package api
 
 import (
 	"bytes"
+	"context"
+	"encoding/xml"
 	"errors"
 	"fmt"
 	"io"
@@ -24,6 +26,9 @@ import (
 type (
 	BaseParams struct {
 		Client *http.Client
+		// optional; when canceled (or past its deadline) aborts the in-flight request,
+		// including reading or writing its body
+		Ctx    context.Context
 		URL    string
 		Method string
 		Token  string
@@ -85,6 +90,17 @@ func SetAuxHeaders(r *http.Request, bp *BaseParams) {
 	}
 }
 
+////////////////
+// BaseParams //
+////////////////
+
+func (bp *BaseParams) ctx() context.Context {
+	if bp.Ctx == nil {
+		return context.Background()
+	}
+	return bp.Ctx
+}
+
 func GetWhatRawQuery(getWhat, getProps string) string {
 	q := url.Values{}
 	q.Add(apc.QparamWhat, getWhat)
@@ -154,6 +170,21 @@ func (reqParams *ReqParams) DoReqAny(out any) (int, error) {
 	return resp.StatusCode, err
 }
 
+// same as above with XML-formatted response (S3 API)
+func (reqParams *ReqParams) doXML(out any) error {
+	body, err := reqParams.doReader()
+	if err != nil {
+		return err
+	}
+	err = xml.NewDecoder(body).Decode(out)
+	cos.DrainReader(body)
+	body.Close()
+	if err != nil {
+		return fmt.Errorf("failed to read response: %w", err)
+	}
+	return nil
+}
+
 // same as above with `out` being a string
 func (reqParams *ReqParams) doReqStr(out *string) (int, error) {
 	resp, err := reqParams.do()
@@ -201,7 +232,7 @@ func (reqParams *ReqParams) do() (resp *http.Response, err error) {
 		reqBody = bytes.NewBuffer(reqParams.Body)
 	}
 	urlPath := reqParams.BaseParams.URL + reqParams.Path
-	req, errR := http.NewRequest(reqParams.BaseParams.Method, urlPath, reqBody)
+	req, errR := http.NewRequestWithContext(reqParams.BaseParams.ctx(), reqParams.BaseParams.Method, urlPath, reqBody)
 	if errR != nil {
 		return nil, fmt.Errorf("failed to create http request: %w", errR)
 	}
@@ -7,6 +7,8 @@ package api
 import (
 	"net/http"
 	"net/url"
+	"strconv"
+	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cluster"
@@ -94,6 +96,55 @@ func GetNodeClusterMap(bp BaseParams, sid string) (smap *cluster.Smap, err error
 	return
 }
 
+// GetNodeBMD retrieves bucket metadata (BMD) from a specific node.
+func GetNodeBMD(bp BaseParams, sid string) (bmd *cluster.BMD, err error) {
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathReverseDae.S
+		reqParams.Query = url.Values{apc.QparamWhat: []string{apc.WhatBMD}}
+		reqParams.Header = http.Header{apc.HdrNodeID: []string{sid}}
+	}
+	_, err = reqParams.DoReqAny(&bmd)
+	FreeRp(reqParams)
+	return
+}
+
+// GetSmapHistory retrieves Smap history (versions, times, and changes) recorded by a given node while primary;
+// see also: cluster config `smap_history`
+func GetSmapHistory(bp BaseParams, sid string) (hist []cluster.SmapHistEntry, err error) {
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathReverseDae.S
+		reqParams.Query = url.Values{apc.QparamWhat: []string{apc.WhatSmapHist}}
+		reqParams.Header = http.Header{apc.HdrNodeID: []string{sid}}
+	}
+	_, err = reqParams.DoReqAny(&hist)
+	FreeRp(reqParams)
+	return
+}
+
+// GetRetainedSmap retrieves a given (older) Smap version, if retained by the specified node.
+func GetRetainedSmap(bp BaseParams, sid string, ver int64) (smap *cluster.Smap, err error) {
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathReverseDae.S
+		reqParams.Query = url.Values{
+			apc.QparamWhat:        []string{apc.WhatSmapHist},
+			apc.QparamSmapVersion: []string{strconv.FormatInt(ver, 10)},
+		}
+		reqParams.Header = http.Header{apc.HdrNodeID: []string{sid}}
+	}
+	_, err = reqParams.DoReqAny(&smap)
+	FreeRp(reqParams)
+	return
+}
+
 // GetClusterSysInfo retrieves AIStore system info.
 func GetClusterSysInfo(bp BaseParams) (info apc.ClusterSysInfo, err error) {
 	bp.Method = http.MethodGet
@@ -174,8 +225,33 @@ func GetRemoteAIS(bp BaseParams) (remais cluster.Remotes, err error) {
 	return
 }
 
+// GetDupNodes returns (IP, port) and DaemonID conflicts pending operator's resolution
+// (see ResolveDupNode)
+func GetDupNodes(bp BaseParams) (dups []*cluster.DupNodes, err error) {
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathClu.S
+		reqParams.Query = url.Values{apc.QparamWhat: []string{apc.WhatDupNodes}}
+	}
+	_, err = reqParams.DoReqAny(&dups)
+	FreeRp(reqParams)
+	return
+}
+
 // JoinCluster add a node to a cluster.
 func JoinCluster(bp BaseParams, nodeInfo *cluster.Snode) (rebID, sid string, err error) {
+	return joinCluster(bp, nodeInfo, nil)
+}
+
+// JoinClusterProbation adds a target in probation: the target joins the cluster map
+// but does not store user data (and is not rebalanced to) until promoted (see EndProbation)
+func JoinClusterProbation(bp BaseParams, nodeInfo *cluster.Snode) (rebID, sid string, err error) {
+	return joinCluster(bp, nodeInfo, url.Values{apc.QparamProbation: []string{"true"}})
+}
+
+func joinCluster(bp BaseParams, nodeInfo *cluster.Snode, query url.Values) (rebID, sid string, err error) {
 	bp.Method = http.MethodPost
 	reqParams := AllocRp()
 	{
@@ -183,6 +259,7 @@ func JoinCluster(bp BaseParams, nodeInfo *cluster.Snode) (rebID, sid string, err
 		reqParams.Path = apc.URLPathCluUserReg.S
 		reqParams.Body = cos.MustMarshal(nodeInfo)
 		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+		reqParams.Query = query
 	}
 
 	var info apc.JoinNodeResult
@@ -206,6 +283,20 @@ func SetPrimaryProxy(bp BaseParams, newPrimaryID string, force bool) error {
 	return err
 }
 
+// SetPrimaryProxyDrain is SetPrimaryProxy with graceful handoff: prior to switching,
+// the current primary pauses Smap modifications and waits (up to drainTimeout)
+// for the new primary to catch up; fails (and does not switch) upon timeout.
+func SetPrimaryProxyDrain(bp BaseParams, newPrimaryID string, drainTimeout time.Duration) error {
+	bp.Method = http.MethodPut
+	reqParams := AllocRp()
+	reqParams.BaseParams = bp
+	reqParams.Path = apc.URLPathCluProxy.Join(newPrimaryID)
+	reqParams.Query = url.Values{apc.QparamDrainTimeout: []string{drainTimeout.String()}}
+	err := reqParams.DoRequest()
+	FreeRp(reqParams)
+	return err
+}
+
 // SetClusterConfig given key-value pairs of cluster configuration parameters,
 // sets the cluster-wide configuration accordingly. Setting cluster-wide
 // configuration requires sending the request to a proxy.
@@ -268,6 +359,23 @@ func ResetClusterConfig(bp BaseParams) error {
 	return err
 }
 
+// ResetClusterConfigSection resets a single named section of the cluster configuration
+// (e.g., "lru") to its initial (deployment-time) values, leaving all other sections intact;
+// returns the reverted keys along with their restored values
+func ResetClusterConfigSection(bp BaseParams, section string) (reverted cos.StrKVs, err error) {
+	bp.Method = http.MethodPut
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathClu.S
+		reqParams.Body = cos.MustMarshal(apc.ActMsg{Action: apc.ActResetConfig, Value: section})
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+	}
+	_, err = reqParams.DoReqAny(&reverted)
+	FreeRp(reqParams)
+	return
+}
+
 // GetClusterConfig returns cluster-wide configuration
 // (compare with `api.GetDaemonConfig`)
 func GetClusterConfig(bp BaseParams) (*cmn.ClusterConfig, error) {
@@ -376,6 +484,26 @@ func DecommissionNode(bp BaseParams, actValue *apc.ActValRmNode) (xid string, er
 	return xid, err
 }
 
+// ResolveDupNode keeps the specified node (`actValue.DaemonID`) and removes
+// from the cluster map all nodes that conflict with it (see GetDupNodes)
+func ResolveDupNode(bp BaseParams, actValue *apc.ActValRmNode) error {
+	msg := apc.ActMsg{
+		Action: apc.ActResolveDupNode,
+		Value:  actValue,
+	}
+	bp.Method = http.MethodPut
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathClu.S
+		reqParams.Body = cos.MustMarshal(msg)
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+	}
+	err := reqParams.DoRequest()
+	FreeRp(reqParams)
+	return err
+}
+
 func StopMaintenance(bp BaseParams, actValue *apc.ActValRmNode) (xid string, err error) {
 	msg := apc.ActMsg{
 		Action: apc.ActStopMaintenance,
@@ -394,6 +522,26 @@ func StopMaintenance(bp BaseParams, actValue *apc.ActValRmNode) (xid string, err
 	return xid, err
 }
 
+// EndProbation promotes probationary target to a full member of the cluster
+// (that stores user data); returns ID of the triggered global rebalance, if any
+func EndProbation(bp BaseParams, actValue *apc.ActValRmNode) (xid string, err error) {
+	msg := apc.ActMsg{
+		Action: apc.ActEndProbation,
+		Value:  actValue,
+	}
+	bp.Method = http.MethodPut
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathClu.S
+		reqParams.Body = cos.MustMarshal(msg)
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+	}
+	_, err = reqParams.doReqStr(&xid)
+	FreeRp(reqParams)
+	return xid, err
+}
+
 // ShutdownCluster shuts down the whole cluster
 func ShutdownCluster(bp BaseParams) error {
 	msg := apc.ActMsg{Action: apc.ActShutdown}
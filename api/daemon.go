@@ -22,6 +22,7 @@ type GetLogInput struct {
 	Writer   io.Writer
 	Severity string // one of: {cmn.LogInfo, ...}
 	Offset   int64
+	Follow   bool // keep streaming until the writer fails or the node goes away; negative offset: start at the end
 }
 
 // GetMountpaths given the direct public URL of the target, returns the target's mountpaths or error.
@@ -42,6 +43,25 @@ func GetMountpaths(bp BaseParams, node *cluster.Snode) (mpl *apc.MountpathList,
 	return mpl, err
 }
 
+// VerifyDecommission asks the target that is being decommissioned to check whether
+// all its objects have been migrated (see also: apc.ActValRmNode.Verify).
+func VerifyDecommission(bp BaseParams, node *cluster.Snode) (dv *apc.DecommVerifyResult, err error) {
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathReverseDae.S
+		reqParams.Query = url.Values{apc.QparamWhat: []string{apc.WhatDecommVerify}}
+		reqParams.Header = http.Header{
+			apc.HdrNodeID:  []string{node.ID()},
+			apc.HdrNodeURL: []string{node.URL(cmn.NetPublic)},
+		}
+	}
+	_, err = reqParams.DoReqAny(&dv)
+	FreeRp(reqParams)
+	return dv, err
+}
+
 // TODO: rewrite tests that come here with `force`
 func AttachMountpath(bp BaseParams, node *cluster.Snode, mountpath string, force bool) error {
 	bp.Method = http.MethodPut
@@ -221,6 +241,21 @@ func GetDiskStats(bp BaseParams, tid string) (res ios.AllDiskStats, err error) {
 	return
 }
 
+// Returns target's capacity samples (oldest first) - see also: stats.CapSample
+func GetCapHistory(bp BaseParams, tid string) (res []stats.CapSample, err error) {
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathReverseDae.S
+		reqParams.Query = url.Values{apc.QparamWhat: []string{apc.WhatCapHistory}}
+		reqParams.Header = http.Header{apc.HdrNodeID: []string{tid}}
+	}
+	_, err = reqParams.DoReqAny(&res)
+	FreeRp(reqParams)
+	return
+}
+
 // Returns both node's stats and extended status
 func GetStatsAndStatus(bp BaseParams, node *cluster.Snode) (daeStatus *stats.NodeStatus, err error) {
 	bp.Method = http.MethodGet
@@ -237,14 +272,21 @@ func GetStatsAndStatus(bp BaseParams, node *cluster.Snode) (daeStatus *stats.Nod
 }
 
 // Returns log of a specific node in a cluster.
+// With `args.Follow`, keeps streaming the log and returns the (absolute) offset reached
+// when done - to be used to resume following.
 func GetDaemonLog(bp BaseParams, node *cluster.Snode, args GetLogInput) (int64, error) {
 	w := args.Writer
-	q := make(url.Values, 3)
+	q := make(url.Values, 4)
 	q.Set(apc.QparamWhat, apc.WhatLog)
 	if args.Severity != "" {
 		q.Set(apc.QparamLogSev, args.Severity)
 	}
-	if args.Offset != 0 {
+	if args.Follow {
+		q.Set(apc.QparamLogFlw, "true")
+		if args.Offset >= 0 {
+			q.Set(apc.QparamLogOff, strconv.FormatInt(args.Offset, 10))
+		}
+	} else if args.Offset != 0 {
 		q.Set(apc.QparamLogOff, strconv.FormatInt(args.Offset, 10))
 	}
 	bp.Method = http.MethodGet
@@ -255,6 +297,11 @@ func GetDaemonLog(bp BaseParams, node *cluster.Snode, args GetLogInput) (int64,
 		reqParams.Query = q
 		reqParams.Header = http.Header{apc.HdrNodeID: []string{node.ID()}}
 	}
+	if args.Follow {
+		off, err := followLog(reqParams, w, args.Offset)
+		FreeRp(reqParams)
+		return off, err
+	}
 	wrap, err := reqParams.doWriter(w)
 	FreeRp(reqParams)
 	if err == nil {
@@ -263,6 +310,29 @@ func GetDaemonLog(bp BaseParams, node *cluster.Snode, args GetLogInput) (int64,
 	return 0, err
 }
 
+// returns the (absolute) log offset reached prior to disconnecting, or the original offset
+// if it never connected
+func followLog(reqParams *ReqParams, w io.Writer, off int64) (int64, error) {
+	resp, err := reqParams.do()
+	if err != nil {
+		return off, err
+	}
+	defer resp.Body.Close()
+	if err := reqParams.checkResp(resp); err != nil {
+		return off, err
+	}
+	if off < 0 {
+		if off, err = strconv.ParseInt(resp.Header.Get(apc.HdrLogOffset), 10, 64); err != nil {
+			off = -1
+		}
+	}
+	n, err := io.Copy(w, resp.Body)
+	if off < 0 {
+		return off, err
+	}
+	return off + n, err
+}
+
 // SetDaemonConfig, given key value pairs, sets the configuration accordingly for a specific node.
 func SetDaemonConfig(bp BaseParams, nodeID string, nvs cos.StrKVs, transient ...bool) error {
 	bp.Method = http.MethodPut
@@ -40,6 +40,21 @@ func AbortDSort(bp BaseParams, managerUUID string) error {
 	return err
 }
 
+// AbortDSortCleanup aborts dsort job and removes its partially written output shards;
+// returns the number of removed shards
+func AbortDSortCleanup(bp BaseParams, managerUUID string) (removed int, err error) {
+	bp.Method = http.MethodDelete
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathdSortAbort.S
+		reqParams.Query = url.Values{apc.QparamUUID: []string{managerUUID}, apc.QparamCleanup: []string{"true"}}
+	}
+	_, err = reqParams.DoReqAny(&removed)
+	FreeRp(reqParams)
+	return
+}
+
 func MetricsDSort(bp BaseParams, managerUUID string) (metrics map[string]*dsort.Metrics, err error) {
 	bp.Method = http.MethodGet
 	reqParams := AllocRp()
@@ -0,0 +1,137 @@
+// Package api provides AIStore API over HTTP(S)
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package api
+
+import (
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// Failover is an optional client-side resilience for GET: when the designated (HRW)
+// target fails with a retryable error - connection refused or reset, 500, 502, 503, 504 -
+// retry the same GET directly against alternate targets, in their HRW order (that is,
+// the targets that are next in line to store the object's replicas and EC slices).
+//
+// An alternate target that does not have the object will (try to) restore it, e.g.,
+// from EC slices - which is why the failover makes sense only for buckets with
+// cross-target redundancy.
+//
+// The failover is never attempted once any part of the object has been written.
+type Failover struct {
+	// Cluster map to select alternate targets from; if not specified, will be
+	// retrieved from the cluster (via BaseParams) upon the first failure
+	Smap *cluster.Smap
+
+	// (out) alternate target that served the object, nil if none
+	Served *cluster.Snode
+
+	// max number of alternate targets to try (default 1)
+	NumAlt int
+
+	// GetObjectWithValidation (default: GetObject)
+	Validate bool
+}
+
+// counts written bytes to tell whether the GET can still be retried
+type cntWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (cw *cntWriter) Write(b []byte) (n int, err error) {
+	n, err = cw.w.Write(b)
+	cw.n += int64(n)
+	return
+}
+
+// GetObjectFailover is GetObject (or GetObjectWithValidation) with Failover (see above).
+// Upon failure of all alternates, returns the original error (e.g., "connection refused"
+// rather than "not found" from an alternate that could not restore the object).
+func GetObjectFailover(bp BaseParams, bck cmn.Bck, object string, args *GetArgs, fo *Failover) (oah ObjAttrs, err error) {
+	var (
+		a   GetArgs
+		get = GetObject
+	)
+	if args != nil {
+		a = *args
+	}
+	if fo.Validate {
+		get = GetObjectWithValidation
+	}
+	if a.Writer == nil {
+		a.Writer = io.Discard
+	}
+	cw := &cntWriter{w: a.Writer}
+	a.Writer = cw
+
+	fo.Served = nil
+	oah, err = get(bp, bck, object, &a)
+	if err == nil || cw.n > 0 || !IsRetriableGet(err) {
+		return oah, err
+	}
+	alts, erra := fo.alternates(bp, bck, object)
+	if erra != nil {
+		return oah, err
+	}
+	for _, tsi := range alts {
+		abp := bp
+		abp.URL = tsi.URL(cmn.NetPublic)
+		aoah, errn := get(abp, bck, object, &a)
+		if errn == nil {
+			fo.Served = tsi
+			return aoah, nil
+		}
+		if cw.n > 0 {
+			return aoah, errn
+		}
+	}
+	return oah, err
+}
+
+// returns up to NumAlt targets that follow the designated one in the HRW order
+func (fo *Failover) alternates(bp BaseParams, bck cmn.Bck, object string) (cluster.Nodes, error) {
+	if fo.Smap == nil {
+		smap, err := GetClusterMap(bp)
+		if err != nil {
+			return nil, err
+		}
+		fo.Smap = smap
+	}
+	var (
+		uname = bck.MakeUname(object)
+		count = cos.Max(fo.NumAlt, 1) + 1
+	)
+	if cnt := fo.Smap.CountActiveTs(); cnt < count {
+		count = cnt
+	}
+	if count < 2 {
+		return nil, errors.New("no alternate targets")
+	}
+	sis, err := cluster.HrwTargetList(uname, fo.Smap, count)
+	if err != nil {
+		return nil, err
+	}
+	return sis[1:], nil
+}
+
+// GET errors that may indicate the (designated) target's failure
+func IsRetriableGet(err error) bool {
+	if cos.IsRetriableConnErr(err) {
+		return true
+	}
+	if herr := cmn.Err2HTTPErr(err); herr != nil {
+		switch herr.Status {
+		case http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout:
+			return true
+		}
+	}
+	return false
+}
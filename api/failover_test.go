@@ -0,0 +1,71 @@
+// Package api_test contains tests for the AIStore API package.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package api_test
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestGetObjectFailover(t *testing.T) {
+	const body = "0123456789"
+	var (
+		bck    = cmn.Bck{Name: "b", Provider: apc.AIS}
+		status = http.StatusServiceUnavailable
+		smap   = &cluster.Smap{Tmap: make(cluster.NodeMap, 3)}
+	)
+	for _, tid := range []string{"t1", "t2", "t3"} {
+		smap.Tmap[tid] = cluster.NewSnode(tid, apc.Target, cluster.NetInfo{}, cluster.NetInfo{}, cluster.NetInfo{})
+	}
+	sis, err := cluster.HrwTargetList(bck.MakeUname("o"), smap, 3)
+	tassert.CheckFatal(t, err)
+
+	// designated target fails; the next one cannot restore the object; the last one serves it
+	designated := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(status)
+	}))
+	defer designated.Close()
+	notFound := httptest.NewServer(http.NotFoundHandler())
+	defer notFound.Close()
+	alternate := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		io.WriteString(w, body)
+	}))
+	defer alternate.Close()
+	sis[0].PubNet.URL, sis[1].PubNet.URL, sis[2].PubNet.URL = designated.URL, notFound.URL, alternate.URL
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, designated.URL+r.URL.Path, http.StatusTemporaryRedirect)
+	}))
+	defer proxy.Close()
+
+	var (
+		bp  = api.BaseParams{Client: &http.Client{}, URL: proxy.URL}
+		fo  = &api.Failover{Smap: smap, NumAlt: 2}
+		out bytes.Buffer
+	)
+	oah, err := api.GetObjectFailover(bp, bck, "o", &api.GetArgs{Writer: &out}, fo)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, oah.Size() == int64(len(body)) && out.String() == body, "unexpected body %q", out.String())
+	tassert.Errorf(t, fo.Served == sis[2], "expecting %s to serve, got %v", sis[2], fo.Served)
+
+	// not enough alternates: original error
+	fo = &api.Failover{Smap: smap, NumAlt: 1}
+	_, err = api.GetObjectFailover(bp, bck, "o", nil, fo)
+	tassert.Errorf(t, cmn.IsStatusServiceUnavailable(err) && fo.Served == nil, "expecting 503, got %v (%v)", err, fo.Served)
+
+	// not retriable
+	status = http.StatusNotFound
+	fo = &api.Failover{Smap: smap, NumAlt: 2}
+	_, err = api.GetObjectFailover(bp, bck, "o", nil, fo)
+	tassert.Errorf(t, cmn.IsStatusNotFound(err) && fo.Served == nil, "expecting 404, got %v (%v)", err, fo.Served)
+}
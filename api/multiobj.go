@@ -96,6 +96,24 @@ func PrefetchRange(bp BaseParams, bck cmn.Bck, rng string) (string, error) {
 	return dolr(bp, bck, apc.ActPrefetchObjects, msg, q)
 }
 
+// Prefetch sends request to prefetch a list or a range of objects from a remote bucket,
+// with optional pacing (see cmn.PrefetchMsg)
+func Prefetch(bp BaseParams, bck cmn.Bck, msg *cmn.PrefetchMsg) (string, error) {
+	bp.Method = http.MethodPost
+	q := bck.AddToQuery(nil)
+	return dolr(bp, bck, apc.ActPrefetchObjects, msg, q)
+}
+
+// Rechecksum recomputes and stores the checksums of the specified type for the selected
+// (list, range, prefix, or - when neither is specified - all) in-cluster objects;
+// with `msg.DryRun` the objects are only counted.
+// Returns xaction ID.
+func Rechecksum(bp BaseParams, bck cmn.Bck, msg *cmn.RechecksumMsg) (string, error) {
+	bp.Method = http.MethodPost
+	q := bck.AddToQuery(nil)
+	return dolr(bp, bck, apc.ActRechecksum, msg, q)
+}
+
 // multi-object list-range (delete, prefetch, evict, archive, copy, and etl)
 func dolr(bp BaseParams, bck cmn.Bck, action string, msg any, q url.Values) (xid string, err error) {
 	reqParams := AllocRp()
@@ -37,9 +37,10 @@ type (
 		// (in other words, with no writer the object that is being read will be discarded)
 		Writer io.Writer
 
-		// Currently, the Query field can optionally carry 2 (two) distinct values:
+		// Currently, the Query field can optionally carry 3 (three) distinct values:
 		// 1. `apc.QparamETLName`: named ETL to transform the object (i.e., perform "inline transformation")
 		// 2. `apc.QparamOrigURL`: GET from a vanilla http(s) location (`ht://` bucket with the corresponding `OrigURLBck`)
+		// 3. `apc.QparamVersionID`: GET a specific version of a remote object (versioned remote buckets only)
 		Query url.Values
 
 		// The field is exclusively used to facilitate Range Read.
@@ -80,6 +81,10 @@ type (
 
 		Size uint64 // optional
 
+		// optional; user-defined custom metadata to store along with the object
+		// (see also: SetObjectCustomProps)
+		CustomMD cos.StrKVs
+
 		// Skip loading existing object's metadata in order to
 		// compare its Checksum and update its existing Version (if exists);
 		// can be used to reduce PUT latency when:
@@ -229,6 +234,25 @@ func GetObjectReader(bp BaseParams, bck cmn.Bck, object string, args *GetArgs) (
 	return
 }
 
+// GetObjectVersions returns all available versions of a given remote object.
+// Supported only for versioning-capable remote backends (e.g., s3:// buckets
+// with versioning enabled); otherwise, returns `http.StatusNotImplemented`.
+// To read a given version, use `GetObject` with `apc.QparamVersionID` query.
+func GetObjectVersions(bp BaseParams, bck cmn.Bck, object string) (vers cmn.ObjVersions, err error) {
+	q := bck.AddToQuery(nil)
+	q.Set(apc.QparamListVersions, "true")
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathObjects.Join(bck.Name, object)
+		reqParams.Query = q
+	}
+	_, err = reqParams.DoReqAny(&vers)
+	FreeRp(reqParams)
+	return
+}
+
 /////////////
 // PutArgs //
 /////////////
@@ -240,6 +264,7 @@ func (args *PutArgs) put(reqArgs *cmn.HreqArgs) (*http.Request, error) {
 	if err != nil {
 		return nil, newErrCreateHTTPRequest(err)
 	}
+	req = req.WithContext(args.BaseParams.ctx())
 	// Go http doesn't automatically set this for files, so to handle redirect we do it here.
 	req.GetBody = args.getBody
 	if args.Cksum != nil && args.Cksum.Ty() != cos.ChecksumNone {
@@ -257,6 +282,9 @@ func (args *PutArgs) put(reqArgs *cmn.HreqArgs) (*http.Request, error) {
 	if args.Size != 0 {
 		req.ContentLength = int64(args.Size) // as per https://tools.ietf.org/html/rfc7230#section-3.3.2
 	}
+	for k, v := range args.CustomMD {
+		req.Header.Add(apc.HdrObjCustomMD, k+"="+v)
+	}
 	SetAuxHeaders(req, &args.BaseParams)
 	return req, nil
 }
@@ -272,6 +300,7 @@ func (args *AppendArgs) _append(reqArgs *cmn.HreqArgs) (*http.Request, error) {
 	if err != nil {
 		return nil, newErrCreateHTTPRequest(err)
 	}
+	req = req.WithContext(args.BaseParams.ctx())
 	// The HTTP package doesn't automatically set this for files, so it has to be done manually
 	// If it wasn't set, we would need to deal with the redirect manually.
 	req.GetBody = args.getBody
@@ -285,6 +314,23 @@ func (args *AppendArgs) _append(reqArgs *cmn.HreqArgs) (*http.Request, error) {
 // HeadObject returns object properties; can be conventionally used to establish in-cluster presence.
 // `fltPresence` - as per QparamFltPresence enum (for values and comments, see api/apc/query.go)
 func HeadObject(bp BaseParams, bck cmn.Bck, object string, fltPresence int) (*cmn.ObjectProps, error) {
+	op, _, err := headObject(bp, bck, object, fltPresence, false /*latest*/)
+	return op, err
+}
+
+// HeadObjectLatest is HeadObject(FltPresent) but, in addition, compares the in-cluster copy of
+// a remote object with its origin (the remote backend); returns stale = true when
+// the former is out of date or the latter does not exist anymore.
+func HeadObjectLatest(bp BaseParams, bck cmn.Bck, object string) (op *cmn.ObjectProps, stale bool, err error) {
+	var hdr http.Header
+	op, hdr, err = headObject(bp, bck, object, apc.FltPresent, true /*latest*/)
+	if err == nil {
+		stale = cos.IsParseBool(hdr.Get(apc.HdrObjStale))
+	}
+	return
+}
+
+func headObject(bp BaseParams, bck cmn.Bck, object string, fltPresence int, latest bool) (*cmn.ObjectProps, http.Header, error) {
 	bp.Method = http.MethodHead
 
 	q := bck.AddToQuery(nil)
@@ -292,6 +338,9 @@ func HeadObject(bp BaseParams, bck cmn.Bck, object string, fltPresence int) (*cm
 	if fltPresence == apc.FltPresentNoProps {
 		q.Set(apc.QparamSilent, "true")
 	}
+	if latest {
+		q.Set(apc.QparamLatestVer, "true")
+	}
 
 	reqParams := AllocRp()
 	defer FreeRp(reqParams)
@@ -302,10 +351,10 @@ func HeadObject(bp BaseParams, bck cmn.Bck, object string, fltPresence int) (*cm
 	}
 	hdr, err := reqParams.doReqHdr()
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	if fltPresence == apc.FltPresentNoProps {
-		return nil, err
+		return nil, hdr, err
 	}
 
 	// first, cnm.ObjAttrs (NOTE: compare with `headObject()` in target.go)
@@ -322,9 +371,9 @@ func HeadObject(bp BaseParams, bck cmn.Bck, object string, fltPresence int) (*cm
 		return field.SetValue(hdr.Get(headerName), true /*force*/), false
 	}, cmn.IterOpts{OnlyRead: false})
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-	return op, nil
+	return op, hdr, nil
 }
 
 // Given cos.StrKVs (map[string]string) keys and values, sets object's custom properties.
@@ -522,6 +571,23 @@ func RenameObject(bp BaseParams, bck cmn.Bck, oldName, newName string) error {
 	return err
 }
 
+// CopyObject copies (server-side) object `objName` to `newName` - within the same,
+// specified bucket (the source object remains intact).
+func CopyObject(bp BaseParams, bck cmn.Bck, objName, newName string) error {
+	bp.Method = http.MethodPost
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathObjects.Join(bck.Name, objName)
+		reqParams.Body = cos.MustMarshal(apc.ActMsg{Action: apc.ActCopyObject, Name: newName})
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+		reqParams.Query = bck.AddToQuery(nil)
+	}
+	err := reqParams.DoRequest()
+	FreeRp(reqParams)
+	return err
+}
+
 // promote files and directories to ais objects
 func Promote(args *PromoteArgs) (xid string, err error) {
 	actMsg := apc.ActMsg{Action: apc.ActPromote, Name: args.SrcFQN}
@@ -540,6 +606,25 @@ func Promote(args *PromoteArgs) (xid string, err error) {
 	return
 }
 
+// Extract (unpack) archived files into individual objects at the `msg.ToBck` destination,
+// optionally filtered by name (`msg.Filter`); archived pathnames are preserved and
+// prefixed with `msg.Prefix`. Returns ID of the (server-side) extract xaction.
+// For supported archiving formats, see `cos.ArchExtensions` (excluding msgpack).
+func ExtractArchive(bp BaseParams, bck cmn.Bck, archName string, msg *cmn.ExtractMsg) (xid string, err error) {
+	bp.Method = http.MethodPost
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathObjects.Join(bck.Name, archName)
+		reqParams.Body = cos.MustMarshal(apc.ActMsg{Action: apc.ActExtract, Value: msg})
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+		reqParams.Query = bck.AddToQuery(nil)
+	}
+	_, err = reqParams.doReqStr(&xid)
+	FreeRp(reqParams)
+	return
+}
+
 // DoWithRetry executes `http-client.Do` and retries *retriable connection errors*,
 // such as "broken pipe" and "connection refused".
 //
@@ -598,7 +683,7 @@ func DoWithRetry(client *http.Client, cb NewRequestCB, reqArgs *cmn.HreqArgs) (r
 	}
 exit:
 	if err != nil {
-		return nil, fmt.Errorf("failed to %s: %v", reqArgs.Method, err)
+		return nil, fmt.Errorf("failed to %s: %w", reqArgs.Method, err)
 	}
 	reqParams := AllocRp()
 	err = reqParams.checkResp(resp)
@@ -5,12 +5,51 @@
 package api
 
 import (
+	"encoding/xml"
+	"fmt"
 	"io"
 	"net/http"
 	"net/url"
+	"strconv"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// S3-compatible multipart upload (ais:// buckets only - see ais/tgts3mpt.go)
+type (
+	PutPartArgs struct {
+		Reader     cos.ReadOpenCloser
+		BaseParams BaseParams
+		Bck        cmn.Bck
+		ObjName    string
+		UploadID   string
+		PartNum    int
+		Size       int64
+	}
+	// (subset of the respective XML-formatted S3 structures)
+	mptInitResult struct {
+		UploadID string `xml:"UploadId"`
+	}
+	mptPart struct {
+		ETag       string `xml:"ETag"`
+		PartNumber int    `xml:"PartNumber"`
+	}
+	mptComplete struct {
+		XMLName xml.Name  `xml:"CompleteMultipartUpload"`
+		Parts   []mptPart `xml:"Part"`
+	}
+	mptCompleteResult struct {
+		ETag string `xml:"ETag"`
+	}
+)
+
+// S3 query parameters (compare with ais/s3/const.go)
+const (
+	qparamMptUploads  = "uploads"
+	qparamMptUploadID = "uploadId"
+	qparamMptPartNo   = "partNumber"
 )
 
 // s3/<bucket-name>/<object-name>
@@ -39,3 +78,95 @@ func GetObjectS3(bp BaseParams, bck cmn.Bck, objectName string, args ...GetArgs)
 	}
 	return wresp.n, nil
 }
+
+// StartMptS3 initiates multipart upload and returns the upload ID
+func StartMptS3(bp BaseParams, bck cmn.Bck, objName string) (string, error) {
+	bp.Method = http.MethodPost
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathS3.Join(bck.Name, objName)
+		reqParams.Query = url.Values{qparamMptUploads: []string{""}}
+	}
+	var res mptInitResult
+	err := reqParams.doXML(&res)
+	FreeRp(reqParams)
+	if err == nil && res.UploadID == "" {
+		err = fmt.Errorf("failed to start multipart upload of %s: empty upload ID", bck.Cname(objName))
+	}
+	return res.UploadID, err
+}
+
+// PutPartS3 uploads a single part (numbered from 1) and returns its ETag
+func PutPartS3(args *PutPartArgs) (string, error) {
+	q := url.Values{
+		qparamMptUploadID: []string{args.UploadID},
+		qparamMptPartNo:   []string{strconv.Itoa(args.PartNum)},
+	}
+	reqArgs := cmn.AllocHra()
+	{
+		reqArgs.Method = http.MethodPut
+		reqArgs.Base = args.BaseParams.URL
+		reqArgs.Path = apc.URLPathS3.Join(args.Bck.Name, args.ObjName)
+		reqArgs.Query = q
+		reqArgs.BodyR = args.Reader
+	}
+	resp, err := DoWithRetry(args.BaseParams.Client, args.put, reqArgs) //nolint:bodyclose // is closed inside
+	cmn.FreeHra(reqArgs)
+	if err != nil {
+		return "", err
+	}
+	return resp.Header.Get(cos.S3CksumHeader), nil
+}
+
+func (args *PutPartArgs) put(reqArgs *cmn.HreqArgs) (*http.Request, error) {
+	req, err := reqArgs.Req()
+	if err != nil {
+		return nil, newErrCreateHTTPRequest(err)
+	}
+	req = req.WithContext(args.BaseParams.ctx())
+	req.GetBody = func() (io.ReadCloser, error) { return args.Reader.Open() }
+	req.ContentLength = args.Size
+	SetAuxHeaders(req, &args.BaseParams)
+	return req, nil
+}
+
+// CompleteMptS3 finalizes multipart upload given part ETags (in the part number order)
+// and returns the resulting (S3-compatible) ETag
+func CompleteMptS3(bp BaseParams, bck cmn.Bck, objName, uploadID string, etags []string) (string, error) {
+	msg := mptComplete{Parts: make([]mptPart, 0, len(etags))}
+	for i, etag := range etags {
+		msg.Parts = append(msg.Parts, mptPart{ETag: etag, PartNumber: i + 1})
+	}
+	body, err := xml.Marshal(&msg)
+	if err != nil {
+		return "", err
+	}
+	bp.Method = http.MethodPost
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathS3.Join(bck.Name, objName)
+		reqParams.Query = url.Values{qparamMptUploadID: []string{uploadID}}
+		reqParams.Body = body
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentXML}}
+	}
+	var res mptCompleteResult
+	err = reqParams.doXML(&res)
+	FreeRp(reqParams)
+	return res.ETag, err
+}
+
+// AbortMptS3 aborts multipart upload and removes all uploaded parts
+func AbortMptS3(bp BaseParams, bck cmn.Bck, objName, uploadID string) error {
+	bp.Method = http.MethodDelete
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathS3.Join(bck.Name, objName)
+		reqParams.Query = url.Values{qparamMptUploadID: []string{uploadID}}
+	}
+	err := reqParams.DoRequest()
+	FreeRp(reqParams)
+	return err
+}
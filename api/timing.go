@@ -0,0 +1,105 @@
+// Package api provides AIStore API over HTTP(S)
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package api
+
+import (
+	"context"
+	"crypto/tls"
+	"net/http/httptrace"
+	"sync"
+	"time"
+)
+
+// ReqTiming is a client-side latency breakdown of a single API call, including
+// redirects (e.g., proxy => target) - similar to `curl -w`. Usage:
+//
+//	rt := &api.ReqTiming{}
+//	bp.Ctx = rt.Trace(bp.Ctx)
+//	_, err := api.GetObject(bp, bck, objName, nil)
+//	rt.Done()
+//
+// All durations are in nanoseconds; DNS, Connect, and TLS are summed up across
+// all the connections established in the process.
+type ReqTiming struct {
+	DNS      time.Duration `json:"dns"`
+	Connect  time.Duration `json:"connect"`
+	TLS      time.Duration `json:"tls"`
+	Redirect time.Duration `json:"redirect"` // from the start until the last (redirected) request (zero if none)
+	TTFB     time.Duration `json:"ttfb"`     // from the start until the first byte of the last response
+	Transfer time.Duration `json:"transfer"` // reading the (last) response body
+	Total    time.Duration `json:"total"`
+	Conns    int           `json:"conns"`  // new connections
+	Reused   int           `json:"reused"` // idle connections that were reused
+	Requests int           `json:"requests"`
+
+	start, dnsStart, connStart, tlsStart time.Time
+	hop, firstByte                       time.Time
+	mu                                   sync.Mutex
+}
+
+// returns context that carries (and reports to) httptrace.ClientTrace
+func (rt *ReqTiming) Trace(ctx context.Context) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	rt.start = time.Now()
+	trace := &httptrace.ClientTrace{
+		GetConn:              rt.getConn,
+		GotConn:              rt.gotConn,
+		DNSStart:             func(httptrace.DNSStartInfo) { rt.mark(&rt.dnsStart) },
+		DNSDone:              func(httptrace.DNSDoneInfo) { rt.add(&rt.DNS, &rt.dnsStart) },
+		ConnectStart:         func(_, _ string) { rt.mark(&rt.connStart) },
+		ConnectDone:          func(_, _ string, _ error) { rt.add(&rt.Connect, &rt.connStart) },
+		TLSHandshakeStart:    func() { rt.mark(&rt.tlsStart) },
+		TLSHandshakeDone:     func(tls.ConnectionState, error) { rt.add(&rt.TLS, &rt.tlsStart) },
+		GotFirstResponseByte: func() { rt.mark(&rt.firstByte) },
+	}
+	return httptrace.WithClientTrace(ctx, trace)
+}
+
+// to be called upon reading the entire response body
+func (rt *ReqTiming) Done() {
+	now := time.Now()
+	rt.mu.Lock()
+	rt.Total = now.Sub(rt.start)
+	if rt.Requests > 1 {
+		rt.Redirect = rt.hop.Sub(rt.start)
+	}
+	if !rt.firstByte.IsZero() {
+		rt.TTFB = rt.firstByte.Sub(rt.start)
+		rt.Transfer = now.Sub(rt.firstByte)
+	}
+	rt.mu.Unlock()
+}
+
+// (each request - the original and every redirected one - starts by getting a connection)
+func (rt *ReqTiming) getConn(string) {
+	rt.mu.Lock()
+	rt.hop = time.Now()
+	rt.Requests++
+	rt.mu.Unlock()
+}
+
+func (rt *ReqTiming) gotConn(info httptrace.GotConnInfo) {
+	rt.mu.Lock()
+	if info.Reused {
+		rt.Reused++
+	} else {
+		rt.Conns++
+	}
+	rt.mu.Unlock()
+}
+
+func (rt *ReqTiming) mark(t *time.Time) {
+	rt.mu.Lock()
+	*t = time.Now()
+	rt.mu.Unlock()
+}
+
+func (rt *ReqTiming) add(d *time.Duration, since *time.Time) {
+	rt.mu.Lock()
+	*d += time.Since(*since)
+	rt.mu.Unlock()
+}
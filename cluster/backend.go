@@ -15,18 +15,26 @@ import (
 
 // TODO: git mv => ais/backend as `backend.Provider` (with t.backend updated accordingly)
 
-type BackendProvider interface {
-	Provider() string
-	MaxPageSize() uint
-	CreateBucket(bck *Bck) (errCode int, err error)
-	ListObjects(bck *Bck, msg *apc.LsoMsg, lst *cmn.LsoResult) (errCode int, err error)
-	ListBuckets(qbck cmn.QueryBcks) (bcks cmn.Bcks, errCode int, err error)
-	PutObj(r io.ReadCloser, lom *LOM) (errCode int, err error)
-	DeleteObj(lom *LOM) (errCode int, err error)
+type (
+	BackendProvider interface {
+		Provider() string
+		MaxPageSize() uint
+		CreateBucket(bck *Bck) (errCode int, err error)
+		ListObjects(bck *Bck, msg *apc.LsoMsg, lst *cmn.LsoResult) (errCode int, err error)
+		ListBuckets(qbck cmn.QueryBcks) (bcks cmn.Bcks, errCode int, err error)
+		PutObj(r io.ReadCloser, lom *LOM) (errCode int, err error)
+		DeleteObj(lom *LOM) (errCode int, err error)
 
-	// with context
-	HeadBucket(ctx context.Context, bck *Bck) (bckProps cos.StrKVs, errCode int, err error)
-	HeadObj(ctx context.Context, lom *LOM) (objAttrs *cmn.ObjAttrs, errCode int, err error)
-	GetObj(ctx context.Context, lom *LOM, owt cmn.OWT) (errCode int, err error)
-	GetObjReader(ctx context.Context, lom *LOM) (r io.ReadCloser, expectedCksum *cos.Cksum, errCode int, err error)
-}
+		// with context
+		HeadBucket(ctx context.Context, bck *Bck) (bckProps cos.StrKVs, errCode int, err error)
+		HeadObj(ctx context.Context, lom *LOM) (objAttrs *cmn.ObjAttrs, errCode int, err error)
+		GetObj(ctx context.Context, lom *LOM, owt cmn.OWT) (errCode int, err error)
+		GetObjReader(ctx context.Context, lom *LOM) (r io.ReadCloser, expectedCksum *cos.Cksum, errCode int, err error)
+	}
+
+	// optional; implemented by backends that support object versioning (e.g., aws);
+	// to read a specific version, pass its ID via `cos.CtxVersionID` context to `GetObjReader`
+	VersionedBackend interface {
+		ListObjVersions(ctx context.Context, lom *LOM) (vers cmn.ObjVersions, errCode int, err error)
+	}
+)
@@ -41,7 +41,7 @@ func _hrwTarget(uname string, smap *Smap, skipMaint bool) (si *Snode, err error)
 		digest = xxhash.ChecksumString64S(uname, cos.MLCG32)
 	)
 	for _, tsi := range smap.Tmap {
-		if skipMaint && tsi.InMaintOrDecomm() {
+		if skipMaint && (tsi.InMaintOrDecomm() || tsi.InProbation()) {
 			continue
 		}
 		cs := xoshiro256.Hash(tsi.idDigest ^ digest)
@@ -72,7 +72,7 @@ func HrwTargetList(uname string, smap *Smap, count int) (sis Nodes, err error) {
 
 	for _, tsi := range smap.Tmap {
 		cs := xoshiro256.Hash(tsi.idDigest ^ digest)
-		if tsi.InMaintOrDecomm() {
+		if tsi.InMaintOrDecomm() || tsi.InProbation() {
 			continue
 		}
 		hlist.add(cs, tsi)
@@ -137,7 +137,7 @@ func HrwTargetTask(uuid string, smap *Smap) (si *Snode, err error) {
 		digest = xxhash.ChecksumString64S(uuid, cos.MLCG32)
 	)
 	for _, tsi := range smap.Tmap {
-		if tsi.InMaintOrDecomm() {
+		if tsi.InMaintOrDecomm() || tsi.InProbation() {
 			continue
 		}
 		// Assumes that sinfo.idDigest is initialized
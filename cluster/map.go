@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"net"
 	"net/url"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -35,6 +36,7 @@ const (
 	SnodeIC
 	NodeFlagMaint
 	NodeFlagDecomm
+	NodeFlagProbation // joined target that does not serve (store) data until promoted (see `ActEndProbation`)
 )
 
 const NodeFlagsMaintDecomm = NodeFlagMaint | NodeFlagDecomm
@@ -66,7 +68,8 @@ type (
 		DaeType    string     `json:"daemon_type"`       // "target" or "proxy"
 		DaeID      string     `json:"daemon_id"`
 		name       string
-		Flags      cos.BitFlags `json:"flags"` // enum { SnodeNonElectable, SnodeIC, ... }
+		Flags      cos.BitFlags `json:"flags"`                        // enum { SnodeNonElectable, SnodeIC, ... }
+		MaintUntil int64        `json:"maint_until,string,omitempty"` // auto-resume from maintenance (Unix nanoseconds)
 		idDigest   uint64
 	}
 	Nodes   []*Snode          // slice of Snodes
@@ -76,12 +79,32 @@ type (
 		Ext          any     `json:"ext,omitempty"`
 		Pmap         NodeMap `json:"pmap"` // [pid => Snode]
 		Primary      *Snode  `json:"proxy_si"`
-		Tmap         NodeMap `json:"tmap"`          // [tid => Snode]
-		UUID         string  `json:"uuid"`          // assigned once at creation time and never change
-		CreationTime string  `json:"creation_time"` // creation timestamp
+		Tmap         NodeMap `json:"tmap"`            // [tid => Snode]
+		UUID         string  `json:"uuid"`            // assigned once at creation time and never change
+		CreationTime string  `json:"creation_time"`   // creation timestamp
+		Cause        string  `json:"cause,omitempty"` // action that produced this version (e.g., "self-join-target")
 		Version      int64   `json:"version,string"`
 	}
 
+	// pair of nodes that share (IP, port) or DaemonID - a conflict that, unless
+	// auto-resolved, requires operator's attention (see `apc.ActResolveDupNode`)
+	DupNodes struct {
+		Osi  *Snode `json:"old"`         // currently in the cluster map
+		Nsi  *Snode `json:"new"`         // joining (or keepalive-ing) duplicate
+		Err  string `json:"err"`         // conflict description
+		Time int64  `json:"time,string"` // when detected (Unix nanoseconds)
+	}
+
+	// a single Smap transition as retained by the (primary's and other nodes') Smap history
+	// (see `cluster.smap_history` config)
+	SmapHistEntry struct {
+		Version  int64    `json:"version,string"`
+		Time     int64    `json:"time,string"`     // when installed by the node (Unix nanoseconds)
+		Cause    string   `json:"cause,omitempty"` // see Smap.Cause
+		Diff     []string `json:"diff,omitempty"`  // changes vs previous version (see Smap.Diff)
+		Retained bool     `json:"retained"`        // the entire Smap (copy) is retained in memory
+	}
+
 	// Smap on-change listeners
 	Slistener interface {
 		String() string
@@ -255,6 +278,7 @@ func (d *Snode) IsTarget() bool { return d.DaeType == apc.Target }
 // node flags
 func (d *Snode) InMaintOrDecomm() bool { return d.Flags.IsAnySet(NodeFlagsMaintDecomm) }
 func (d *Snode) nonElectable() bool    { return d.Flags.IsSet(SnodeNonElectable) }
+func (d *Snode) InProbation() bool     { return d.Flags.IsSet(NodeFlagProbation) }
 func (d *Snode) isIC() bool            { return d.Flags.IsSet(SnodeIC) }
 
 /////////////
@@ -433,6 +457,24 @@ func (m *Smap) GetRandProxy(excludePrimary bool) (si *Snode, err error) {
 		len(m.Pmap), cnt, excludePrimary)
 }
 
+// Validate checks that Smap is initialized (non-zero version and valid UUID)
+// and contains its own primary
+func (m *Smap) Validate() error {
+	if m.Version == 0 {
+		return errors.New("Smap v0")
+	}
+	if m.Primary == nil {
+		return errors.New("Smap: primary <nil>")
+	}
+	if m.GetProxy(m.Primary.ID()) == nil {
+		return errors.New("Smap: primary not present")
+	}
+	if !cos.IsValidUUID(m.UUID) {
+		return fmt.Errorf("Smap: invalid UUID %q", m.UUID)
+	}
+	return nil
+}
+
 func (m *Smap) IsDuplicate(nsi *Snode) (osi *Snode, err error) {
 	for _, tsi := range m.Tmap {
 		if tsi.ID() == nsi.ID() {
@@ -475,6 +517,76 @@ func (m *Smap) Compare(other *Smap) (uuid string, sameOrigin, sameVersion, eq bo
 	return
 }
 
+// human-readable changes from `prev` to `m`: added and removed nodes, modified node flags,
+// new primary (no changes is an empty list)
+func (m *Smap) Diff(prev *Smap) (diff []string) {
+	if prev == nil {
+		return []string{"initial"}
+	}
+	if prev.UUID != "" && m.UUID != prev.UUID {
+		diff = append(diff, fmt.Sprintf("uuid: %s => %s", prev.UUID, m.UUID))
+	}
+	if m.Primary != nil && (prev.Primary == nil || prev.Primary.ID() != m.Primary.ID()) {
+		from := "none"
+		if prev.Primary != nil {
+			from = prev.Primary.StringEx()
+		}
+		diff = append(diff, "primary: "+from+" => "+m.Primary.StringEx())
+	}
+	for _, pair := range [][2]NodeMap{{m.Pmap, prev.Pmap}, {m.Tmap, prev.Tmap}} {
+		cur, old := pair[0], pair[1]
+		for _, id := range sortedIDs(cur) {
+			si := cur[id]
+			osi, ok := old[id]
+			switch {
+			case !ok:
+				diff = append(diff, "+ "+si.StringEx())
+			case osi.Flags != si.Flags:
+				diff = append(diff, fmt.Sprintf("flags %s: %s => %s", si.StringEx(), fl2s(osi.Flags), fl2s(si.Flags)))
+			case osi.PubNet.URL != si.PubNet.URL:
+				diff = append(diff, "url "+si.StringEx()+": "+osi.PubNet.URL+" => "+si.PubNet.URL)
+			}
+		}
+		for _, id := range sortedIDs(old) {
+			if _, ok := cur[id]; !ok {
+				diff = append(diff, "- "+old[id].StringEx())
+			}
+		}
+	}
+	return
+}
+
+func sortedIDs(nmap NodeMap) []string {
+	ids := make([]string, 0, len(nmap))
+	for id := range nmap {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+func fl2s(flags cos.BitFlags) string {
+	if flags == 0 {
+		return "none"
+	}
+	var names []string
+	for _, fl := range []struct {
+		f cos.BitFlags
+		n string
+	}{
+		{SnodeNonElectable, "non-electable"},
+		{SnodeIC, "ic"},
+		{NodeFlagMaint, "maintenance"},
+		{NodeFlagDecomm, "decommission"},
+		{NodeFlagProbation, "probation"},
+	} {
+		if flags.IsSet(fl.f) {
+			names = append(names, fl.n)
+		}
+	}
+	return strings.Join(names, ",")
+}
+
 func (m *Smap) CompareTargets(other *Smap) (equal bool) {
 	return mapsEq(m.Tmap, other.Tmap)
 }
@@ -26,6 +26,7 @@ func (*StatsTracker) Get(string) int64           { return 0 }
 func (*StatsTracker) IncErr(string)              {}
 func (*StatsTracker) Inc(string)                 {}
 func (*StatsTracker) Add(string, int64)          {}
+func (*StatsTracker) SetGauge(string, int64)     {}
 func (*StatsTracker) AddMany(...cos.NamedVal64)  {}
 func (*StatsTracker) RegMetrics(*cluster.Snode)  {}
 func (*StatsTracker) GetMetricNames() cos.StrKVs { return nil }
@@ -10,6 +10,9 @@ type (
 		Alias string `json:"alias"`
 		UUID  string `json:"uuid"` // Smap.UUID
 		Smap  *Smap  `json:"smap"`
+		// as of the last (periodic or on-demand) probe
+		Online      bool  `json:"online"`
+		LastContact int64 `json:"last-contact,omitempty"` // unix nanoseconds (last successful contact)
 	}
 	Remotes struct {
 		A   []*RemAis `json:"a"`
@@ -140,6 +140,11 @@ type (
 		// and _not_ to try to auto-detect if it is;
 		// (auto-detection takes time, etc.)
 		SrcIsNotFshare bool `json:"notshr,omitempty"` // the source is not a file share equally accessible by all targets
+		// checksumming:
+		ComputeCksum bool `json:"cks,omitempty"` // compute bucket-configured checksum (and send it along when promoting remotely)
+		VerifyCksum  bool `json:"vfy,omitempty"` // once promoted, re-read the object and validate its content checksum
+		// keep promoting in presence of errors (e.g., checksum mismatch) while logging each failure
+		ContinueOnError bool `json:"coer,omitempty"`
 	}
 	PromoteParams struct {
 		Bck         *Bck       // destination bucket
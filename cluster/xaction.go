@@ -85,6 +85,7 @@ type (
 		DstBck    cmn.Bck   `json:"dst-bck"`
 		ID        string    `json:"id"`
 		Kind      string    `json:"kind"`
+		ParentID  string    `json:"parent-id,omitempty"` // xaction that has spawned this one (if any)
 
 		// rebalance-only
 		RebID int64 `json:"glob.id,string"`
@@ -92,8 +92,10 @@ func (h *hserv) tokenHandler(w http.ResponseWriter, r *http.Request) {
 	switch r.Method {
 	case http.MethodDelete:
 		h.httpRevokeToken(w, r)
+	case http.MethodPost:
+		h.httpRefreshToken(w, r)
 	default:
-		cmn.WriteErr405(w, r, http.MethodDelete)
+		cmn.WriteErr405(w, r, http.MethodDelete, http.MethodPost)
 	}
 }
 
@@ -133,6 +135,29 @@ func (h *hserv) httpRevokeToken(w http.ResponseWriter, r *http.Request) {
 	h.mgr.revokeToken(msg.Token)
 }
 
+// Issues a new token in exchange for the (still valid) token from the request header
+func (h *hserv) httpRefreshToken(w http.ResponseWriter, r *http.Request) {
+	if _, err := checkRESTItems(w, r, 0, apc.URLPathTokens.L); err != nil {
+		return
+	}
+	token, err := tok.ExtractToken(r.Header)
+	if err != nil {
+		cmn.WriteErr(w, r, err, http.StatusUnauthorized)
+		return
+	}
+	msg := &authn.LoginMsg{}
+	if err := cmn.ReadJSON(w, r, msg); err != nil {
+		return
+	}
+	tokenString, err := h.mgr.refreshToken(token, msg)
+	if err != nil {
+		cmn.WriteErr(w, r, err, http.StatusUnauthorized)
+		return
+	}
+	repl := fmt.Sprintf(`{"token": %q}`, tokenString)
+	writeBytes(w, []byte(repl), "auth")
+}
+
 func (h *hserv) httpUserDel(w http.ResponseWriter, r *http.Request) {
 	apiItems, err := checkRESTItems(w, r, 1, apc.URLPathUsers.L)
 	if err != nil {
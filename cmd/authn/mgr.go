@@ -372,22 +372,50 @@ func (m *mgr) delCluster(cluID string) error {
 // Token includes user ID, permissions, and token expiration time.
 // If a new token was generated then it sends the proxy a new valid token list
 func (m *mgr) issueToken(userID, pwd string, msg *authn.LoginMsg) (string, error) {
-	var (
-		err     error
-		expires time.Time
-		token   string
-		uInfo   = &authn.User{}
-		cid     string
-	)
-
-	err = m.db.Get(usersCollection, userID, uInfo)
-	if err != nil {
+	uInfo := &authn.User{}
+	if err := m.db.Get(usersCollection, userID, uInfo); err != nil {
 		glog.Error(err)
 		return "", errInvalidCredentials
 	}
 	if !isSamePassword(pwd, uInfo.Password) {
 		return "", errInvalidCredentials
 	}
+	return m._issue(userID, uInfo, msg)
+}
+
+// Issues a new token in exchange for the existing one, provided the latter
+// is neither expired nor revoked. The new token reflects the user's current
+// permissions; the existing token remains valid until it expires (or gets revoked).
+func (m *mgr) refreshToken(token string, msg *authn.LoginMsg) (string, error) {
+	tk, err := tok.DecryptToken(token, Conf.Secret())
+	if err != nil {
+		return "", err
+	}
+	if tk.Expires.Before(time.Now()) {
+		return "", fmt.Errorf("cannot refresh: %w (%s)", tok.ErrTokenExpired, tk)
+	}
+	var revoked string
+	if err := m.db.Get(revokedCollection, token, &revoked); err == nil {
+		return "", fmt.Errorf("cannot refresh: %w (%s)", tok.ErrTokenRevoked, tk)
+	}
+	uInfo := &authn.User{}
+	if err := m.db.Get(usersCollection, tk.UserID, uInfo); err != nil {
+		glog.Error(err)
+		return "", errInvalidCredentials
+	}
+	if msg.ClusterID == "" && !uInfo.IsAdmin() && len(tk.ClusterACLs) > 0 {
+		msg.ClusterID = tk.ClusterACLs[0].ID
+	}
+	return m._issue(tk.UserID, uInfo, msg)
+}
+
+func (m *mgr) _issue(userID string, uInfo *authn.User, msg *authn.LoginMsg) (string, error) {
+	var (
+		err     error
+		expires time.Time
+		token   string
+		cid     string
+	)
 	if !uInfo.IsAdmin() {
 		if msg.ClusterID == "" {
 			return "", fmt.Errorf("Couldn't issue token for %q: cluster ID not set", userID)
@@ -5,6 +5,7 @@
 package main
 
 import (
+	"errors"
 	"testing"
 	"time"
 
@@ -178,6 +179,21 @@ func TestToken(t *testing.T) {
 		t.Errorf("Invalid user %s returned for token of %s", info.UserID, users[1])
 	}
 
+	// refresh
+	longExpiration := time.Hour
+	refreshed, err := mgr.refreshToken(token, &authn.LoginMsg{ClusterID: clu.ID, ExpiresIn: &longExpiration})
+	tassert.CheckFatal(t, err)
+	infoRefreshed, err := tok.DecryptToken(refreshed, secret)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, infoRefreshed.UserID == users[1], "Invalid user %s in refreshed token of %s", infoRefreshed.UserID, users[1])
+	tassert.Errorf(t, infoRefreshed.Expires.After(info.Expires), "Refreshed token must expire later: %s vs %s",
+		infoRefreshed.Expires, info.Expires)
+
+	// refresh revoked token
+	tassert.CheckFatal(t, mgr.db.Set(revokedCollection, refreshed, "!"))
+	_, err = mgr.refreshToken(refreshed, &authn.LoginMsg{ClusterID: clu.ID})
+	tassert.Errorf(t, errors.Is(err, tok.ErrTokenRevoked), "Expected %v, got %v", tok.ErrTokenRevoked, err)
+
 	// incorrect user creds
 	loginMsg = &authn.LoginMsg{}
 	tokenInval, err := mgr.issueToken(users[1], passs[0], loginMsg)
@@ -192,6 +208,8 @@ func TestToken(t *testing.T) {
 	if tk.Expires.After(time.Now()) {
 		t.Fatalf("Token must be expired: %s", token)
 	}
+	_, err = mgr.refreshToken(token, &authn.LoginMsg{ClusterID: clu.ID})
+	tassert.Errorf(t, errors.Is(err, tok.ErrTokenExpired), "Expected %v, got %v", tok.ErrTokenExpired, err)
 }
 
 func TestMergeCluACLS(t *testing.T) {
@@ -0,0 +1,209 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+// This file implements `.aisignore` (gitignore syntax) to skip files (recursive PUT) and objects (prefix GET).
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/urfave/cli"
+)
+
+const aisIgnoreFname = ".aisignore"
+
+type (
+	ignoreRule struct {
+		pattern string // slash-separated, relative to the containing directory (see globMatch)
+		negate  bool   // "!pattern" re-includes
+		dirOnly bool   // "pattern/" matches directories only
+	}
+	// `.aisignore` files found in the root directory and its subdirectories (loaded on demand);
+	// deeper files take precedence, and within a given file the last matching rule wins
+	aisIgnore struct {
+		root  string
+		rules map[string][]ignoreRule // relative (slash-separated) dir => its rules; "" is the root
+		dirs  map[string]bool         // cached (ignored or not) directory decisions
+		cnt   int                     // number of ignored files (or objects)
+	}
+)
+
+func newAisIgnore(root string) *aisIgnore {
+	return &aisIgnore{root: root, rules: make(map[string][]ignoreRule, 4), dirs: make(map[string]bool, 16)}
+}
+
+func (ign *aisIgnore) load(dir string) ([]ignoreRule, error) {
+	if rules, ok := ign.rules[dir]; ok {
+		return rules, nil
+	}
+	fqn := filepath.Join(ign.root, filepath.FromSlash(dir), aisIgnoreFname)
+	fh, err := os.Open(fqn)
+	if err != nil {
+		if os.IsNotExist(err) {
+			ign.rules[dir] = nil
+			return nil, nil
+		}
+		return nil, err
+	}
+	rules, err := parseIgnoreRules(fh)
+	fh.Close()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", fqn, err)
+	}
+	if rules == nil {
+		rules = []ignoreRule{} // (empty file)
+	}
+	ign.rules[dir] = rules
+	return rules, nil
+}
+
+func parseIgnoreRules(r io.Reader) (rules []ignoreRule, err error) {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), " \t\r")
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		var rule ignoreRule
+		switch {
+		case strings.HasPrefix(line, "!"):
+			rule.negate, line = true, line[1:]
+		case strings.HasPrefix(line, `\!`), strings.HasPrefix(line, `\#`):
+			line = line[1:]
+		}
+		if strings.HasSuffix(line, "/") {
+			rule.dirOnly, line = true, strings.TrimRight(line, "/")
+		}
+		// leading or middle slash: relative to the `.aisignore` directory; otherwise, at any depth
+		anchored := strings.Contains(line, "/")
+		line = strings.TrimLeft(line, "/")
+		if line == "" {
+			continue
+		}
+		if _, err := path.Match(line, ""); err != nil {
+			return nil, fmt.Errorf("invalid pattern %q", scanner.Text())
+		}
+		if !anchored {
+			line = "**/" + line
+		}
+		rule.pattern = line
+		rules = append(rules, rule)
+	}
+	return rules, scanner.Err()
+}
+
+// rel is slash-separated and relative to the root;
+// a file inside ignored directory is ignored (and cannot be re-included - same as git)
+func (ign *aisIgnore) ignored(rel string, isDir bool) (bool, error) {
+	if ign == nil {
+		return false, nil
+	}
+	if isDir {
+		return ign.ignoredDir(rel)
+	}
+	var (
+		ignored bool
+		err     error
+	)
+	if dir := path.Dir(rel); dir != "." {
+		ignored, err = ign.ignoredDir(dir)
+	}
+	if !ignored && err == nil {
+		ignored, err = ign.match(rel, false)
+	}
+	if ignored {
+		ign.cnt++
+	}
+	return ignored, err
+}
+
+func (ign *aisIgnore) ignoredDir(dir string) (bool, error) {
+	if ignored, ok := ign.dirs[dir]; ok {
+		return ignored, nil
+	}
+	ignored := false
+	if parent := path.Dir(dir); parent != "." {
+		var err error
+		if ignored, err = ign.ignoredDir(parent); err != nil {
+			return false, err
+		}
+	}
+	if !ignored {
+		var err error
+		if ignored, err = ign.match(dir, true); err != nil {
+			return false, err
+		}
+	}
+	ign.dirs[dir] = ignored
+	return ignored, nil
+}
+
+// consult `.aisignore` files from the root down to the parent directory of `rel`
+func (ign *aisIgnore) match(rel string, isDir bool) (ignored bool, _ error) {
+	parts := strings.Split(rel, "/")
+	for i := 0; i < len(parts); i++ {
+		dir := strings.Join(parts[:i], "/")
+		rules, errL := ign.load(dir)
+		if errL != nil {
+			return false, errL
+		}
+		sub := strings.Join(parts[i:], "/")
+		for _, rule := range rules {
+			if rule.dirOnly && !isDir {
+				continue
+			}
+			if globMatch(rule.pattern, sub) {
+				ignored = !rule.negate
+			}
+		}
+	}
+	return ignored, nil
+}
+
+func (ign *aisIgnore) count() int {
+	if ign == nil {
+		return 0
+	}
+	return ign.cnt
+}
+
+// multi-object GET: skip objects that match `.aisignore` in the destination directory
+// (or in the current directory when the destination is not a directory)
+func ignoreEntries(c *cli.Context, entries cmn.LsoEntries, outDir string) (cmn.LsoEntries, error) {
+	root := "."
+	if outDir != "" && outDir != fileStdIO && outDir != discardIO {
+		if finfo, err := os.Stat(outDir); err == nil && finfo.IsDir() {
+			root = outDir
+		}
+	}
+	var (
+		ign      = newAisIgnore(root)
+		filtered = entries[:0]
+	)
+	for _, en := range entries {
+		ignored, err := ign.ignored(strings.TrimLeft(en.Name, "/"), false)
+		if err != nil {
+			return nil, err
+		}
+		if !ignored {
+			filtered = append(filtered, en)
+		}
+	}
+	if n := ign.count(); n > 0 {
+		if len(filtered) == 0 {
+			return nil, fmt.Errorf("no objects to GET: all %d object%s ignored via %q (use %s to override)",
+				n, cos.Plural(n), filepath.Join(root, aisIgnoreFname), qflprn(noIgnoreFlag))
+		}
+		actionNote(c, fmt.Sprintf("ignoring %d object%s via %s file(s) in %q", n, cos.Plural(n), aisIgnoreFname, root))
+	}
+	return filtered, nil
+}
@@ -0,0 +1,45 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestAisIgnore(t *testing.T) {
+	dir := t.TempDir()
+	writeTestFile(t, dir, aisIgnoreFname, "# comment\n*.tmp\n!keep.tmp\nbuild/\n/top.txt\ndocs/*.md\n")
+	writeTestFile(t, dir, "src/"+aisIgnoreFname, "!*.tmp\ngen/\n")
+	for _, name := range []string{
+		"a.go", "x.tmp", "keep.tmp", "top.txt", "sub/top.txt", "build/out.o", "sub/build",
+		"docs/readme.md", "docs/api/ref.md", "src/y.tmp", "src/gen/z.go", "src/main.go",
+	} {
+		writeTestFile(t, dir, name, "0")
+	}
+
+	files, err := listRecurs(dir, dir, "", "*", nil, newAisIgnore(dir))
+	tassert.CheckFatal(t, err)
+	var names []string
+	for _, fo := range files {
+		names = append(names, fo.name)
+	}
+	expected := []string{
+		aisIgnoreFname, "a.go", "docs/api/ref.md", "keep.tmp", "src/" + aisIgnoreFname,
+		"src/main.go", "src/y.tmp", "sub/build", "sub/top.txt",
+	}
+	tassert.Errorf(t, reflect.DeepEqual(names, expected), "expected %v, got %v", expected, names)
+
+	// same rules applied to object names (multi-object GET)
+	ign := newAisIgnore(dir)
+	for name, expect := range map[string]bool{"build/a/b": true, "src/gen/c": true, "src/x.tmp": false, "z.tmp": true, "c.go": false} {
+		ignored, err := ign.ignored(name, false)
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, ignored == expect, "%q: expected ignored=%t", name, expect)
+	}
+	tassert.Errorf(t, ign.count() == 3, "expected 3 ignored, got %d", ign.count())
+}
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"regexp"
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/NVIDIA/aistore/cmd/cli/config"
@@ -21,6 +22,9 @@ import (
 
 const invalidAlias = "alias must start with a letter and can only contain letters, numbers, hyphens (-), and underscores (_)"
 
+// positional placeholders in parameterized aliases, e.g.: `ais alias set backup "cp {1} {2} --sync"`
+var aliasPlaceholder = regexp.MustCompile(`\{(\d+)\}`)
+
 func (a *acli) getAliasCmd() cli.Command {
 	aliasCmd := cli.Command{
 		Name:   commandAlias,
@@ -58,15 +62,95 @@ func (a *acli) getAliasCmd() cli.Command {
 // NOTE: for default alias config, see cmd/cli/config/config.go and `DefaultAliasConfig`
 func (a *acli) initAliases() (aliasCmds []cli.Command) {
 	for alias, orig := range cfg.Aliases {
-		cmd := a.resolveCmd(orig)
-
-		if cmd != nil {
+		cmd, path, tmpl := a.splitAlias(orig)
+		switch {
+		case cmd == nil:
+		case len(tmpl) == 0: // plain (command prefix) alias
 			aliasCmds = append(aliasCmds, makeAlias(*cmd, orig, false, alias))
+		default:
+			aliasCmds = append(aliasCmds, a.makeParamAlias(cmd, alias, orig, path, tmpl))
 		}
 	}
 	return
 }
 
+// splitAlias separates the longest (sub)command path from the rest of the alias:
+// arguments and flags, possibly with positional placeholders {1}, {2}, etc.
+func (a *acli) splitAlias(orig string) (cmd *cli.Command, path string, tmpl []string) {
+	tokens := strings.Fields(orig)
+	for i := len(tokens); i > 0; i-- {
+		path = strings.Join(tokens[:i], " ")
+		if cmd = a.resolveCmd(path); cmd != nil {
+			return cmd, path, tokens[i:]
+		}
+	}
+	return nil, "", nil
+}
+
+// parameterized alias: substitute placeholders with the alias's own arguments
+// and run the aliased command
+func (a *acli) makeParamAlias(cmd *cli.Command, alias, orig, path string, tmpl []string) cli.Command {
+	var argsUsage string
+	for i := 1; i <= maxPlaceholder(tmpl); i++ {
+		argsUsage += "ARG" + strconv.Itoa(i) + " "
+	}
+	return cli.Command{
+		Name:            alias,
+		Usage:           fmt.Sprintf("(alias for %q) %s", orig, cmd.Usage),
+		ArgsUsage:       argsUsage + "[ARG...]",
+		SkipFlagParsing: true,
+		HideHelp:        true,
+		Action: func(c *cli.Context) error {
+			args, err := expandAlias(tmpl, c.Args())
+			if err != nil {
+				return incorrectUsageMsg(c, "alias %q (%s): %v", alias, orig, err)
+			}
+			full := append([]string{a.app.Name}, strings.Fields(path)...)
+			return a.app.Run(append(full, args...))
+		},
+	}
+}
+
+func maxPlaceholder(tmpl []string) (n int) {
+	for _, tok := range tmpl {
+		for _, m := range aliasPlaceholder.FindAllStringSubmatch(tok, -1) {
+			i, _ := strconv.Atoi(m[1])
+			n = cos.Max(n, i)
+		}
+	}
+	return
+}
+
+// substitute positional placeholders {1}, {2}, etc. with the respective arguments;
+// append the remaining arguments (those that follow the last referenced one)
+func expandAlias(tmpl, args []string) ([]string, error) {
+	var (
+		err  error
+		last int
+		res  = make([]string, 0, len(tmpl)+len(args))
+	)
+	for _, tok := range tmpl {
+		tok = aliasPlaceholder.ReplaceAllStringFunc(tok, func(ph string) string {
+			i, _ := strconv.Atoi(ph[1 : len(ph)-1])
+			switch {
+			case i == 0:
+				err = fmt.Errorf("invalid placeholder %s (placeholders are 1-based)", ph)
+			case i > len(args):
+				err = fmt.Errorf("missing argument %s", ph)
+			default:
+				last = cos.Max(last, i)
+				return args[i-1]
+			}
+			return ph
+		})
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, tok)
+	}
+	return append(res, args[last:]...), nil
+}
+
 func validateAlias(alias string) (matched bool) {
 	matched, _ = regexp.MatchString(`^[a-zA-Z][a-zA-Z0-9_-]*$`, alias)
 	return
@@ -84,7 +168,7 @@ func (a *acli) resolveCmd(command string) *cli.Command {
 		toplevel = args[0]
 		tlCmd    = app.Command(toplevel)
 	)
-	if len(args) == 1 {
+	if len(args) == 1 || tlCmd == nil {
 		return tlCmd
 	}
 
@@ -186,9 +270,13 @@ func (a *acli) setAliasHandler(c *cli.Context) (err error) {
 		}
 		newCmd += arg
 	}
-	if cmd := a.resolveCmd(newCmd); cmd == nil {
+	cmd, _, tmpl := a.splitAlias(newCmd)
+	if cmd == nil {
 		return fmt.Errorf("%q is not AIS command", newCmd)
 	}
+	if _, err := expandAlias(tmpl, make([]string, maxPlaceholder(tmpl))); err != nil {
+		return fmt.Errorf("invalid alias %q: %v", newCmd, err)
+	}
 	cfg.Aliases[alias] = newCmd
 	if ok {
 		fmt.Fprintf(c.App.Writer, "Alias %q new command %q (was: %q)\n", alias, newCmd, oldCmd)
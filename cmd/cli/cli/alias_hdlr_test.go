@@ -0,0 +1,39 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestExpandAlias(t *testing.T) {
+	tests := []struct {
+		tmpl     []string
+		args     []string
+		expected []string
+		fail     bool
+	}{
+		{tmpl: []string{}, args: []string{"a", "b"}, expected: []string{"a", "b"}},
+		{tmpl: []string{"{1}", "{2}", "--sync"}, args: []string{"a", "b"}, expected: []string{"a", "b", "--sync"}},
+		{tmpl: []string{"{2}", "{1}"}, args: []string{"a", "b", "c"}, expected: []string{"b", "a", "c"}},
+		{tmpl: []string{"ais://{1}/{2}"}, args: []string{"bck", "obj"}, expected: []string{"ais://bck/obj"}},
+		{tmpl: []string{"{1}", "--limit", "10"}, args: []string{"a", "--all"}, expected: []string{"a", "--limit", "10", "--all"}},
+		{tmpl: []string{"{1}", "{2}"}, args: []string{"a"}, fail: true},
+		{tmpl: []string{"{0}"}, args: []string{"a"}, fail: true},
+	}
+	for _, test := range tests {
+		res, err := expandAlias(test.tmpl, test.args)
+		if test.fail {
+			tassert.Errorf(t, err != nil, "expected error for %v %v", test.tmpl, test.args)
+			continue
+		}
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, reflect.DeepEqual(res, test.expected), "%v %v: expected %v, got %v",
+			test.tmpl, test.args, test.expected, res)
+	}
+}
@@ -35,7 +35,7 @@ const (
 
 // TODO: simplify
 const (
-	warnRemAisOffline = `remote ais cluster %s is crrrently unreachable.
+	warnRemAisOffline = `remote ais cluster %s is currently unreachable.
 Run 'ais config cluster backend.conf --json' - to show the respective configuration;
     'ais config cluster backend.conf <new JSON formatted value>' - to reconfigure or remove.
 For details and usage examples, see: docs/cli/config.md`
@@ -58,6 +58,7 @@ type (
 
 var (
 	cfg         *config.Config
+	curProfile  *config.ProfileConfig // `--profile`, if specified
 	buildTime   string
 	k8sDetected bool
 )
@@ -96,7 +97,7 @@ func Run(version, buildtime string, args []string) error {
 	buildTime = buildtime
 	a.init(version)
 
-	teb.Init(os.Stdout, cfg.NoColor)
+	teb.Init(os.Stdout, cfg.NoColor, cfg.Defaults.Units)
 
 	// run
 	if err := a.runOnce(args); err != nil {
@@ -113,7 +114,11 @@ func Run(version, buildtime string, args []string) error {
 
 func (a *acli) runOnce(args []string) error {
 	err := a.app.Run(args)
-	return formatErr(err)
+	if err == nil {
+		return nil
+	}
+	code := exitCode(err) // (before formatting that discards error types)
+	return &errExit{err: formatErr(err), code: code}
 }
 
 func (a *acli) runForever(args []string) error {
@@ -218,7 +223,8 @@ func (a *acli) init(version string) {
 	app.Version = version
 	app.EnableBashCompletion = true
 	app.HideHelp = true
-	app.Flags = []cli.Flag{cli.HelpFlag}
+	app.Flags = []cli.Flag{cli.HelpFlag, profileFlag}
+	app.Before = initProfile
 	app.CommandNotFound = commandNotFoundHandler
 	app.OnUsageError = onUsageErrorHandler
 	app.Metadata = map[string]any{metadata: a.longRun}
@@ -8,10 +8,17 @@ package cli
 import (
 	"fmt"
 	"os"
+	"path"
 	"path/filepath"
 	"strings"
+	"time"
 
+	"github.com/NVIDIA/aistore/api"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmd/cli/teb"
+	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/xact"
 	"github.com/urfave/cli"
 )
 
@@ -19,12 +26,17 @@ var (
 	archCmdsFlags = map[string][]cli.Flag{
 		commandCreate: {
 			dryRunFlag,
+			archFormatFlag,
 			sourceBckFlag,
 			templateFlag,
+			showTemplateFlag,
+			templateCapFlag,
 			listFlag,
 			includeSrcBucketNameFlag,
 			allowAppendToExistingFlag,
 			continueOnErrorFlag,
+			maxErrorsFlag,
+			errorRateFlag,
 		},
 		cmdAppend: {
 			archpathRequiredFlag,
@@ -33,11 +45,18 @@ var (
 			objPropsFlag,
 			allPropsFlag,
 		},
+		cmdExtract: {
+			extractToFlag,
+			extractListFlag,
+			extractFilterFlag,
+			waitFlag,
+			waitJobXactFinishedFlag,
+		},
 	}
 
 	archCmd = cli.Command{
 		Name:  commandArch,
-		Usage: "Create multi-object archive, append files to an existing archive",
+		Usage: "Create multi-object archive, append files to an existing archive, extract archived files",
 		Subcommands: []cli.Command{
 			{
 				Name:         commandCreate,
@@ -64,6 +83,15 @@ var (
 				Action:       listArchHandler,
 				BashComplete: bucketCompletions(bcmplop{}),
 			},
+			{
+				Name: cmdExtract,
+				Usage: "extract (unpack) archived files into individual objects, e.g.:\n" +
+					indent4 + "\t'extract ais://src/shard.tar --to ais://dst/shard/' (use '--list' to preview)",
+				ArgsUsage:    objectArgument,
+				Flags:        archCmdsFlags[cmdExtract],
+				Action:       extractArchHandler,
+				BashComplete: bucketCompletions(bcmplop{}),
+			},
 		},
 	}
 )
@@ -119,3 +147,109 @@ func listArchHandler(c *cli.Context) error {
 	}
 	return listObjects(c, bck, objName, true /*list arch*/)
 }
+
+func extractArchHandler(c *cli.Context) error {
+	if c.NArg() == 0 {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	bck, archName, err := parseBckObjectURI(c, c.Args().Get(0))
+	if err != nil {
+		return err
+	}
+	var (
+		bckTo  cmn.Bck
+		prefix string
+		filter = parseStrFlag(c, extractFilterFlag)
+	)
+	if filter != "" {
+		if _, err := path.Match(filter, ""); err != nil {
+			return fmt.Errorf("invalid %s %q: %v", qflprn(extractFilterFlag), filter, err)
+		}
+	}
+	if flagIsSet(c, extractToFlag) {
+		if bckTo, prefix, err = parseBckObjectURI(c, parseStrFlag(c, extractToFlag), true /*optional objName*/); err != nil {
+			return err
+		}
+		// destination prefix is a virtual directory
+		if prefix != "" && !strings.HasSuffix(prefix, "/") {
+			prefix += "/"
+		}
+	} else if !flagIsSet(c, extractListFlag) {
+		return missingArgumentsError(c, "destination bucket and (optional) prefix via "+qflprn(extractToFlag))
+	}
+
+	if flagIsSet(c, extractListFlag) {
+		return previewExtract(c, bck, archName, &bckTo, prefix, filter)
+	}
+
+	msg := &cmn.ExtractMsg{ToBck: bckTo, Prefix: prefix, Filter: filter}
+	xid, err := api.ExtractArchive(apiBP, bck, archName, msg)
+	if err != nil {
+		return err
+	}
+	_, xname := xact.GetKindName(apc.ActExtract)
+	text := fmt.Sprintf("%s[%s] %s => %s", xname, xid, bck.Cname(archName), bckTo.Cname(prefix))
+	if !flagIsSet(c, waitFlag) && !flagIsSet(c, waitJobXactFinishedFlag) {
+		actionDone(c, text+". "+toMonitorMsg(c, xid, ""))
+		return nil
+	}
+
+	// wait
+	var timeout time.Duration
+	if flagIsSet(c, waitJobXactFinishedFlag) {
+		timeout = parseDurationFlag(c, waitJobXactFinishedFlag)
+	}
+	fmt.Fprintln(c.App.Writer, text+" ...")
+	xargs := xact.ArgsMsg{ID: xid, Kind: apc.ActExtract, Timeout: timeout}
+	if err := waitXact(apiBP, xargs); err != nil {
+		return err
+	}
+	fmt.Fprint(c.App.Writer, fmtXactSucceeded)
+	return nil
+}
+
+// list archived files that'd be extracted, along with their respective destinations
+func previewExtract(c *cli.Context, bck cmn.Bck, archName string, bckTo *cmn.Bck, prefix, filter string) error {
+	msg := &apc.LsoMsg{Prefix: archName}
+	msg.AddProps(apc.GetPropsName, apc.GetPropsSize)
+	msg.SetFlag(apc.LsArchDir)
+	objList, err := api.ListObjects(apiBP, bck, msg, 0)
+	if err != nil {
+		return err
+	}
+	var (
+		cnt  int
+		size int64
+		pref = archName + "/"
+	)
+	for _, en := range objList.Entries {
+		if !en.IsInsideArch() || !strings.HasPrefix(en.Name, pref) {
+			continue
+		}
+		filename := strings.TrimPrefix(en.Name, pref)
+		if path.IsAbs(filename) || cos.StringInSlice("..", strings.Split(filename, "/")) {
+			actionWarn(c, fmt.Sprintf("skipping %q: absolute or %q-containing names are not extracted", filename, ".."))
+			continue
+		}
+		filename = path.Clean(filename)
+		if filter != "" {
+			if ok, _ := path.Match(filter, filename); !ok {
+				if ok, _ = path.Match(filter, path.Base(filename)); !ok {
+					continue
+				}
+			}
+		}
+		if bckTo.IsEmpty() {
+			fmt.Fprintf(c.App.Writer, "%s\t%s\n", filename, teb.FmtSize(en.Size, "", 2))
+		} else {
+			fmt.Fprintf(c.App.Writer, "%s\t%s\t=> %s\n", filename, teb.FmtSize(en.Size, "", 2), bckTo.Cname(prefix+filename))
+		}
+		cnt++
+		size += en.Size
+	}
+	if cnt == 0 {
+		return fmt.Errorf("%s: no matching archived files", bck.Cname(archName))
+	}
+	fmt.Fprintf(c.App.Writer, "Total: %d file%s (%s)\n", cnt, cos.Plural(cnt), teb.FmtSize(size, "", 2))
+	return nil
+}
@@ -6,8 +6,10 @@
 package cli
 
 import (
+	"encoding/csv"
 	"errors"
 	"fmt"
+	"net/http"
 	"os"
 	"path/filepath"
 	"strings"
@@ -33,13 +35,22 @@ import (
 const (
 	flagsAuthUserLogin   = "user_login"
 	flagsAuthUserLogout  = "user_logout"
+	flagsAuthUserAdd     = "user_add"
 	flagsAuthUserShow    = "user_show"
 	flagsAuthRoleAddSet  = "role_add_set"
 	flagsAuthRevokeToken = "revoke_token"
+	flagsAuthRefresh     = "refresh_token"
 	flagsAuthRoleShow    = "role_show"
 	flagsAuthConfShow    = "conf_show"
 )
 
+// `ais auth add user --from-file --on-conflict`
+const (
+	onConflictSkip   = "skip"
+	onConflictUpdate = "update"
+	onConflictFail   = "fail"
+)
+
 const authnUnreachable = `AuthN unreachable at %s. You may need to update AIS CLI configuration or environment variable %s`
 
 var (
@@ -47,11 +58,20 @@ var (
 		flagsAuthUserLogin:   {tokenFileFlag, passwordFlag, expireFlag, clusterTokenFlag},
 		flagsAuthUserLogout:  {tokenFileFlag},
 		cmdAuthUser:          {passwordFlag},
+		flagsAuthUserAdd:     {passwordFlag, usersFromFileFlag, usersSecretsFileFlag, usersOnConflictFlag},
 		flagsAuthRoleAddSet:  {descRoleFlag, clusterRoleFlag, bucketRoleFlag},
 		flagsAuthRevokeToken: {tokenFileFlag},
+		flagsAuthRefresh:     {tokenFileFlag, expireFlag, clusterTokenFlag, tokenInPlaceFlag},
 		flagsAuthUserShow:    {nonverboseFlag, verboseFlag},
-		flagsAuthRoleShow:    {nonverboseFlag, verboseFlag, clusterFilterFlag},
-		flagsAuthConfShow:    {jsonFlag},
+		flagsAuthRoleShow: {
+			nonverboseFlag,
+			verboseFlag,
+			clusterFilterFlag,
+			roleEffectiveFlag,
+			bucketFilterFlag,
+			jsonFlag,
+		},
+		flagsAuthConfShow: {jsonFlag},
 	}
 
 	// define separately to allow for aliasing (see alias_hdlr.go)
@@ -104,7 +124,7 @@ var (
 						Name:         cmdAuthUser,
 						Usage:        "add a new user",
 						ArgsUsage:    addAuthUserArgument,
-						Flags:        authFlags[cmdAuthUser],
+						Flags:        authFlags[flagsAuthUserAdd],
 						Action:       wrapAuthN(addAuthUserHandler),
 						BashComplete: oneRoleCompletions,
 					},
@@ -195,6 +215,20 @@ var (
 					},
 				},
 			},
+			// token
+			{
+				Name:  cmdAuthToken,
+				Usage: "manage AuthN tokens",
+				Subcommands: []cli.Command{
+					{
+						Name: cmdAuthRefresh,
+						Usage: "exchange a still-valid token (see '--file' and " + env.AuthN.TokenFile + ")\n" +
+							indent4 + "\tfor a newly issued one with a new expiration time",
+						Flags:  authFlags[flagsAuthRefresh],
+						Action: wrapAuthN(refreshTokenHandler),
+					},
+				},
+			},
 			// login, logout
 			{
 				Name:      cmdAuthLogin,
@@ -319,6 +353,15 @@ func updateAuthUserHandler(c *cli.Context) (err error) {
 }
 
 func addAuthUserHandler(c *cli.Context) (err error) {
+	if flagIsSet(c, usersFromFileFlag) {
+		if c.NArg() > 0 {
+			return incorrectUsageMsg(c, "flag %s cannot be used together with %s", qflprn(usersFromFileFlag), c.Command.ArgsUsage)
+		}
+		return addAuthUsersFromFile(c)
+	}
+	if flagIsSet(c, usersSecretsFileFlag) {
+		return fmt.Errorf("flag %s requires %s", qflprn(usersSecretsFileFlag), qflprn(usersFromFileFlag))
+	}
 	user := userFromArgsOrStdin(c, false /*omitEmpty*/)
 	list, err := authn.GetAllUsers(authParams)
 	if err != nil {
@@ -333,6 +376,155 @@ func addAuthUserHandler(c *cli.Context) (err error) {
 	return authn.AddUser(authParams, user)
 }
 
+// bulk import: add all users from a CSV file; existing users are skipped,
+// updated, or cause the entire import to fail (before adding anything) - see `usersOnConflictFlag`
+func addAuthUsersFromFile(c *cli.Context) error {
+	onConflict := parseStrFlag(c, usersOnConflictFlag)
+	switch onConflict {
+	case onConflictSkip, onConflictUpdate, onConflictFail:
+	default:
+		return fmt.Errorf("invalid %s value %q (expecting one of: %s, %s, %s)", qflprn(usersOnConflictFlag),
+			onConflict, onConflictSkip, onConflictUpdate, onConflictFail)
+	}
+	users, err := readUsersCSV(parseStrFlag(c, usersFromFileFlag))
+	if err != nil {
+		return err
+	}
+	if flagIsSet(c, usersSecretsFileFlag) {
+		if err := readUsersSecrets(parseStrFlag(c, usersSecretsFileFlag), users); err != nil {
+			return err
+		}
+	}
+	for _, user := range users {
+		if user.Password == "" {
+			return fmt.Errorf("user %q: missing password (neither in %s nor in %s)",
+				user.ID, qflprn(usersFromFileFlag), qflprn(usersSecretsFileFlag))
+		}
+	}
+
+	list, err := authn.GetAllUsers(authParams)
+	if err != nil {
+		return err
+	}
+	existing := make(cos.StrSet, len(list))
+	for _, uInfo := range list {
+		existing.Add(uInfo.ID)
+	}
+	if onConflict == onConflictFail {
+		var conflicts []string
+		for _, user := range users {
+			if existing.Contains(user.ID) {
+				conflicts = append(conflicts, user.ID)
+			}
+		}
+		if len(conflicts) > 0 {
+			return fmt.Errorf("found %d existing user%s %v - nothing added (see %s)", len(conflicts),
+				cos.Plural(len(conflicts)), conflicts, qflprn(usersOnConflictFlag))
+		}
+	}
+
+	var added, updated, skipped, failed int
+	for _, user := range users {
+		switch {
+		case !existing.Contains(user.ID):
+			err = authn.AddUser(authParams, user)
+			if err == nil {
+				added++
+				fmt.Fprintf(c.App.Writer, "%s: added\n", user.ID)
+			}
+		case onConflict == onConflictUpdate:
+			err = authn.UpdateUser(authParams, user)
+			if err == nil {
+				updated++
+				fmt.Fprintf(c.App.Writer, "%s: updated\n", user.ID)
+			}
+		default:
+			skipped++
+			fmt.Fprintf(c.App.Writer, "%s: skipped (already exists)\n", user.ID)
+		}
+		if err != nil {
+			failed++
+			color.New(color.FgRed).Fprintf(c.App.ErrWriter, "%s: %v\n", user.ID, err)
+			err = nil
+		}
+	}
+	actionDone(c, fmt.Sprintf("\nTotal: %d added, %d updated, %d skipped, %d failed", added, updated, skipped, failed))
+	if failed > 0 {
+		return fmt.Errorf("failed to import %d (out of %d) user%s", failed, len(users), cos.Plural(len(users)))
+	}
+	return nil
+}
+
+// USER_NAME,PASSWORD[,ROLE...]
+func readUsersCSV(path string) ([]*authn.User, error) {
+	records, err := readAuthCSV(path)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		users = make([]*authn.User, 0, len(records))
+		seen  = make(cos.StrSet, len(records))
+	)
+	for i, rec := range records {
+		if len(rec) < 2 || rec[0] == "" {
+			return nil, fmt.Errorf("%s, line %d: expecting USER_NAME,PASSWORD[,ROLE...], got %q", path, i+1, strings.Join(rec, ","))
+		}
+		if seen.Contains(rec[0]) {
+			return nil, fmt.Errorf("%s, line %d: duplicate user %q", path, i+1, rec[0])
+		}
+		seen.Add(rec[0])
+		user := &authn.User{ID: rec[0], Password: rec[1]}
+		for _, role := range rec[2:] {
+			if role != "" {
+				user.Roles = append(user.Roles, role)
+			}
+		}
+		users = append(users, user)
+	}
+	if len(users) == 0 {
+		return nil, fmt.Errorf("%s: no users to add", path)
+	}
+	return users, nil
+}
+
+// USER_NAME,PASSWORD
+func readUsersSecrets(path string, users []*authn.User) error {
+	records, err := readAuthCSV(path)
+	if err != nil {
+		return err
+	}
+	secrets := make(map[string]string, len(records))
+	for i, rec := range records {
+		if len(rec) != 2 || rec[0] == "" {
+			return fmt.Errorf("%s, line %d: expecting USER_NAME,PASSWORD", path, i+1)
+		}
+		secrets[rec[0]] = rec[1]
+	}
+	for _, user := range users {
+		if user.Password == "" {
+			user.Password = secrets[user.ID]
+		}
+	}
+	return nil
+}
+
+func readAuthCSV(path string) ([][]string, error) {
+	fh, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer fh.Close()
+	r := csv.NewReader(fh)
+	r.Comment = '#'
+	r.FieldsPerRecord = -1
+	r.TrimLeadingSpace = true
+	records, err := r.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %v", path, err)
+	}
+	return records, nil
+}
+
 func deleteUserHandler(c *cli.Context) (err error) {
 	userName := c.Args().First()
 	if userName == "" {
@@ -522,8 +714,142 @@ func showAuthAllRoles(c *cli.Context) error {
 	return teb.Print(list, teb.AuthNRoleTmpl)
 }
 
+// effective (resolved) permissions
+type (
+	effectivePerms struct {
+		Scope   string   `json:"scope"`
+		Object  []string `json:"object"`
+		Bucket  []string `json:"bucket"`
+		Cluster []string `json:"cluster"`
+	}
+	effectiveRole struct {
+		Role  string            `json:"role"`
+		Admin bool              `json:"admin"`
+		Perms []*effectivePerms `json:"permissions"`
+	}
+)
+
+const (
+	accessObject  = apc.AceGET | apc.AceObjHEAD | apc.AcePUT | apc.AceAPPEND | apc.AceObjDELETE | apc.AceObjMOVE | apc.AcePromote | apc.AceDisconnectedBackend
+	accessBucket  = apc.AceBckHEAD | apc.AceObjLIST | apc.AcePATCH | apc.AceBckSetACL
+	accessCluster = apc.AceListBuckets | apc.AceShowCluster | apc.AceCreateBucket | apc.AceDestroyBucket | apc.AceMoveBucket | apc.AceAdmin
+)
+
+func newEffectivePerms(scope string, acl apc.AccessAttrs) *effectivePerms {
+	ops := func(a apc.AccessAttrs) []string {
+		if a == 0 {
+			return []string{}
+		}
+		return strings.Split(a.Describe(), ",")
+	}
+	return &effectivePerms{
+		Scope:   scope,
+		Object:  ops(acl & accessObject),
+		Bucket:  ops(acl & accessBucket),
+		Cluster: ops(acl & accessCluster),
+	}
+}
+
+// resolves role's permissions the same way AIS does when checking a token (see tok.CheckPermissions):
+// - cluster ACL with matching ID takes precedence over the "global" one (empty ID);
+// - bucket ACL, if defined, overrides cluster-wide object and bucket permissions
+func showAuthEffectiveRole(c *cli.Context, roleID string) error {
+	rInfo, err := authn.GetRole(authParams, roleID)
+	if err != nil {
+		return err
+	}
+	var (
+		cluster = parseStrFlag(c, clusterFilterFlag)
+		bucket  = parseStrFlag(c, bucketFilterFlag)
+		out     = &effectiveRole{Role: rInfo.ID, Admin: rInfo.IsAdmin, Perms: []*effectivePerms{}}
+	)
+	if strings.Contains(cluster, ",") {
+		return fmt.Errorf("flag %s with %s expects a single cluster ID or alias, got %q",
+			qflprn(clusterFilterFlag), qflprn(roleEffectiveFlag), cluster)
+	}
+	if bucket != "" && cluster == "" {
+		return fmt.Errorf("flag %s requires %s to be specified", qflprn(bucketFilterFlag), qflprn(clusterFilterFlag))
+	}
+	if cluster != "" {
+		if cluster, err = lookupClusterID(cluster); err != nil {
+			return err
+		}
+	}
+	switch {
+	case rInfo.IsAdmin:
+		scope := "all clusters"
+		if cluster != "" {
+			scope = "cluster " + cluster
+		}
+		out.Perms = append(out.Perms, newEffectivePerms(scope, apc.AccessAll))
+	case cluster == "":
+		// no scope given: resolve each cluster and bucket the role refers to
+		for _, clu := range rInfo.ClusterACLs {
+			scope := "all clusters"
+			if clu.ID != "" {
+				scope = "cluster " + clu.ID
+			}
+			out.Perms = append(out.Perms, newEffectivePerms(scope, clu.Access))
+		}
+		for _, b := range rInfo.BucketACLs {
+			out.Perms = append(out.Perms, newEffectivePerms("bucket "+b.Bck.Cname(""), b.Access))
+		}
+	default:
+		cluACL := roleACLForCluster(rInfo, cluster)
+		out.Perms = append(out.Perms, newEffectivePerms("cluster "+cluster, cluACL))
+		if bucket != "" {
+			bck, err := parseBckURI(c, bucket, true /*require provider*/)
+			if err != nil {
+				return err
+			}
+			acl, ok := roleACLForBucket(rInfo, cluster, &bck)
+			if !ok {
+				// no bucket-specific ACL: inherit cluster-wide object and bucket permissions
+				acl = cluACL & (accessObject | accessBucket)
+			}
+			out.Perms = append(out.Perms, newEffectivePerms("bucket "+bck.Cname(""), acl))
+		}
+	}
+	return teb.Print(out, teb.AuthNRoleEffectiveTmpl, teb.Jopts(flagIsSet(c, jsonFlag)))
+}
+
+func roleACLForCluster(rInfo *authn.Role, cluID string) (acl apc.AccessAttrs) {
+	for _, clu := range rInfo.ClusterACLs {
+		if clu.ID == cluID {
+			return clu.Access
+		}
+		if clu.ID == "" {
+			acl = clu.Access
+		}
+	}
+	return acl
+}
+
+func roleACLForBucket(rInfo *authn.Role, cluID string, bck *cmn.Bck) (apc.AccessAttrs, bool) {
+	for _, b := range rInfo.BucketACLs {
+		if b.Bck.Ns.UUID != cluID {
+			continue
+		}
+		// AuthN buckets carry the UUID of their respective cluster
+		roleBck := cmn.Bck{Name: b.Bck.Name, Provider: b.Bck.Provider}
+		if roleBck.Equal(bck) {
+			return b.Access, true
+		}
+	}
+	return 0, false
+}
+
 func showAuthRoleHandler(c *cli.Context) (err error) {
 	roleID := c.Args().First()
+	if flagIsSet(c, roleEffectiveFlag) {
+		if roleID == "" {
+			return missingArgumentsError(c, "role name")
+		}
+		return showAuthEffectiveRole(c, roleID)
+	}
+	if flagIsSet(c, bucketFilterFlag) {
+		return fmt.Errorf("flag %s requires %s", qflprn(bucketFilterFlag), qflprn(roleEffectiveFlag))
+	}
 	if roleID != "" {
 		return showAuthSingleRole(c, roleID)
 	}
@@ -684,15 +1010,55 @@ func revokeTokenHandler(c *cli.Context) (err error) {
 	if err != nil {
 		return err
 	}
+	msg, err := loadTokenFile(tokenFile)
+	if err != nil {
+		return err
+	}
+	return authn.RevokeToken(authParams, msg.Token)
+}
+
+func refreshTokenHandler(c *cli.Context) error {
+	var expireIn *time.Duration
+	tokenFile, err := tokfile(c)
+	if err != nil {
+		return err
+	}
+	msg, err := loadTokenFile(tokenFile)
+	if err != nil {
+		return err
+	}
+	if flagIsSet(c, expireFlag) {
+		expireIn = api.Duration(parseDurationFlag(c, expireFlag))
+	}
+	token, err := authn.RefreshToken(authParams, msg.Token, parseStrFlag(c, clusterTokenFlag), expireIn)
+	if err != nil {
+		if herr, ok := err.(*cmn.ErrHTTP); ok && herr.Status == http.StatusUnauthorized &&
+			strings.Contains(herr.Message, "token expired") {
+			return fmt.Errorf("token %q has expired and cannot be refreshed - use 'ais %s %s' to log in again",
+				tokenFile, commandAuth, cmdAuthLogin)
+		}
+		return err
+	}
+	if !flagIsSet(c, tokenInPlaceFlag) {
+		return jsoniter.NewEncoder(c.App.Writer).Encode(token)
+	}
+	if err := jsp.Save(tokenFile, token, jsp.Plain(), nil); err != nil {
+		return fmt.Errorf("failed to write token %q: %v", tokenFile, err)
+	}
+	actionDone(c, fmt.Sprintf("Token %q refreshed", tokenFile))
+	return nil
+}
+
+func loadTokenFile(tokenFile string) (*authn.TokenMsg, error) {
 	b, err := os.ReadFile(tokenFile)
 	if err != nil {
-		return fmt.Errorf("failed to read token %q: %v", tokenFile, err)
+		return nil, fmt.Errorf("failed to read token %q: %v", tokenFile, err)
 	}
 	msg := &authn.TokenMsg{}
 	if err := jsoniter.Unmarshal(b, msg); err != nil {
-		return fmt.Errorf("invalid token %q format: %v", tokenFile, err)
+		return nil, fmt.Errorf("invalid token %q format: %v", tokenFile, err)
 	}
-	return authn.RevokeToken(authParams, msg.Token)
+	return msg, nil
 }
 
 func showAuthConfigHandler(c *cli.Context) (err error) {
@@ -8,8 +8,12 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"os"
 	"regexp"
+	"sort"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/NVIDIA/aistore/api"
@@ -17,13 +21,19 @@ import (
 	"github.com/NVIDIA/aistore/cluster"
 	"github.com/NVIDIA/aistore/cmd/cli/teb"
 	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/xact"
+	jsoniter "github.com/json-iterator/go"
 	"github.com/urfave/cli"
+	"gopkg.in/yaml.v2"
 	"k8s.io/apimachinery/pkg/util/duration"
 )
 
+// `ls --fill-checksum`: max number of concurrent HEAD requests
+const fillCksumWorkers = 16
+
 const (
 	fmtXactFailed      = "Failed to %s (%q => %q)\n"
 	fmtXactSucceeded   = "Done.\n"
@@ -66,6 +76,111 @@ func createBucket(c *cli.Context, bck cmn.Bck, props *cmn.BucketPropsToUpdate) (
 	return
 }
 
+//
+// create --from-bucket | --props-file
+//
+
+// bucket properties inherited from a template bucket
+var bckTemplateSections = []string{"checksum", "ec", "mirror", "versioning", "lru"}
+
+func bckTemplateProps(tmpl cmn.Bck) (*cmn.BucketPropsToUpdate, error) {
+	p, err := headBucket(tmpl, true /*don't add*/)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		all = make(map[string]jsoniter.RawMessage, 16)
+		sel = make(map[string]jsoniter.RawMessage, len(bckTemplateSections))
+	)
+	if err := jsoniter.Unmarshal(cos.MustMarshal(p), &all); err != nil {
+		return nil, err
+	}
+	for _, section := range bckTemplateSections {
+		if v, ok := all[section]; ok {
+			sel[section] = v
+		}
+	}
+	props := &cmn.BucketPropsToUpdate{}
+	err = jsoniter.Unmarshal(cos.MustMarshal(sel), props)
+	return props, err
+}
+
+func bckPropsFromFile(path string) (*cmn.BucketPropsToUpdate, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	props := &cmn.BucketPropsToUpdate{}
+	if errj := jsoniter.Unmarshal(b, props); errj != nil {
+		var ydoc any
+		if erry := yaml.Unmarshal(b, &ydoc); erry != nil {
+			return nil, fmt.Errorf("%s: failed to parse as JSON (%v) or YAML (%v)", path, errj, erry)
+		}
+		props = &cmn.BucketPropsToUpdate{}
+		if err := jsoniter.Unmarshal(cos.MustMarshal(yamlToJSON(ydoc)), props); err != nil {
+			return nil, fmt.Errorf("%s: %v", path, err)
+		}
+	}
+	// (e.g., `ais bucket props show --json` of a bucket that has no backend)
+	if bb := props.BackendBck; bb != nil && (bb.Name == nil || *bb.Name == "") {
+		props.BackendBck = nil
+	}
+	return props, nil
+}
+
+// apply `overrides` on top of `base`
+func mergeBckProps(base, overrides *cmn.BucketPropsToUpdate) (*cmn.BucketPropsToUpdate, error) {
+	if base == nil {
+		return overrides, nil
+	}
+	err := jsoniter.Unmarshal(cos.MustMarshal(overrides), base)
+	return base, err
+}
+
+// check inherited props against the current cluster (use '--force' to skip)
+func validateInheritedProps(c *cli.Context, props *cmn.BucketPropsToUpdate, src string) error {
+	ec := props.EC
+	if ec == nil || ec.Enabled == nil || !*ec.Enabled {
+		return nil
+	}
+	smap, err := getClusterMap(c)
+	if err != nil {
+		return err
+	}
+	clucfg, err := api.GetClusterConfig(apiBP)
+	if err != nil {
+		return err
+	}
+	conf := clucfg.EC // (new buckets start with cluster defaults)
+	conf.Enabled = true
+	if ec.DataSlices != nil {
+		conf.DataSlices = *ec.DataSlices
+	}
+	if ec.ParitySlices != nil {
+		conf.ParitySlices = *ec.ParitySlices
+	}
+	if err := conf.ValidateAsProps(smap.CountActiveTs()); err != nil {
+		return fmt.Errorf("cannot inherit from %s: %v (use %s to create anyway)", src, err, qflprn(forceFlag))
+	}
+	return nil
+}
+
+func reportInheritedProps(c *cli.Context, bck cmn.Bck, props *cmn.BucketPropsToUpdate, src string) {
+	p, err := headBucket(bck, true /*don't add*/)
+	if err != nil {
+		actionWarn(c, err.Error())
+		return
+	}
+	var sections map[string]jsoniter.RawMessage
+	_ = jsoniter.Unmarshal(cos.MustMarshal(props), &sections)
+	fmt.Fprintf(c.App.Writer, "Inherited from %s:\n", src)
+	for _, nv := range flattenConfig(p, "", "") {
+		if _, ok := sections[strings.Split(nv.Name, ".")[0]]; ok {
+			fmt.Fprintf(c.App.Writer, "%s%s=%s\n", indent1, nv.Name, nv.Value)
+		}
+	}
+}
+
 // Destroy ais buckets
 func destroyBuckets(c *cli.Context, buckets []cmn.Bck) (err error) {
 	for _, bck := range buckets {
@@ -85,7 +200,7 @@ func destroyBuckets(c *cli.Context, buckets []cmn.Bck) (err error) {
 		if cmn.IsStatusNotFound(err) {
 			desc := fmt.Sprintf("Bucket %q does not exist", bck)
 			if !flagIsSet(c, ignoreErrorFlag) {
-				return errors.New(desc)
+				return cmn.NewErrNotFound("Bucket %q", bck)
 			}
 			fmt.Fprint(c.App.Writer, desc)
 			continue
@@ -369,6 +484,10 @@ func listObjects(c *cli.Context, bck cmn.Bck, prefix string, listArch bool) erro
 	if err != nil {
 		return err
 	}
+	rt, err := parseFormatTemplate(c, cmn.LsoEntry{})
+	if err != nil {
+		return err
+	}
 	if flagIsSet(c, listObjCachedFlag) {
 		msg.SetFlag(apc.LsObjCached)
 		addCachedCol = false
@@ -376,6 +495,13 @@ func listObjects(c *cli.Context, bck cmn.Bck, prefix string, listArch bool) erro
 	if flagIsSet(c, listAnonymousFlag) {
 		msg.SetFlag(apc.LsTryHeadRemote)
 	}
+	latest := flagIsSet(c, listObjLatestFlag)
+	if latest {
+		if !bck.IsRemote() {
+			return fmt.Errorf("flag %s applies only to remote buckets (%s is not)", qflprn(listObjLatestFlag), bck.Cname(""))
+		}
+		addCachedCol = true
+	}
 	if listArch {
 		msg.SetFlag(apc.LsArchDir)
 	}
@@ -416,6 +542,18 @@ func listObjects(c *cli.Context, bck cmn.Bck, prefix string, listArch bool) erro
 		// (due to mirroring, EC). The status helps to tell an object from its replica(s).
 		msg.AddProps(apc.GetPropsStatus)
 	}
+	// TTL (client-side) is computed from the object's custom metadata
+	propsToShow := msg.Props
+	if msg.WantProp(teb.ObjPropTTL) {
+		props := strings.Split(msg.Props, apc.LsPropsSepa)
+		msg.Props = ""
+		for _, prop := range props {
+			if prop != teb.ObjPropTTL {
+				msg.AddProps(prop)
+			}
+		}
+		msg.AddProps(apc.GetPropsCustom)
+	}
 	if flagIsSet(c, startAfterFlag) {
 		msg.StartAfter = parseStrFlag(c, startAfterFlag)
 	}
@@ -426,6 +564,18 @@ func listObjects(c *cli.Context, bck cmn.Bck, prefix string, listArch bool) erro
 	}
 	msg.PageSize = uint(pageSize)
 
+	fillCksum := flagIsSet(c, listFillCksumFlag)
+	if fillCksum {
+		if !msg.WantProp(apc.GetPropsChecksum) {
+			return incorrectUsageMsg(c, "%s requires checksum property (e.g., '%s checksum')",
+				qflprn(listFillCksumFlag), flprn(objPropsFlag))
+		}
+		if limit == 0 {
+			return incorrectUsageMsg(c, "%s requires %s (to bound the number of HEAD requests)",
+				qflprn(listFillCksumFlag), qflprn(objLimitFlag))
+		}
+	}
+
 	// list bucket's objects page by page and print pages, one at a time
 	if flagIsSet(c, pagedFlag) {
 		pageCounter, maxPages, toShow := 0, parseIntFlag(c, maxPagesFlag), limit
@@ -443,10 +593,22 @@ func listObjects(c *cli.Context, bck cmn.Bck, prefix string, listArch bool) erro
 			} else {
 				toPrint = objList.Entries
 			}
-			err = printObjProps(c, toPrint, objectListFilter, msg.Props, addCachedCol)
+			if latest {
+				if _, err := reconcileLatest(bck, toPrint); err != nil {
+					return err
+				}
+			}
+			var filled int
+			if fillCksum {
+				if filled, err = fillChecksums(bck, toPrint); err != nil {
+					return err
+				}
+			}
+			err = printObjProps(c, toPrint, objectListFilter, propsToShow, addCachedCol, rt)
 			if err != nil {
 				return err
 			}
+			fillCksumFooter(c, filled)
 
 			// interrupt the loop if:
 			// 1. the last page is printed
@@ -482,7 +644,95 @@ func listObjects(c *cli.Context, bck cmn.Bck, prefix string, listArch bool) erro
 	if err != nil {
 		return err
 	}
-	return printObjProps(c, objList.Entries, objectListFilter, msg.Props, addCachedCol)
+	var stale, filled int
+	if latest {
+		if stale, err = reconcileLatest(bck, objList.Entries); err != nil {
+			return err
+		}
+	}
+	if fillCksum {
+		if filled, err = fillChecksums(bck, objList.Entries); err != nil {
+			return err
+		}
+	}
+	if err := printObjProps(c, objList.Entries, objectListFilter, propsToShow, addCachedCol, rt); err != nil {
+		return err
+	}
+	if latest && !flagIsSet(c, noFooterFlag) {
+		fmt.Fprintf(c.App.Writer, "Out of date: %d object%s\n", stale, cos.Plural(stale))
+	}
+	fillCksumFooter(c, filled)
+	return nil
+}
+
+// `ls --fill-checksum`: HEAD listed objects that have no checksum in the listing
+// (not all backends provide one), with bounded concurrency; the number of HEAD
+// requests is bounded by the size of the list (and, therefore, by `--limit`)
+func fillChecksums(bck cmn.Bck, entries cmn.LsoEntries) (int, error) {
+	var (
+		wg     = cos.NewLimitedWaitGroup(fillCksumWorkers, len(entries))
+		filled atomic.Int32
+		mu     sync.Mutex
+		errH   error
+	)
+	for _, en := range entries {
+		if en.Checksum != "" || !en.IsStatusOK() || en.IsInsideArch() || en.Flags&apc.EntryIsDir != 0 {
+			continue
+		}
+		wg.Add(1)
+		go func(en *cmn.LsoEntry) {
+			defer wg.Done()
+			props, err := api.HeadObject(apiBP, bck, en.Name, apc.FltExists)
+			switch {
+			case err == nil:
+				if props.Cksum != nil && props.Cksum.Value() != "" {
+					en.Checksum = props.Cksum.Value()
+					en.SetHeadCksum()
+					filled.Inc()
+				}
+			case cmn.IsStatusNotFound(err):
+				// deleted in the meantime
+			default:
+				mu.Lock()
+				if errH == nil {
+					errH = fmt.Errorf("failed to HEAD %s: %v", bck.Cname(en.Name), err)
+				}
+				mu.Unlock()
+			}
+		}(en)
+	}
+	wg.Wait()
+	return int(filled.Load()), errH
+}
+
+func fillCksumFooter(c *cli.Context, filled int) {
+	if filled > 0 && !flagIsSet(c, noFooterFlag) {
+		fmt.Fprintf(c.App.Writer, "(%s) %d checksum%s obtained via HEAD(object) - not carried by the listing\n",
+			teb.HeadCksumSuffix, filled, cos.Plural(filled))
+	}
+}
+
+// `ls --latest`: HEAD each listed in-cluster ("cached") object while comparing it with
+// its remote origin; the number of HEAD requests is bounded by the size of the list
+// (and, therefore, by `--limit`)
+func reconcileLatest(bck cmn.Bck, entries cmn.LsoEntries) (stale int, err error) {
+	for _, en := range entries {
+		if !en.CheckExists() || !en.IsStatusOK() {
+			continue
+		}
+		_, isStale, errH := api.HeadObjectLatest(apiBP, bck, en.Name)
+		if errH != nil {
+			if cmn.IsStatusNotFound(errH) {
+				continue // evicted in the meantime
+			}
+			return stale, fmt.Errorf("failed to check %s: %v", bck.Cname(en.Name), errH)
+		}
+		if isStale {
+			en.SetStale()
+			stale++
+		}
+	}
+	return stale, nil
 }
 
 func _setPage(c *cli.Context, bck cmn.Bck) (pageSize, limit int, err error) {
@@ -672,16 +922,296 @@ func configureNCopies(c *cli.Context, bck cmn.Bck, copies int) (err error) {
 }
 
 // erasure code the entire bucket
-func ecEncode(c *cli.Context, bck cmn.Bck, data, parity int) (err error) {
-	var xid string
-	if xid, err = api.ECEncodeBucket(apiBP, bck, data, parity); err != nil {
-		return
+func ecEncode(c *cli.Context, bck cmn.Bck, p *cmn.BucketProps, data, parity int) error {
+	total, err := ecPreflight(c, bck, p, data, parity)
+	if err != nil {
+		return err
+	}
+	xid, err := api.ECEncodeBucket(apiBP, bck, data, parity)
+	if err != nil {
+		return err
+	}
+	if !flagIsSet(c, refreshFlag) {
+		msg := fmt.Sprintf("Erasure-coding bucket %s. ", bck.Cname(""))
+		actionDone(c, msg+toMonitorMsg(c, xid, ""))
+		return nil
 	}
-	msg := fmt.Sprintf("Erasure-coding bucket %s. ", bck.Cname(""))
-	actionDone(c, msg+toMonitorMsg(c, xid, ""))
+	return ecEncodeProgress(c, bck, xid, total)
+}
+
+// pre-flight feasibility check:
+// - the cluster must have enough targets to accommodate data and parity slices (always enforced);
+// - the expected capacity overhead must fit into the available capacity (unless '--force')
+// returns the number of objects to encode
+func ecPreflight(c *cli.Context, bck cmn.Bck, p *cmn.BucketProps, data, parity int) (int64, error) {
+	smap, err := getClusterMap(c)
+	if err != nil {
+		return 0, err
+	}
+	ecConf := p.EC
+	ecConf.Enabled, ecConf.DataSlices, ecConf.ParitySlices = true, data, parity
+	numTs := smap.CountActiveTs()
+	if err := ecConf.ValidateAsProps(numTs); err != nil {
+		// NOTE: unlike bucket props, not enough targets is not a soft error here - the cluster won't start encoding
+		return 0, fmt.Errorf("cannot erasure-code %s: %v", bck.Cname(""), err)
+	}
+
+	_, info, err := api.GetBucketInfo(apiBP, bck, apc.FltPresent)
+	if err != nil {
+		return 0, err
+	}
+	var (
+		size     = int64(info.TotalSize.PresentObjs)
+		overhead = size * int64(parity) / int64(data)
+		avail    int64
+	)
+	for _, tsi := range smap.Tmap {
+		if smap.InMaintOrDecomm(tsi) {
+			continue
+		}
+		tstatus, err := api.GetStatsAndStatus(apiBP, tsi)
+		if err != nil {
+			return 0, err
+		}
+		for _, cdf := range tstatus.TargetCDF.Mountpaths {
+			avail += int64(cdf.Avail)
+		}
+	}
+	fmt.Fprintf(c.App.Writer, "%s: %d object%s (%s), EC %d:%d - expected capacity overhead %s (%d%%), available %s\n",
+		bck.Cname(""), info.ObjCount.Present, cos.Plural(int(info.ObjCount.Present)), teb.FmtSize(size, "", 2),
+		data, parity, teb.FmtSize(overhead, "", 2), parity*100/data, teb.FmtSize(avail, "", 2))
+	if ecConf.ObjSizeLimit > 0 && info.ObjSize.Min < ecConf.ObjSizeLimit {
+		fmt.Fprintf(c.App.Writer, "Note: objects smaller than %s are replicated rather than encoded (%d extra copies each)\n",
+			teb.FmtSize(ecConf.ObjSizeLimit, "", 2), parity)
+	}
+	if overhead > avail {
+		err := fmt.Errorf("expected capacity overhead %s exceeds available capacity %s",
+			teb.FmtSize(overhead, "", 2), teb.FmtSize(avail, "", 2))
+		if !flagIsSet(c, forceFlag) {
+			return 0, fmt.Errorf("%v (use %s to override)", err, qflprn(forceFlag))
+		}
+		actionWarn(c, err.Error()+"\n")
+	}
+	return int64(info.ObjCount.Present), nil
+}
+
+// show "encoded/total" progress at '--refresh' intervals; when finished, report
+// objects (if any) that couldn't be encoded
+func ecEncodeProgress(c *cli.Context, bck cmn.Bck, xid string, total int64) error {
+	var (
+		xargs   = xact.ArgsMsg{ID: xid, Kind: apc.ActECEncode}
+		refresh = _refreshRate(c)
+		encoded int64
+	)
+	for {
+		time.Sleep(refresh)
+		xs, err := queryXactions(xargs)
+		if err != nil {
+			return err
+		}
+		encoded, _, _ = xs.ObjCounts(xid)
+		fmt.Fprintf(c.App.Writer, "\rErasure-coding %s: %d/%d objects", bck.Cname(""), encoded, total)
+		if aborted, _ := xs.IsAborted(xid); aborted {
+			fmt.Fprintln(c.App.Writer)
+			return fmt.Errorf("%s[%s] was aborted", apc.ActECEncode, xid)
+		}
+		if allFinished(xs) {
+			fmt.Fprintln(c.App.Writer)
+			return ecEncodeReport(c, xs, encoded)
+		}
+	}
+}
+
+func allFinished(xs xact.MultiSnap) bool {
+	for _, snaps := range xs {
+		for _, snap := range snaps {
+			if !snap.Finished() {
+				return false
+			}
+		}
+	}
+	return len(xs) > 0
+}
+
+func ecEncodeReport(c *cli.Context, xs xact.MultiSnap, encoded int64) error {
+	var (
+		nerr, failed = xsFailed(xs, "ec.encode.err.n", "ec.encode.failed")
+		reencoded    = xsExtSum(xs, "ec.encode.reencoded.n")
+		remained     = xsExtSum(xs, "ec.encode.remained.n")
+		replicated   = xsExtSum(xs, "ec.encode.replicated.n")
+		details      string
+	)
+	if reencoded > 0 || remained > 0 {
+		// (re-encoding upon EC props change)
+		details = fmt.Sprintf(" (%d re-encoded); %d remained as is", reencoded, remained)
+		if replicated > 0 {
+			details += fmt.Sprintf(" (including %d too small to slice and replicated instead)", replicated)
+		}
+	}
+	if nerr == 0 {
+		actionDone(c, fmt.Sprintf("Done: %d object%s erasure-coded%s.", encoded, cos.Plural(int(encoded)), details))
+		return nil
+	}
+	printFailed(c, "Failed to erasure-code", nerr, failed)
+	return fmt.Errorf("%d object%s erasure-coded%s, %d failed (see target logs for details)",
+		encoded, cos.Plural(int(encoded)), details, nerr)
+}
+
+// sum up (xaction-specific) extended stats counter across all targets
+func xsExtSum(xs xact.MultiSnap, key string) (n int64) {
+	for _, snaps := range xs {
+		for _, snap := range snaps {
+			ext, ok := snap.Ext.(map[string]any)
+			if !ok {
+				continue
+			}
+			if v, ok := ext[key].(string); ok {
+				cnt, _ := strconv.ParseInt(v, 10, 64)
+				n += cnt
+			}
+		}
+	}
+	return
+}
+
+// collect per-object failures reported via (xaction-specific) extended stats:
+// the total count under `errKey` and (the first) failed object names under `namesKey`
+func xsFailed(xs xact.MultiSnap, errKey, namesKey string) (nerr int64, failed []string) {
+	for _, snaps := range xs {
+		for _, snap := range snaps {
+			ext, ok := snap.Ext.(map[string]any)
+			if !ok {
+				continue
+			}
+			if v, ok := ext[errKey].(string); ok {
+				n, _ := strconv.ParseInt(v, 10, 64)
+				nerr += n
+			}
+			if names, ok := ext[namesKey].([]any); ok {
+				for _, name := range names {
+					failed = append(failed, fmt.Sprint(name))
+				}
+			}
+		}
+	}
+	sort.Strings(failed)
 	return
 }
 
+func printFailed(c *cli.Context, prompt string, nerr int64, failed []string) {
+	fmt.Fprintf(c.App.ErrWriter, "%s %d object%s", prompt, nerr, cos.Plural(int(nerr)))
+	if int64(len(failed)) < nerr {
+		fmt.Fprintf(c.App.ErrWriter, " (showing %d)", len(failed))
+	}
+	fmt.Fprintln(c.App.ErrWriter, ":")
+	for _, name := range failed {
+		fmt.Fprintln(c.App.ErrWriter, indent1+name)
+	}
+}
+
+//
+// rechecksum
+//
+
+func rechecksum(c *cli.Context, bck cmn.Bck) error {
+	p, err := headBucket(bck, false /* don't add */)
+	if err != nil {
+		return err
+	}
+	ty := p.Cksum.Type
+	if flagIsSet(c, rechecksumTypeFlag) {
+		ty = parseStrFlag(c, rechecksumTypeFlag)
+	}
+	if err := cos.ValidateCksumType(ty); err != nil {
+		return incorrectUsageMsg(c, "%v", err)
+	}
+	if ty == cos.ChecksumNone {
+		return incorrectUsageMsg(c, "cannot rechecksum %s: checksum type is %q (use %s to specify)",
+			bck.Cname(""), ty, qflprn(rechecksumTypeFlag))
+	}
+	if ty != p.Cksum.Type {
+		actionWarn(c, fmt.Sprintf("%s is configured with %q checksum, the objects will be rechecksummed with %q\n",
+			bck.Cname(""), p.Cksum.Type, ty))
+	}
+
+	if isStdinList(c) {
+		return errStdinList(c)
+	}
+	msg := &cmn.RechecksumMsg{CksumType: ty, DryRun: flagIsSet(c, dryRunFlag)}
+	switch {
+	case flagIsSet(c, listFlag) && (flagIsSet(c, templateFlag) || flagIsSet(c, rechecksumPrefixFlag)):
+		return incorrectUsageMsg(c, errFmtExclusive, qflprn(listFlag), "--template (--prefix)")
+	case flagIsSet(c, templateFlag) && flagIsSet(c, rechecksumPrefixFlag):
+		return incorrectUsageMsg(c, errFmtExclusive, qflprn(templateFlag), qflprn(rechecksumPrefixFlag))
+	case flagIsSet(c, listFlag):
+		msg.ObjNames = splitCsv(parseStrFlag(c, listFlag))
+	case flagIsSet(c, templateFlag):
+		msg.Template = parseStrFlag(c, templateFlag)
+	case flagIsSet(c, rechecksumPrefixFlag):
+		msg.Template = parseStrFlag(c, rechecksumPrefixFlag) // (no ranges - prefix-only template)
+	}
+
+	xid, err := api.Rechecksum(apiBP, bck, msg)
+	if err != nil {
+		return err
+	}
+	if !msg.DryRun && !flagIsSet(c, waitFlag) && !flagIsSet(c, refreshFlag) {
+		text := fmt.Sprintf("Rechecksumming %s (%s). ", bck.Cname(""), ty)
+		actionDone(c, text+toMonitorMsg(c, xid, ""))
+		return nil
+	}
+	return rechecksumProgress(c, bck, xid, msg)
+}
+
+func rechecksumProgress(c *cli.Context, bck cmn.Bck, xid string, msg *cmn.RechecksumMsg) error {
+	var (
+		xargs   = xact.ArgsMsg{ID: xid, Kind: apc.ActRechecksum}
+		refresh = _refreshRate(c)
+		showing = flagIsSet(c, refreshFlag) && !msg.DryRun
+	)
+	for {
+		time.Sleep(refresh)
+		xs, err := queryXactions(xargs)
+		if err != nil {
+			return err
+		}
+		objs, _, _ := xs.ObjCounts(xid)
+		if showing {
+			fmt.Fprintf(c.App.Writer, "\rRechecksumming %s (%s): %d objects", bck.Cname(""), msg.CksumType, objs)
+		}
+		if aborted, _ := xs.IsAborted(xid); aborted {
+			if showing {
+				fmt.Fprintln(c.App.Writer)
+			}
+			return fmt.Errorf("%s[%s] was aborted", apc.ActRechecksum, xid)
+		}
+		if !allFinished(xs) {
+			continue
+		}
+		if showing {
+			fmt.Fprintln(c.App.Writer)
+		}
+		size, _, _ := xs.ByteCounts(xid)
+		nerr, failed := xsFailed(xs, "rechecksum.err.n", "rechecksum.failed")
+		if msg.DryRun {
+			fmt.Fprintf(c.App.Writer, "[DRY RUN] %s: %d object%s (%s) to rechecksum with %q\n",
+				bck.Cname(""), objs, cos.Plural(int(objs)), teb.FmtSize(size, "", 2), msg.CksumType)
+			if nerr > 0 {
+				printFailed(c, "Failed to load", nerr, failed)
+			}
+			return nil
+		}
+		if nerr == 0 {
+			actionDone(c, fmt.Sprintf("Done: %d object%s (%s) rechecksummed with %q.",
+				objs, cos.Plural(int(objs)), teb.FmtSize(size, "", 2), msg.CksumType))
+			return nil
+		}
+		printFailed(c, "Failed to rechecksum", nerr, failed)
+		return fmt.Errorf("%d object%s rechecksummed, %d failed (see target logs for details)",
+			objs, cos.Plural(int(objs)), nerr)
+	}
+}
+
 // Return `bckFrom` and `bckTo` - the [shift] and the [shift+1] arguments, respectively
 func parseBcks(c *cli.Context, bckFromArg, bckToArg string, shift int) (bckFrom, bckTo cmn.Bck, err error) {
 	if c.NArg() == shift {
@@ -705,7 +1235,8 @@ func parseBcks(c *cli.Context, bckFromArg, bckToArg string, shift int) (bckFrom,
 	return
 }
 
-func printObjProps(c *cli.Context, entries cmn.LsoEntries, objectFilter *objectListFilter, props string, addCachedCol bool) error {
+func printObjProps(c *cli.Context, entries cmn.LsoEntries, objectFilter *objectListFilter, props string, addCachedCol bool,
+	rt *teb.RowTemplate) error {
 	var (
 		hideHeader     = flagIsSet(c, noHeaderFlag)
 		matched, other = objectFilter.filter(entries)
@@ -714,6 +1245,14 @@ func printObjProps(c *cli.Context, entries cmn.LsoEntries, objectFilter *objectL
 	if errU != nil {
 		return errU
 	}
+	if rt != nil {
+		for i := range matched {
+			if err := rt.Print(&matched[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
 
 	propsList := splitCsv(props)
 	tmpl := teb.ObjPropsTemplate(propsList, hideHeader, addCachedCol)
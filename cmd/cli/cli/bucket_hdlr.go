@@ -35,9 +35,20 @@ Usage examples:
 var (
 	// flags
 	bucketCmdsFlags = map[string][]cli.Flag{
+		cmdRechecksum: {
+			rechecksumTypeFlag,
+			rechecksumPrefixFlag,
+			templateFlag,
+			listFlag,
+			dryRunFlag,
+			waitFlag,
+			refreshFlag,
+		},
 		commandCreate: {
 			ignoreErrorFlag,
 			bucketPropsFlag,
+			fromBucketFlag,
+			propsFileFlag,
 			forceFlag,
 		},
 		commandRemove: {
@@ -70,12 +81,17 @@ var (
 		),
 		cmdSetBprops: {
 			forceFlag,
+			dryRunFlag,
+			ecReencodeFlag,
+			refreshFlag,
 		},
 		cmdResetBprops: {},
 
 		commandList: {
 			allObjsOrBcksFlag,
 			listObjCachedFlag,
+			listObjLatestFlag,
+			listFillCksumFlag,
 			nameOnlyFlag,
 			objPropsFlag,
 			regexLsAnyFlag,
@@ -92,6 +108,7 @@ var (
 			bckSummaryFlag,
 			listAnonymousFlag,
 			listArchFlag,
+			formatTemplateFlag,
 			unitsFlag,
 		},
 
@@ -173,6 +190,15 @@ var (
 			},
 			bucketCmdCopy,
 			bucketCmdRename,
+			{
+				Name: cmdRechecksum,
+				Usage: "recompute and store checksums of the bucket's objects - e.g., after changing bucket's checksum type;\n" +
+					indent1 + "use '--prefix', '--template', or '--list' to select objects, and '--dry-run' to count those that need it",
+				ArgsUsage:    bucketArgument,
+				Flags:        bucketCmdsFlags[cmdRechecksum],
+				Action:       rechecksumHandler,
+				BashComplete: bucketCompletions(bcmplop{}),
+			},
 			{
 				Name:      commandRemove,
 				Usage:     "remove ais buckets",
@@ -215,15 +241,54 @@ var (
 	}
 )
 
+func rechecksumHandler(c *cli.Context) error {
+	bck, err := parseBckURI(c, c.Args().First(), true /*require provider*/)
+	if err != nil {
+		return err
+	}
+	return rechecksum(c, bck)
+}
+
 func createBucketHandler(c *cli.Context) (err error) {
-	var props *cmn.BucketPropsToUpdate
+	var (
+		props    *cmn.BucketPropsToUpdate
+		inherits string // template bucket or props file
+	)
+	if flagIsSet(c, fromBucketFlag) && flagIsSet(c, propsFileFlag) {
+		return incorrectUsageMsg(c, errFmtExclusive, qflprn(fromBucketFlag), qflprn(propsFileFlag))
+	}
+	switch {
+	case flagIsSet(c, fromBucketFlag):
+		var tmpl cmn.Bck
+		if tmpl, err = parseBckURI(c, parseStrFlag(c, fromBucketFlag), true /*require provider*/); err != nil {
+			return err
+		}
+		if props, err = bckTemplateProps(tmpl); err != nil {
+			return err
+		}
+		inherits = tmpl.Cname("")
+	case flagIsSet(c, propsFileFlag):
+		inherits = parseStrFlag(c, propsFileFlag)
+		if props, err = bckPropsFromFile(inherits); err != nil {
+			return err
+		}
+	}
 	if flagIsSet(c, bucketPropsFlag) {
 		propSingleBck, err := parseBckPropsFromContext(c)
 		if err != nil {
 			return err
 		}
-		props = propSingleBck
+		if props, err = mergeBckProps(props, propSingleBck); err != nil {
+			return err
+		}
+	}
+	if props != nil {
 		props.Force = flagIsSet(c, forceFlag)
+		if inherits != "" && !props.Force {
+			if err := validateInheritedProps(c, props, inherits); err != nil {
+				return err
+			}
+		}
 	}
 	buckets, err := bucketsFromArgsOrEnv(c)
 	if err != nil {
@@ -233,20 +298,33 @@ func createBucketHandler(c *cli.Context) (err error) {
 		if err := createBucket(c, bck, props); err != nil {
 			return err
 		}
+		if inherits != "" {
+			reportInheritedProps(c, bck, props, inherits)
+		}
 	}
 	return nil
 }
 
+type bucketHealth struct {
+	Bck           cmn.Bck
+	ObjectCnt     uint64
+	Misplaced     uint64
+	MissingCopies uint64
+	props         *cmn.BucketProps
+}
+
 func checkObjectHealth(queryBcks cmn.QueryBcks) error {
-	type bucketHealth struct {
-		Bck           cmn.Bck
-		ObjectCnt     uint64
-		Misplaced     uint64
-		MissingCopies uint64
+	bckSums, err := collectObjectHealth(queryBcks)
+	if err != nil {
+		return err
 	}
+	return teb.Print(bckSums, teb.BucketSummaryValidateTmpl)
+}
+
+func collectObjectHealth(queryBcks cmn.QueryBcks) ([]*bucketHealth, error) {
 	bcks, err := api.ListBuckets(apiBP, queryBcks, apc.FltPresent)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	bckSums := make([]*bucketHealth, 0)
 	msg := &apc.LsoMsg{Flags: apc.LsAll}
@@ -262,13 +340,13 @@ func checkObjectHealth(queryBcks cmn.QueryBcks) error {
 		)
 		p, err := headBucket(bck, true /* don't add */)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		copies := int16(p.Mirror.Copies)
-		stats := &bucketHealth{Bck: bck}
+		stats := &bucketHealth{Bck: bck, props: p}
 		objList, err = api.ListObjects(apiBP, bck, msg, 0)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		updateStats := func(obj *cmn.LsoEntry) {
@@ -301,7 +379,7 @@ func checkObjectHealth(queryBcks cmn.QueryBcks) error {
 
 		bckSums = append(bckSums, stats)
 	}
-	return teb.Print(bckSums, teb.BucketSummaryValidateTmpl)
+	return bckSums, nil
 }
 
 func summaryBucketHandler(c *cli.Context) (err error) {
@@ -318,6 +396,9 @@ func showMisplacedAndMore(c *cli.Context) (err error) {
 		return err
 	}
 
+	if flagIsSet(c, repairFlag) {
+		return validateRepair(c, queryBcks)
+	}
 	fValidate := func() error {
 		return checkObjectHealth(queryBcks)
 	}
@@ -457,6 +538,7 @@ func setPropsHandler(c *cli.Context) (err error) {
 		return fmt.Errorf("%v%s", err, examplesBckSetProps)
 	}
 	newProps.Force = flagIsSet(c, forceFlag)
+	newProps.Reencode = flagIsSet(c, ecReencodeFlag)
 
 	return updateBckProps(c, bck, currProps, newProps)
 }
@@ -469,7 +551,22 @@ func updateBckProps(c *cli.Context, bck cmn.Bck, currProps *cmn.BucketProps, upd
 		displayPropsEqMsg(c, bck)
 		return nil
 	}
-	if _, err = api.SetBucketProps(apiBP, bck, updateProps); err != nil {
+	var total int64 // objects to re-encode
+	if updateProps.Reencode {
+		if total, err = reencodePreflight(c, bck, currProps, allNewProps); err != nil {
+			return err
+		}
+	}
+	if err := validateBckProps(c, allNewProps, updateProps.Force); err != nil {
+		return err
+	}
+	if flagIsSet(c, dryRunFlag) {
+		showDiff(c, currProps, allNewProps)
+		actionDone(c, "\n[DRY RUN] No changes applied.")
+		return nil
+	}
+	xid, err := api.SetBucketProps(apiBP, bck, updateProps)
+	if err != nil {
 		if herr, ok := err.(*cmn.ErrHTTP); ok && herr.Status == http.StatusNotFound {
 			return herr
 		}
@@ -479,9 +576,48 @@ func updateBckProps(c *cli.Context, bck cmn.Bck, currProps *cmn.BucketProps, upd
 	}
 	showDiff(c, currProps, allNewProps)
 	actionDone(c, "\nBucket props successfully updated.")
+	if updateProps.Reencode && xid != "" {
+		return ecEncodeProgress(c, bck, xid, total)
+	}
 	return nil
 }
 
+// '--reencode' requires EC to be enabled and (unlike other EC props changes)
+// does not tolerate having fewer targets than data + parity + 1
+func reencodePreflight(c *cli.Context, bck cmn.Bck, currProps, newProps *cmn.BucketProps) (int64, error) {
+	if !newProps.EC.Enabled {
+		return 0, incorrectUsageMsg(c, "%s requires erasure coding to be enabled (ec.enabled=true)", qflprn(ecReencodeFlag))
+	}
+	if currProps.EC.Enabled && currProps.EC.DataSlices == newProps.EC.DataSlices &&
+		currProps.EC.ParitySlices == newProps.EC.ParitySlices {
+		actionWarn(c, fmt.Sprintf("%s: data and parity slices remain unchanged (%d:%d) - nothing to re-encode\n",
+			bck.Cname(""), newProps.EC.DataSlices, newProps.EC.ParitySlices))
+		return 0, nil
+	}
+	return ecPreflight(c, bck, newProps, newProps.EC.DataSlices, newProps.EC.ParitySlices)
+}
+
+// validate the entire resulting document prior to applying (or dry-running) the update;
+// soft errors (e.g., not enough targets to EC-encode) can be overridden with '--force'
+func validateBckProps(c *cli.Context, props *cmn.BucketProps, force bool) error {
+	smap, err := getClusterMap(c)
+	if err != nil {
+		return err
+	}
+	err = props.Validate(smap.CountActiveTs())
+	switch {
+	case err == nil:
+		return nil
+	case !cmn.IsErrSoft(err):
+		return fmt.Errorf("invalid bucket props: %v", err)
+	case force || flagIsSet(c, dryRunFlag):
+		actionWarn(c, err.Error()+"\n")
+		return nil
+	default:
+		return fmt.Errorf("%v (use %s to override)", err, qflprn(forceFlag))
+	}
+}
+
 func displayPropsEqMsg(c *cli.Context, bck cmn.Bck) {
 	args := c.Args().Tail()
 	if len(args) == 1 && !isJSON(args[0]) {
@@ -524,6 +660,10 @@ func listAnyHandler(c *cli.Context) error {
 		}
 		return showObjProps(c, bck, objName)
 	case bck.Name == "": // list buckets
+		if flagIsSet(c, formatTemplateFlag) {
+			return incorrectUsageMsg(c, "%s applies to listing objects (and showing object properties) - not buckets",
+				qflprn(formatTemplateFlag))
+		}
 		fltPresence := apc.FltPresent
 		if flagIsSet(c, allObjsOrBcksFlag) {
 			fltPresence = apc.FltExists
@@ -0,0 +1,88 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"net/http"
+	"path"
+	"reflect"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+	"github.com/NVIDIA/aistore/xact"
+)
+
+func TestXsFailed(t *testing.T) {
+	xs := xact.MultiSnap{
+		"t1": {{Ext: map[string]any{"rechecksum.err.n": "2", "rechecksum.failed": []any{"b", "a"}}}},
+		"t2": {{Ext: map[string]any{"rechecksum.err.n": "40", "rechecksum.failed": []any{"c"}}}, {}},
+	}
+	nerr, failed := xsFailed(xs, "rechecksum.err.n", "rechecksum.failed")
+	tassert.Errorf(t, nerr == 42, "expected 42 errors, got %d", nerr)
+	tassert.Errorf(t, reflect.DeepEqual(failed, []string{"a", "b", "c"}), "unexpected failed names %v", failed)
+}
+
+func TestBckPropsFromFile(t *testing.T) {
+	var (
+		dir  = t.TempDir()
+		docs = map[string]string{
+			"props.json": `{"checksum": {"type": "md5"}, "mirror": {"enabled": true, "copies": 3},` +
+				` "backend_bck": {"name": "", "provider": ""}, "provider": "ais"}`,
+			"props.yaml": "checksum:\n  type: md5\nmirror:\n  enabled: true\n  copies: 3\n",
+		}
+	)
+	for name, doc := range docs {
+		props, err := bckPropsFromFile(writeTestFile(t, dir, name, doc))
+		tassert.CheckFatal(t, err)
+		tassert.Fatalf(t, props.Cksum != nil && *props.Cksum.Type == cos.ChecksumMD5, "%s: expected md5", name)
+		tassert.Fatalf(t, props.Mirror != nil && *props.Mirror.Copies == 3, "%s: expected 3 copies", name)
+		tassert.Errorf(t, props.BackendBck == nil, "%s: unexpected backend %+v", name, props.BackendBck)
+
+		// --props on top
+		overrides, err := cmn.NewBucketPropsToUpdate(cos.StrKVs{"mirror.copies": "2"})
+		tassert.CheckFatal(t, err)
+		props, err = mergeBckProps(props, overrides)
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, *props.Mirror.Copies == 2 && *props.Mirror.Enabled, "%s: unexpected mirror %+v", name, props.Mirror)
+		tassert.Errorf(t, *props.Cksum.Type == cos.ChecksumMD5, "%s: expected md5", name)
+	}
+}
+
+func TestFillChecksums(t *testing.T) {
+	var heads atomic.Int32
+	mockCluster(t, func(w http.ResponseWriter, r *http.Request) {
+		heads.Inc()
+		switch path.Base(r.URL.Path) {
+		case "gone":
+			w.WriteHeader(http.StatusNotFound)
+		case "nocksum":
+		default:
+			w.Header().Set(apc.HdrObjCksumType, cos.ChecksumXXHash)
+			w.Header().Set(apc.HdrObjCksumVal, "head-"+path.Base(r.URL.Path))
+		}
+	})
+
+	entries := cmn.LsoEntries{
+		{Name: "listed", Checksum: "from-listing"},
+		{Name: "missing"},
+		{Name: "gone"},
+		{Name: "nocksum"},
+		{Name: "archived", Flags: apc.EntryInArch},
+	}
+	filled, err := fillChecksums(cmn.Bck{Name: "b", Provider: apc.AWS}, entries)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, filled == 1, "expecting 1 filled checksum, got %d", filled)
+	tassert.Errorf(t, heads.Load() == 3, "expecting HEAD only for entries without checksum (3), got %d", heads.Load())
+
+	tassert.Errorf(t, entries[0].Checksum == "from-listing" && !entries[0].IsHeadCksum(), "listed checksum must stay as is")
+	tassert.Errorf(t, entries[1].Checksum == "head-missing" && entries[1].IsHeadCksum(), "expecting filled-in and marked, got %+v", entries[1])
+	for _, en := range entries[2:] {
+		tassert.Errorf(t, en.Checksum == "" && !en.IsHeadCksum(), "%s: expecting no checksum, got %q", en.Name, en.Checksum)
+	}
+}
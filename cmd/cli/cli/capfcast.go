@@ -0,0 +1,198 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+// This file implements `ais show storage --window` (capacity forecasting).
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"fmt"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	"github.com/NVIDIA/aistore/api"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmd/cli/teb"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/stats"
+	"github.com/urfave/cli"
+)
+
+const day = 24 * time.Hour
+
+type (
+	// capacity projection: target's (or cluster's) used and total capacity, and the rate at which
+	// the former grows; negative DaysUntilFull indicates "not growing" or insufficient data
+	capFcast struct {
+		Target        string  `json:"target"`
+		Used          uint64  `json:"used,string"`
+		Total         uint64  `json:"total,string"`
+		Samples       int     `json:"samples"`
+		GrowthPerDay  int64   `json:"growth_per_day,string"` // bytes
+		DaysUntilFull float64 `json:"days_until_full"`
+		Alert         bool    `json:"alert"` // projected to fill up within '--horizon'
+	}
+	capFcastAll struct {
+		Window  string      `json:"window"`
+		Horizon string      `json:"horizon"`
+		Targets []*capFcast `json:"targets"`
+		Cluster *capFcast   `json:"cluster"`
+	}
+)
+
+func showCapForecast(c *cli.Context) error {
+	var (
+		window  = parseDurationFlag(c, capWindowFlag)
+		horizon = parseDurationFlag(c, capHorizonFlag)
+	)
+	if window <= 0 || horizon <= 0 {
+		return incorrectUsageMsg(c, "%s and %s must be positive", qflprn(capWindowFlag), qflprn(capHorizonFlag))
+	}
+	sid, sname, err := argNode(c)
+	if err != nil {
+		return err
+	}
+	if sid != "" && getNodeType(c, sid) == apc.Proxy {
+		return fmt.Errorf("%s is a proxy (AIS gateways do not store user data and do not have any data drives)", sname)
+	}
+	setLongRunParams(c)
+
+	smap, err := getClusterMap(c)
+	if err != nil {
+		return err
+	}
+	if smap.CountActiveTs() == 0 {
+		return cmn.NewErrNoNodes(apc.Target, smap.CountTargets())
+	}
+	var (
+		now = time.Now().UnixNano()
+		all = capFcastAll{Window: window.String(), Horizon: horizon.String()}
+	)
+	for tid, tsi := range smap.Tmap {
+		if (sid != "" && tid != sid) || smap.InMaintOrDecomm(tsi) {
+			continue
+		}
+		samples, err := api.GetCapHistory(apiBP, tid)
+		if err != nil {
+			return fmt.Errorf("failed to get capacity history from %s: %v", tsi.StringEx(), err)
+		}
+		fc := projectCap(samples, window, now)
+		fc.Target = tid
+		fc.Alert = fc.alert(horizon)
+		all.Targets = append(all.Targets, fc)
+	}
+	sort.Slice(all.Targets, func(i, j int) bool { return all.Targets[i].Target < all.Targets[j].Target })
+	if sid == "" {
+		all.Cluster = sumCapFcast(all.Targets)
+		all.Cluster.Alert = all.Cluster.alert(horizon)
+	}
+
+	if flagIsSet(c, jsonFlag) {
+		return teb.Print(all, "", teb.Jopts(true))
+	}
+	tw := &tabwriter.Writer{}
+	tw.Init(c.App.Writer, 0, 8, 2, ' ', 0)
+	if !flagIsSet(c, noHeaderFlag) {
+		fmt.Fprintln(tw, "TARGET\tUSED\tTOTAL\tSAMPLES\tGROWTH/DAY\tDAYS UNTIL FULL\tALERT")
+	}
+	for _, fc := range all.Targets {
+		fc.fprint(tw, fc.Target)
+	}
+	if all.Cluster != nil && len(all.Targets) > 1 {
+		all.Cluster.fprint(tw, cluTotal)
+	}
+	return tw.Flush()
+}
+
+// least-squares slope of the used capacity over the samples that fall within the window
+// (requires at least two samples)
+func projectCap(samples []stats.CapSample, window time.Duration, now int64) *capFcast {
+	var (
+		fc    = &capFcast{DaysUntilFull: -1}
+		since = now - int64(window)
+		n     float64
+		t0    int64
+		st    float64
+		su    float64
+		stt   float64
+		stu   float64
+	)
+	for i := range samples {
+		s := &samples[i]
+		if s.Time < since {
+			continue
+		}
+		if fc.Samples == 0 {
+			t0 = s.Time
+		}
+		fc.Samples++
+		fc.Used, fc.Total = s.Used, s.Total // (the latest)
+		var (
+			t = float64(s.Time-t0) / float64(day)
+			u = float64(s.Used)
+		)
+		st += t
+		su += u
+		stt += t * t
+		stu += t * u
+	}
+	if fc.Samples < 2 {
+		return fc
+	}
+	n = float64(fc.Samples)
+	denom := n*stt - st*st
+	if denom <= 0 {
+		return fc
+	}
+	fc.GrowthPerDay = int64((n*stu - st*su) / denom)
+	fc.project()
+	return fc
+}
+
+func sumCapFcast(targets []*capFcast) *capFcast {
+	fc := &capFcast{Target: "cluster", DaysUntilFull: -1}
+	for _, t := range targets {
+		fc.Used += t.Used
+		fc.Total += t.Total
+		fc.Samples += t.Samples
+		fc.GrowthPerDay += t.GrowthPerDay
+	}
+	fc.project()
+	return fc
+}
+
+func (fc *capFcast) project() {
+	if fc.GrowthPerDay <= 0 {
+		return
+	}
+	var free uint64
+	if fc.Total > fc.Used {
+		free = fc.Total - fc.Used
+	}
+	fc.DaysUntilFull = float64(free) / float64(fc.GrowthPerDay)
+}
+
+func (fc *capFcast) alert(horizon time.Duration) bool {
+	return fc.DaysUntilFull >= 0 && fc.DaysUntilFull*float64(day) <= float64(horizon)
+}
+
+func (fc *capFcast) fprint(tw *tabwriter.Writer, name string) {
+	var (
+		growth = teb.UnknownStatusVal
+		days   = teb.UnknownStatusVal
+		alert  string
+	)
+	if fc.Samples >= 2 {
+		growth = teb.FmtSize(fc.GrowthPerDay, "", 2)
+		days = teb.NotSetVal // not growing
+	}
+	if fc.DaysUntilFull >= 0 {
+		days = fmt.Sprintf("%.1f", fc.DaysUntilFull)
+	}
+	if fc.Alert {
+		alert = "yes"
+	}
+	fmt.Fprintf(tw, "%s\t%s\t%s\t%d\t%s\t%s\t%s\n", name,
+		teb.FmtSize(int64(fc.Used), "", 2), teb.FmtSize(int64(fc.Total), "", 2), fc.Samples, growth, days, alert)
+}
@@ -0,0 +1,42 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/stats"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestProjectCap(t *testing.T) {
+	var (
+		now     = time.Now().UnixNano()
+		samples []stats.CapSample
+	)
+	// 10GiB per day over the last 4 days
+	for i := 4; i >= 0; i-- {
+		samples = append(samples, stats.CapSample{
+			Time:  now - int64(i)*int64(day),
+			Used:  uint64(100-10*i) * cos.GiB,
+			Total: 200 * cos.GiB,
+		})
+	}
+	fc := projectCap(samples, 5*day, now)
+	tassert.Errorf(t, fc.Samples == 5, "expected 5 samples, got %d", fc.Samples)
+	tassert.Errorf(t, fc.GrowthPerDay == 10*cos.GiB, "expected 10GiB/day, got %d", fc.GrowthPerDay)
+	tassert.Errorf(t, fc.DaysUntilFull > 9.99 && fc.DaysUntilFull < 10.01, "expected 10 days, got %f", fc.DaysUntilFull)
+	tassert.Errorf(t, fc.alert(14*day) && !fc.alert(7*day), "unexpected alert")
+
+	// window too short: a single sample
+	fc = projectCap(samples, time.Hour, now)
+	tassert.Errorf(t, fc.Samples == 1 && fc.DaysUntilFull < 0, "expected no projection, got %+v", fc)
+
+	// cluster: not growing
+	clu := sumCapFcast([]*capFcast{{Used: cos.GiB, Total: 2 * cos.GiB, Samples: 2}})
+	tassert.Errorf(t, clu.DaysUntilFull < 0 && !clu.alert(day), "expected no projection, got %+v", clu)
+}
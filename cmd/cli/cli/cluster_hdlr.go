@@ -8,11 +8,17 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"net/http"
+	"os"
 	"strings"
+	"text/tabwriter"
+	"time"
 
 	"github.com/NVIDIA/aistore/api"
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/xact"
 	"github.com/urfave/cli"
@@ -21,26 +27,50 @@ import (
 const (
 	fmtRebalanceStarted = "Started rebalance %q (to monitor, run 'ais show rebalance').\n"
 
+	decommRemovalTimeout = 30 * time.Second
+
 	roleProxyShort  = "p"
 	roleTargetShort = "t"
 )
 
 var (
 	clusterCmdsFlags = map[string][]cli.Flag{
-		cmdCluAttach: {},
+		cmdCluAttach: {
+			remAttachForceFlag,
+		},
 		cmdCluDetach: {},
 		cmdCluConfig: {
 			transientFlag,
 		},
+		cmdCluConfig + "." + cmdReset: {
+			cfgSectionFlag,
+		},
 		cmdShutdown: {
 			yesFlag,
 		},
-		cmdPrimary: {},
+		cmdPrimary: {
+			drainTimeoutFlag,
+		},
+		cmdExportSmap: {},
+		cmdResolveDup: {
+			keepNodeFlag,
+			noRebalanceFlag,
+			noHeaderFlag,
+		},
 		cmdJoin: {
 			roleFlag,
+			probationFlag,
+		},
+		cmdMembershipApply: {
+			dryRunFlag,
+			yesFlag,
+		},
+		cmdEndProbation: {
+			noRebalanceFlag,
 		},
 		cmdStartMaint: {
 			noRebalanceFlag,
+			maintDurationFlag,
 			yesFlag,
 		},
 		cmdShutdown + ".node": {
@@ -52,6 +82,8 @@ var (
 			noRebalanceFlag,
 			noShutdownFlag,
 			rmUserDataFlag,
+			decommVerifyFlag,
+			decommForceFlag,
 			yesFlag,
 		},
 		cmdClusterDecommission: {
@@ -67,6 +99,13 @@ var (
 		cmdResetStats: {
 			errorsOnlyFlag,
 		},
+		cmdCluHealth: {
+			capacityWarnFlag,
+			capacityCritFlag,
+			maxVersionLagFlag,
+			jsonFlag,
+			noHeaderFlag,
+		},
 	}
 
 	startRebalance = cli.Command{
@@ -102,6 +141,14 @@ var (
 				Action:       detachRemoteAISHandler,
 				BashComplete: suggestRemote,
 			},
+			{
+				Name: cmdCluHealth,
+				Usage: "check cluster health: unresponsive nodes, nodes behind on Smap/BMD version, capacity thresholds,\n" +
+					indent1 + "disabled mountpaths, nodes in maintenance, and jobs aborted on some of the targets;\n" +
+					indent1 + "exit with non-zero status if any critical condition is detected (e.g., for use in CI and monitoring)",
+				Flags:  clusterCmdsFlags[cmdCluHealth],
+				Action: clusterHealthHandler,
+			},
 			{
 				Name:  cmdRebalance,
 				Usage: "administratively start and stop global rebalance; show global rebalance",
@@ -125,6 +172,33 @@ var (
 				Action:       setPrimaryHandler,
 				BashComplete: suggestProxyNodes,
 			},
+			{
+				Name: cmdExportSmap,
+				Usage: "save cluster map (Smap) as plain JSON, e.g., to later restore cluster membership\n" +
+					indent1 + "(to restore, use 'xmeta' to format the saved Smap, and place the result in nodes' config directories)",
+				ArgsUsage: exportSmapArgument,
+				Flags:     clusterCmdsFlags[cmdExportSmap],
+				Action:    exportSmapHandler,
+			},
+			{
+				Name:   cmdResolveDup,
+				Usage:  "list nodes that share IP:port (or node ID) with other nodes; resolve the conflict by keeping the specified node",
+				Flags:  clusterCmdsFlags[cmdResolveDup],
+				Action: resolveDupHandler,
+			},
+			{
+				Name:  cmdCluConfig,
+				Usage: "configure AIS cluster (see also: 'ais config cluster')",
+				Subcommands: []cli.Command{
+					{
+						Name: cmdReset,
+						Usage: "reset a single named config section (e.g., 'lru') to its initial (deployment-time) values\n" +
+							indent4 + "\twhile leaving all other sections intact; show the reverted keys",
+						Flags:  clusterCmdsFlags[cmdCluConfig+"."+cmdReset],
+						Action: resetCluConfigSectionHandler,
+					},
+				},
+			},
 			// cluster level
 			{
 				Name:   cmdShutdown,
@@ -150,6 +224,23 @@ var (
 						Flags:     clusterCmdsFlags[cmdJoin],
 						Action:    joinNodeHandler,
 					},
+					{
+						Name: cmdMembershipApply,
+						Usage: "reconcile cluster membership with a JSON or YAML file that lists nodes to join and nodes to decommission,\n" +
+							indent4 + "\te.g. '{\"join\": [{\"addr\": \"10.0.0.5:51081\", \"role\": \"target\"}], \"decommission\": [\"t[xyz]\"]}';\n" +
+							indent4 + "\tprints the plan and asks for a single confirmation (see also '--dry-run')",
+						ArgsUsage: membershipFileArgument,
+						Flags:     clusterCmdsFlags[cmdMembershipApply],
+						Action:    membershipApplyHandler,
+					},
+					{
+						Name:         cmdEndProbation,
+						Usage:        "promote target that joined in probation to a full (data-serving) member and rebalance the cluster",
+						ArgsUsage:    nodeIDArgument,
+						Flags:        clusterCmdsFlags[cmdEndProbation],
+						Action:       nodeMaintShutDecommHandler,
+						BashComplete: suggestTargetNodes,
+					},
 					{
 						Name:         cmdStartMaint,
 						Usage:        "put node in maintenance mode, temporarily suspend its operation",
@@ -200,20 +291,73 @@ func attachRemoteAISHandler(c *cli.Context) (err error) {
 	if err != nil {
 		return
 	}
+	var rsmap *cluster.Smap
+	if !flagIsSet(c, remAttachForceFlag) {
+		if rsmap, err = remAttachPrecheck(alias, url); err != nil {
+			return fmt.Errorf("%v (use %s to attach anyway)", err, qflprn(remAttachForceFlag))
+		}
+	}
 	if err = api.AttachRemoteAIS(apiBP, alias, url); err != nil {
 		return
 	}
 	msg := fmt.Sprintf("Remote cluster (%s=%s) successfully attached", alias, url)
+	if rsmap != nil {
+		msg += fmt.Sprintf(": UUID=%s, proxies: %d, targets: %d", rsmap.UUID, rsmap.CountActivePs(), rsmap.CountActiveTs())
+	}
 	actionDone(c, msg)
 	return
 }
 
+// fail fast: the alias must not be in use, and the remote cluster
+// must be reachable and must return a valid cluster map
+func remAttachPrecheck(alias, url string) (*cluster.Smap, error) {
+	attached, err := api.GetRemoteAIS(apiBP)
+	if err != nil {
+		return nil, err
+	}
+	for _, ra := range attached.A {
+		if ra.Alias == alias || ra.UUID == alias {
+			return nil, fmt.Errorf("alias %q is already in use by remote cluster %s (%s)", alias, ra.UUID, ra.URL)
+		}
+	}
+	rbp := api.BaseParams{Client: defaultHTTPClient, URL: url, Token: loggedUserToken, UA: ua}
+	rsmap, err := api.GetClusterMap(rbp)
+	if err != nil {
+		return nil, fmt.Errorf("remote cluster at %s is unreachable or is not an AIS cluster: %v", url, err)
+	}
+	if rsmap.UUID == "" || rsmap.Version == 0 {
+		return nil, fmt.Errorf("remote cluster at %s returned invalid cluster map (UUID %q, version %d)",
+			url, rsmap.UUID, rsmap.Version)
+	}
+	if smap, errV := api.GetClusterMap(apiBP); errV == nil && smap.UUID == rsmap.UUID {
+		return nil, fmt.Errorf("cannot attach cluster %s to itself", rsmap.UUID)
+	}
+	for _, ra := range attached.A {
+		if ra.UUID == rsmap.UUID {
+			return nil, fmt.Errorf("remote cluster %s is already attached as %q", rsmap.UUID, ra.Alias)
+		}
+	}
+	return rsmap, nil
+}
+
 func detachRemoteAISHandler(c *cli.Context) (err error) {
 	if c.NArg() == 0 {
 		err = missingArgumentsError(c, c.Command.ArgsUsage)
 		return
 	}
 	alias := c.Args().Get(0)
+	if attached, errV := api.GetRemoteAIS(apiBP); errV == nil {
+		var found bool
+		for _, ra := range attached.A {
+			if ra.Alias == alias || ra.UUID == alias {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("remote cluster %q is not attached (see 'ais show remote-cluster')", alias)
+		}
+	}
 	if err = api.DetachRemoteAIS(apiBP, alias); err != nil {
 		return
 	}
@@ -267,40 +411,79 @@ func clusterDecommissionHandler(c *cli.Context) error {
 
 func joinNodeHandler(c *cli.Context) (err error) {
 	var (
-		daemonType, prefix string
-		addr, rebID        string
-		addrParts          []string
+		daemonType string
+		rebID      string
+		nodeInfo   *cluster.Snode
 	)
 	if c.NArg() < 1 {
 		return missingArgumentsError(c, "public IPv4:PORT address to communicate with the node")
 	}
-	addr = c.Args().Get(0)
-	addrParts = strings.Split(addr, ":")
-	if len(addrParts) != 2 {
-		return fmt.Errorf("invalid address, expecting 'IPv4:PORT'")
+	if daemonType, err = parseRole(parseStrFlag(c, roleFlag)); err != nil {
+		return err
+	}
+	probation := flagIsSet(c, probationFlag)
+	if probation && daemonType != apc.Target {
+		return fmt.Errorf("option %s is valid only for targets", qflprn(probationFlag))
+	}
+	if rebID, nodeInfo, err = joinNode(c.Args().Get(0), daemonType, probation); err != nil {
+		return
+	}
+
+	// double check
+	var sname string
+	_, sname, err = getNodeIDName(c, nodeInfo.DaeID)
+	if err != nil {
+		return
+	}
+	if probation {
+		fmt.Fprintf(c.App.Writer, "Target %s joined the cluster in probation (to promote, run `ais cluster %s %s %s`)\n",
+			sname, cmdMembership, cmdEndProbation, nodeInfo.DaeID)
+		return
 	}
+	fmt.Fprintf(c.App.Writer, "Node %s successfully joined the cluster\n", sname)
 
-	switch parseStrFlag(c, roleFlag) {
+	if rebID != "" {
+		fmt.Fprintf(c.App.Writer, fmtRebalanceStarted, rebID)
+	}
+	return
+}
+
+func parseRole(role string) (string, error) {
+	switch role {
 	case apc.Proxy, roleProxyShort:
-		daemonType = apc.Proxy
+		return apc.Proxy, nil
 	case apc.Target, roleTargetShort:
-		daemonType = apc.Target
+		return apc.Target, nil
 	default:
-		return fmt.Errorf("invalid aisnode role, must be one of: %q (or %q), %q (or %q)",
-			apc.Proxy, roleProxyShort, apc.Target, roleTargetShort)
+		return "", fmt.Errorf("invalid aisnode role %q, must be one of: %q (or %q), %q (or %q)",
+			role, apc.Proxy, roleProxyShort, apc.Target, roleTargetShort)
 	}
+}
 
-	if addrParts[0] == "localhost" {
-		addrParts[0] = "127.0.0.1"
+// normalize and validate IPv4:PORT
+func parseJoinAddr(addr string) (host, port string, err error) {
+	parts := strings.Split(addr, ":")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("invalid address %q, expecting 'IPv4:PORT'", addr)
+	}
+	host, port = parts[0], parts[1]
+	if host == "localhost" {
+		host = "127.0.0.1"
 	}
+	return host, port, nil
+}
 
-	prefix = getPrefixFromPrimary()
+func joinNode(addr, daemonType string, probation bool) (rebID string, nodeInfo *cluster.Snode, err error) {
+	host, port, err := parseJoinAddr(addr)
+	if err != nil {
+		return "", nil, err
+	}
 	netInfo := cluster.NetInfo{
-		Hostname: addrParts[0],
-		Port:     addrParts[1],
-		URL:      prefix + addr,
+		Hostname: host,
+		Port:     port,
+		URL:      getPrefixFromPrimary() + host + ":" + port,
 	}
-	nodeInfo := &cluster.Snode{
+	nodeInfo = &cluster.Snode{
 		// once contacted, aisnode reports its ID (see also: `envDaemonID` and `genDaemonID`)
 		DaeID: "",
 		// (important to have it right)
@@ -308,22 +491,12 @@ func joinNodeHandler(c *cli.Context) (err error) {
 		// for the primary to perform initial handshake, validation, and the rest of it (NOTE: control-net)
 		ControlNet: netInfo,
 	}
-	if rebID, nodeInfo.DaeID, err = api.JoinCluster(apiBP, nodeInfo); err != nil {
-		return
-	}
-
-	// double check
-	var sname string
-	_, sname, err = getNodeIDName(c, nodeInfo.DaeID)
-	if err != nil {
-		return
-	}
-	fmt.Fprintf(c.App.Writer, "Node %s successfully joined the cluster\n", sname)
-
-	if rebID != "" {
-		fmt.Fprintf(c.App.Writer, fmtRebalanceStarted, rebID)
+	if probation {
+		rebID, nodeInfo.DaeID, err = api.JoinClusterProbation(apiBP, nodeInfo)
+	} else {
+		rebID, nodeInfo.DaeID, err = api.JoinCluster(apiBP, nodeInfo)
 	}
-	return
+	return rebID, nodeInfo, err
 }
 
 // (compare w/ cluster-level clusterDecommissionHandler & clusterShutdownHandler)
@@ -353,7 +526,7 @@ func nodeMaintShutDecommHandler(c *cli.Context) error {
 		rmUserData    = flagIsSet(c, rmUserDataFlag)
 		actValue      = &apc.ActValRmNode{DaemonID: sid, SkipRebalance: skipRebalance, NoShutdown: noShutdown}
 	)
-	if skipRebalance && node.IsTarget() {
+	if skipRebalance && node.IsTarget() && action != cmdEndProbation {
 		warn := fmt.Sprintf("executing %q and _not_ running global rebalance may lead to a loss of data!", action)
 		actionWarn(c, warn)
 		fmt.Fprintln(c.App.Writer,
@@ -362,6 +535,15 @@ func nodeMaintShutDecommHandler(c *cli.Context) error {
 	if action == cmdNodeDecommission {
 		actValue.NoShutdown = noShutdown
 		actValue.RmUserData = rmUserData
+		actValue.Verify = flagIsSet(c, decommVerifyFlag)
+		actValue.Force = flagIsSet(c, decommForceFlag)
+		if actValue.Verify && (skipRebalance || node.IsProxy()) {
+			return fmt.Errorf("option %s requires a target and global rebalance (cannot be used with %s)",
+				qflprn(decommVerifyFlag), qflprn(noRebalanceFlag))
+		}
+		if actValue.Force && !actValue.Verify {
+			return fmt.Errorf("option %s requires %s", qflprn(decommForceFlag), qflprn(decommVerifyFlag))
+		}
 	} else {
 		const fmterr = "option %s is valid only for decommissioning\n"
 		if noShutdown {
@@ -373,6 +555,13 @@ func nodeMaintShutDecommHandler(c *cli.Context) error {
 	}
 	switch action {
 	case cmdStartMaint:
+		if flagIsSet(c, maintDurationFlag) {
+			d := parseDurationFlag(c, maintDurationFlag)
+			if d <= 0 {
+				return incorrectUsageMsg(c, "%s must be positive", qflprn(maintDurationFlag))
+			}
+			actValue.Duration = int64(d)
+		}
 		if !flagIsSet(c, yesFlag) {
 			warn := fmt.Sprintf("about to put node %s in maintenance mode", sname)
 			if ok := confirm(c, "Proceed?", warn); !ok {
@@ -382,6 +571,11 @@ func nodeMaintShutDecommHandler(c *cli.Context) error {
 		xid, err = api.StartMaintenance(apiBP, actValue)
 	case cmdStopMaint:
 		xid, err = api.StopMaintenance(apiBP, actValue)
+	case cmdEndProbation:
+		if !node.IsTarget() {
+			return fmt.Errorf("%s is not a target (only targets can join in probation)", sname)
+		}
+		xid, err = api.EndProbation(apiBP, actValue)
 	case cmdNodeDecommission:
 		if !flagIsSet(c, yesFlag) {
 			warn := fmt.Sprintf("about to permanently decommission node %s. The operation cannot be undone!", sname)
@@ -408,7 +602,12 @@ func nodeMaintShutDecommHandler(c *cli.Context) error {
 	switch action {
 	case cmdStopMaint:
 		fmt.Fprintf(c.App.Writer, "%s is now active\n", sname)
+	case cmdEndProbation:
+		fmt.Fprintf(c.App.Writer, "%s is now a full member of the cluster\n", sname)
 	case cmdNodeDecommission:
+		if actValue.Verify && xid != "" {
+			return decommVerify(c, node, sname, xid, actValue.Force)
+		}
 		if skipRebalance || node.IsProxy() {
 			fmt.Fprintf(c.App.Writer, "%s has been decommissioned (permanently removed from the cluster)\n", sname)
 		} else {
@@ -426,10 +625,79 @@ func nodeMaintShutDecommHandler(c *cli.Context) error {
 		fmt.Fprintf(c.App.Writer, "restarted at any later time (and subsequently activated via '%s' operation).\n", cmdStopMaint)
 	case cmdStartMaint:
 		fmt.Fprintf(c.App.Writer, "%s is now in maintenance mode\n", sname)
+		if actValue.Duration > 0 {
+			reportMaintUntil(c, sid, sname)
+		}
 	}
 	return nil
 }
 
+// the primary records auto-resume time in the cluster map
+func reportMaintUntil(c *cli.Context, sid, sname string) {
+	smap, err := api.GetClusterMap(apiBP)
+	if err != nil {
+		actionWarn(c, "failed to get auto-resume time: "+err.Error())
+		return
+	}
+	node := smap.GetNode(sid)
+	if node == nil || node.MaintUntil == 0 {
+		actionWarn(c, sname+": auto-resume time is not set (is the cluster running an older version?)")
+		return
+	}
+	until := time.Unix(0, node.MaintUntil)
+	fmt.Fprintf(c.App.Writer, "%s will automatically exit maintenance at %s (in %v); to end it sooner, run `ais cluster %s %s %s`\n",
+		sname, until.Format(time.RFC3339), time.Until(until).Round(time.Second), cmdMembership, cmdStopMaint, sid)
+}
+
+// wait for rebalance; check (and report) objects that haven't been migrated;
+// finally, wait for the primary to remove the node from the cluster map
+func decommVerify(c *cli.Context, node *cluster.Snode, sname, xid string, force bool) error {
+	fmt.Fprintf(c.App.Writer, "%s is being decommissioned, waiting for cluster rebalancing to finish...\n", sname)
+	if err := waitXact(apiBP, xact.ArgsMsg{ID: xid, Kind: apc.ActRebalance}); err != nil {
+		return fmt.Errorf("rebalance %q failed: %v (%s remains in the cluster map)", xid, err, sname)
+	}
+	fmt.Fprintf(c.App.Writer, "Verifying that all objects from %s have been migrated...\n", sname)
+	started := time.Now()
+	dv, err := api.VerifyDecommission(apiBP, node)
+	if err != nil {
+		if herr, ok := err.(*cmn.ErrHTTP); !ok || herr.Status != http.StatusNotFound {
+			return err
+		}
+		dv = &apc.DecommVerifyResult{} // not found: already removed
+	}
+	if dv.NumUnplaced > 0 {
+		fmt.Fprintf(c.App.Writer, "%d (out of %d) object%s not migrated:\n",
+			dv.NumUnplaced, dv.NumObjs, cos.Plural(int(dv.NumUnplaced)))
+		for _, name := range dv.Unplaced {
+			fmt.Fprintln(c.App.Writer, indent1+name)
+		}
+		if n := dv.NumUnplaced - int64(len(dv.Unplaced)); n > 0 {
+			fmt.Fprintf(c.App.Writer, indent1+"... (and %d more)\n", n)
+		}
+		if !force {
+			return fmt.Errorf("%s has _not_ been removed from the cluster map (to retry, re-run the same command;\n"+
+				"to remove it regardless, add %s)", sname, qflprn(decommForceFlag))
+		}
+		actionWarn(c, "removing "+sname+" regardless (forced)")
+	} else if err == nil {
+		fmt.Fprintf(c.App.Writer, "Verified: all %d object%s migrated\n", dv.NumObjs, cos.Plural(int(dv.NumObjs)))
+	}
+
+	// the primary runs the same verification prior to removing the node
+	timeout := cos.MaxDuration(2*time.Since(started), decommRemovalTimeout)
+	for deadline := time.Now().Add(timeout); time.Now().Before(deadline); time.Sleep(time.Second) {
+		smap, err := api.GetClusterMap(apiBP)
+		if err != nil {
+			return err
+		}
+		if smap.GetNode(node.ID()) == nil {
+			fmt.Fprintf(c.App.Writer, "%s has been decommissioned (permanently removed from the cluster)\n", sname)
+			return nil
+		}
+	}
+	return fmt.Errorf("timed out waiting for %s to be removed from the cluster map", sname)
+}
+
 func setPrimaryHandler(c *cli.Context) error {
 	if c.NArg() == 0 {
 		return missingArgumentsError(c, c.Command.ArgsUsage)
@@ -457,13 +725,76 @@ func setPrimaryHandler(c *cli.Context) error {
 		return fmt.Errorf("%s is non-electable", sname)
 	}
 
-	err = api.SetPrimaryProxy(apiBP, sid, false /*force*/)
+	if flagIsSet(c, drainTimeoutFlag) {
+		timeout := parseDurationFlag(c, drainTimeoutFlag)
+		err = api.SetPrimaryProxyDrain(apiBP, sid, timeout)
+	} else {
+		err = api.SetPrimaryProxy(apiBP, sid, false /*force*/)
+	}
 	if err == nil {
 		actionDone(c, sname+" is now a new primary")
 	}
 	return err
 }
 
+func exportSmapHandler(c *cli.Context) error {
+	if c.NArg() > 1 {
+		return incorrectUsageMsg(c, "", c.Args()[1:])
+	}
+	smap, err := api.GetClusterMap(apiBP)
+	if err != nil {
+		return err
+	}
+	out, err := jsonMarshalIndent(smap)
+	if err != nil {
+		return err
+	}
+	fname := c.Args().First()
+	if fname == "" || fname == fileStdIO {
+		fmt.Fprintln(c.App.Writer, string(out))
+		return nil
+	}
+	if err := os.WriteFile(fname, out, cos.PermRWR); err != nil {
+		return err
+	}
+	actionDone(c, fmt.Sprintf("Saved %s (%s, primary %s) to %s", smap, smap.UUID, smap.Primary.StringEx(), fname))
+	return nil
+}
+
+func resolveDupHandler(c *cli.Context) error {
+	if c.NArg() > 0 {
+		return incorrectUsageMsg(c, "", c.Args())
+	}
+	if !flagIsSet(c, keepNodeFlag) {
+		dups, err := api.GetDupNodes(apiBP)
+		if err != nil {
+			return err
+		}
+		if len(dups) == 0 {
+			fmt.Fprintln(c.App.Writer, "No duplicate nodes")
+			return nil
+		}
+		tw := &tabwriter.Writer{}
+		tw.Init(c.App.Writer, 0, 8, 2, ' ', 0)
+		if !flagIsSet(c, noHeaderFlag) {
+			fmt.Fprintln(tw, "NODE\tURL\tDUPLICATE\tURL\tDETECTED\tERROR")
+		}
+		for _, dup := range dups {
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\n", dup.Osi.ID(), dup.Osi.PubNet.URL, dup.Nsi.ID(),
+				dup.Nsi.PubNet.URL, time.Unix(0, dup.Time).Format(time.Stamp), dup.Err)
+		}
+		tw.Flush()
+		return nil
+	}
+	keep := parseStrFlag(c, keepNodeFlag)
+	actValue := &apc.ActValRmNode{DaemonID: keep, SkipRebalance: flagIsSet(c, noRebalanceFlag)}
+	if err := api.ResolveDupNode(apiBP, actValue); err != nil {
+		return err
+	}
+	actionDone(c, fmt.Sprintf("Resolved: keeping %s", keep))
+	return nil
+}
+
 func startClusterRebalanceHandler(c *cli.Context) (err error) {
 	return startXactionKind(c, apc.ActRebalance)
 }
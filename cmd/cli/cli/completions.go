@@ -7,8 +7,10 @@ package cli
 
 import (
 	"fmt"
+	"os"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/NVIDIA/aistore/api"
 	"github.com/NVIDIA/aistore/api/apc"
@@ -28,6 +30,15 @@ import (
 // Cluster / Daemon //
 //////////////////////
 
+const (
+	// the word that is being completed (see autocomplete/bash and autocomplete/zsh)
+	compCurEnv = "AIS_COMP_CUR"
+
+	// object name completions
+	objCmplMaxNames = 100
+	objCmplTimeout  = 2 * time.Second
+)
+
 var (
 	supportedBool = []string{"true", "false"}
 	propCmpls     = map[string][]string{
@@ -333,6 +344,10 @@ func (opts *bcmplop) buckets(c *cli.Context) {
 		return
 	}
 
+	if opts.separator && objectCompletions(c) {
+		return
+	}
+
 	query := cmn.QueryBcks{Provider: opts.provider}
 	buckets, err := api.ListBuckets(apiBP, query, apc.FltPresent) // NOTE: `present` only
 	if err != nil {
@@ -364,6 +379,66 @@ func bucketCompletions(opts bcmplop) cli.BashCompleteFunc {
 	return opts.buckets
 }
 
+// Once the word being completed includes bucket name and '/' (e.g. `ais object get ais://nnn/abc<TAB>`),
+// suggest names of the objects that start with the typed prefix; "directories" are
+// shown only once, with trailing '/'.
+// The listing is a single name-only page (see `nameOnlyFlag`) capped at `objCmplMaxNames`
+// and time-boxed by `objCmplTimeout`, so that completion never hangs the shell.
+func objectCompletions(c *cli.Context) bool {
+	cur := os.Getenv(compCurEnv)
+	if cur == "" {
+		return false
+	}
+	uri := cur
+	if i := strings.Index(uri, apc.BckProviderSeparator); i >= 0 {
+		uri = uri[i+len(apc.BckProviderSeparator):]
+	}
+	if !strings.Contains(uri, "/") {
+		return false
+	}
+	opts := cmn.ParseURIOpts{}
+	if cfg != nil {
+		opts.DefaultProvider = cfg.DefaultProvider
+	}
+	bck, prefix, err := cmn.ParseBckObjectURI(cur, opts)
+	if err != nil || bck.Validate() != nil {
+		return false
+	}
+
+	bp := apiBP
+	bp.Client = cmn.NewClient(cmn.TransportArgs{
+		DialTimeout: objCmplTimeout,
+		Timeout:     objCmplTimeout,
+		UseHTTPS:    cos.IsHTTPS(clusterURL),
+		SkipVerify:  cfg.Cluster.SkipVerifyCrt,
+	})
+	msg := &apc.LsoMsg{Prefix: prefix, Props: apc.GetPropsName, PageSize: objCmplMaxNames}
+	msg.SetFlag(apc.LsNameOnly)
+	lst, err := api.ListObjectsPage(bp, bck, msg)
+	if err != nil {
+		return true // (silently)
+	}
+
+	var (
+		typed = cur[:len(cur)-len(prefix)] // as in: (provider, bucket) the way it was typed
+		seen  = make(cos.StrSet, len(lst.Entries))
+	)
+	for _, en := range lst.Entries {
+		name := en.Name
+		if !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		if i := strings.IndexByte(name[len(prefix):], '/'); i >= 0 {
+			name = name[:len(prefix)+i+1]
+		}
+		if !seen.Contains(name) {
+			seen.Set(name)
+			fmt.Println(typed + name)
+		}
+	}
+	return true
+}
+
 func printNotUsedBuckets(c *cli.Context, buckets []cmn.Bck, separator, multiple bool) {
 	var sep string
 	if separator {
@@ -6,11 +6,16 @@
 package cli
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
+	"io"
+	"os"
 	"regexp"
 	"sort"
+	"strconv"
 	"strings"
+	"text/tabwriter"
 
 	"github.com/NVIDIA/aistore/api"
 	"github.com/NVIDIA/aistore/api/apc"
@@ -22,17 +27,26 @@ import (
 	"github.com/NVIDIA/aistore/cmn/feat"
 	jsoniter "github.com/json-iterator/go"
 	"github.com/urfave/cli"
+	"gopkg.in/yaml.v2"
 )
 
 var (
 	configCmdsFlags = map[string][]cli.Flag{
 		cmdCluster: {
 			transientFlag,
-			jsonFlag, // to show
+			jsonFlag,        // to show
+			unitsFlag,       // ditto
+			showSecretsFlag, // ditto
 		},
 		cmdNode: {
 			transientFlag,
-			jsonFlag, // to show
+			jsonFlag,        // to show
+			unitsFlag,       // ditto
+			showSecretsFlag, // ditto
+		},
+		cmdCfgApply: {
+			cfgApplyFileFlag,
+			transientFlag,
 		},
 	}
 
@@ -81,6 +95,15 @@ var (
 				Flags:        configCmdsFlags[cmdCluster],
 				Action:       setCluConfigHandler,
 				BashComplete: setCluConfigCompletions,
+				Subcommands: []cli.Command{
+					{
+						Name: cmdCfgApply,
+						Usage: "apply cluster configuration from a YAML or JSON file (e.g., maintained in version control):\n" +
+							indent1 + "validate the entire (full or partial) document, show the diff, and apply only the changes",
+						Flags:  configCmdsFlags[cmdCfgApply],
+						Action: applyCluConfigHandler,
+					},
+				},
 			},
 			{
 				Name:         cmdNode,
@@ -133,20 +156,28 @@ var (
 	}
 )
 
-func setCluConfigHandler(c *cli.Context) error {
+// names of all cluster config properties that can be updated at runtime (e.g. "checksum.type")
+func cluConfigProps() []string {
 	var (
-		nvs      cos.StrKVs
 		config   cmn.Config
 		propList = make([]string, 0, 48)
-		args     = c.Args()
-		kvs      = args.Tail()
 	)
 	err := cmn.IterFields(&config.ClusterConfig, func(tag string, _ cmn.IterField) (err error, b bool) {
 		propList = append(propList, tag)
 		return
 	}, cmn.IterOpts{Allowed: apc.Cluster})
 	debug.AssertNoErr(err)
+	return propList
+}
 
+func setCluConfigHandler(c *cli.Context) error {
+	var (
+		err      error
+		nvs      cos.StrKVs
+		propList = cluConfigProps()
+		args     = c.Args()
+		kvs      = args.Tail()
+	)
 	if cos.StringInSlice(args.First(), propList) || strings.Contains(args.First(), keyAndValueSeparator) {
 		kvs = args
 	}
@@ -163,7 +194,7 @@ func setCluConfigHandler(c *cli.Context) error {
 	}
 	for k := range nvs {
 		if !cos.StringInSlice(k, propList) {
-			return fmt.Errorf("invalid property name %q%s", k, examplesCluSetCfg)
+			return fmt.Errorf("0 applied: invalid property name %q%s", k, examplesCluSetCfg)
 		}
 	}
 
@@ -182,20 +213,25 @@ func setCluConfigHandler(c *cli.Context) error {
 		if k == feat.FeaturesPropName {
 			featfl, err := parseFeatureFlags(v)
 			if err != nil {
-				return fmt.Errorf("invalid feature flag %q", v)
+				return fmt.Errorf("0 applied: invalid feature flag %q", v)
 			}
 			nvs[k] = featfl.Value()
 		}
 	}
+	if clucfg, err := api.GetClusterConfig(apiBP); err != nil {
+		return err
+	} else if err := validateCfgKVs(nvs, clucfg); err != nil {
+		return err
+	}
 
 	// assorted named fields that require (cluster | node) restart
 	// for the change to take an effect
 	if name := nvs.ContainsAnyMatch(cmn.ConfigRestartRequired); name != "" {
-		warn := fmt.Sprintf("cluster restart required for the change '%s=%s' to take an effect.", name, nvs[name])
+		warn := fmt.Sprintf("cluster restart required for the change '%s=%s' to take an effect.", name, redactVal(name, nvs[name]))
 		actionWarn(c, warn)
 	}
 	if err := api.SetClusterConfig(apiBP, nvs, flagIsSet(c, transientFlag)); err != nil {
-		return err
+		return fmt.Errorf("0 applied: %v", err)
 	}
 
 show:
@@ -210,10 +246,266 @@ show:
 			fmt.Fprintln(c.App.ErrWriter, redErr(err))
 		}
 	}
-	actionDone(c, "Cluster config updated")
+	actionDone(c, fmt.Sprintf("Cluster config updated: all %d applied%s", len(nvs), _transient(c)))
+	return nil
+}
+
+func _transient(c *cli.Context) string {
+	if flagIsSet(c, transientFlag) {
+		return " (transient - in memory only)"
+	}
+	return ""
+}
+
+//
+// config cluster apply --file
+//
+
+// read-only values (e.g., in the output of `ais show config cluster --json`) - skipped
+var cfgReadOnly = []string{"lastupdate_time", "uuid", "config_version", "ext"}
+
+func applyCluConfigHandler(c *cli.Context) error {
+	if !flagIsSet(c, cfgApplyFileFlag) {
+		return incorrectUsageMsg(c, "missing %s", qflprn(cfgApplyFileFlag))
+	}
+	path := parseStrFlag(c, cfgApplyFileFlag)
+	b, err := readCfgDoc(path)
+	if err != nil {
+		return err
+	}
+	nvs, err := parseCfgDoc(b, cluConfigProps())
+	if err != nil {
+		return fmt.Errorf("0 applied: %s: %v", path, err)
+	}
+	if len(nvs) == 0 {
+		return fmt.Errorf("0 applied: %s contains no configuration properties", path)
+	}
+	if v, ok := nvs[feat.FeaturesPropName]; ok {
+		if _, err := strconv.ParseUint(v, 10, 64); err != nil {
+			featfl, err := parseFeatureFlags(v)
+			if err != nil {
+				return fmt.Errorf("0 applied: invalid feature flag %q", v)
+			}
+			nvs[feat.FeaturesPropName] = featfl.Value()
+		}
+	}
+
+	// validate the entire document
+	clucfg, err := api.GetClusterConfig(apiBP)
+	if err != nil {
+		return err
+	}
+	if err := validateCfgKVs(nvs, clucfg); err != nil {
+		return err
+	}
+
+	// diff and apply only the changes (all or nothing)
+	diff, err := diffCfgKVs(nvs, clucfg)
+	if err != nil {
+		return err
+	}
+	if len(diff) == 0 {
+		actionDone(c, "Nothing to apply: cluster config is up to date")
+		return nil
+	}
+	changes := make(cos.StrKVs, len(diff))
+	tw := &tabwriter.Writer{}
+	tw.Init(c.App.Writer, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "PROPERTY\tCURRENT\tNEW")
+	for _, d := range diff {
+		changes[d.Name] = nvs[d.Name]
+		fmt.Fprintf(tw, "%s\t%s\t%s\n", d.Name, redactVal(d.Name, d.Old), redactVal(d.Name, d.Current))
+	}
+	tw.Flush()
+
+	if name := changes.ContainsAnyMatch(cmn.ConfigRestartRequired); name != "" {
+		warn := fmt.Sprintf("cluster restart required for the change '%s=%s' to take an effect.", name, redactVal(name, changes[name]))
+		actionWarn(c, warn)
+	}
+	if err := api.SetClusterConfig(apiBP, changes, flagIsSet(c, transientFlag)); err != nil {
+		return fmt.Errorf("0 applied: %v", err)
+	}
+	actionDone(c, fmt.Sprintf("Cluster config updated: %d change%s applied%s", len(changes), cos.Plural(len(changes)), _transient(c)))
+	return nil
+}
+
+func readCfgDoc(path string) ([]byte, error) {
+	if path == fileStdIO {
+		return io.ReadAll(io.LimitReader(os.Stdin, cos.MiB))
+	}
+	return os.ReadFile(path)
+}
+
+// parse YAML or JSON document, e.g. `{"checksum": {"type": "md5"}, "lru": {"enabled": true}}`,
+// and flatten it into (property name, value) pairs, e.g. "checksum.type=md5"
+func parseCfgDoc(b []byte, props []string) (cos.StrKVs, error) {
+	var doc any
+	dec := jsoniter.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	if errj := dec.Decode(&doc); errj != nil {
+		var ydoc any
+		if erry := yaml.Unmarshal(b, &ydoc); erry != nil {
+			return nil, fmt.Errorf("failed to parse as JSON (%v) or YAML (%v)", errj, erry)
+		}
+		doc = yamlToJSON(ydoc)
+	}
+	nvs := make(cos.StrKVs, 16)
+	return nvs, flattenCfgDoc("", doc, props, nvs)
+}
+
+func flattenCfgDoc(prefix string, v any, props []string, nvs cos.StrKVs) error {
+	if cos.StringInSlice(prefix, cfgReadOnly) {
+		return nil
+	}
+	if cos.StringInSlice(prefix, props) {
+		if v == nil {
+			return fmt.Errorf("missing value for %q", prefix)
+		}
+		switch vv := v.(type) {
+		case string:
+			nvs[prefix] = vv
+		case map[string]any, []any:
+			nvs[prefix] = cos.MustMarshalToString(vv)
+		default:
+			nvs[prefix] = fmt.Sprintf("%v", vv)
+		}
+		return nil
+	}
+	m, ok := v.(map[string]any)
+	if !ok {
+		if prefix == "" {
+			return errors.New("expecting a (sectioned) configuration document")
+		}
+		return fmt.Errorf("invalid property name %q", prefix)
+	}
+	for k, vv := range m {
+		name := k
+		if prefix != "" {
+			name = prefix + "." + k
+		}
+		if err := flattenCfgDoc(name, vv, props, nvs); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+// yaml.v2 decodes nested maps as map[any]any
+func yamlToJSON(v any) any {
+	switch vv := v.(type) {
+	case map[any]any:
+		m := make(map[string]any, len(vv))
+		for k, val := range vv {
+			m[fmt.Sprintf("%v", k)] = yamlToJSON(val)
+		}
+		return m
+	case []any:
+		for i := range vv {
+			vv[i] = yamlToJSON(vv[i])
+		}
+	}
+	return v
+}
+
+// the resulting (current => new) changes
+func diffCfgKVs(nvs cos.StrKVs, current *cmn.ClusterConfig) ([]propDiff, error) {
+	toUpdate := &cmn.ConfigToUpdate{}
+	for k, v := range nvs {
+		if err := cmn.UpdateFieldValue(toUpdate, k, v); err != nil {
+			return nil, err
+		}
+	}
+	updated, err := _cfgApply(current, toUpdate)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		// compare exact (raw) values, show human-readable
+		before, beforeRaw = flattenConfig(current, "", ""), flattenConfig(current, "", cos.UnitsRaw)
+		after, afterRaw   = flattenConfig(updated, "", ""), flattenConfig(updated, "", cos.UnitsRaw)
+		diff              = make([]propDiff, 0, len(nvs))
+	)
+	for i := range after {
+		name := after[i].Name
+		if _, ok := nvs[name]; !ok {
+			continue
+		}
+		if beforeRaw[i].Value != afterRaw[i].Value {
+			diff = append(diff, propDiff{Name: name, Current: after[i].Value, Old: before[i].Value})
+		}
+	}
+	return diff, nil
+}
+
+// Validate all key=value pairs up front - nothing gets applied unless all of them are valid.
+// In addition to parsing each value, apply the entire update to a copy of the current
+// (cluster-wide or node's) config, and check the result with the config's own validators.
+func validateCfgKVs(nvs cos.StrKVs, current *cmn.ClusterConfig) error {
+	keys := nvs.Keys()
+	sort.Strings(keys)
+	toUpdate := &cmn.ConfigToUpdate{}
+	for _, k := range keys {
+		if err := cmn.UpdateFieldValue(toUpdate, k, nvs[k]); err != nil {
+			return fmt.Errorf("0 applied: %q invalid: %v", k, err)
+		}
+	}
+	if current == nil {
+		return nil
+	}
+	// (pre-existing errors, if any, are not to be blamed on the update)
+	before := _cfgErrs(current, nil)
+	errs := _cfgErrs(current, toUpdate)
+	if len(errs) == 0 {
+		return nil
+	}
+	for _, err := range errs {
+		if cos.StringInSlice(err, before) {
+			continue
+		}
+		// find the offending key, if possible
+		for _, k := range keys {
+			single := &cmn.ConfigToUpdate{}
+			_ = cmn.UpdateFieldValue(single, k, nvs[k]) // (checked above)
+			if cos.StringInSlice(err, _cfgErrs(current, single)) {
+				return fmt.Errorf("0 applied: %q invalid: %s", k, err)
+			}
+		}
+		return fmt.Errorf("0 applied: invalid combination of values: %s", err)
+	}
+	return nil
+}
+
+// apply update (if any) to a deep copy of the config
+func _cfgApply(current *cmn.ClusterConfig, toUpdate *cmn.ConfigToUpdate) (*cmn.ClusterConfig, error) {
+	config := &cmn.ClusterConfig{}
+	if err := jsoniter.Unmarshal(cos.MustMarshal(current), config); err != nil {
+		return nil, err
+	}
+	if toUpdate != nil {
+		if err := config.Apply(toUpdate, apc.Cluster); err != nil {
+			return nil, err
+		}
+	}
+	return config, nil
+}
+
+// apply update (if any) to a deep copy of the config and return all validation errors
+func _cfgErrs(current *cmn.ClusterConfig, toUpdate *cmn.ConfigToUpdate) (errs []string) {
+	config, err := _cfgApply(current, toUpdate)
+	if err != nil {
+		return []string{err.Error()}
+	}
+	_ = cmn.IterFields(config, func(_ string, field cmn.IterField) (error, bool) {
+		if v, ok := field.Value().(cmn.Validator); ok {
+			if err := v.Validate(); err != nil {
+				errs = append(errs, err.Error())
+			}
+		}
+		return nil, false
+	}, cmn.IterOpts{VisitAll: true})
+	return
+}
+
 // E.g.:
 // ais config cluster backend.conf='{"aws":{}}'
 // ais config cluster backend.conf '{"gcp":{}, "aws":{}}'
@@ -234,27 +526,32 @@ func isFmtJSON(nvs cos.StrKVs) (val string, ans bool, err error) {
 }
 
 // TODO: remove switch w/ assorted hardcoded sections - use reflection
+// (all keys in a single update - all or nothing)
 func setcfg(c *cli.Context, nvs cos.StrKVs) error {
 	toUpdate := &cmn.ConfigToUpdate{}
 	for k, v := range nvs {
+		var err error
 		switch {
 		case k == "backend" || strings.HasPrefix(k, "backend."):
-			jsoniter.Unmarshal([]byte(v), &toUpdate.Backend)
+			err = jsoniter.Unmarshal([]byte(v), &toUpdate.Backend)
 		case k == "mirror" || strings.HasPrefix(k, "mirror."):
-			jsoniter.Unmarshal([]byte(v), &toUpdate.Mirror)
+			err = jsoniter.Unmarshal([]byte(v), &toUpdate.Mirror)
 		case k == "ec" || strings.HasPrefix(k, "ec."):
-			jsoniter.Unmarshal([]byte(v), &toUpdate.EC)
+			err = jsoniter.Unmarshal([]byte(v), &toUpdate.EC)
 		case k == "log" || strings.HasPrefix(k, "log."):
-			jsoniter.Unmarshal([]byte(v), &toUpdate.Log)
+			err = jsoniter.Unmarshal([]byte(v), &toUpdate.Log)
 		case k == "checksum" || strings.HasPrefix(k, "checksum."):
-			jsoniter.Unmarshal([]byte(v), &toUpdate.Cksum)
+			err = jsoniter.Unmarshal([]byte(v), &toUpdate.Cksum)
 		default:
-			return fmt.Errorf("cannot update config using JSON-formatted %q - not implemented yet", k)
+			return fmt.Errorf("0 applied: cannot update config using JSON-formatted %q - not implemented yet", k)
 		}
-		if err := api.SetClusterConfigUsingMsg(apiBP, toUpdate, flagIsSet(c, transientFlag)); err != nil {
-			return err
+		if err != nil {
+			return fmt.Errorf("0 applied: %q invalid: %v", k, err)
 		}
 	}
+	if err := api.SetClusterConfigUsingMsg(apiBP, toUpdate, flagIsSet(c, transientFlag)); err != nil {
+		return fmt.Errorf("0 applied: %v", err)
+	}
 	return nil
 }
 
@@ -310,7 +607,7 @@ func setNodeConfigHandler(c *cli.Context) error {
 	}
 	for k := range nvs {
 		if !cos.StringInSlice(k, propList) {
-			return fmt.Errorf("invalid property name %q%s", k, examplesNodeSetCfg)
+			return fmt.Errorf("0 applied: invalid property name %q%s", k, examplesNodeSetCfg)
 		}
 	}
 
@@ -330,14 +627,25 @@ func setNodeConfigHandler(c *cli.Context) error {
 		// have api.SetClusterConfigUsingMsg but not "api.SetDaemonConfigUsingMsg"
 		return fmt.Errorf("cannot update node configuration using JSON-formatted %q - not implemented yet", jsonval)
 	}
-	if err := api.SetDaemonConfig(apiBP, sid, nvs, flagIsSet(c, transientFlag)); err != nil {
+	smap, err := getClusterMap(c)
+	if err != nil {
+		return err
+	}
+	nodecfg, err := api.GetDaemonConfig(apiBP, smap.GetNode(sid))
+	if err != nil {
+		return err
+	}
+	if err := validateCfgKVs(nvs, &nodecfg.ClusterConfig); err != nil {
 		return err
 	}
+	if err := api.SetDaemonConfig(apiBP, sid, nvs, flagIsSet(c, transientFlag)); err != nil {
+		return fmt.Errorf("0 applied: %v", err)
+	}
 
 	s, err := jsonMarshalIndent(nvs)
 	debug.AssertNoErr(err)
 	fmt.Fprintf(c.App.Writer, "%s\n", string(s))
-	fmt.Fprintf(c.App.Writer, "\nnode %s config updated\n", sname)
+	fmt.Fprintf(c.App.Writer, "\nnode %s config updated: all %d applied%s\n", sname, len(nvs), _transient(c))
 	return nil
 }
 
@@ -360,6 +668,45 @@ func resetConfigHandler(c *cli.Context) (err error) {
 	return
 }
 
+// `ais cluster configure reset --section NAME`
+func resetCluConfigSectionHandler(c *cli.Context) error {
+	if !flagIsSet(c, cfgSectionFlag) {
+		return missingArgumentsError(c, flprn(cfgSectionFlag))
+	}
+	section := parseStrFlag(c, cfgSectionFlag)
+	config, err := api.GetClusterConfig(apiBP)
+	if err != nil {
+		return err
+	}
+	reverted, err := api.ResetClusterConfigSection(apiBP, section)
+	if err != nil {
+		return err
+	}
+	if len(reverted) == 0 {
+		actionDone(c, fmt.Sprintf("Config section %q: nothing to reset (all values are initial)", section))
+		return nil
+	}
+	prior := make(cos.StrKVs, len(reverted))
+	for _, nv := range flattenConfig(config, section, "") {
+		prior[nv.Name] = nv.Value
+	}
+	names := make([]string, 0, len(reverted))
+	for name := range reverted {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		old, val := redactVal(name, prior[name]), redactVal(name, reverted[name])
+		if val == "" && cmn.IsConfigSecret(name) {
+			val = redactedVal // (withheld by the cluster)
+		}
+		fmt.Fprintf(c.App.Writer, "%s: %s => %s\n", name, old, val)
+	}
+	actionDone(c, fmt.Sprintf("\nConfig section %q reset: %d key%s reverted to initial values",
+		section, len(names), cos.Plural(len(names))))
+	return nil
+}
+
 func resetNodeConfigHandler(c *cli.Context) error {
 	if c.NArg() == 0 {
 		return missingArgumentsError(c, c.Command.ArgsUsage)
@@ -393,7 +740,7 @@ func showCLIConfigHandler(c *cli.Context) (err error) {
 		return
 	}
 
-	flat := flattenConfig(cfg, "")
+	flat := flattenConfig(cfg, "", "")
 	sort.Slice(flat, func(i, j int) bool {
 		return flat[i].Name < flat[j].Name
 	})
@@ -410,14 +757,24 @@ func setCLIConfigHandler(c *cli.Context) (err error) {
 		return err
 	}
 
-	flatOld := flattenConfig(cfg, "")
+	flatOld := flattenConfig(cfg, "", "")
 	for k, v := range nvs {
+		if strings.HasPrefix(k, profilesPrefix) {
+			if err := setCLIProfile(c, k, v); err != nil {
+				return err
+			}
+			continue
+		}
 		if err := cmn.UpdateFieldValue(cfg, k, v); err != nil {
 			return err
 		}
 	}
 
-	flatNew := flattenConfig(cfg, "")
+	if err := cfg.Defaults.Validate(); err != nil {
+		return err
+	}
+
+	flatNew := flattenConfig(cfg, "", "")
 	diff := diffConfigs(flatNew, flatOld)
 	for _, val := range diff {
 		if val.Old == "-" {
@@ -429,6 +786,32 @@ func setCLIConfigHandler(c *cli.Context) (err error) {
 	return config.Save(cfg)
 }
 
+const profilesPrefix = "profiles."
+
+// `ais config cli set profiles.NAME.FIELD=VALUE` (adds new profile if need be)
+func setCLIProfile(c *cli.Context, key, value string) error {
+	name, field, ok := strings.Cut(strings.TrimPrefix(key, profilesPrefix), ".")
+	if !ok || field == "" {
+		return incorrectUsageMsg(c, "invalid profile property %q (expecting %sNAME.FIELD, e.g. %sprod.url)",
+			key, profilesPrefix, profilesPrefix)
+	}
+	p, exists := cfg.Profiles[name]
+	if !exists {
+		p = &config.ProfileConfig{}
+	}
+	if err := cmn.UpdateFieldValue(p, field, value); err != nil {
+		return err
+	}
+	if err := p.Validate(name); err != nil {
+		return err
+	}
+	if cfg.Profiles == nil {
+		cfg.Profiles = make(config.ProfilesConfig, 1)
+	}
+	cfg.Profiles[name] = p
+	return nil
+}
+
 func resetCLIConfigHandler(c *cli.Context) (err error) {
 	if err = config.Reset(); err == nil {
 		actionDone(c, "CLI config successfully reset to all defaults")
@@ -0,0 +1,38 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestParseCfgDoc(t *testing.T) {
+	props := cluConfigProps()
+	docs := []string{
+		`{"checksum": {"type": "md5"}, "lru": {"enabled": true}, "space": {"lowwm": 75}, "uuid": "xyz"}`,
+		"checksum:\n  type: md5\nlru:\n  enabled: true\nspace:\n  lowwm: 75\nuuid: xyz\n",
+	}
+	expected := cos.StrKVs{"checksum.type": "md5", "lru.enabled": "true", "space.lowwm": "75"}
+	for _, doc := range docs {
+		nvs, err := parseCfgDoc([]byte(doc), props)
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, reflect.DeepEqual(nvs, expected), "expected %v, got %v", expected, nvs)
+	}
+	for _, bad := range []string{`{"checksum": {"kind": "md5"}}`, `{"checksum": "md5"}`, `[1, 2]`} {
+		_, err := parseCfgDoc([]byte(bad), props)
+		tassert.Errorf(t, err != nil, "expected %q to fail", bad)
+	}
+
+	current := &cmn.ClusterConfig{}
+	current.Cksum.Type = cos.ChecksumXXHash
+	diff, err := diffCfgKVs(cos.StrKVs{"checksum.type": cos.ChecksumXXHash, "lru.enabled": "true"}, current)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(diff) == 1 && diff[0].Name == "lru.enabled", "unexpected diff %+v", diff)
+}
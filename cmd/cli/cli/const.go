@@ -8,10 +8,12 @@
 package cli
 
 import (
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/ext/dload"
 	"github.com/NVIDIA/aistore/ext/dsort"
@@ -59,6 +61,7 @@ const (
 	commandCreate    = "create"
 	commandGet       = "get"
 	commandList      = "ls"
+	commandLocate    = "locate"
 	commandSetCustom = "set-custom"
 	commandPut       = "put"
 	commandRemove    = "rm"
@@ -82,6 +85,8 @@ const (
 
 	commandPromote  = apc.ActPromote
 	commandECEncode = apc.ActECEncode
+	cmdRechecksum   = apc.ActRechecksum
+	cmdExpirySweep  = apc.ActExpirySweep
 	commandMirror   = "mirror"   // display name for apc.ActMakeNCopies
 	commandEvict    = "evict"    // apc.ActEvictRemoteBck or apc.ActEvictObjects
 	commandPrefetch = "prefetch" // apc.ActPrefetchObjects
@@ -98,6 +103,8 @@ const (
 	cmdCluster    = commandCluster
 	cmdNode       = "node"
 	cmdPrimary    = "set-primary"
+	cmdResolveDup = "resolve-duplicate"
+	cmdExportSmap = "export-smap"
 	cmdList       = commandList
 	cmdLogs       = "logs"
 	cmdStop       = "stop"
@@ -112,6 +119,8 @@ const (
 	cmdCluAttach = "remote-" + cmdAttach
 	cmdCluDetach = "remote-" + cmdDetach
 	cmdCluConfig = "configure"
+	cmdCfgApply  = "apply" // config cluster apply --file
+	cmdCluHealth = "health"
 	cmdReset     = "reset"
 
 	// Mountpath (disk) actions
@@ -122,8 +131,10 @@ const (
 
 	// Node subcommands
 	cmdJoin                = "join"
+	cmdMembershipApply     = "apply"
 	cmdStartMaint          = "start-maintenance"
 	cmdStopMaint           = "stop-maintenance"
+	cmdEndProbation        = "promote"
 	cmdNodeDecommission    = "decommission"
 	cmdClusterDecommission = "decommission"
 
@@ -142,7 +153,8 @@ const (
 	cmdResetBprops = cmdReset
 
 	// Archive subcommands
-	cmdAppend = "append"
+	cmdAppend  = "append"
+	cmdExtract = "extract"
 
 	// AuthN subcommands
 	cmdAuthAdd     = "add"
@@ -156,6 +168,7 @@ const (
 	cmdAuthCluster = cmdCluster
 	cmdAuthToken   = "token"
 	cmdAuthConfig  = cmdConfig
+	cmdAuthRefresh = "refresh"
 
 	// K8s subcommans
 	cmdK8s        = "kubectl"
@@ -195,6 +208,9 @@ const (
 
 const NilValue = "none"
 
+// shown in place of secret config values (see `--show-secrets`)
+const redactedVal = "***"
+
 const (
 	defaultChunkSize = 10 * cos.MiB
 )
@@ -218,6 +234,7 @@ const (
 
 	// Job IDs (download, dsort)
 	jobIDArgument                 = "JOB_ID"
+	jobKindArgument               = "KIND [ARGS]"
 	optionalJobIDArgument         = "[JOB_ID]"
 	optionalJobIDDaemonIDArgument = "[JOB_ID [NODE_ID]]"
 
@@ -253,23 +270,28 @@ const (
 	concatObjectArgument    = "FILE|DIRECTORY[/PATTERN] [ FILE|DIRECTORY[/PATTERN] ...] BUCKET/OBJECT_NAME"
 	objectArgument          = "BUCKET/OBJECT_NAME"
 	optionalObjectsArgument = "BUCKET[/OBJECT_NAME]..."
+	locateObjectArgument    = "BUCKET[/OBJECT_NAME]"
 	renameObjectArgument    = "BUCKET/OBJECT_NAME NEW_OBJECT_NAME"
 	appendToArchArgument    = "FILE BUCKET[/OBJECT_NAME]"
 
 	setCustomArgument = objectArgument + " " + jsonKeyValueArgument + " | " + keyValuePairsArgument + ", e.g.:\n" +
 		indent1 +
-		"mykey1=value1 mykey2=value2 OR '{\"mykey1\":\"value1\", \"mykey2\":\"value2\"}'"
+		"mykey1=value1 mykey2=value2 OR '{\"mykey1\":\"value1\", \"mykey2\":\"value2\"}'\n" +
+		indent1 +
+		"(or, to update multiple objects: BUCKET --from-file MANIFEST)"
 
 	// nodes
 	nodeIDArgument            = "NODE_ID"
 	optionalNodeIDArgument    = "[NODE_ID]"
 	optionalTargetIDArgument  = "[TARGET_ID]"
 	joinNodeArgument          = "IP:PORT"
+	membershipFileArgument    = "MEMBERSHIP_FILE"
 	nodeMountpathPairArgument = "NODE_ID=MOUNTPATH [NODE_ID=MOUNTPATH...]"
 
 	// cluster
 	showClusterArgument = "[NODE_ID] | [target [NODE_ID]] | [proxy [NODE_ID]] | " +
 		"[smap [NODE_ID]] | [bmd [NODE_ID]] | [config [NODE_ID]] | [stats [NODE_ID]]"
+	exportSmapArgument = "[OUT_FILE|-]"
 
 	// config
 	showConfigArgument = "cli | cluster [CONFIG SECTION OR PREFIX] |\n" +
@@ -379,12 +401,32 @@ var (
 			indent4 + "\ta/b that have their names (relative to this directory) starting with c;\n" +
 			indent4 + "\t'--prefix \"\"' - get entire bucket",
 	}
+	stripPrefixFlag = cli.BoolFlag{
+		Name: "strip-prefix",
+		Usage: "remove the matching prefix from the destination pathnames, e.g.:\n" +
+			indent4 + "\t'ais get ais://nnn ./out --prefix a/b/ --strip-prefix' - writes object a/b/c/d as ./out/c/d\n" +
+			indent4 + "\t(applies only to multi-object '--prefix' downloads)",
+	}
+	flattenFlag = cli.BoolFlag{
+		Name: "flatten",
+		Usage: "write all prefix-matching objects directly into the destination directory using their base names;\n" +
+			indent4 + "\tname collisions are errors unless '--overwrite-dst' is specified",
+	}
 	copyObjPrefixFlag = cli.StringFlag{
 		Name: "prefix",
 		Usage: "copy objects that start with the specified prefix, e.g.:\n" +
 			indent4 + "\t'--prefix a/b/c' - copy virtual directory a/b/c and/or objects from the virtual directory\n" +
 			indent4 + "\ta/b that have their names (relative to this directory) starting with the letter c",
 	}
+	rechecksumPrefixFlag = cli.StringFlag{
+		Name:  "prefix",
+		Usage: "rechecksum only those objects that start with the specified prefix (compare with '--template')",
+	}
+	rechecksumTypeFlag = cli.StringFlag{
+		Name: "type",
+		Usage: "checksum type to recompute and store, e.g.: sha256, xxhash, md5;\n" +
+			indent4 + "\tdefault: bucket's configured checksum type (see 'ais bucket props show BUCKET checksum')",
+	}
 	bsummPrefixFlag = cli.StringFlag{
 		Name: "prefix",
 		Usage: "for each bucket, select only those objects (names) that start with the specified prefix, e.g.:\n" +
@@ -436,6 +478,11 @@ var (
 	nonverboseFlag = cli.BoolFlag{Name: "non-verbose,nv", Usage: "non-verbose"}
 
 	averageSizeFlag = cli.BoolFlag{Name: "average-size", Usage: "show average GET, PUT, etc. request size"}
+	perfDeltaFlag   = cli.BoolFlag{
+		Name: "delta,rate",
+		Usage: "show per-second rates (ops/s, bytes/s) computed between successive samples instead of cumulative counters;\n" +
+			indent4 + "\tthe sampling interval is '--refresh' (default: " + refreshRateDefault.String() + ")",
+	}
 
 	ignoreErrorFlag = cli.BoolFlag{
 		Name:  "ignore-error",
@@ -447,8 +494,29 @@ var (
 		Usage: "bucket properties, e.g. --props=\"mirror.enabled=true mirror.copies=4 checksum.type=md5\"",
 	}
 
+	fromBucketFlag = cli.StringFlag{
+		Name: "from-bucket",
+		Usage: "inherit checksum, erasure coding, mirroring, versioning, and LRU properties from an existing (template) bucket,\n" +
+			indent4 + "\te.g. '--from-bucket ais://template'; can be combined with '--props' to override selected values",
+	}
+	propsFileFlag = cli.StringFlag{
+		Name: "props-file",
+		Usage: "path to JSON or YAML file with bucket properties, e.g. as shown by 'ais bucket props show BUCKET --json';\n" +
+			indent4 + "\tcan be combined with '--props' to override selected values",
+	}
+
 	forceFlag = cli.BoolFlag{Name: "force,f", Usage: "force an action"}
 
+	mpathAttachForceFlag = cli.BoolFlag{
+		Name:  forceFlag.Name,
+		Usage: "attach mountpath notwithstanding non-fatal pre-flight warnings (e.g., used capacity above high watermark)",
+	}
+
+	remAttachForceFlag = cli.BoolFlag{
+		Name:  forceFlag.Name,
+		Usage: "attach remote cluster without checking that it is reachable (e.g., when the cluster is not online yet)",
+	}
+
 	// units enum { unitsIEC, unitsSI, unitsRaw }
 	unitsFlag = cli.StringFlag{
 		Name: "units",
@@ -464,13 +532,42 @@ var (
 		Usage: "list bucket's content alphabetically starting with the first name _after_ the specified",
 	}
 	objLimitFlag = cli.IntFlag{Name: "limit", Usage: "limit object name count (0 - unlimited)"}
+
+	searchLimitFlag = cli.IntFlag{
+		Name:  "limit",
+		Usage: "show at most so many best-matching commands (0 - unlimited)",
+	}
 	pageSizeFlag = cli.IntFlag{
 		Name:  "page-size",
 		Usage: "maximum number of names per page (0 - the maximum is defined by the corresponding backend)",
 	}
+	// cluster health
+	capacityWarnFlag = cli.IntFlag{
+		Name:  "capacity-warn",
+		Usage: "report a warning when target's used capacity (the fullest mountpath, %) reaches this threshold",
+		Value: 80,
+	}
+	capacityCritFlag = cli.IntFlag{
+		Name:  "capacity-crit",
+		Usage: "report a critical issue when target's used capacity (the fullest mountpath, %) reaches this threshold",
+		Value: 90,
+	}
+	maxVersionLagFlag = cli.IntFlag{
+		Name: "max-version-lag",
+		Usage: "maximum number of versions a node's cluster map (Smap) or bucket metadata (BMD) can be behind the primary's\n" +
+			indent4 + "\tbefore it's considered critical (any lag within the limit is reported as a warning)",
+		Value: 1,
+	}
+
 	copiesFlag   = cli.IntFlag{Name: "copies", Usage: "number of object replicas", Value: 1, Required: true}
 	maxPagesFlag = cli.IntFlag{Name: "max-pages", Usage: "display up to this number pages of bucket objects"}
 
+	spreadFlag = cli.StringFlag{
+		Name: "spread-across",
+		Usage: "make sure object replicas land on distinct: mountpaths (the only supported placement);\n" +
+			indent4 + "\tcheck and report, for each target, whether the requested number of copies is achievable",
+	}
+
 	validateSummaryFlag = cli.BoolFlag{
 		Name:  "validate",
 		Usage: "perform checks (correctness of placement, number of copies, and more) and show the corresponding error counts",
@@ -485,16 +582,50 @@ var (
 	}
 	showUnmatchedFlag = cli.BoolFlag{Name: "show-unmatched", Usage: "list objects that were not matched by regex and template"}
 
+	formatTemplateFlag = cli.StringFlag{
+		Name: "format-template",
+		Usage: "render each listed object (or object's properties) through Go text/template, e.g. '{{.Name}}\\t{{.Size}}';\n" +
+			indent4 + "\tfor the available field names and functions, see docs/cli/object.md (\"Format template\")",
+	}
+
 	keepMDFlag       = cli.BoolFlag{Name: "keep-md", Usage: "keep bucket metadata"}
 	dataSlicesFlag   = cli.IntFlag{Name: "data-slices,data,d", Usage: "number of data slices", Required: true}
 	paritySlicesFlag = cli.IntFlag{Name: "parity-slices,parity,p", Usage: "number of parity slices", Required: true}
 	compactPropFlag  = cli.BoolFlag{Name: "compact,c", Usage: "display properties grouped in human-readable mode"}
+	ecReencodeFlag   = cli.BoolFlag{
+		Name: "reencode",
+		Usage: "change data and/or parity slices of an erasure-coded bucket and re-encode\n" +
+			indent4 + "\texisting objects as per the new configuration (showing progress at '--refresh' intervals)",
+	}
 
 	nameOnlyFlag = cli.BoolFlag{
 		Name:  "name-only",
 		Usage: "faster request to retrieve only the names of objects (if defined, '--props' flag will be ignored)",
 	}
 
+	// show cluster
+	metasyncLagFlag = cli.IntFlag{
+		Name: "metasync-lag",
+		Usage: "flag nodes that are more than so many versions behind the primary as far as\n" +
+			indent4 + "\tcluster-level metadata (Smap, BMD, etc.); see METASYNC LAG column",
+		Value: 2,
+	}
+	smapHistoryFlag = cli.BoolFlag{
+		Name: "history",
+		Usage: "show Smap history recorded by the primary (or by a given node while it was primary):\n" +
+			indent4 + "\tversions, times, and per-transition changes\n" +
+			indent4 + "\t(the number of Smaps retained in their entirety is configurable via 'cluster.smap_history')",
+	}
+	smapVersionFlag = cli.IntFlag{
+		Name:  "smap-version",
+		Usage: "show a given (older) Smap version that is still retained by the node (see '--history')",
+	}
+	nodeVersionsFlag = cli.BoolFlag{
+		Name: "versions",
+		Usage: "always show software version and build time of each node (by default, shown only when versions differ);\n" +
+			indent4 + "\tnodes that run a version different from the primary's are highlighted",
+	}
+
 	// Log severity (cmn.LogInfo, ....) enum
 	logSevFlag   = cli.StringFlag{Name: "severity", Usage: "show the specified log, one of: 'i[nfo]','w[arning]','e[rror]'"}
 	logFlushFlag = DurationFlag{
@@ -502,6 +633,10 @@ var (
 		Usage: "can be used in combination with " + qflprn(refreshFlag) + " to override configured '" + nodeLogFlushName + "'",
 		Value: logFlushTime,
 	}
+	logFollowFlag = cli.BoolFlag{
+		Name:  "follow,f",
+		Usage: "keep showing new log entries as they are written (until Ctrl-C); with no node specified - from all nodes, prefixed with node IDs",
+	}
 
 	// Download
 	descJobFlag = cli.StringFlag{Name: "description,desc", Usage: "job description"}
@@ -529,22 +664,77 @@ var (
 			indent4 + "\tthe value is parsed in accordance with the '--units' (see '--units' for details);\n" +
 			indent4 + "\tomitting the flag or (same) specifying '--limit-bph 0' means that download won't be throttled",
 	}
+	prefetchThrottleFlag = cli.StringFlag{
+		Name: "throttle",
+		Usage: "yield to user (foreground) I/O by pausing between prefetched objects, one of: " +
+			cmn.PrefetchThrottleLow + ", " + cmn.PrefetchThrottleMedium + ", " + cmn.PrefetchThrottleHigh + ";\n" +
+			indent4 + "\tthe higher the level the longer the pauses (" + cmn.PrefetchThrottleLow +
+			" - 1/4, " + cmn.PrefetchThrottleMedium + " - 1x, " + cmn.PrefetchThrottleHigh +
+			" - 4x the time it took to prefetch the previous object)",
+	}
+	prefetchLimitBphFlag = cli.StringFlag{
+		Name: "limit-bytes-per-hour",
+		Usage: "maximum total size to prefetch per hour (cluster-wide, evenly divided between targets), e.g.:\n" +
+			indent4 + "\t'--limit-bytes-per-hour 100GiB'; omitting the flag or specifying 0 means no limit (see '--units')",
+	}
+	dloadManifestFlag = cli.StringFlag{
+		Name: "manifest",
+		Usage: "path to JSON file with an array of download entries '{\"src\": SOURCE_LINK, \"dst\": BUCKET/OBJECT_NAME}'\n" +
+			indent4 + "\t(each entry is downloaded into its own destination bucket and object name)",
+	}
+	dloadCreateBucketsFlag = cli.BoolFlag{
+		Name:  "create",
+		Usage: "create destination buckets that do not exist (default: fail)",
+	}
+	dloadValidateCksumFlag = cli.BoolFlag{
+		Name: "validate-checksum",
+		Usage: "validate each downloaded object against the checksum (or MD5 ETag) provided by the source\n" +
+			indent4 + "\tand download it again in case of mismatch",
+	}
+	dloadNotifyURLFlag = cli.StringFlag{
+		Name: "notify-url",
+		Usage: "upon job completion (or failure), POST the final job status (JSON) to this URL,\n" +
+			indent4 + "\te.g. 'http://orchestrator:8080/hooks/download'; the webhook is retried a few times on failure",
+	}
 	objectsListFlag = cli.StringFlag{
 		Name:  "object-list,from",
 		Usage: "path to file containing JSON array of object names to download",
 	}
 	syncFlag = cli.BoolFlag{Name: "sync", Usage: "sync bucket with Cloud"}
 
+	cfgApplyFileFlag = cli.StringFlag{
+		Name: "file,f",
+		Usage: "path to YAML or JSON file with (full or partial) sectioned cluster configuration,\n" +
+			indent4 + "\te.g. 'checksum: {type: md5}'; use '-' to read from standard input",
+	}
+	cfgSectionFlag = cli.StringFlag{
+		Name:  "section",
+		Usage: "cluster config section to reset to its initial values, e.g. 'lru', 'space', 'ec', 'distributed_sort'",
+	}
+	showSecretsFlag = cli.BoolFlag{
+		Name:  "show-secrets",
+		Usage: "show secret values (e.g., 'auth.secret') as is, without redaction (requires confirmation)",
+	}
+
 	// dSort
 	dsortFsizeFlag  = cli.StringFlag{Name: "fsize", Value: "1024", Usage: "size of the files in a shard"}
 	dsortLogFlag    = cli.StringFlag{Name: "log", Usage: "path to file where the metrics will be saved"}
 	dsortFcountFlag = cli.IntFlag{Name: "fcount", Value: 5, Usage: "number of files inside single shard"}
 	dsortSpecFlag   = cli.StringFlag{Name: "file,f", Value: "", Usage: "path to file with dSort specification"}
+	dsortSetFlag    = cli.StringSliceFlag{
+		Name: "set",
+		Usage: "override dSort specification field, e.g.: '--set output_shard_size=512MB --set algorithm.kind=shuffle'\n" +
+			indent4 + "\t(the flag can be repeated; nested fields are separated by '.'; buckets are specified as URIs)",
+	}
 
 	cleanupFlag = cli.BoolFlag{
 		Name:  "cleanup",
 		Usage: "remove old bucket and create it again (warning: removes the entire content of the old bucket)",
 	}
+	dsortCleanupFlag = cli.BoolFlag{
+		Name:  "cleanup",
+		Usage: "remove partially written output shards produced by the aborted " + dsort.DSortName + " job",
+	}
 	concurrencyFlag = cli.IntFlag{
 		Name: "conc", Value: 10,
 		Usage: "limits number of concurrent put requests and number of concurrent shards created",
@@ -561,6 +751,59 @@ var (
 		Usage: "maximum time to wait for a job to finish; if omitted wait forever or Ctrl-C;\n" +
 			indent4 + "\tvalid time units: " + timeUnits,
 	}
+	// get, put
+	xferTimeoutFlag = DurationFlag{
+		Name: "timeout",
+		Usage: "abort the operation, including any in-flight transfer, if it does not complete within the specified time;\n" +
+			indent4 + "\tapplies to the entire command (e.g., all objects when transferring multiple objects); e.g. '--timeout 30s';\n" +
+			indent4 + "\tvalid time units: " + timeUnits,
+	}
+	// set-primary: graceful handoff
+	drainTimeoutFlag = DurationFlag{
+		Name: "drain-timeout",
+		Usage: "prior to switching, pause cluster map changes and wait (up to the specified time) for the new primary\n" +
+			indent4 + "\tto receive the current cluster map; do not switch if it fails to catch up in time, e.g. '--drain-timeout 10s';\n" +
+			indent4 + "\tvalid time units: " + timeUnits,
+	}
+	keepNodeFlag = cli.StringFlag{
+		Name: "keep",
+		Usage: "node ID to keep; all nodes that conflict with it (share its IP:port) will be removed\n" +
+			indent4 + "\tfrom the cluster map (run without this option to list conflicts pending resolution)",
+	}
+	// storage cleanup: remove only stale files
+	cleanupOlderThanFlag = DurationFlag{
+		Name: "older-than",
+		Usage: "remove only old workfiles, misplaced and corrupted objects (that is, older than the specified duration), e.g. '--older-than 24h';\n" +
+			indent4 + "\tvalid time units: " + timeUnits,
+	}
+	// show jobs in a given time window
+	jobsSinceFlag = DurationFlag{
+		Name: "since",
+		Usage: "show jobs that were running during the last specified time interval, e.g. '--since 1h'\n" +
+			indent4 + "\t(implies '--all', i.e., includes finished jobs); valid time units: " + timeUnits,
+	}
+	jobsUntilFlag = cli.StringFlag{
+		Name: "until",
+		Usage: "show jobs that started before the specified time (implies '--all'), e.g.:\n" +
+			indent4 + "\t'--until 2023-05-30T17:00:00Z', '--until \"2023-05-30 17:00\"', or '--until 2023-05-30'",
+	}
+	jobsBckFlag = cli.StringFlag{
+		Name: "bck",
+		Usage: "show jobs of all kinds that read or write the specified bucket, in a single table\n" +
+			indent4 + "\t(as the job's bucket, source, or destination; cluster-wide jobs such as rebalance are not included);\n" +
+			indent4 + "\tsame as specifying BUCKET without job name, e.g.: 'ais show job ais://abc --all'",
+	}
+	activeJobsFlag = cli.BoolFlag{
+		Name:  "active",
+		Usage: "show only running jobs (the default, unless '--all' or time window ('--since', '--until') is specified)",
+	}
+
+	jobsTreeFlag = cli.BoolFlag{
+		Name: "tree",
+		Usage: "group jobs by job ID (with per-target instances underneath) and show jobs spawned by other jobs\n" +
+			indent4 + "\tnested under their respective parents (applies to xactions only - excludes download, dsort, and ETL)",
+	}
+
 	waitFlag = cli.BoolFlag{
 		Name:  "wait",
 		Usage: "wait for an asynchronous operation to finish (optionally, use '--timeout' to limit the waiting time)",
@@ -571,7 +814,8 @@ var (
 		Name: "list",
 		Usage: "comma-separated list of object names, e.g.:\n" +
 			indent4 + "\t--list 'o1,o2,o3'\n" +
-			indent4 + "\t--list \"abc/1.tar, abc/1.cls, abc/1.jpeg\"",
+			indent4 + "\t--list \"abc/1.tar, abc/1.cls, abc/1.jpeg\"\n" +
+			indent4 + "\tor, '--list -' to read object names from STDIN, one per line (get, rm, evict, prefetch, and archive)",
 	}
 	listFileFlag = cli.StringFlag{
 		Name: "list",
@@ -596,9 +840,23 @@ var (
 			indent4 + "\t--template \"prefix-{0010..9999..2}-suffix\"",
 	}
 
+	showTemplateFlag = cli.BoolFlag{
+		Name: "show-template",
+		Usage: "do not execute the command; instead, print the number of names that '--template' expands to,\n" +
+			indent4 + "\tand a sample of those names",
+	}
+	templateCapFlag = cli.StringFlag{
+		Name: "template-cap",
+		Usage: "warn if '--template' expands to more than the specified number of names (default: " +
+			templateCapDefault + "; zero to disable);\n" +
+			indent4 + "\tpersistent default: 'ais config cli set defaults.template_cap=N'",
+	}
+
 	listrangeFlags = []cli.Flag{
 		listFlag,
 		templateFlag,
+		showTemplateFlag,
+		templateCapFlag,
 		waitFlag,
 		waitJobXactFinishedFlag,
 		progressFlag,
@@ -607,6 +865,8 @@ var (
 	listrangeFileFlags = []cli.Flag{
 		listFileFlag,
 		templateFileFlag,
+		showTemplateFlag,
+		templateCapFlag,
 		progressFlag,
 		refreshFlag,
 	}
@@ -620,6 +880,22 @@ var (
 		Usage: "object read length; default formatting: IEC (use '--units' to override)",
 	}
 
+	// cat: line (record) selection, client-side
+	headFlag = cli.IntFlag{
+		Name:  "head",
+		Usage: "print the first N lines and stop reading (closes the connection without reading the rest of the content)",
+	}
+	tailFlag = cli.IntFlag{
+		Name:  "tail",
+		Usage: "print the last N lines (note: necessarily reads the object, archived file, or read range to the end)",
+	}
+	recordsFlag = cli.StringFlag{
+		Name: "records",
+		Usage: "print a range of newline-delimited records (lines), one-based and inclusive, e.g.:\n" +
+			indent4 + "\t--records 100-200\t- print lines 100 through 200 (and stop reading)\n" +
+			indent4 + "\t--records 100-\t- print line 100 and all subsequent lines",
+	}
+
 	// NOTE:
 	// In many cases, stating that a given object "is present" will sound more appropriate and,
 	// in fact, accurate then "object is cached". The latter comes with a certain implied sense
@@ -635,6 +911,18 @@ var (
 		Name:  "cached",
 		Usage: "list only those objects from a remote bucket that are present (\"cached\")",
 	}
+	listObjLatestFlag = cli.BoolFlag{
+		Name: "latest",
+		Usage: "check in-cluster (\"cached\") copies of listed remote objects against the remote backend\n" +
+			indent4 + "\tand flag those that are out of date (notice: one HEAD request per cached object;\n" +
+			indent4 + "\tuse '--limit' to cap the number of checked objects)",
+	}
+	listFillCksumFlag = cli.BoolFlag{
+		Name: "fill-checksum",
+		Usage: "when listing with '--props checksum' (or '--props all'), HEAD objects whose checksums are missing\n" +
+			indent4 + "\tin the listing (notice: up to one HEAD request per listed object - requires '--limit');\n" +
+			indent4 + "\tchecksums obtained this way are marked with '*'",
+	}
 	getObjCachedFlag = cli.BoolFlag{
 		Name:  "cached",
 		Usage: "get only those objects from a remote bucket that are present (\"cached\") in AIS",
@@ -644,6 +932,17 @@ var (
 		Name:  "not-cached",
 		Usage: "show properties of _all_ objects from a remote bucket including those (objects) that are not present (not \"cached\")",
 	}
+	// versioned remote buckets
+	objVersionsFlag = cli.BoolFlag{
+		Name:  "versions",
+		Usage: "list all available versions of a given object (versioned remote buckets only)",
+	}
+	objVersionIDFlag = cli.StringFlag{
+		Name: "version-id",
+		Usage: "get a specific version of a given object (versioned remote buckets only);\n" +
+			indent4 + "\tthe object is read directly from remote storage and is not stored in the cluster;\n" +
+			indent4 + "\tto list all available versions, run 'ais show object BUCKET/OBJECT --versions'",
+	}
 	// to anonymously list public-access Cloud buckets
 	listAnonymousFlag = cli.BoolFlag{
 		Name:  "anonymous",
@@ -666,13 +965,64 @@ var (
 
 	yesFlag = cli.BoolFlag{Name: "yes,y", Usage: "assume 'yes' for all questions"}
 
+	// storage validate
+	repairFlag = cli.BoolFlag{
+		Name: "repair",
+		Usage: "after validation, run corrective jobs for the detected problems:\n" +
+			indent4 + "\trebalance (or resilver) for misplaced objects, n-way mirroring for missing copies, and EC encoding",
+	}
+
 	chunkSizeFlag = cli.StringFlag{
 		Name:  "chunk-size",
 		Usage: "chunk size in IEC or SI units, or \"raw\" bytes (e.g.: 1MiB or 1048576; see '--units')",
 	}
+	partWorkersFlag = cli.IntFlag{
+		Name: "part-workers",
+		Usage: "upload a large file in parts (of " + qflprn(chunkSizeFlag) + " size, 10MiB by default)" +
+			" using the specified number of workers;\n" +
+			indent4 + "\tfiles smaller than two parts are uploaded as a single stream, and so are the files\n" +
+			indent4 + "\tdestined to buckets that do not support multipart upload (other than ais://, or with remote backend)",
+	}
+	spillThresholdFlag = cli.StringFlag{
+		Name: "spill-threshold",
+		Usage: "when writing from standard input (that is, a stream of unknown size): buffer up to the specified size in memory,\n" +
+			indent4 + "\tspill the rest to a temporary file, and PUT the object with known content length once the input ends;\n" +
+			indent4 + "\tthe default is to append the input in chunks (see '--chunk-size'); e.g.: 64MiB (see '--units')",
+	}
+
+	putIncludeFlag = cli.StringSliceFlag{
+		Name: "include",
+		Usage: "PUT only those files (in a given directory) that match the shell filename pattern, e.g.: '--include \"*.jpg\"';\n" +
+			indent4 + "\tthe pattern is matched against the file's path relative to the directory (and its parent directories);\n" +
+			indent4 + "\t'**' matches zero or more subdirectories (e.g., 'src/**/*.go'); the flag can be repeated",
+	}
+	putExcludeFlag = cli.StringSliceFlag{
+		Name: "exclude",
+		Usage: "skip files that match the shell filename pattern, e.g.: '--exclude .git --exclude \"*.tmp\"';\n" +
+			indent4 + "\ttakes precedence over '--include'; same matching rules; the flag can be repeated",
+	}
+	noIgnoreFlag = cli.BoolFlag{
+		Name: "no-ignore",
+		Usage: "do not consult '.aisignore' files (gitignore syntax) that otherwise exclude:\n" +
+			indent4 + "\t- files from recursive PUT ('.aisignore' in the source directory and its subdirectories);\n" +
+			indent4 + "\t- objects from multi-object GET ('.aisignore' in the destination directory, or current directory)",
+	}
+
+	stdinSizeFlag = cli.StringFlag{
+		Name: "size",
+		Usage: "when writing from standard input: declared size of the input, e.g.: 5GiB (see '--units');\n" +
+			indent4 + "\tPUT the object with this content length (and fail if the input turns out to be shorter or longer);\n" +
+			indent4 + "\tuse together with one of the checksum flags (e.g., '--sha256 HEX') to have the target validate the content",
+	}
 
 	cksumFlag = cli.BoolFlag{Name: "checksum", Usage: "validate checksum"}
 
+	verifyOnlyFlag = cli.BoolFlag{
+		Name: "verify-only",
+		Usage: "read object(s) and validate their content against stored checksums without writing anything;\n" +
+			indent4 + "\treport pass/fail for each object (implies '--checksum'; with '--prefix' - client-side bucket integrity scan)",
+	}
+
 	putObjCksumText     = indent4 + "\tand provide it as part of the PUT request for subsequent validation on the server side"
 	putObjCksumFlags    = initPutObjCksumFlags()
 	putObjDfltCksumFlag = cli.BoolFlag{
@@ -681,6 +1031,59 @@ var (
 			putObjCksumText,
 	}
 
+	promoteCksumFlag = cli.BoolFlag{
+		Name: putObjDfltCksumFlag.Name,
+		Usage: "[end-to-end protection] compute checksum configured for the destination bucket on the target side\n" +
+			indent4 + "\twhile reading each source file, and store it as part of the promoted object's metadata",
+	}
+	promoteVerifyFlag = cli.BoolFlag{
+		Name: "verify",
+		Usage: "once promoted, validate each object's checksum (re-read locally stored objects, HEAD remote ones);\n" +
+			indent4 + "\timplies '--compute-checksum'",
+	}
+
+	// APPEND (regular objects)
+	appendObjFlag = cli.BoolFlag{
+		Name: "append",
+		Usage: "append file (or standard input) to the destination object; can be repeated any number of times;\n" +
+			indent4 + "\tthe appended content becomes visible only after '--flush' (no concurrent appenders)",
+	}
+	appendHandleFlag = cli.StringFlag{
+		Name: "append-handle",
+		Usage: "append handle returned by the previous '--append';\n" +
+			indent4 + "\tby default, the handle is stored in (and retrieved from) CLI config directory",
+	}
+	flushFlag = cli.BoolFlag{
+		Name:  "flush",
+		Usage: "finalize all previous appends and make the resulting object visible (see '--append')",
+	}
+
+	skipIfSameFlag = cli.BoolFlag{
+		Name: "skip-if-same",
+		Usage: "do not PUT files that are already stored in the destination bucket (same size and checksum);\n" +
+			indent4 + "\tcompares with the destination's checksum type, and reuses the value computed via '--compute-checksum' (and such);\n" +
+			indent4 + "\tfalls back to comparing size and source modification time (stored with the object)\n" +
+			indent4 + "\twhen checksums cannot be compared",
+	}
+
+	putDedupeFlag = cli.BoolFlag{
+		Name: "dedupe",
+		Usage: "upload identical files (same size and content) only once and create the rest as server-side copies of the first one;\n" +
+			indent4 + "\tdedupes only within the current invocation (does not compare with objects already stored in the bucket);\n" +
+			indent4 + "\tais buckets only",
+	}
+
+	preserveAttrsFlag = cli.BoolFlag{
+		Name: "preserve-attrs",
+		Usage: "store source file's modification time and permissions as object's custom metadata\n" +
+			indent4 + "\t(see also: 'ais get --restore-attrs')",
+	}
+	restoreAttrsFlag = cli.BoolFlag{
+		Name: "restore-attrs",
+		Usage: "apply modification time and permissions stored via 'ais put --preserve-attrs' to the destination file;\n" +
+			indent4 + "\tbest-effort: warn and continue if the attributes cannot be set",
+	}
+
 	skipVerCksumFlag = cli.BoolFlag{
 		Name:  "skip-vc",
 		Usage: "skip loading object metadata (and the associated checksum & version related processing)",
@@ -695,6 +1098,25 @@ var (
 		Name:  "cluster",
 		Usage: "comma-separated list of AIS cluster IDs (type ',' for an empty cluster ID)",
 	}
+	roleEffectiveFlag = cli.BoolFlag{
+		Name:  "effective",
+		Usage: "show resolved (effective) permissions of a given role, optionally scoped to '--cluster' and/or '--bucket'",
+	}
+	usersFromFileFlag = cli.StringFlag{
+		Name:  "from-file",
+		Usage: "CSV file with users to add, one user per line: USER_NAME,PASSWORD[,ROLE...]",
+	}
+	usersSecretsFileFlag = cli.StringFlag{
+		Name: "secrets-file",
+		Usage: "CSV file with user passwords, one per line: USER_NAME,PASSWORD;\n" +
+			indent4 + "\tused for users that have empty password in the '--from-file' file",
+	}
+	usersOnConflictFlag = cli.StringFlag{
+		Name:  "on-conflict",
+		Value: onConflictFail,
+		Usage: "what to do when adding a user that already exists: " + onConflictSkip + " | " + onConflictUpdate + " | " + onConflictFail,
+	}
+	bucketFilterFlag = cli.StringFlag{Name: "bucket", Usage: "resolve effective permissions for the specified bucket (requires '--cluster')"}
 
 	// archive
 	listArchFlag   = cli.BoolFlag{Name: "archive", Usage: "list archived content (see docs/archive.md for details)"}
@@ -709,6 +1131,16 @@ var (
 		Usage:    archpathOptionalFlag.Usage,
 		Required: true,
 	}
+	archpathGetFlag = cli.StringFlag{
+		Name: archpathOptionalFlag.Name,
+		Usage: "filename in archive, or archived directory ending with '/' (e.g., 'data/train/')\n" +
+			indent4 + "\tto extract all archived files under it into a local directory in one pass (see '--to')",
+	}
+	archToDirFlag = cli.StringFlag{
+		Name: "to",
+		Usage: "local destination directory for the archived files under '--archpath DIR/', e.g. './out/'\n" +
+			indent4 + "\t(archived paths are written relative to DIR; files that'd end up outside the destination are skipped)",
+	}
 
 	includeSrcBucketNameFlag = cli.BoolFlag{
 		Name:  "include-src-bck",
@@ -725,6 +1157,45 @@ var (
 		Name:  "cont-on-err",
 		Usage: "keep running archiving xaction in presence of errors in a any given multi-object transaction",
 	}
+	toTarFlag = cli.StringFlag{
+		Name: "to-tar",
+		Usage: "write all prefix-matching objects into a single tar file (or STDOUT, if '-'), e.g.:\n" +
+			indent4 + "\t'ais get ais://nnn --prefix data/ --to-tar out.tar' - one tar member per object, in listing order;\n" +
+			indent4 + "\tuse '--cont-on-err' to skip failed objects (the tar is always finalized)",
+	}
+	maxErrorsFlag = cli.IntFlag{
+		Name: "max-errors",
+		Usage: "abort multi-object operation once the number of errors exceeds the specified limit\n" +
+			indent4 + "\t(default 0: no limit)",
+	}
+	errorRateFlag = cli.IntFlag{
+		Name: "error-rate",
+		Usage: "abort multi-object operation once the percentage of failed objects exceeds the specified limit\n" +
+			indent4 + "\t(enforced after the first " + strconv.Itoa(cmn.ErrRateMinSample) + " objects; default 0: no limit)",
+	}
+	promoteContOnErrFlag = cli.BoolFlag{
+		Name: continueOnErrorFlag.Name,
+		Usage: "keep promoting in presence of errors (e.g., checksum mismatch);\n" +
+			indent4 + "\twait for the job to finish, print the failed files, and exit with an error (if any)",
+	}
+	archFormatFlag = cli.StringFlag{
+		Name: "format",
+		Usage: "archive format, one of: " + strings.Join(cos.ArchExtensions, ", ") + ";\n" +
+			indent4 + "\tif omitted, the format is determined by the destination object's extension",
+	}
+	extractToFlag = cli.StringFlag{
+		Name:  "to",
+		Usage: "destination bucket and optional prefix for the extracted objects, e.g. 'ais://dst/extracted/'",
+	}
+	extractListFlag = cli.BoolFlag{
+		Name:  "list",
+		Usage: "preview archived files (and their destination names) without extracting",
+	}
+	extractFilterFlag = cli.StringFlag{
+		Name: "filter",
+		Usage: "extract only archived files that match the pattern (shell filename pattern, e.g. '*.jpg');\n" +
+			indent4 + "	the pattern applies to the full archived pathname or its base",
+	}
 	// end archive
 
 	// AuthN
@@ -736,6 +1207,10 @@ var (
 			indent4 + "\tvalid time units: " + timeUnits,
 		Value: 24 * time.Hour,
 	}
+	tokenInPlaceFlag = cli.BoolFlag{
+		Name:  "in-place",
+		Usage: "overwrite the token file with the refreshed token (default: print the refreshed token to STDOUT)",
+	}
 
 	// Copy Bucket
 	copyDryRunFlag = cli.BoolFlag{
@@ -750,12 +1225,61 @@ var (
 	}
 
 	// ETL
+	getExtMapFlag = cli.StringFlag{
+		Name: "ext-map",
+		Usage: "rewrite object name's extension before GET (the reverse of ETL '--ext'), e.g.:\n" +
+			indent4 + "\t--ext-map jpg=txt\t- GET 'a/b.txt' when asked for 'a/b.jpg';\n" +
+			indent4 + "\t--ext-map \"jpg=txt,png=txt\"\t- the same for two extensions (also accepts ETL form: \"{jpg:txt,png:txt}\")",
+	}
+	getTimingFlag = cli.BoolFlag{
+		Name: "timing",
+		Usage: "show latency breakdown: DNS, connect, TLS, redirect (proxy => target), time to first byte, and transfer\n" +
+			indent4 + "\t(use '--json' for machine-readable output)",
+	}
+	noFailoverFlag = cli.BoolFlag{
+		Name: "no-failover",
+		Usage: "do not retry GET against alternate targets when the designated target fails\n" +
+			indent4 + "\t(by default, enabled for erasure-coded buckets; use this option for debugging)",
+	}
 	etlExtFlag  = cli.StringFlag{Name: "ext", Usage: "mapping from old to new extensions of transformed objects' names"}
 	etlNameFlag = cli.StringFlag{
 		Name:     "name",
 		Usage:    "unique ETL name (leaving this field empty will have unique ID auto-generated)",
 		Required: true,
 	}
+	etlBatchSizeFlag = cli.IntFlag{
+		Name: "batch",
+		Usage: "transform up to so many objects per ETL request (to reduce per-request overhead for small objects);\n" +
+			indent4 + "\trequires ETL that supports batching (see 'ais etl init spec --supports-batch')",
+	}
+	etlSupportsBatchFlag = cli.BoolFlag{
+		Name:  "supports-batch",
+		Usage: "declare that the transformer accepts multiple objects per request (TAR in, TAR out; hpush:// only)",
+	}
+	etlErrorsToFlag = cli.StringFlag{
+		Name: "errors-to",
+		Usage: "for each object that fails to transform, store a JSON record (name, error, transformer's response output)\n" +
+			indent4 + "\tas BUCKET/[PREFIX]OBJECT_NAME.err, e.g.: --errors-to ais://etl-errors/run1/\n" +
+			indent4 + "\t(use together with '--cont-on-err' to keep transforming in presence of errors)",
+	}
+	etlMaxErrorsFlag = cli.IntFlag{
+		Name:  "max-errors",
+		Usage: "max number of error records to store (per target) when '--errors-to' is specified (default: no limit)",
+	}
+	etlReadinessPathFlag = cli.StringFlag{
+		Name:  "readiness-path",
+		Usage: "HTTP path of the ETL container's readiness probe (e.g. /health); adds or overrides 'readinessProbe' in the spec",
+	}
+	etlLivenessPathFlag = cli.StringFlag{
+		Name:  "liveness-path",
+		Usage: "HTTP path of the ETL container's liveness probe (e.g. /health); adds or overrides 'livenessProbe' in the spec",
+	}
+	etlInitTimeoutFlag = DurationFlag{
+		Name: "init-timeout",
+		Usage: "max time to wait for ETL pods to be scheduled and start serving (ie., pass readiness probe);\n" +
+			indent4 + "\ton timeout, show the last known pod status and events;\n" +
+			indent4 + "\tvalid time units: " + timeUnits,
+	}
 	etlBucketRequestTimeout = DurationFlag{
 		Name: "etl-timeout",
 		Usage: "server-side timeout transforming a single object;\n" +
@@ -789,14 +1313,34 @@ var (
 		Name: "role", Required: true,
 		Usage: "role of this AIS daemon: proxy or target",
 	}
+	probationFlag = cli.BoolFlag{
+		Name: "probation",
+		Usage: "join target in probation: the target won't store user data (and won't be rebalanced to)\n" +
+			indent4 + "\tuntil promoted via 'ais cluster add-remove-nodes promote'",
+	}
 	noRebalanceFlag = cli.BoolFlag{
 		Name:  "no-rebalance",
 		Usage: "do _not_ run global rebalance after putting node in maintenance (advanced usage only!)",
 	}
+	maintDurationFlag = DurationFlag{
+		Name: "duration",
+		Usage: "automatically take the node out of maintenance after the specified time, e.g. '--duration 2h' or '--duration 1d';\n" +
+			indent4 + "\tthe primary does it (same as 'ais cluster " + cmdMembership + " " + cmdStopMaint + "', which can still be used to end it sooner);\n" +
+			indent4 + "\tvalid time units: " + timeUnits + ", d",
+	}
 	noResilverFlag = cli.BoolFlag{
 		Name:  "no-resilver",
 		Usage: "do _not_ resilver data off of the mountpaths that are being disabled or detached",
 	}
+	mpathDDForceFlag = cli.BoolFlag{
+		Name:  forceFlag.Name,
+		Usage: "disable or detach the last usable mountpath (that is, make the target unable to store data)",
+	}
+	mpathCapThresholdFlag = cli.IntFlag{
+		Name: "capacity-threshold",
+		Usage: "warn if the projected used capacity of any remaining mountpath exceeds the threshold (%);\n" +
+			indent4 + "\tdefault: cluster configuration 'space.highwm'",
+	}
 	noShutdownFlag = cli.BoolFlag{
 		Name:  "no-shutdown",
 		Usage: "do not shutdown node upon decommissioning it from the cluster",
@@ -805,17 +1349,43 @@ var (
 		Name:  "rm-user-data",
 		Usage: "remove all user data when decommissioning node from the cluster",
 	}
+	decommVerifyFlag = cli.BoolFlag{
+		Name: "verify",
+		Usage: "upon rebalance, verify that all objects from the node being decommissioned have their\n" +
+			indent4 + "\trequired copies (or EC) elsewhere; do not remove the node from the cluster map otherwise (see also: '--force')",
+	}
+	decommForceFlag = cli.BoolFlag{
+		Name:  forceFlag.Name,
+		Usage: "with '--verify': report objects that haven't been migrated but remove the node from the cluster map anyway",
+	}
 
 	transientFlag = cli.BoolFlag{
 		Name:  "transient",
 		Usage: "update config in memory without storing the change(s) on disk",
 	}
 
+	objExpireFlag = DurationFlag{
+		Name: "expire",
+		Usage: "object's time-to-live, e.g. '--expire 7d' or '--expire 36h' (stored as '" + cmn.ExpiresObjMD + "' custom metadata);\n" +
+			indent4 + "\texpired objects get removed by 'ais start " + cmdExpirySweep + "'",
+	}
 	setNewCustomMDFlag = cli.BoolFlag{
 		Name:  "set-new-custom",
 		Usage: "remove existing custom keys (if any) and store new custom metadata",
 	}
+	customMDFromFileFlag = cli.StringFlag{
+		Name: "from-file",
+		Usage: "path to manifest file that maps object names to their respective custom properties, one of:\n" +
+			indent4 + "\t- JSON: '{\"obj1\": {\"mykey1\": \"value1\"}, \"obj2\": {\"mykey2\": \"value2\"}}'\n" +
+			indent4 + "\t- CSV (one object per line): 'obj1,mykey1=value1,mykey2=value2'",
+	}
 
+	// global (app-level) flag: `ais --profile NAME COMMAND ...`
+	profileFlag = cli.StringFlag{
+		Name: "profile",
+		Usage: "use cluster endpoint, AuthN URL and token, and defaults from the named CLI config profile\n" +
+			indent4 + "\t(takes precedence over environment; see 'ais config cli set profiles.NAME.url=...')",
+	}
 	cliConfigPathFlag = cli.BoolFlag{
 		Name:  "path",
 		Usage: "display path to the AIS CLI configuration",
@@ -826,10 +1396,26 @@ var (
 		Usage: "reset only error counters",
 	}
 
+	locateSummaryFlag = cli.BoolFlag{
+		Name:  "summary",
+		Usage: "show only the resulting per-target distribution (number and percentage of objects per target)",
+	}
 	diskSummaryFlag = cli.BoolFlag{
 		Name:  "summary",
 		Usage: "tally up target disks to show per-target read/write summary stats and average utilizations",
 	}
+	capWindowFlag = DurationFlag{
+		Name: "window",
+		Usage: "forecast days until full based on the capacity growth over the specified (trailing) period of time,\n" +
+			indent4 + "\te.g. '--window 24h' (targets retain up to 7 days of capacity samples);\n" +
+			indent4 + "\tvalid time units: " + timeUnits,
+	}
+	capHorizonFlag = DurationFlag{
+		Name: "horizon",
+		Usage: "used with " + qflprn(capWindowFlag) + " to flag targets (and the cluster) projected to fill up\n" +
+			indent4 + "\twithin the specified time, e.g. '--horizon 720h' (30 days)",
+		Value: capDefaultHorizon,
+	}
 	mountpathFlag = cli.BoolFlag{
 		Name:  "mountpath",
 		Usage: "show target mountpaths with underlying disks and used/available capacities",
@@ -837,9 +1423,23 @@ var (
 
 	// LRU
 	lruBucketsFlag = cli.StringFlag{
-		Name: "buckets",
+		Name: "buckets,bucket",
 		Usage: "comma-separated list of bucket names, e.g.:\n" +
 			indent1 + "\t\t\t--buckets 'ais://b1,ais://b2,ais://b3'\n" +
 			indent1 + "\t\t\t--buckets \"gs://b1, s3://b2\"",
 	}
+	lruTargetFlag = cli.StringFlag{
+		Name:  "target",
+		Usage: "run LRU eviction on the specified target only (default: all targets)",
+	}
+	lruAboveFlag = cli.StringFlag{
+		Name: "above",
+		Usage: "evict only if used capacity exceeds this watermark, e.g. '--above 85%'\n" +
+			indent4 + "\t(overrides 'space.highwm' config for this run only)",
+	}
+	lruBelowFlag = cli.StringFlag{
+		Name: "below",
+		Usage: "evict down to this watermark, e.g. '--below 70%'\n" +
+			indent4 + "\t(overrides 'space.lowwm' config for this run only)",
+	}
 )
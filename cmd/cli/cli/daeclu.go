@@ -44,7 +44,7 @@ func getBMD(c *cli.Context) error {
 				}
 				if props.EC.Enabled {
 					ec = fmt.Sprintf("%d/%d, %s", props.EC.DataSlices,
-						props.EC.ParitySlices, cos.ToSizeIEC(props.EC.ObjSizeLimit, 0))
+						props.EC.ParitySlices, teb.FmtSize(props.EC.ObjSizeLimit, "", 0))
 				}
 				fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
 					provider, ns, bucket, props.BackendBck, copies, ec,
@@ -107,11 +107,7 @@ func collapseDisks(dsh []teb.DiskStatsHelper, numTs int) {
 			dst.DiskName = fmt.Sprintf("(%d disk%s)", dn, cos.Plural(dn))
 			tsums[src.TargetID] = dst
 		}
-		dst.Stat.RBps += src.Stat.RBps
-		dst.Stat.Ravg += src.Stat.Ravg
-		dst.Stat.WBps += src.Stat.WBps
-		dst.Stat.Wavg += src.Stat.Wavg
-		dst.Stat.Util += src.Stat.Util
+		teb.AddDiskStats(&dst.Stat, &src.Stat)
 	}
 	for tid, dst := range tsums {
 		dn := int64(dnums[tid])
@@ -7,15 +7,18 @@ package cli
 
 import (
 	"fmt"
+	"os"
 	"sort"
 	"strings"
 	"time"
 
 	"github.com/NVIDIA/aistore/api"
 	"github.com/NVIDIA/aistore/cmd/cli/teb"
+	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/ext/dload"
+	jsoniter "github.com/json-iterator/go"
 	"github.com/urfave/cli"
 	"github.com/vbauerster/mpb/v4"
 	"github.com/vbauerster/mpb/v4/decor"
@@ -320,18 +323,27 @@ func (b *downloaderPB) totalFilesCnt() int {
 }
 
 func downloadJobsList(c *cli.Context, regex string, caption bool) (int, error) {
-	onlyActive := !flagIsSet(c, allJobsFlag)
+	onlyActive := !showAllJobs(c)
 	list, err := api.DownloadGetList(apiBP, regex, onlyActive)
 	if err != nil || len(list) == 0 {
 		return 0, err
 	}
-	if caption {
-		jobCptn(c, cmdDownload, onlyActive, "", false)
+	if tw, _ := parseJobsWindow(c); tw != nil {
+		filtered := list[:0]
+		for _, j := range list {
+			if tw.overlaps(j.StartedTime, j.FinishedTime) {
+				filtered = append(filtered, j)
+			}
+		}
+		list = filtered
 	}
 	l := len(list)
 	if l == 0 {
 		return 0, nil
 	}
+	if caption {
+		jobCptn(c, cmdDownload, onlyActive, "", false)
+	}
 	sort.Slice(list, func(i int, j int) bool {
 		if !list[i].JobFinished() && (list[j].JobFinished() || list[j].Aborted) {
 			return true
@@ -391,14 +403,18 @@ func printDownloadStatus(c *cli.Context, d *dload.StatusResp, verbose bool) {
 	}
 
 	if d.JobFinished() {
-		var skipped, errs string
+		var resumed, skipped, errs string
+		if d.ResumedCnt > 0 {
+			fresh := d.FinishedCnt - d.SkippedCnt - d.ResumedCnt
+			resumed = fmt.Sprintf(" (fresh: %d, resumed: %d)", fresh, d.ResumedCnt)
+		}
 		if d.SkippedCnt > 0 {
 			skipped = fmt.Sprintf(", skipped: %d", d.SkippedCnt)
 		}
 		if d.ErrorCnt > 0 {
 			errs = fmt.Sprintf(", error%s: %d", cos.Plural(d.ErrorCnt), d.ErrorCnt)
 		}
-		fmt.Fprintf(w, "Done: %d file%s downloaded%s%s\n", d.FinishedCnt, cos.Plural(d.FinishedCnt), skipped, errs)
+		fmt.Fprintf(w, "Done: %d file%s downloaded%s%s%s\n", d.FinishedCnt, cos.Plural(d.FinishedCnt), resumed, skipped, errs)
 
 		if len(d.Errs) == 0 {
 			debug.Assert(d.ErrorCnt == 0)
@@ -428,6 +444,9 @@ func printDownloadStatus(c *cli.Context, d *dload.StatusResp, verbose bool) {
 		fmt.Fprintf(w, "Download %s progress: 0/?\n", d.ID)
 	} else {
 		progressMsg := fmt.Sprintf("%s progress: downloaded %d file%s (out of %d) ", d.ID, doneCnt, cos.Plural(doneCnt), totalCnt)
+		if d.ResumedCnt > 0 {
+			progressMsg += fmt.Sprintf("[resumed: %d] ", d.ResumedCnt)
+		}
 		if totalCnt >= minTotalCnt {
 			pctDone := 100 * float64(doneCnt) / float64(totalCnt)
 			progressMsg = fmt.Sprintf("%s (%0.2f%%)", progressMsg, pctDone)
@@ -442,11 +461,11 @@ func printDownloadStatus(c *cli.Context, d *dload.StatusResp, verbose bool) {
 			for _, task := range d.CurrentTasks {
 				fmt.Fprintf(w, "\t%s: ", task.Name)
 				if task.Total == 0 {
-					fmt.Fprintln(w, cos.ToSizeIEC(task.Downloaded, 2))
+					fmt.Fprintln(w, teb.FmtSize(task.Downloaded, "", 2))
 				} else {
 					pctDownloaded := 100 * float64(task.Downloaded) / float64(task.Total)
 					fmt.Fprintf(w, "%s/%s (%.2f%%)\n",
-						cos.ToSizeIEC(task.Downloaded, 2), cos.ToSizeIEC(task.Total, 2), pctDownloaded)
+						teb.FmtSize(task.Downloaded, "", 2), teb.FmtSize(task.Total, "", 2), pctDownloaded)
 				}
 			}
 		}
@@ -461,3 +480,144 @@ func printDownloadStatus(c *cli.Context, d *dload.StatusResp, verbose bool) {
 		fmt.Fprintf(w, "For details, run 'ais show job %s -v'\n", d.ID)
 	}
 }
+
+//
+// download from manifest (`--manifest`)
+//
+
+type (
+	dlManifestEntry struct {
+		Src string `json:"src"`
+		Dst string `json:"dst"`
+	}
+	// all manifest entries with the same destination bucket => single (multi-object) download job
+	dlManifestJob struct {
+		bck     cmn.Bck
+		objs    cos.StrKVs // object name => link
+		entries []*dlManifestEntry
+		id      string
+	}
+)
+
+func startDownloadManifest(c *cli.Context) error {
+	var (
+		manifest = parseStrFlag(c, dloadManifestFlag)
+		entries  []*dlManifestEntry
+	)
+	b, err := os.ReadFile(manifest)
+	if err != nil {
+		return err
+	}
+	if err := jsoniter.Unmarshal(b, &entries); err != nil {
+		return fmt.Errorf("%s doesn't seem to contain JSON array of {\"src\", \"dst\"} entries: %v", manifest, err)
+	}
+	if len(entries) == 0 {
+		return fmt.Errorf("%s: no entries to download", manifest)
+	}
+	base, err := dloadBase(c)
+	if err != nil {
+		return err
+	}
+
+	// validate all entries and group them by destination bucket
+	var (
+		jobs  = make(map[string]*dlManifestJob, 4)
+		order []string
+	)
+	for i, e := range entries {
+		source, err := parseSource(e.Src)
+		if err != nil || source.link == "" {
+			return fmt.Errorf("%s, entry %d: invalid source %q (expecting a link)", manifest, i, e.Src)
+		}
+		bck, objName, err := parseDest(c, e.Dst)
+		if err != nil {
+			return fmt.Errorf("%s, entry %d: invalid destination %q: %v", manifest, i, e.Dst, err)
+		}
+		if objName == "" {
+			return fmt.Errorf("%s, entry %d: destination %q must include object name", manifest, i, e.Dst)
+		}
+		uname := bck.MakeUname("")
+		job, ok := jobs[uname]
+		if !ok {
+			job = &dlManifestJob{bck: bck, objs: make(cos.StrKVs, 16)}
+			jobs[uname] = job
+			order = append(order, uname)
+		}
+		if _, ok := job.objs[objName]; ok {
+			return fmt.Errorf("%s, entry %d: duplicate destination %q", manifest, i, e.Dst)
+		}
+		job.objs[objName] = source.link
+		job.entries = append(job.entries, e)
+	}
+
+	// destination buckets
+	create := flagIsSet(c, dloadCreateBucketsFlag)
+	for _, uname := range order {
+		job := jobs[uname]
+		if _, err := api.HeadBucket(apiBP, job.bck, true /* don't add */); err == nil {
+			continue
+		} else if !cmn.IsStatusNotFound(err) {
+			return err
+		}
+		if !create {
+			return fmt.Errorf("destination bucket %s does not exist (use %s to create)", job.bck.Cname(""),
+				qflprn(dloadCreateBucketsFlag))
+		}
+		if err := api.CreateBucket(apiBP, job.bck, nil); err != nil {
+			return err
+		}
+		actionDone(c, "Created bucket "+job.bck.Cname(""))
+	}
+
+	// start
+	for _, uname := range order {
+		job := jobs[uname]
+		payload := dload.MultiBody{Base: base, ObjectsPayload: job.objs}
+		payload.Bck = job.bck
+		if job.id, err = api.DownloadWithParam(apiBP, dload.TypeMulti, payload); err != nil {
+			return fmt.Errorf("failed to start downloading %d object%s => %s: %v",
+				len(job.objs), cos.Plural(len(job.objs)), job.bck.Cname(""), err)
+		}
+		fmt.Fprintf(c.App.Writer, "Started download job %s (%d object%s => %s)\n",
+			job.id, len(job.objs), cos.Plural(len(job.objs)), job.bck.Cname(""))
+	}
+
+	if !flagIsSet(c, progressFlag) && !flagIsSet(c, waitFlag) && !flagIsSet(c, waitJobXactFinishedFlag) {
+		return nil
+	}
+
+	// wait and report per-entry outcomes
+	var failed int
+	for _, uname := range order {
+		job := jobs[uname]
+		if flagIsSet(c, progressFlag) {
+			err = pbDownload(c, job.id)
+		} else {
+			err = waitDownload(c, job.id)
+		}
+		if err != nil {
+			return err
+		}
+		resp, err := api.DownloadStatus(apiBP, job.id, false /*onlyActive*/)
+		if err != nil {
+			return err
+		}
+		errs := make(cos.StrKVs, len(resp.Errs))
+		for _, e := range resp.Errs {
+			errs[e.Name] = e.Err
+		}
+		for _, e := range job.entries {
+			_, objName, _ := parseDest(c, e.Dst)
+			if msg, ok := errs[objName]; ok {
+				failed++
+				fmt.Fprintf(c.App.Writer, "%s => %s: %s\n", e.Src, e.Dst, fred("FAILED: ")+msg)
+			} else {
+				fmt.Fprintf(c.App.Writer, "%s => %s: OK\n", e.Src, e.Dst)
+			}
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("failed to download %d (out of %d) manifest entries", failed, len(entries))
+	}
+	return nil
+}
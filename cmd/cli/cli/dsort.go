@@ -409,6 +409,146 @@ func printCondensedStats(w io.Writer, id string, errhint bool) error {
 	return nil
 }
 
+// `ais show job dsort JOB_ID --json --refresh`: a stream of single-line JSON records
+// (aggregated across all targets), one per refresh interval. Each record carries the current
+// phase and its `event` that is one of:
+// - "phase-start": first record of a new phase (phases: extraction, sorting, creation)
+// - "progress":    periodic update
+// - "done":        final record with aggregate (cluster-wide) timings
+type (
+	dsortPhaseProgress struct {
+		Running   bool  `json:"running"`
+		Finished  bool  `json:"finished"`
+		ElapsedMs int64 `json:"elapsed_ms"`
+	}
+	dsortExtractionProgress struct {
+		dsortPhaseProgress
+		TotalShards     int64 `json:"total_shards"`
+		ExtractedShards int64 `json:"extracted_shards"`
+		ExtractedBytes  int64 `json:"extracted_bytes"`
+		ExtractedRecs   int64 `json:"extracted_records"`
+		Throughput      int64 `json:"throughput_bps"` // extracted bytes per second
+	}
+	dsortCreationProgress struct {
+		dsortPhaseProgress
+		ToCreate      int64   `json:"to_create"`
+		CreatedShards int64   `json:"created_shards"`
+		Throughput    float64 `json:"throughput_shards_per_sec"`
+	}
+	dsortProgress struct {
+		Time       time.Time               `json:"time"`
+		ID         string                  `json:"id"`
+		Event      string                  `json:"event"`
+		Phase      string                  `json:"phase"`
+		ElapsedMs  int64                   `json:"elapsed_ms"`
+		Extraction dsortExtractionProgress `json:"extraction"`
+		Sorting    dsortPhaseProgress      `json:"sorting"`
+		Creation   dsortCreationProgress   `json:"creation"`
+		Warnings   int                     `json:"warnings"`
+		Errors     int                     `json:"errors"`
+		Aborted    bool                    `json:"aborted"`
+		Finished   bool                    `json:"finished"`
+	}
+)
+
+const (
+	dsortEventPhase    = "phase-start"
+	dsortEventProgress = "progress"
+	dsortEventDone     = "done"
+)
+
+func (pp *dsortPhaseProgress) add(pi *dsort.PhaseInfo, now time.Time) {
+	var elapsed time.Duration
+	switch {
+	case pi.Finished:
+		elapsed = pi.End.Sub(pi.Start)
+	case pi.Running:
+		elapsed = now.Sub(pi.Start)
+	}
+	pp.Running = pp.Running || pi.Running
+	pp.ElapsedMs = cos.MaxI64(pp.ElapsedMs, elapsed.Milliseconds())
+}
+
+func newDsortProgress(id string, resp map[string]*dsort.Metrics) *dsortProgress {
+	var (
+		now = time.Now()
+		p   = &dsortProgress{Time: now, ID: id}
+	)
+	p.Extraction.Finished, p.Sorting.Finished, p.Creation.Finished = true, true, true
+	for _, tm := range resp {
+		p.Aborted = p.Aborted || tm.Aborted.Load()
+		p.Warnings += len(tm.Warnings)
+		p.Errors += len(tm.Errors)
+
+		p.Extraction.add(&tm.Extraction.PhaseInfo, now)
+		p.Extraction.Finished = p.Extraction.Finished && tm.Extraction.Finished
+		p.Extraction.TotalShards = cos.MaxI64(p.Extraction.TotalShards, tm.Extraction.TotalCnt)
+		p.Extraction.ExtractedShards += tm.Extraction.ExtractedCnt
+		p.Extraction.ExtractedBytes += tm.Extraction.ExtractedSize
+		p.Extraction.ExtractedRecs += tm.Extraction.ExtractedRecordCnt
+
+		p.Sorting.add(&tm.Sorting.PhaseInfo, now)
+		p.Sorting.Finished = p.Sorting.Finished && tm.Sorting.Finished
+
+		p.Creation.add(&tm.Creation.PhaseInfo, now)
+		p.Creation.Finished = p.Creation.Finished && tm.Creation.Finished
+		p.Creation.ToCreate += tm.Creation.ToCreate
+		p.Creation.CreatedShards += tm.Creation.CreatedCnt
+	}
+	if ms := p.Extraction.ElapsedMs; ms > 0 {
+		p.Extraction.Throughput = p.Extraction.ExtractedBytes * 1000 / ms
+	}
+	if ms := p.Creation.ElapsedMs; ms > 0 {
+		p.Creation.Throughput = float64(p.Creation.CreatedShards) * 1000 / float64(ms)
+	}
+	p.Finished = p.Creation.Finished
+	p.ElapsedMs = p.Extraction.ElapsedMs + p.Sorting.ElapsedMs + p.Creation.ElapsedMs
+
+	switch {
+	case p.Aborted:
+		p.Phase = "aborted"
+	case p.Finished:
+		p.Phase = "finished"
+	case p.Creation.Running || p.Sorting.Finished:
+		p.Phase = dsort.CreationPhase
+	case p.Sorting.Running || p.Extraction.Finished:
+		p.Phase = dsort.SortingPhase
+	default:
+		p.Phase = dsort.ExtractionPhase
+	}
+	return p
+}
+
+func streamDsortProgress(c *cli.Context, id string, rate time.Duration) error {
+	var (
+		prevPhase string
+		enc       = jsonStd.NewEncoder(c.App.Writer) // (one line per record)
+	)
+	for {
+		resp, err := api.MetricsDSort(apiBP, id)
+		if err != nil {
+			return err
+		}
+		p := newDsortProgress(id, resp)
+		switch {
+		case p.Aborted || p.Finished:
+			p.Event = dsortEventDone
+		case p.Phase != prevPhase:
+			p.Event = dsortEventPhase
+		default:
+			p.Event = dsortEventProgress
+		}
+		prevPhase = p.Phase
+		if err := enc.Encode(p); err != nil {
+			return err
+		}
+		if p.Event == dsortEventDone {
+			return nil
+		}
+		time.Sleep(rate)
+	}
+}
+
 func dsortJobsList(c *cli.Context, list []*dsort.JobInfo, usejs bool) error {
 	sort.Slice(list, func(i int, j int) bool {
 		if list[i].IsRunning() && !list[j].IsRunning() {
@@ -443,6 +583,11 @@ func dsortJobStatus(c *cli.Context, id string) error {
 		usejs   = flagIsSet(c, jsonFlag)
 	)
 
+	// Stream JSON progress records.
+	if usejs && !verbose && refresh && !logging {
+		return streamDsortProgress(c, id, _refreshRate(c))
+	}
+
 	// Show progress bar.
 	if !verbose && refresh && !logging {
 		refreshRate := _refreshRate(c)
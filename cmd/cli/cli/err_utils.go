@@ -6,15 +6,29 @@
 package cli
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	iofs "io/fs"
+	"net/http"
 	"regexp"
 	"strings"
 
 	"github.com/NVIDIA/aistore/cmd/cli/teb"
+	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/urfave/cli"
 )
 
+// CLI exit codes (documented in docs/cli.md)
+const (
+	ExitOther    = 1 // any other error, including incorrect usage
+	ExitNotFound = 2 // bucket, object, or other entity does not exist
+	ExitPerm     = 3 // authentication failure or permission denied
+	ExitConflict = 4 // conflict: already exists, or is busy
+	ExitTimeout  = 5 // timeout (including '--timeout')
+)
+
 type (
 	errUsage struct {
 		helpData      any
@@ -27,6 +41,16 @@ type (
 		baseErr        error
 		additionalInfo string
 	}
+	// user-facing message that retains the original error (for the exit code)
+	errMsgCause struct {
+		cause error
+		msg   string
+	}
+	// formatted error that is returned by Run() along with its exit code
+	errExit struct {
+		err  error
+		code int
+	}
 )
 
 //////////////
@@ -67,6 +91,67 @@ func (e *errAdditionalInfo) Error() string {
 	return fmt.Sprintf("%s.\n%s\n", e.baseErr.Error(), cos.StrToSentence(e.additionalInfo))
 }
 
+func (e *errAdditionalInfo) Unwrap() error { return e.baseErr }
+
+/////////////////
+// errMsgCause //
+/////////////////
+
+func newErrMsgCause(cause error, format string, a ...any) error {
+	return &errMsgCause{cause: cause, msg: fmt.Sprintf(format, a...)}
+}
+
+func (e *errMsgCause) Error() string { return e.msg }
+func (e *errMsgCause) Unwrap() error { return e.cause }
+
+///////////////
+// exit code //
+///////////////
+
+func (e *errExit) Error() string { return e.err.Error() }
+func (e *errExit) Unwrap() error { return e.err }
+
+// ExitCode returns the process exit code for the error returned by Run()
+func ExitCode(err error) int {
+	var e *errExit
+	if errors.As(err, &e) {
+		return e.code
+	}
+	return ExitOther
+}
+
+// map (unformatted) error onto exit code
+func exitCode(err error) int {
+	var (
+		herr *cmn.ErrHTTP
+		nerr *cmn.ErrNotFound
+		terr interface{ Timeout() bool }
+	)
+	if errors.As(err, &herr) {
+		switch herr.Status {
+		case http.StatusNotFound:
+			return ExitNotFound
+		case http.StatusUnauthorized, http.StatusForbidden:
+			return ExitPerm
+		case http.StatusConflict:
+			return ExitConflict
+		case http.StatusRequestTimeout, http.StatusGatewayTimeout:
+			return ExitTimeout
+		}
+	}
+	switch {
+	case errors.As(err, &nerr) || errors.Is(err, iofs.ErrNotExist):
+		return ExitNotFound
+	case errors.Is(err, iofs.ErrPermission):
+		return ExitPerm
+	case errors.Is(err, iofs.ErrExist):
+		return ExitConflict
+	case errors.Is(err, context.DeadlineExceeded) || (errors.As(err, &terr) && terr.Timeout()):
+		return ExitTimeout
+	}
+	return ExitOther
+}
+
 /////////////////
 // error utils //
 /////////////////
@@ -0,0 +1,53 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"testing"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestExitCode(t *testing.T) {
+	var (
+		herr = func(status int) error { return &cmn.ErrHTTP{Status: status, Message: "msg"} }
+		tmo  = &url.Error{Op: "Get", URL: "http://x", Err: context.DeadlineExceeded}
+	)
+	tests := []struct {
+		err  error
+		code int
+	}{
+		{errors.New("other"), ExitOther},
+		{herr(http.StatusInternalServerError), ExitOther},
+		{herr(http.StatusNotFound), ExitNotFound},
+		{cmn.NewErrNotFound("bucket %q", "ais://nnn"), ExitNotFound},
+		{newErrMsgCause(herr(http.StatusNotFound), "%q not found", "obj"), ExitNotFound},
+		{newAdditionalInfoError(herr(http.StatusNotFound), "hint"), ExitNotFound},
+		{fmt.Errorf("failed: %w", os.ErrNotExist), ExitNotFound},
+		{herr(http.StatusUnauthorized), ExitPerm},
+		{herr(http.StatusForbidden), ExitPerm},
+		{os.ErrPermission, ExitPerm},
+		{herr(http.StatusConflict), ExitConflict},
+		{fmt.Errorf("%w (--timeout=1s): %v", context.DeadlineExceeded, "EOF"), ExitTimeout},
+		{tmo, ExitTimeout},
+		{herr(http.StatusGatewayTimeout), ExitTimeout},
+	}
+	for _, test := range tests {
+		code := exitCode(test.err)
+		tassert.Errorf(t, code == test.code, "%v: expected exit code %d, got %d", test.err, test.code, code)
+
+		// formatting (as in Run) must not change the code nor the message
+		eerr := &errExit{err: test.err, code: code}
+		tassert.Errorf(t, ExitCode(eerr) == test.code && eerr.Error() == test.err.Error(), "%v: %d", eerr, ExitCode(eerr))
+	}
+	tassert.Errorf(t, ExitCode(errors.New("unformatted")) == ExitOther, "expecting %d", ExitOther)
+}
@@ -42,6 +42,10 @@ var (
 			commTypeFlag,
 			etlNameFlag,
 			waitPodReadyTimeoutFlag,
+			etlInitTimeoutFlag,
+			etlReadinessPathFlag,
+			etlLivenessPathFlag,
+			etlSupportsBatchFlag,
 		},
 		cmdStop: {
 			allRunningJobsFlag,
@@ -54,6 +58,9 @@ var (
 			copyPrependFlag,
 			copyObjPrefixFlag,
 			copyDryRunFlag,
+			etlBatchSizeFlag,
+			etlErrorsToFlag,
+			etlMaxErrorsFlag,
 			etlBucketRequestTimeout,
 			templateFlag,
 			listFlag,
@@ -202,7 +209,16 @@ func etlInitSpecHandler(c *cli.Context) (err error) {
 	{
 		msg.IDX = parseStrFlag(c, etlNameFlag)
 		msg.CommTypeX = parseStrFlag(c, commTypeFlag)
+		msg.Batch = flagIsSet(c, etlSupportsBatchFlag)
 		msg.Spec = spec
+		msg.ReadinessPath = parseStrFlag(c, etlReadinessPathFlag)
+		msg.LivenessPath = parseStrFlag(c, etlLivenessPathFlag)
+	}
+	switch {
+	case flagIsSet(c, etlInitTimeoutFlag):
+		msg.Timeout = cos.Duration(parseDurationFlag(c, etlInitTimeoutFlag))
+	case flagIsSet(c, waitPodReadyTimeoutFlag):
+		msg.Timeout = cos.Duration(parseDurationFlag(c, waitPodReadyTimeoutFlag))
 	}
 	if err = msg.Validate(); err != nil {
 		return err
@@ -213,6 +229,11 @@ func etlInitSpecHandler(c *cli.Context) (err error) {
 		return
 	}
 
+	// targets respond only when their respective pods are scheduled _and_ serving;
+	// otherwise (e.g., on timeout), the error includes the last known pod status and events
+	if msg.Timeout != 0 {
+		fmt.Fprintf(c.App.Writer, "ETL[%s]: waiting up to %v for pods to start serving...\n", msg.Name(), msg.Timeout)
+	}
 	xid, err := api.ETLInit(apiBP, msg)
 	if err != nil {
 		return err
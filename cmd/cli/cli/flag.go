@@ -33,10 +33,13 @@ var (
 // DurationFlagVar //
 /////////////////////
 
-// "s" (seconds) is the default time unit
+// "s" (seconds) is the default time unit; in addition, "d" stands for days (e.g., "7d")
 func (f *DurationFlagVar) Set(s string) (err error) {
 	if _, err := strconv.ParseInt(s, 10, 64); err == nil {
 		s += "s"
+	} else if days, err := strconv.ParseInt(strings.TrimSuffix(s, "d"), 10, 64); err == nil && strings.HasSuffix(s, "d") {
+		f.Value = time.Duration(days) * 24 * time.Hour
+		return nil
 	}
 	f.Value, err = time.ParseDuration(s)
 	return err
@@ -109,12 +112,41 @@ func flagIsSet(c *cli.Context, flag cli.Flag) (v bool) {
 	return
 }
 
+// persistent default (`ais config cli set defaults.<flag-name>=...`, or the same in `--profile`) of a flag
+// that is supported by the command but not explicitly specified in the command line;
+// NOTE: applies to the flag's value only - `flagIsSet` still means "specified in the command line"
+func flagDefault(c *cli.Context, name string) (string, bool) {
+	if cfg == nil || c.Command.Name == "" {
+		return "", false
+	}
+	val := cfg.Defaults.Get(name)
+	if curProfile != nil {
+		if v := curProfile.Defaults.Get(name); v != "" {
+			val = v
+		}
+	}
+	if val == "" {
+		return "", false
+	}
+	for _, f := range c.Command.Flags {
+		if fl1n(f.GetName()) == name {
+			return val, true
+		}
+	}
+	return "", false
+}
+
 // Returns the value of a string flag (either parent or local scope)
 func parseStrFlag(c *cli.Context, flag cli.Flag) string {
 	flagName := fl1n(flag.GetName())
 	if c.GlobalIsSet(flagName) {
 		return c.GlobalString(flagName)
 	}
+	if !c.IsSet(flagName) {
+		if val, ok := flagDefault(c, flagName); ok {
+			return val
+		}
+	}
 	return c.String(flagName)
 }
 
@@ -133,6 +165,14 @@ func parseDurationFlag(c *cli.Context, flag cli.Flag) time.Duration {
 	if c.GlobalIsSet(flagName) {
 		return c.GlobalDuration(flagName)
 	}
+	if !c.IsSet(flagName) {
+		if val, ok := flagDefault(c, flagName); ok {
+			fvar := DurationFlagVar{}
+			if err := fvar.Set(val); err == nil { // (validated upon loading CLI config)
+				return fvar.Value
+			}
+		}
+	}
 	return c.Duration(flagName)
 }
 
@@ -153,8 +193,8 @@ func parseSizeFlag(c *cli.Context, flag cli.StringFlag, unitsParsed ...string) (
 	)
 	if len(unitsParsed) > 0 {
 		units = unitsParsed[0]
-	} else if flagIsSet(c, unitsFlag) {
-		units, err = parseUnitsFlag(c, unitsFlag)
+	} else {
+		units, err = parseUnitsFlag(c, unitsFlag) // (including configured default, if any)
 		if err != nil {
 			return 0, err
 		}
@@ -0,0 +1,45 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"flag"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmd/cli/config"
+	"github.com/NVIDIA/aistore/tools/tassert"
+	"github.com/urfave/cli"
+)
+
+func TestFlagDefaults(t *testing.T) {
+	saved := cfg
+	cfg = &config.Config{Defaults: config.DefaultsConfig{Refresh: "5s"}}
+	defer func() { cfg = saved }()
+
+	newCtx := func(args ...string) *cli.Context {
+		fset := flag.NewFlagSet("test", flag.ContinueOnError)
+		refreshFlag.Apply(fset)
+		tassert.CheckFatal(t, fset.Parse(args))
+		return newTestContext(fset)
+	}
+
+	// configured default: the interval only (never "set")
+	c := newCtx()
+	c.Command = cli.Command{Name: "test", Flags: []cli.Flag{refreshFlag}}
+	tassert.Errorf(t, !flagIsSet(c, refreshFlag), "configured default must not imply '--refresh'")
+	tassert.Errorf(t, _refreshRate(c) == 5*time.Second, "expected 5s, got %v", _refreshRate(c))
+
+	// command line takes precedence
+	c = newCtx("--refresh", "3s")
+	c.Command = cli.Command{Name: "test", Flags: []cli.Flag{refreshFlag}}
+	tassert.Errorf(t, flagIsSet(c, refreshFlag), "expected '--refresh' set")
+	tassert.Errorf(t, _refreshRate(c) == 3*time.Second, "expected 3s, got %v", _refreshRate(c))
+
+	// command that does not support the flag
+	c = newCtx()
+	c.Command = cli.Command{Name: "test"}
+	tassert.Errorf(t, _refreshRate(c) == refreshRateDefault, "expected default %v, got %v", refreshRateDefault, _refreshRate(c))
+}
@@ -6,20 +6,59 @@
 package cli
 
 import (
+	"bufio"
+	"errors"
 	"fmt"
+	"io"
 	"net/url"
 	"os"
+	"os/signal"
+	"path"
 	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"text/tabwriter"
+	"time"
 
 	"github.com/NVIDIA/aistore/api"
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmd/cli/teb"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
+	jsoniter "github.com/json-iterator/go"
 	"github.com/urfave/cli"
 	"github.com/vbauerster/mpb/v4"
 )
 
+// STDOUT that may be closed early by the consumer, as in `ais object cat BUCKET/OBJECT | head`;
+// a closed STDOUT (EPIPE) is an expected (and clean) termination - see `done` below
+type stdoutW struct {
+	w      io.Writer
+	closed bool
+}
+
+func newStdoutW() *stdoutW {
+	// receive EPIPE rather than terminate on SIGPIPE
+	signal.Ignore(syscall.SIGPIPE)
+	return &stdoutW{w: os.Stdout}
+}
+
+func (sw *stdoutW) Write(p []byte) (n int, err error) {
+	n, err = sw.w.Write(p)
+	if err != nil && errors.Is(err, syscall.EPIPE) {
+		sw.closed = true
+	}
+	return
+}
+
+func (sw *stdoutW) done(err error) error {
+	if sw.closed {
+		return nil
+	}
+	return err
+}
+
 func catHandler(c *cli.Context) error {
 	if c.NArg() == 0 {
 		return missingArgumentsError(c, c.Command.ArgsUsage)
@@ -30,7 +69,10 @@ func catHandler(c *cli.Context) error {
 	if err != nil {
 		return err
 	}
-	return getObject(c, bck, objName, fileStdIO, true /*silent*/)
+	if flagIsSet(c, headFlag) || flagIsSet(c, tailFlag) || flagIsSet(c, recordsFlag) {
+		return catLines(c, bck, objName)
+	}
+	return getObject(c, bck, objName, fileStdIO, true /*silent*/, nil)
 }
 
 func getHandler(c *cli.Context) error {
@@ -46,22 +88,136 @@ func getHandler(c *cli.Context) error {
 	// destination (empty "" implies using source `basename`)
 	outFile := c.Args().Get(1)
 
+	if flagIsSet(c, verifyOnlyFlag) {
+		if outFile != "" {
+			return incorrectUsageMsg(c, "%s does not write objects - destination %q is not expected",
+				qflprn(verifyOnlyFlag), outFile)
+		}
+		// (stored checksum is the checksum of the entire in-cluster object)
+		for _, f := range []cli.Flag{archpathOptionalFlag, offsetFlag, lengthFlag, checkObjCachedFlag, objVersionIDFlag,
+			stripPrefixFlag, flattenFlag, getTimingFlag} {
+			if flagIsSet(c, f) {
+				return incorrectUsageMsg(c, "%s cannot be used together with %s", qflprn(verifyOnlyFlag), qflprn(f))
+			}
+		}
+	}
+
+	// latency breakdown of a single GET
+	if flagIsSet(c, getTimingFlag) {
+		for _, f := range []cli.Flag{listFlag, getObjPrefixFlag, checkObjCachedFlag} {
+			if flagIsSet(c, f) {
+				return incorrectUsageMsg(c, errFmtExclusive, qflprn(getTimingFlag), qflprn(f))
+			}
+		}
+	} else if flagIsSet(c, jsonFlag) {
+		return incorrectUsageMsg(c, "%s requires %s", qflprn(jsonFlag), qflprn(getTimingFlag))
+	}
+
+	// extension mapping applies to the requested object name(s) - not to listed (`--prefix`) ones that already exist
+	if flagIsSet(c, getExtMapFlag) {
+		if flagIsSet(c, getObjPrefixFlag) {
+			return incorrectUsageMsg(c, errFmtExclusive, qflprn(getExtMapFlag), qflprn(getObjPrefixFlag))
+		}
+		if objName != "" {
+			extMap, err := parseExtMap(c, getExtMapFlag)
+			if err != nil {
+				return err
+			}
+			objName = mapObjExt(objName, extMap)
+		}
+	}
+
+	// GET listed (`--list` or `--list -` to read names from STDIN)
+	if flagIsSet(c, listFlag) {
+		if objName != "" {
+			return fmt.Errorf("object name in %q and %s cannot be used together", uri, qflprn(listFlag))
+		}
+		for _, f := range []cli.Flag{getObjPrefixFlag, objVersionIDFlag, toTarFlag, stripPrefixFlag, flattenFlag, progressFlag} {
+			if flagIsSet(c, f) {
+				return incorrectUsageMsg(c, errFmtExclusive, qflprn(listFlag), qflprn(f))
+			}
+		}
+		return getListObj(c, bck, outFile)
+	}
+
 	// GET multiple
 	if flagIsSet(c, getObjPrefixFlag) {
 		if objName != "" {
 			return fmt.Errorf("object name in %q and %s cannot be used together (hint: use directory as destination)",
 				uri, qflprn(getObjPrefixFlag))
 		}
+		if flagIsSet(c, objVersionIDFlag) {
+			return incorrectUsageMsg(c, "%s cannot be used together with %s", qflprn(objVersionIDFlag), qflprn(getObjPrefixFlag))
+		}
+		if flagIsSet(c, toTarFlag) {
+			if outFile != "" {
+				return incorrectUsageMsg(c, "%s writes to the specified tar - destination %q is not expected",
+					qflprn(toTarFlag), outFile)
+			}
+			for _, f := range []cli.Flag{verifyOnlyFlag, stripPrefixFlag, flattenFlag, archpathOptionalFlag,
+				offsetFlag, lengthFlag, progressFlag} {
+				if flagIsSet(c, f) {
+					return incorrectUsageMsg(c, errFmtExclusive, qflprn(toTarFlag), qflprn(f))
+				}
+			}
+			outFile = parseStrFlag(c, toTarFlag)
+		}
 		return getMultiObj(c, bck, outFile)
 	}
 
 	// GET
+	for _, f := range []cli.Flag{stripPrefixFlag, flattenFlag, maxErrorsFlag, errorRateFlag, toTarFlag} {
+		if flagIsSet(c, f) {
+			return incorrectUsageMsg(c, "%s requires %s", qflprn(f), qflprn(getObjPrefixFlag))
+		}
+	}
+	// GET archived directory (subtree)
+	if archPath := parseStrFlag(c, archpathOptionalFlag); isArchDir(archPath) {
+		if flagIsSet(c, archToDirFlag) {
+			if outFile != "" {
+				return incorrectUsageMsg(c, "destination %q and %s cannot be used together", outFile, qflprn(archToDirFlag))
+			}
+			outFile = parseStrFlag(c, archToDirFlag)
+		}
+		if outFile == fileStdIO || outFile == discardIO {
+			return incorrectUsageMsg(c, "%s ending with '/' requires destination directory", qflprn(archpathOptionalFlag))
+		}
+		for _, f := range []cli.Flag{offsetFlag, lengthFlag, objVersionIDFlag, checkObjCachedFlag, getTimingFlag, progressFlag} {
+			if flagIsSet(c, f) {
+				return incorrectUsageMsg(c, "%s ending with '/' cannot be used together with %s", qflprn(archpathOptionalFlag), qflprn(f))
+			}
+		}
+		return getArchSubtree(c, bck, objName, archPath, outFile)
+	}
+	if flagIsSet(c, archToDirFlag) {
+		return incorrectUsageMsg(c, "%s requires %s ending with '/'", qflprn(archToDirFlag), qflprn(archpathOptionalFlag))
+	}
+
+	var p *cmn.BucketProps
 	if !bck.IsHTTP() {
-		if _, err = headBucket(bck, false /* don't add */); err != nil {
+		if p, err = headBucket(bck, false /* don't add */); err != nil {
+			return err
+		}
+	}
+	if flagIsSet(c, objVersionIDFlag) {
+		if flagIsSet(c, archpathOptionalFlag) || flagIsSet(c, checkObjCachedFlag) {
+			return incorrectUsageMsg(c, "%s cannot be used together with %s or %s",
+				qflprn(objVersionIDFlag), qflprn(archpathOptionalFlag), qflprn(checkObjCachedFlag))
+		}
+		if err := checkVersioning(bck, p); err != nil {
 			return err
 		}
 	}
-	return getObject(c, bck, objName, outFile, false /*silent*/)
+	return getObject(c, bck, objName, outFile, false /*silent*/, newFailover(c, p))
+}
+
+// retry failed GET against alternate targets (see api.Failover) -
+// only makes sense when the object can be restored elsewhere
+func newFailover(c *cli.Context, p *cmn.BucketProps) *api.Failover {
+	if flagIsSet(c, noFailoverFlag) || p == nil || !p.EC.Enabled {
+		return nil
+	}
+	return &api.Failover{NumAlt: p.EC.ParitySlices}
 }
 
 func getMultiObj(c *cli.Context, bck cmn.Bck, outFile string) error {
@@ -70,6 +226,10 @@ func getMultiObj(c *cli.Context, bck cmn.Bck, outFile string) error {
 		msg      = &apc.LsoMsg{Prefix: prefix}
 		listArch = flagIsSet(c, listArchFlag) // archived content
 	)
+	elim, err := parseErrLimits(c)
+	if err != nil {
+		return err
+	}
 	// setup list-objects msg and call
 	msg.AddProps(apc.GetPropsMinimal...)
 	if listArch {
@@ -89,9 +249,21 @@ func getMultiObj(c *cli.Context, bck cmn.Bck, outFile string) error {
 	if err != nil {
 		return err
 	}
+	if !flagIsSet(c, noIgnoreFlag) {
+		if objList.Entries, err = ignoreEntries(c, objList.Entries, outFile); err != nil {
+			return err
+		}
+	}
+	if flagIsSet(c, toTarFlag) {
+		return getMultiTar(c, bck, objList.Entries, outFile)
+	}
+
 	// can't do many to one
-	l := len(objList.Entries)
-	if l > 1 {
+	var (
+		l          = len(objList.Entries)
+		verifyOnly = flagIsSet(c, verifyOnlyFlag)
+	)
+	if l > 1 && !verifyOnly {
 		if outFile != "" && outFile != fileStdIO && outFile != discardIO {
 			finfo, errEx := os.Stat(outFile)
 			// destination directory must exist
@@ -100,6 +272,17 @@ func getMultiObj(c *cli.Context, bck cmn.Bck, outFile string) error {
 			}
 		}
 	}
+	// --strip-prefix, --flatten
+	var (
+		dsts    map[string]string
+		skipped []string
+	)
+	if flagIsSet(c, stripPrefixFlag) || flagIsSet(c, flattenFlag) {
+		if dsts, skipped, err = getMultiDsts(c, objList.Entries, prefix, outFile); err != nil {
+			return err
+		}
+	}
+
 	// total size
 	var totalSize int64
 	for _, entry := range objList.Entries {
@@ -115,6 +298,10 @@ func getMultiObj(c *cli.Context, bck cmn.Bck, outFile string) error {
 	}
 	cptn := fmt.Sprintf("GET %d object%s from %s to %s (total size %s)",
 		l, cos.Plural(l), bck.Cname(""), outFile, teb.FmtSize(totalSize, units, 2))
+	if verifyOnly {
+		cptn = fmt.Sprintf("Verify %d object%s from %s (total size %s)",
+			l, cos.Plural(l), bck.Cname(""), teb.FmtSize(totalSize, units, 2))
+	}
 	if flagIsSet(c, yesFlag) && (l > 1 || silent) {
 		fmt.Fprintln(c.App.Writer, cptn)
 	} else if ok := confirm(c, cptn); !ok {
@@ -124,6 +311,7 @@ func getMultiObj(c *cli.Context, bck cmn.Bck, outFile string) error {
 	u := &uctx{
 		showProgress: flagIsSet(c, progressFlag),
 		wg:           cos.NewLimitedWaitGroup(4, 0),
+		elim:         elim,
 	}
 	if u.showProgress {
 		var (
@@ -143,32 +331,248 @@ func getMultiObj(c *cli.Context, bck cmn.Bck, outFile string) error {
 		u.barObjs = totalBars[0]
 		u.barSize = totalBars[1]
 	}
+	for _, name := range skipped {
+		actionWarn(c, fmt.Sprintf("skipping %s: cannot be written inside destination directory", bck.Cname(name)))
+		u.errCount.Inc()
+		u.processedCnt.Inc()
+	}
+	u.checkErrLimits()
 	for _, entry := range objList.Entries {
+		if u.aborted.Load() {
+			break
+		}
+		dst := outFile
+		if dsts != nil {
+			var ok bool
+			if dst, ok = dsts[entry.Name]; !ok {
+				continue // skipped or overwritten (see '--flatten')
+			}
+			if err := cos.CreateDir(filepath.Dir(dst)); err != nil {
+				return err
+			}
+		}
 		u.wg.Add(1)
-		go u.get(c, bck, entry.Name, outFile, entry.Size, silent)
+		go u.get(c, bck, entry.Name, dst, entry.Size, silent)
 	}
 	u.wg.Wait()
 
 	if u.showProgress {
+		if u.aborted.Load() {
+			u.barObjs.Abort(false)
+			u.barSize.Abort(false)
+		}
 		u.progress.Wait()
 		fmt.Fprint(c.App.Writer, u.errSb.String())
 	}
-	if numFailed := u.errCount.Load(); numFailed > 0 {
+	if u.aborted.Load() {
+		verb := "GET"
+		if verifyOnly {
+			verb = "Verification"
+		}
+		return u.abortErr(verb, l)
+	}
+	numFailed := u.errCount.Load()
+	switch {
+	case numFailed > 0 && verifyOnly:
+		return fmt.Errorf("%d out of %d object%s failed verification", numFailed, l, cos.Plural(l))
+	case numFailed > 0:
 		return fmt.Errorf("failed to GET %d object%s", numFailed, cos.Plural(int(numFailed)))
+	case verifyOnly:
+		actionDone(c, fmt.Sprintf("Verified %d object%s: all OK", l, cos.Plural(l)))
 	}
 	return nil
 }
 
+// names are streamed to the workers as they are read (see `stdinList`)
+func getListObj(c *cli.Context, bck cmn.Bck, outDir string) error {
+	elim, err := parseErrLimits(c)
+	if err != nil {
+		return err
+	}
+	verifyOnly := flagIsSet(c, verifyOnlyFlag)
+	if !verifyOnly && outDir != discardIO {
+		if outDir == "" {
+			outDir = "."
+		}
+		if finfo, errV := os.Stat(outDir); errV != nil || !finfo.IsDir() {
+			return fmt.Errorf("destination %q must be an existing directory", outDir)
+		}
+	}
+	var (
+		sl     *stdinList
+		extMap cos.StrKVs
+		u      = &uctx{wg: cos.NewLimitedWaitGroup(4, 0), elim: elim}
+	)
+	if flagIsSet(c, getExtMapFlag) {
+		if extMap, err = parseExtMap(c, getExtMapFlag); err != nil {
+			return err
+		}
+	}
+	if isStdinList(c) {
+		sl = newStdinList(os.Stdin, stdinListBatch)
+	} else {
+		sl = newStdinList(strings.NewReader(strings.ReplaceAll(parseStrFlag(c, listFlag), ",", "\n")), stdinListBatch)
+	}
+	for !u.aborted.Load() {
+		names, err := sl.next()
+		if err != nil {
+			u.wg.Wait()
+			return err
+		}
+		if len(names) == 0 {
+			break
+		}
+		for _, name := range names {
+			if u.aborted.Load() {
+				break
+			}
+			if extMap != nil {
+				name = mapObjExt(name, extMap)
+			}
+			u.wg.Add(1)
+			go u.get(c, bck, name, outDir, 0 /*size*/, !flagIsSet(c, verboseFlag))
+		}
+	}
+	u.wg.Wait()
+
+	n := int(u.processedCnt.Load())
+	if u.aborted.Load() {
+		return u.abortErr("GET", n)
+	}
+	if numFailed := int(u.errCount.Load()); numFailed > 0 {
+		return fmt.Errorf("failed to GET %d (out of %d) object%s", numFailed, n, cos.Plural(n))
+	}
+	if n == 0 {
+		return errors.New("no object names to GET")
+	}
+	if verifyOnly {
+		actionDone(c, fmt.Sprintf("Verified %d object%s: all OK", n, cos.Plural(n)))
+	} else if outDir != discardIO {
+		actionDone(c, fmt.Sprintf("GET %d object%s from %s to %s", n, cos.Plural(n), bck.Cname(""), outDir))
+	}
+	return nil
+}
+
+func getMultiTar(c *cli.Context, bck cmn.Bck, entries cmn.LsoEntries, outFile string) error {
+	l := len(entries)
+	if l == 0 {
+		return fmt.Errorf("no objects matching %q in %s", parseStrFlag(c, getObjPrefixFlag), bck.Cname(""))
+	}
+	// (STDOUT is the tar stream - no prompts)
+	if outFile != fileStdIO {
+		var totalSize int64
+		for _, entry := range entries {
+			totalSize += entry.Size
+		}
+		units, err := parseUnitsFlag(c, unitsFlag)
+		if err != nil {
+			return err
+		}
+		cptn := fmt.Sprintf("GET %d object%s from %s into %s (total size %s)",
+			l, cos.Plural(l), bck.Cname(""), outFile, teb.FmtSize(totalSize, units, 2))
+		if flagIsSet(c, yesFlag) {
+			fmt.Fprintln(c.App.Writer, cptn)
+		} else if ok := confirm(c, cptn); !ok {
+			return nil
+		}
+	}
+	return getToTar(c, bck, entries, outFile)
+}
+
+// --strip-prefix: remove the matched prefix and preserve the rest of the object's (virtual) path;
+// --flatten: drop all directory structure and write objects by their base names
+// (name collisions are errors unless '--overwrite-dst', in which case the last listed object wins)
+// Returns destination pathnames (within `outDir`) indexed by object names, and
+// the names of the objects that would otherwise be written outside `outDir`.
+func getMultiDsts(c *cli.Context, entries cmn.LsoEntries, prefix, outDir string) (dsts map[string]string,
+	skipped []string, err error) {
+	flatten := flagIsSet(c, flattenFlag)
+	if flatten && flagIsSet(c, stripPrefixFlag) {
+		return nil, nil, incorrectUsageMsg(c, errFmtExclusive, qflprn(flattenFlag), qflprn(stripPrefixFlag))
+	}
+	if outDir == fileStdIO || outDir == discardIO {
+		return nil, nil, incorrectUsageMsg(c, "%s and %s require destination directory", qflprn(flattenFlag), qflprn(stripPrefixFlag))
+	}
+	if outDir == "" {
+		outDir = "."
+	}
+	if finfo, errV := os.Stat(outDir); errV != nil || !finfo.IsDir() {
+		return nil, nil, fmt.Errorf("destination %q must be an existing directory", outDir)
+	}
+	names := make([]string, len(entries))
+	for i, en := range entries {
+		names[i] = en.Name
+	}
+	var rels map[string]string
+	rels, skipped, err = localRelPaths(names, prefix, flatten, flagIsSet(c, overwriteFlag))
+	if err != nil {
+		if flatten {
+			err = fmt.Errorf("%v (use %s to overwrite)", err, qflprn(overwriteFlag))
+		}
+		return nil, nil, err
+	}
+	dsts = make(map[string]string, len(rels))
+	for name, rel := range rels {
+		dsts[name] = filepath.Join(outDir, rel)
+	}
+	return dsts, skipped, nil
+}
+
+func localRelPaths(names []string, prefix string, flatten, overwrite bool) (rels map[string]string, skipped []string, err error) {
+	taken := make(map[string]string, len(names)) // relative path => object name
+	rels = make(map[string]string, len(names))
+	for _, name := range names {
+		var rel string
+		if flatten {
+			rel = path.Base(name)
+		} else {
+			rel = strings.TrimLeft(strings.TrimPrefix(name, prefix), "/")
+			if rel == "" {
+				rel = path.Base(name)
+			}
+		}
+		if rel, err = sanitizeRelPath(rel); err != nil {
+			skipped = append(skipped, name)
+			err = nil
+			continue
+		}
+		if prev, ok := taken[rel]; ok {
+			if !overwrite {
+				return nil, nil, fmt.Errorf("name collision: objects %q and %q map to the same local name %q", prev, name, rel)
+			}
+			delete(rels, prev)
+		}
+		taken[rel] = name
+		rels[name] = rel
+	}
+	return rels, skipped, nil
+}
+
+// object names may contain anything - make sure the resulting local path
+// is relative and does not escape its root (no `../`)
+func sanitizeRelPath(rel string) (string, error) {
+	if strings.HasPrefix(rel, "/") || filepath.IsAbs(rel) {
+		return "", fmt.Errorf("absolute path %q", rel)
+	}
+	clean := filepath.Clean(filepath.FromSlash(rel))
+	if clean == "." || clean == ".." || strings.HasPrefix(clean, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("invalid path %q", rel)
+	}
+	return clean, nil
+}
+
 //////////
 // uctx - "get" extension
 //////////
 
 func (u *uctx) get(c *cli.Context, bck cmn.Bck, objName, outFile string, size int64, silent bool) {
 	defer u.wg.Done()
-	err := getObject(c, bck, objName, outFile, silent)
+	err := getObject(c, bck, objName, outFile, silent, nil)
 	if err != nil {
 		u.errCount.Inc()
 	}
+	u.processedCnt.Inc()
+	u.checkErrLimits()
 	if u.showProgress {
 		u.barObjs.IncrInt64(1)
 		u.barSize.IncrInt64(size)
@@ -180,17 +584,23 @@ func (u *uctx) get(c *cli.Context, bck cmn.Bck, objName, outFile string, size in
 	}
 }
 
-func getObject(c *cli.Context, bck cmn.Bck, objName, outFile string, silent bool) (err error) {
+func getObject(c *cli.Context, bck cmn.Bck, objName, outFile string, silent bool, fo *api.Failover) (err error) {
 	var (
 		getArgs api.GetArgs
 		oah     api.ObjAttrs
 		units   string
+		bp      = apiBP
+		rt      *api.ReqTiming
 	)
 	// just check if a remote object is present (do not GET)
 	// TODO: archived files
 	if flagIsSet(c, checkObjCachedFlag) {
 		return isObjPresent(c, bck, objName)
 	}
+	// read and validate (do not write)
+	if flagIsSet(c, verifyOnlyFlag) {
+		return verifyObject(c, bck, objName, silent)
+	}
 
 	var offset, length int64
 	units, err = parseUnitsFlag(c, unitsFlag)
@@ -236,9 +646,11 @@ func getObject(c *cli.Context, bck cmn.Bck, objName, outFile string, silent bool
 		}
 	}
 
+	var sw *stdoutW
 	hdr := cmn.MakeRangeHdr(offset, length)
 	if outFile == fileStdIO {
-		getArgs = api.GetArgs{Writer: os.Stdout, Header: hdr}
+		sw = newStdoutW()
+		getArgs = api.GetArgs{Writer: sw, Header: hdr}
 		silent = true
 	} else {
 		var file *os.File
@@ -266,19 +678,70 @@ func getObject(c *cli.Context, bck cmn.Bck, objName, outFile string, silent bool
 		}
 		getArgs.Query.Set(apc.QparamArchpath, archPath)
 	}
+	vid := parseStrFlag(c, objVersionIDFlag)
+	if vid != "" {
+		if getArgs.Query == nil {
+			getArgs.Query = make(url.Values, 1)
+		}
+		getArgs.Query.Set(apc.QparamVersionID, vid)
+	}
 
-	if flagIsSet(c, cksumFlag) {
-		oah, err = api.GetObjectWithValidation(apiBP, bck, objName, &getArgs)
-	} else {
-		oah, err = api.GetObject(apiBP, bck, objName, &getArgs)
+	if flagIsSet(c, getTimingFlag) {
+		rt = &api.ReqTiming{}
+		bp.Ctx = rt.Trace(bp.Ctx)
+	}
+	switch {
+	case fo != nil:
+		fo.Validate = flagIsSet(c, cksumFlag)
+		oah, err = api.GetObjectFailover(bp, bck, objName, &getArgs, fo)
+		if err == nil && fo.Served != nil {
+			actionWarn(c, fmt.Sprintf("GET %s: designated target failed - served by %s", bck.Cname(objName), fo.Served.StringEx()))
+		}
+	case flagIsSet(c, cksumFlag):
+		oah, err = api.GetObjectWithValidation(bp, bck, objName, &getArgs)
+	default:
+		oah, err = api.GetObject(bp, bck, objName, &getArgs)
+	}
+	if sw != nil && sw.closed {
+		return nil // (and the body is closed)
 	}
 	if err != nil {
-		if cmn.IsStatusNotFound(err) && archPath == "" {
-			err = fmt.Errorf("%q does not exist", bck.Cname(objName))
+		switch {
+		case cmn.IsStatusNotFound(err) && vid != "":
+			err = cmn.NewErrNotFound("%q version %q", bck.Cname(objName), vid)
+		case cmn.IsStatusNotFound(err) && archPath == "":
+			err = cmn.NewErrNotFound("%q", bck.Cname(objName))
+		case vid != "":
+			err = versioningErr(bck, err)
 		}
 		return
 	}
 	objLen := oah.Size()
+	if rt != nil {
+		rt.Done()
+		// (when writing object to STDOUT, timing goes to STDERR)
+		w := c.App.Writer
+		if outFile == fileStdIO {
+			w = c.App.ErrWriter
+		}
+		defer func() {
+			if err == nil {
+				err = printReqTiming(c, w, rt)
+			}
+		}()
+		silent = silent || flagIsSet(c, jsonFlag)
+	}
+
+	if flagIsSet(c, restoreAttrsFlag) {
+		switch {
+		case outFile == fileStdIO || outFile == discardIO:
+		case archPath != "":
+			actionWarn(c, fmt.Sprintf("%s does not apply to archived files - ignoring", qflprn(restoreAttrsFlag)))
+		default:
+			attrs := oah.Attrs()
+			restoreSrcAttrs(c, attrs.GetCustomMD(), outFile)
+		}
+	}
 
 	// print result (variations)
 	sz := teb.FmtSize(objLen, units, 2)
@@ -302,8 +765,281 @@ func getObject(c *cli.Context, bck cmn.Bck, objName, outFile string, silent bool
 	if archPath != "" {
 		fmt.Fprintf(c.App.Writer, "GET %q from archive %q as %q (size %s)\n",
 			archPath, bck.Cname(objName), outFile, sz)
+	} else if vid != "" {
+		fmt.Fprintf(c.App.Writer, "GET %q (version %s) from %s as %q (size %s)\n", objName, vid, bn, outFile, sz)
 	} else {
 		fmt.Fprintf(c.App.Writer, "GET %q from %s as %q (size %s)\n", objName, bn, outFile, sz)
 	}
 	return
 }
+
+// --timing (see also: api.ReqTiming)
+func printReqTiming(c *cli.Context, w io.Writer, rt *api.ReqTiming) error {
+	if flagIsSet(c, jsonFlag) {
+		b, err := jsoniter.MarshalIndent(rt, "", "    ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(w, string(b))
+		return err
+	}
+	var (
+		tw    = &tabwriter.Writer{}
+		fmtd  = func(d time.Duration) string { return d.Round(time.Microsecond).String() }
+		conns = fmt.Sprintf("%d new, %d reused connection%s", rt.Conns, rt.Reused, cos.Plural(rt.Conns+rt.Reused))
+	)
+	tw.Init(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintf(tw, "DNS:\t%s\n", fmtd(rt.DNS))
+	fmt.Fprintf(tw, "Connect:\t%s\t(%s)\n", fmtd(rt.Connect), conns)
+	fmt.Fprintf(tw, "TLS:\t%s\n", fmtd(rt.TLS))
+	if rt.Redirect > 0 {
+		fmt.Fprintf(tw, "Redirect:\t%s\t(proxy => target)\n", fmtd(rt.Redirect))
+	}
+	fmt.Fprintf(tw, "TTFB:\t%s\n", fmtd(rt.TTFB))
+	fmt.Fprintf(tw, "Transfer:\t%s\n", fmtd(rt.Transfer))
+	fmt.Fprintf(tw, "Total:\t%s\n", fmtd(rt.Total))
+	return tw.Flush()
+}
+
+// --restore-attrs: best-effort (see also: srcAttrsMD)
+func restoreSrcAttrs(c *cli.Context, md cos.StrKVs, outFile string) {
+	sa, err := parseSrcAttrsMD(md)
+	if err != nil {
+		actionWarn(c, fmt.Sprintf("cannot restore %q attributes: %v", outFile, err))
+		return
+	}
+	if sa.mtime.IsZero() && !sa.hasMode {
+		actionWarn(c, fmt.Sprintf("%q: no stored source attributes to restore (hint: 'ais put %s')",
+			outFile, flprn(preserveAttrsFlag)))
+		return
+	}
+	if sa.hasMode {
+		if err := os.Chmod(outFile, sa.mode); err != nil {
+			actionWarn(c, fmt.Sprintf("failed to set %q permissions (%s): %v", outFile, sa.mode, err))
+		}
+	}
+	if !sa.mtime.IsZero() {
+		if err := os.Chtimes(outFile, sa.mtime, sa.mtime); err != nil {
+			actionWarn(c, fmt.Sprintf("failed to set %q modification time: %v", outFile, err))
+		}
+	}
+}
+
+// --verify-only: compute checksum of the object's content as it is being read
+// (and discarded), and compare with the one stored in the object's metadata
+func verifyObject(c *cli.Context, bck cmn.Bck, objName string, silent bool) error {
+	props, err := api.HeadObject(apiBP, bck, objName, apc.FltPresent)
+	if err != nil {
+		if cmn.IsStatusNotFound(err) {
+			err = cmn.NewErrNotFound("%q", bck.Cname(objName))
+		}
+		return err
+	}
+	stored := props.Cksum
+	if stored.IsEmpty() {
+		return fmt.Errorf("%q: cannot verify - no stored checksum", bck.Cname(objName))
+	}
+
+	var (
+		cksum = cos.NewCksumHash(stored.Ty())
+		wrap  = cos.ReadWrapperFunc(func(r io.ReadCloser) io.ReadCloser {
+			return &cksumReader{r: r, h: cksum.H}
+		})
+	)
+	r, err := api.GetObjectReader(apiBP, bck, objName, nil)
+	if err != nil {
+		return err
+	}
+	r = wrap(r)
+	n, err := io.Copy(io.Discard, r)
+	r.Close()
+	if err != nil {
+		return fmt.Errorf("%q: failed to read: %v", bck.Cname(objName), err)
+	}
+
+	// report the first mismatch (size, checksum)
+	if n != props.Size {
+		return fmt.Errorf("%q: FAIL - read %d bytes, expected size %d", bck.Cname(objName), n, props.Size)
+	}
+	cksum.Finalize()
+	if !cksum.Equal(stored) {
+		return fmt.Errorf("%q: FAIL - %v", bck.Cname(objName),
+			cos.NewBadDataCksumError(&cksum.Cksum, stored))
+	}
+	if !silent {
+		fmt.Fprintf(c.App.Writer, "%q: OK (%s)\n", bck.Cname(objName), stored)
+	}
+	return nil
+}
+
+type cksumReader struct {
+	r io.ReadCloser
+	h io.Writer
+}
+
+func (cr *cksumReader) Read(b []byte) (n int, err error) {
+	n, err = cr.r.Read(b)
+	if n > 0 {
+		cr.h.Write(b[:n])
+	}
+	return
+}
+
+func (cr *cksumReader) Close() error { return cr.r.Close() }
+
+//
+// cat with line (record) selection: --head, --tail, --records
+//
+
+// one-based and inclusive; zero `last` means "through the end"
+type lineSel struct {
+	first, last int64
+	tail        int
+}
+
+func parseLineSel(c *cli.Context) (sel lineSel, err error) {
+	var cnt int
+	for _, f := range []cli.Flag{headFlag, tailFlag, recordsFlag} {
+		if flagIsSet(c, f) {
+			cnt++
+		}
+	}
+	if cnt > 1 {
+		err = fmt.Errorf("%s, %s, and %s options are mutually exclusive", qflprn(headFlag), qflprn(tailFlag), qflprn(recordsFlag))
+		return
+	}
+	switch {
+	case flagIsSet(c, headFlag):
+		n := parseIntFlag(c, headFlag)
+		if n <= 0 {
+			err = fmt.Errorf("invalid %s=%d (expecting a positive number of lines)", flprn(headFlag), n)
+			return
+		}
+		sel.first, sel.last = 1, int64(n)
+	case flagIsSet(c, tailFlag):
+		n := parseIntFlag(c, tailFlag)
+		if n <= 0 {
+			err = fmt.Errorf("invalid %s=%d (expecting a positive number of lines)", flprn(tailFlag), n)
+			return
+		}
+		sel.tail = n
+	default:
+		sel.first, sel.last, err = parseRecords(parseStrFlag(c, recordsFlag))
+	}
+	return
+}
+
+// "A-B" or "A-" (open-ended)
+func parseRecords(s string) (first, last int64, err error) {
+	a, b, ok := strings.Cut(strings.TrimSpace(s), "-")
+	if !ok {
+		err = fmt.Errorf("invalid %s=%q (expecting A-B or A-)", flprn(recordsFlag), s)
+		return
+	}
+	if first, err = strconv.ParseInt(strings.TrimSpace(a), 10, 64); err != nil || first < 1 {
+		err = fmt.Errorf("invalid %s=%q: first record must be a positive (one-based) number", flprn(recordsFlag), s)
+		return
+	}
+	if b = strings.TrimSpace(b); b == "" {
+		return // through the end
+	}
+	if last, err = strconv.ParseInt(b, 10, 64); err != nil || last < first {
+		err = fmt.Errorf("invalid %s=%q: last record must be greater or equal the first", flprn(recordsFlag), s)
+	}
+	return
+}
+
+func catLines(c *cli.Context, bck cmn.Bck, objName string) error {
+	sel, err := parseLineSel(c)
+	if err != nil {
+		return err
+	}
+	if flagIsSet(c, cksumFlag) {
+		return fmt.Errorf("%s cannot be used when selecting lines (the content is not read in its entirety)",
+			qflprn(cksumFlag))
+	}
+	if flagIsSet(c, lengthFlag) != flagIsSet(c, offsetFlag) {
+		return incorrectUsageMsg(c, "%q and %q flags both need to be set", lengthFlag.Name, offsetFlag.Name)
+	}
+	units, err := parseUnitsFlag(c, unitsFlag)
+	if err != nil {
+		return err
+	}
+	offset, err := parseSizeFlag(c, offsetFlag, units)
+	if err != nil {
+		return err
+	}
+	length, err := parseSizeFlag(c, lengthFlag, units)
+	if err != nil {
+		return err
+	}
+	getArgs := api.GetArgs{Header: cmn.MakeRangeHdr(offset, length)}
+	if archPath := parseStrFlag(c, archpathOptionalFlag); archPath != "" {
+		getArgs.Query = url.Values{apc.QparamArchpath: []string{archPath}}
+	}
+
+	r, err := api.GetObjectReader(apiBP, bck, objName, &getArgs)
+	if err != nil {
+		if cmn.IsStatusNotFound(err) {
+			err = cmn.NewErrNotFound("%q", bck.Cname(objName))
+		}
+		return err
+	}
+	// NOTE: closing the body early (--head, --records A-B) terminates the transfer
+	defer r.Close()
+
+	var (
+		sw = newStdoutW()
+		w  = bufio.NewWriter(sw)
+	)
+	if err = copyLines(w, r, sel); err == nil {
+		err = w.Flush()
+	}
+	return sw.done(err)
+}
+
+func copyLines(w io.Writer, r io.Reader, sel lineSel) error {
+	var (
+		br   = bufio.NewReader(r)
+		ring [][]byte // --tail (circular: the oldest line is at ring[head] once full)
+		head int
+		num  int64
+	)
+	if sel.tail > 0 {
+		ring = make([][]byte, 0, sel.tail)
+	}
+	for {
+		line, err := br.ReadBytes('\n')
+		if len(line) > 0 {
+			num++
+			switch {
+			case sel.tail > 0:
+				if len(ring) < sel.tail {
+					ring = append(ring, line)
+				} else {
+					ring[head] = line
+					head = (head + 1) % sel.tail
+				}
+			case num >= sel.first:
+				if _, errW := w.Write(line); errW != nil {
+					return errW
+				}
+				if sel.last > 0 && num >= sel.last {
+					return nil // done reading
+				}
+			}
+		}
+		if err != nil {
+			if !errors.Is(err, io.EOF) {
+				return err
+			}
+			break
+		}
+	}
+	for i := range ring {
+		if _, err := w.Write(ring[(head+i)%len(ring)]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
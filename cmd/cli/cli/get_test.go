@@ -0,0 +1,187 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"syscall"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/tools/tassert"
+	jsoniter "github.com/json-iterator/go"
+)
+
+func TestCopyLines(t *testing.T) {
+	const text = "1\n2\n3\n4\n5\n6"
+	tests := []struct {
+		sel      lineSel
+		expected string
+	}{
+		{sel: lineSel{first: 1, last: 2}, expected: "1\n2\n"},
+		{sel: lineSel{first: 1, last: 100}, expected: text},
+		{sel: lineSel{first: 3, last: 4}, expected: "3\n4\n"},
+		{sel: lineSel{first: 5}, expected: "5\n6"},
+		{sel: lineSel{first: 7}, expected: ""},
+		{sel: lineSel{tail: 2}, expected: "5\n6"},
+		{sel: lineSel{tail: 4}, expected: "3\n4\n5\n6"},
+		{sel: lineSel{tail: 6}, expected: text},
+		{sel: lineSel{tail: 10}, expected: text},
+	}
+	for _, test := range tests {
+		var w bytes.Buffer
+		err := copyLines(&w, strings.NewReader(text), test.sel)
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, w.String() == test.expected, "%+v: expected %q, got %q", test.sel, test.expected, w.String())
+	}
+}
+
+// accepts up to `limit` bytes, and then fails the way a closed pipe (e.g. `| head`) does
+type closingWriter struct {
+	err   error
+	n     int
+	limit int
+}
+
+func (w *closingWriter) Write(p []byte) (int, error) {
+	if w.n+len(p) > w.limit {
+		return 0, w.err
+	}
+	w.n += len(p)
+	return len(p), nil
+}
+
+func TestStdoutClosedEarly(t *testing.T) {
+	var (
+		text  = strings.Repeat("0123456789abcdef\n", 64*1024) // 1MiB
+		epipe = &os.PathError{Op: "write", Path: "/dev/stdout", Err: syscall.EPIPE}
+	)
+	for _, werr := range []error{epipe, errors.New("disk full")} {
+		var (
+			r  = &countingReader{r: strings.NewReader(text)}
+			sw = &stdoutW{w: &closingWriter{err: werr, limit: 4096}}
+			w  = bufio.NewWriter(sw)
+		)
+		err := copyLines(w, r, lineSel{first: 1})
+		if err == nil {
+			err = w.Flush()
+		}
+		err = sw.done(err)
+		if werr == epipe {
+			tassert.CheckFatal(t, err)
+			tassert.Errorf(t, sw.closed, "expected closed stdout")
+			tassert.Errorf(t, r.n < len(text), "expected to stop reading early (read %d)", r.n)
+		} else {
+			tassert.Errorf(t, errors.Is(err, werr), "expected %v, got %v", werr, err)
+			tassert.Errorf(t, !sw.closed, "unexpected closed stdout")
+		}
+	}
+}
+
+type countingReader struct {
+	r io.Reader
+	n int
+}
+
+func (r *countingReader) Read(p []byte) (n int, err error) {
+	n, err = r.r.Read(p)
+	r.n += n
+	return
+}
+
+func TestParseRecords(t *testing.T) {
+	positiveTests := []struct {
+		s           string
+		first, last int64
+	}{
+		{"1-10", 1, 10},
+		{"5-5", 5, 5},
+		{" 100 - 200 ", 100, 200},
+		{"7-", 7, 0},
+	}
+	for _, test := range positiveTests {
+		first, last, err := parseRecords(test.s)
+		tassert.Errorf(t, err == nil, "failed on %q with err: %v", test.s, err)
+		tassert.Errorf(t, first == test.first && last == test.last, "%q: expected %d-%d, got %d-%d",
+			test.s, test.first, test.last, first, last)
+	}
+	for _, s := range []string{"", "10", "0-5", "-5", "a-b", "10-5"} {
+		_, _, err := parseRecords(s)
+		tassert.Errorf(t, err != nil, "expected error on %q", s)
+	}
+}
+
+func TestLocalRelPaths(t *testing.T) {
+	names := []string{"a/b/c/d", "a/b/e", "a/b/../../../etc/passwd", "a/b/x/e"}
+	rels, skipped, err := localRelPaths(names, "a/b/", false /*flatten*/, false)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, reflect.DeepEqual(skipped, []string{"a/b/../../../etc/passwd"}), "unexpected skipped %v", skipped)
+	tassert.Errorf(t, rels["a/b/c/d"] == filepath.Join("c", "d") && rels["a/b/e"] == "e", "unexpected %v", rels)
+
+	_, _, err = localRelPaths(names, "a/b/", true /*flatten*/, false)
+	tassert.Errorf(t, err != nil, "expected name collision (%q vs %q)", names[1], names[3])
+
+	rels, _, err = localRelPaths(names, "a/b/", true /*flatten*/, true /*overwrite*/)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, rels["a/b/x/e"] == "e", "expected the last one to win, got %v", rels)
+	_, ok := rels["a/b/e"]
+	tassert.Errorf(t, !ok, "expected %q to be overwritten", "a/b/e")
+	tassert.Errorf(t, rels["a/b/../../../etc/passwd"] == "passwd", "unexpected %v", rels)
+
+	for _, rel := range []string{"/etc/passwd", "..", "../x", "x/../../y", "."} {
+		_, err := sanitizeRelPath(rel)
+		tassert.Errorf(t, err != nil, "expected %q to be rejected", rel)
+	}
+}
+
+func TestReqTiming(t *testing.T) {
+	const body = "0123456789"
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		io.WriteString(w, body)
+	}))
+	defer target.Close()
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, target.URL+r.URL.Path, http.StatusTemporaryRedirect)
+	}))
+	defer proxy.Close()
+
+	var (
+		rt  = &api.ReqTiming{}
+		bp  = api.BaseParams{Client: &http.Client{}, URL: proxy.URL}
+		out bytes.Buffer
+	)
+	bp.Ctx = rt.Trace(bp.Ctx)
+	oah, err := api.GetObject(bp, cmn.Bck{Name: "b", Provider: apc.AIS}, "o", &api.GetArgs{Writer: &out})
+	tassert.CheckFatal(t, err)
+	rt.Done()
+	tassert.Errorf(t, oah.Size() == int64(len(body)) && out.String() == body, "unexpected body %q", out.String())
+	tassert.Errorf(t, rt.Requests == 2 && rt.Conns == 2, "expecting 2 requests over 2 connections, got %d, %d", rt.Requests, rt.Conns)
+	tassert.Errorf(t, rt.Redirect > 0 && rt.Redirect <= rt.TTFB && rt.TTFB <= rt.Total, "inconsistent timing: %+v", rt)
+	tassert.Errorf(t, rt.TTFB+rt.Transfer == rt.Total, "ttfb %v + transfer %v != total %v", rt.TTFB, rt.Transfer, rt.Total)
+
+	// machine-readable
+	var (
+		fset = flag.NewFlagSet("test", flag.ContinueOnError)
+		jout bytes.Buffer
+	)
+	fset.Bool(fl1n(jsonFlag.Name), true, "")
+	c := newTestContext(fset)
+	tassert.CheckFatal(t, printReqTiming(c, &jout, rt))
+	var parsed map[string]int64
+	tassert.CheckFatal(t, jsoniter.Unmarshal(jout.Bytes(), &parsed))
+	tassert.Errorf(t, parsed["total"] == int64(rt.Total) && parsed["requests"] == 2, "unexpected JSON %s", jout.String())
+}
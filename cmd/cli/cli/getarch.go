@@ -0,0 +1,242 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+// This file implements `ais get --archpath DIR/` - extracting archived subtree into local directory.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/NVIDIA/aistore/api"
+	"github.com/NVIDIA/aistore/cmd/cli/teb"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/klauspost/compress/zstd"
+	"github.com/urfave/cli"
+)
+
+// archived files under a given prefix => local directory
+type archSubtree struct {
+	prefix  string // archived "directory", with trailing '/'
+	outDir  string
+	skipped []string // members that would otherwise be written outside `outDir` (and symlinks, etc.)
+	cnt     int
+	size    int64
+}
+
+func isArchDir(archPath string) bool { return strings.HasSuffix(archPath, "/") }
+
+// GET the archive once (streaming, unless zip) and write all members under the prefix
+func getArchSubtree(c *cli.Context, bck cmn.Bck, archName, archPrefix, outDir string) error {
+	mime, err := cos.Mime("", archName)
+	if err != nil {
+		return err
+	}
+	units, err := parseUnitsFlag(c, unitsFlag)
+	if err != nil {
+		return err
+	}
+	if outDir == "" {
+		outDir = "."
+	}
+	if finfo, err := os.Stat(outDir); err == nil && !finfo.IsDir() {
+		return fmt.Errorf("destination %q is not a directory", outDir)
+	}
+	if err := cos.CreateDir(outDir); err != nil {
+		return err
+	}
+	sub := &archSubtree{prefix: strings.TrimPrefix(archPrefix, "/"), outDir: outDir}
+
+	if mime == cos.ExtZip {
+		err = sub.getZip(bck, archName)
+	} else {
+		err = sub.getTar(bck, archName, mime)
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, name := range sub.skipped {
+		actionWarn(c, fmt.Sprintf("skipping %q (not a regular file or directory, or outside %q)", name, outDir))
+	}
+	if sub.cnt == 0 {
+		return fmt.Errorf("%s: no archived files under %q", bck.Cname(archName), archPrefix)
+	}
+	fmt.Fprintf(c.App.Writer, "GET %d archived file%s (total size %s) under %q from %q => %q\n", sub.cnt, cos.Plural(sub.cnt),
+		teb.FmtSize(sub.size, units, 2), archPrefix, bck.Cname(archName), outDir)
+	return nil
+}
+
+func (sub *archSubtree) getTar(bck cmn.Bck, archName, mime string) error {
+	var (
+		pr, pw = io.Pipe()
+		errCh  = make(chan error, 1)
+	)
+	go func() {
+		_, err := api.GetObject(apiBP, bck, archName, &api.GetArgs{Writer: pw})
+		pw.CloseWithError(err)
+		errCh <- err
+	}()
+	err := sub.untar(pr, mime)
+	if err == nil {
+		_, err = io.Copy(io.Discard, pr) // (tar trailer and padding)
+	}
+	pr.CloseWithError(err)
+	errGet := <-errCh
+	if err != nil {
+		return err
+	}
+	return errGet
+}
+
+func (sub *archSubtree) untar(r io.Reader, mime string) error {
+	switch mime {
+	case cos.ExtTar:
+	case cos.ExtTgz, cos.ExtTarTgz:
+		gzr, err := gzip.NewReader(r)
+		if err != nil {
+			return err
+		}
+		defer gzr.Close()
+		r = gzr
+	case cos.ExtTarZst:
+		zr, err := zstd.NewReader(r)
+		if err != nil {
+			return err
+		}
+		defer zr.Close()
+		r = zr
+	default:
+		return fmt.Errorf("extracting archived subtree from %q is not supported", mime)
+	}
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+		var isDir bool
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			isDir = true
+		case tar.TypeReg, tar.TypeRegA:
+		default:
+			if sub.match(hdr.Name) {
+				sub.skipped = append(sub.skipped, hdr.Name)
+			}
+			continue
+		}
+		if err := sub.add(hdr.Name, isDir, tr); err != nil {
+			return err
+		}
+	}
+}
+
+// zip requires random access - GET into a temp file first
+func (sub *archSubtree) getZip(bck cmn.Bck, archName string) error {
+	fh, err := os.CreateTemp("", "ais-get-*"+cos.ExtZip)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		fh.Close()
+		os.Remove(fh.Name())
+	}()
+	oah, err := api.GetObject(apiBP, bck, archName, &api.GetArgs{Writer: fh})
+	if err != nil {
+		return err
+	}
+	zr, err := zip.NewReader(fh, oah.Size())
+	if err != nil {
+		return err
+	}
+	for _, f := range zr.File {
+		finfo := f.FileInfo()
+		if !finfo.IsDir() && !finfo.Mode().IsRegular() {
+			if sub.match(f.Name) {
+				sub.skipped = append(sub.skipped, f.Name)
+			}
+			continue
+		}
+		if !sub.match(f.Name) {
+			continue
+		}
+		r, err := f.Open()
+		if err != nil {
+			return err
+		}
+		err = sub.add(f.Name, finfo.IsDir(), r)
+		r.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (sub *archSubtree) match(name string) bool {
+	return strings.HasPrefix(strings.TrimPrefix(name, "./"), sub.prefix)
+}
+
+// write member that is under the prefix; skip all others
+func (sub *archSubtree) add(name string, isDir bool, r io.Reader) error {
+	if !sub.match(name) || strings.TrimPrefix(name, "./") == sub.prefix { // (the prefix itself)
+		return nil
+	}
+	dst, ok := archMemberDst(sub.outDir, sub.prefix, name)
+	if !ok {
+		sub.skipped = append(sub.skipped, name)
+		return nil
+	}
+	if isDir {
+		return cos.CreateDir(dst)
+	}
+	if err := cos.CreateDir(filepath.Dir(dst)); err != nil {
+		return err
+	}
+	fh, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	n, err := io.Copy(fh, r)
+	if errC := fh.Close(); err == nil {
+		err = errC
+	}
+	if err != nil {
+		os.Remove(dst)
+		return err
+	}
+	sub.cnt++
+	sub.size += n
+	return nil
+}
+
+// local destination of the archived file: relative to the prefix and never outside `outDir`
+// (e.g., "../../etc/passwd" or absolute paths are rejected)
+func archMemberDst(outDir, prefix, name string) (string, bool) {
+	rel := strings.TrimPrefix(strings.TrimPrefix(name, "./"), prefix)
+	if rel == "" || path.IsAbs(rel) || strings.Contains(rel, `\`) {
+		return "", false
+	}
+	rel = path.Clean(rel)
+	if rel == "." || rel == ".." || strings.HasPrefix(rel, "../") {
+		return "", false
+	}
+	dst := filepath.Join(outDir, filepath.FromSlash(rel))
+	if r, err := filepath.Rel(outDir, dst); err != nil || r == ".." || strings.HasPrefix(r, ".."+string(filepath.Separator)) {
+		return "", false
+	}
+	return dst, true
+}
@@ -0,0 +1,74 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"archive/tar"
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestArchSubtree(t *testing.T) {
+	var (
+		buf bytes.Buffer
+		tw  = tar.NewWriter(&buf)
+		dir = t.TempDir()
+		out = filepath.Join(dir, "out")
+	)
+	add := func(name string, typ byte, data string) {
+		hdr := &tar.Header{Name: name, Typeflag: typ, Mode: 0o644, Size: int64(len(data))}
+		if typ == tar.TypeSymlink {
+			hdr.Linkname, hdr.Size = "/etc/passwd", 0
+		}
+		tassert.CheckFatal(t, tw.WriteHeader(hdr))
+		_, err := tw.Write([]byte(data))
+		tassert.CheckFatal(t, err)
+	}
+	add("data/train/", tar.TypeDir, "")
+	add("data/train/a.jpg", tar.TypeReg, "aaa")
+	add("./data/train/sub/b.jpg", tar.TypeReg, "bb")
+	add("data/train/sub/", tar.TypeDir, "")
+	add("data/train/../../../evil", tar.TypeReg, "x")
+	add("data/train/link", tar.TypeSymlink, "")
+	add("data/test/c.jpg", tar.TypeReg, "c")
+	add("data/trainer.txt", tar.TypeReg, "t")
+	tassert.CheckFatal(t, tw.Close())
+
+	sub := &archSubtree{prefix: "data/train/", outDir: out}
+	tassert.CheckFatal(t, sub.untar(&buf, cos.ExtTar))
+	tassert.Errorf(t, sub.cnt == 2 && sub.size == 5, "expected 2 files (5 bytes), got %d (%d)", sub.cnt, sub.size)
+	tassert.Errorf(t, len(sub.skipped) == 2, "expected 2 skipped (evil, link), got %v", sub.skipped)
+	for name, data := range map[string]string{"a.jpg": "aaa", "sub/b.jpg": "bb"} {
+		b, err := os.ReadFile(filepath.Join(out, name))
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, string(b) == data, "%s: expected %q, got %q", name, data, b)
+	}
+	for _, name := range []string{"evil", filepath.Join(dir, "evil"), filepath.Join(out, "link"), filepath.Join(out, "c.jpg")} {
+		_, err := os.Lstat(name)
+		tassert.Errorf(t, os.IsNotExist(err), "%s: not expected to exist", name)
+	}
+
+	tests := []struct {
+		name, dst string
+	}{
+		{"p/x", "out/x"},
+		{"./p/d/x", "out/d/x"},
+		{"p/d/../x", "out/x"},
+		{"p/../x", ""},
+		{"p//etc/passwd", ""},
+		{"p/d/../../x", ""},
+		{`p/..\x`, ""},
+	}
+	for _, test := range tests {
+		dst, ok := archMemberDst("out", "p/", test.name)
+		tassert.Errorf(t, ok == (test.dst != "") && dst == filepath.FromSlash(test.dst),
+			"%q: expected %q, got %q (%t)", test.name, test.dst, dst, ok)
+	}
+}
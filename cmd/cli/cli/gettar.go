@@ -0,0 +1,173 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+// This file implements `ais get --prefix ... --to-tar` - multi-object GET into a single tar stream.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"archive/tar"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/NVIDIA/aistore/api"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/urfave/cli"
+)
+
+const (
+	getTarWorkers = 4
+	getTarWindow  = 64 // max number of objects fetched ahead of the (in-order) tar writer
+)
+
+type (
+	tarMember struct {
+		name string
+		data *bytes.Buffer
+		err  error
+	}
+	// GET in parallel, write in listing order
+	getTarCtx struct {
+		bck     cmn.Bck
+		entries cmn.LsoEntries
+		results []chan *tarMember
+		window  chan struct{}
+		stop    chan struct{}
+		fetched chan struct{}
+		u       *uctx
+		mtime   time.Time
+		contErr bool
+	}
+)
+
+func getToTar(c *cli.Context, bck cmn.Bck, entries cmn.LsoEntries, outFile string) (err error) {
+	elim, err := parseErrLimits(c)
+	if err != nil {
+		return err
+	}
+	var (
+		w  io.Writer
+		fh *os.File
+		sw *stdoutW
+	)
+	if outFile == fileStdIO {
+		sw = newStdoutW()
+		w = sw
+	} else {
+		if fh, err = os.Create(outFile); err != nil {
+			return err
+		}
+		w = fh
+	}
+	ctx := &getTarCtx{
+		bck:     bck,
+		entries: entries,
+		results: make([]chan *tarMember, len(entries)),
+		window:  make(chan struct{}, getTarWindow),
+		stop:    make(chan struct{}),
+		fetched: make(chan struct{}),
+		u:       &uctx{wg: cos.NewLimitedWaitGroup(getTarWorkers, 0), elim: elim},
+		mtime:   time.Now(),
+		contErr: flagIsSet(c, continueOnErrorFlag) || elim.IsSet(),
+	}
+	for i := range ctx.results {
+		ctx.results[i] = make(chan *tarMember, 1)
+	}
+	go ctx.fetch()
+
+	cnt, size, err := ctx.write(c, w)
+	close(ctx.stop)
+	<-ctx.fetched
+	ctx.u.wg.Wait()
+
+	if fh != nil {
+		if errC := fh.Close(); errC != nil && err == nil {
+			err = errC
+		}
+	}
+	if sw != nil && sw.closed {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if outFile != fileStdIO {
+		actionDone(c, fmt.Sprintf("GET %d object%s (%s) from %s into %s",
+			cnt, cos.Plural(cnt), cos.ToSizeIEC(size, 2), bck.Cname(""), outFile))
+	}
+	if numFailed := int(ctx.u.errCount.Load()); numFailed > 0 {
+		return fmt.Errorf("failed to GET %d object%s (not included in %s)", numFailed, cos.Plural(numFailed), outFile)
+	}
+	return nil
+}
+
+// (runs in its own goroutine)
+func (ctx *getTarCtx) fetch() {
+	defer close(ctx.fetched)
+	for i, entry := range ctx.entries {
+		select {
+		case ctx.window <- struct{}{}:
+		case <-ctx.stop:
+			return
+		}
+		ctx.u.wg.Add(1)
+		go ctx.get(entry, ctx.results[i])
+	}
+}
+
+func (ctx *getTarCtx) get(entry *cmn.LsoEntry, res chan *tarMember) {
+	defer ctx.u.wg.Done()
+	var (
+		m    = &tarMember{name: entry.Name, data: bytes.NewBuffer(make([]byte, 0, entry.Size))}
+		args = api.GetArgs{Writer: m.data}
+	)
+	_, m.err = api.GetObject(apiBP, ctx.bck, entry.Name, &args)
+	res <- m
+}
+
+// write tar members strictly in listing order; always finalize the tar
+func (ctx *getTarCtx) write(c *cli.Context, w io.Writer) (cnt int, size int64, err error) {
+	tw := tar.NewWriter(w)
+	for _, res := range ctx.results {
+		m := <-res
+		<-ctx.window
+		ctx.u.processedCnt.Inc()
+		if m.err != nil {
+			ctx.u.errCount.Inc()
+			if !ctx.contErr {
+				err = fmt.Errorf("failed to GET %s: %v", ctx.bck.Cname(m.name), m.err)
+				break
+			}
+			actionWarn(c, fmt.Sprintf("failed to GET %s: %v (skipping)", ctx.bck.Cname(m.name), m.err))
+			if ctx.u.checkErrLimits(); ctx.u.aborted.Load() {
+				err = ctx.u.abortErr("GET", len(ctx.entries))
+				break
+			}
+			continue
+		}
+		hdr := &tar.Header{
+			Typeflag: tar.TypeReg,
+			Name:     m.name,
+			Size:     int64(m.data.Len()),
+			Mode:     int64(cos.PermRWR),
+			ModTime:  ctx.mtime,
+			Format:   tar.FormatUnknown,
+		}
+		if err = tw.WriteHeader(hdr); err != nil {
+			break
+		}
+		if _, err = m.data.WriteTo(tw); err != nil {
+			break
+		}
+		cnt++
+		size += hdr.Size
+	}
+	if errC := tw.Close(); errC != nil && err == nil {
+		err = errC
+	}
+	return cnt, size, err
+}
@@ -0,0 +1,56 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"archive/tar"
+	"bytes"
+	"errors"
+	"io"
+	"reflect"
+	"testing"
+
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestGetTarWrite(t *testing.T) {
+	if fcyan == nil {
+		fcyan = func(a ...any) string { return a[0].(string) } // (actionWarn)
+	}
+	ctx := &getTarCtx{
+		results: make([]chan *tarMember, 3),
+		window:  make(chan struct{}, 3),
+		u:       &uctx{},
+		contErr: true,
+	}
+	for i, m := range []*tarMember{
+		{name: "data/a", data: bytes.NewBufferString("aaa")},
+		{name: "data/b", err: errors.New("not found")},
+		{name: "data/c", data: bytes.NewBufferString("c")},
+	} {
+		ctx.results[i] = make(chan *tarMember, 1)
+		ctx.results[i] <- m
+		ctx.window <- struct{}{}
+	}
+	var (
+		out bytes.Buffer
+		c   = newTestContext(nil)
+	)
+	cnt, size, err := ctx.write(c, &out)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, cnt == 2 && size == 4 && ctx.u.errCount.Load() == 1, "unexpected %d, %d, %d", cnt, size, ctx.u.errCount.Load())
+
+	var names []string
+	tr := tar.NewReader(&out)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		tassert.CheckFatal(t, err)
+		names = append(names, hdr.Name)
+	}
+	tassert.Errorf(t, reflect.DeepEqual(names, []string{"data/a", "data/c"}), "unexpected members %v", names)
+}
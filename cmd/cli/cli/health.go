@@ -0,0 +1,275 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+// This file implements `ais cluster health` - a roll-up of assorted cluster health checks.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"text/tabwriter"
+
+	"github.com/NVIDIA/aistore/api"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cmd/cli/teb"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/sys"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/urfave/cli"
+)
+
+const (
+	healthOK       = "ok"
+	healthWarning  = "warning"
+	healthCritical = "critical"
+)
+
+// health checks
+const (
+	hcNodeStatus = "node-status"
+	hcSmapVer    = "smap-version"
+	hcBMDVer     = "bmd-version"
+	hcCapacity   = "capacity"
+	hcMountpath  = "mountpath"
+	hcMaint      = "maintenance"
+	hcXaction    = "xaction"
+)
+
+type (
+	healthIssue struct {
+		Severity string `json:"severity"`
+		Check    string `json:"check"`
+		Node     string `json:"node,omitempty"`
+		Details  string `json:"details"`
+	}
+	cluHealth struct {
+		Status   string         `json:"status"`
+		Critical int            `json:"critical"`
+		Warnings int            `json:"warnings"`
+		Issues   []*healthIssue `json:"issues"`
+		mu       sync.Mutex
+	}
+)
+
+func (h *cluHealth) add(sev, check, node, details string) {
+	if sev == healthOK {
+		return
+	}
+	h.mu.Lock()
+	h.Issues = append(h.Issues, &healthIssue{Severity: sev, Check: check, Node: node, Details: details})
+	if sev == healthCritical {
+		h.Critical++
+	} else {
+		h.Warnings++
+	}
+	h.mu.Unlock()
+}
+
+func (h *cluHealth) fin() {
+	switch {
+	case h.Critical > 0:
+		h.Status = healthCritical
+	case h.Warnings > 0:
+		h.Status = healthWarning
+	default:
+		h.Status = healthOK
+	}
+	sort.Slice(h.Issues, func(i, j int) bool {
+		a, b := h.Issues[i], h.Issues[j]
+		if a.Severity != b.Severity {
+			return a.Severity == healthCritical
+		}
+		if a.Check != b.Check {
+			return a.Check < b.Check
+		}
+		return a.Node < b.Node
+	})
+}
+
+// `val` at or above `crit` is critical; at or above `warn` - warning
+func healthSev(val, warn, crit int64) string {
+	switch {
+	case val >= crit:
+		return healthCritical
+	case val >= warn:
+		return healthWarning
+	default:
+		return healthOK
+	}
+}
+
+func clusterHealthHandler(c *cli.Context) error {
+	var (
+		capWarn = int64(parseIntFlag(c, capacityWarnFlag))
+		capCrit = int64(parseIntFlag(c, capacityCritFlag))
+		maxLag  = int64(parseIntFlag(c, maxVersionLagFlag))
+	)
+	if capWarn <= 0 || capCrit > 100 || capWarn > capCrit {
+		return incorrectUsageMsg(c, "invalid capacity thresholds: expecting 0 < %s <= %s <= 100, got %d and %d",
+			qflprn(capacityWarnFlag), qflprn(capacityCritFlag), capWarn, capCrit)
+	}
+	if maxLag < 0 {
+		return incorrectUsageMsg(c, "%s cannot be negative", qflprn(maxVersionLagFlag))
+	}
+
+	smap, tstatusMap, pstatusMap, err := fillNodeStatusMap(c, "" /*both*/)
+	if err != nil {
+		return err
+	}
+	bmd, err := api.GetBMD(apiBP)
+	if err != nil {
+		return err
+	}
+	h := &cluHealth{}
+
+	// nodes: status, maintenance, Smap version, capacity
+	for _, stmap := range []teb.StstMap{pstatusMap, tstatusMap} {
+		for _, ds := range stmap {
+			si := ds.Node.Snode
+			switch ds.Status {
+			case teb.NodeOnline, apc.NodeProbation:
+			case apc.NodeMaintenance, apc.NodeDecommission:
+				h.add(healthWarning, hcMaint, si.StringEx(), "node is in "+ds.Status)
+				continue
+			default:
+				h.add(healthCritical, hcNodeStatus, si.StringEx(), "node is not responding: "+ds.Status)
+				continue
+			}
+			if lag := smap.Version - ds.SmapVersion; lag > 0 {
+				h.add(healthSev(lag, 1, maxLag+1), hcSmapVer, si.StringEx(),
+					fmt.Sprintf("Smap v%d is behind primary's v%d", ds.SmapVersion, smap.Version))
+			}
+			if si.IsTarget() {
+				cdf := &ds.TargetCDF
+				if cdf.CsErr != "" {
+					h.add(healthCritical, hcCapacity, si.StringEx(), cdf.CsErr)
+				} else {
+					h.add(healthSev(int64(cdf.PctMax), capWarn, capCrit), hcCapacity, si.StringEx(),
+						fmt.Sprintf("used capacity %d%% (thresholds: %d%% warning, %d%% critical)",
+							cdf.PctMax, capWarn, capCrit))
+				}
+			}
+		}
+	}
+
+	// nodes: BMD version, targets: disabled mountpaths
+	healthNodes(smap, bmd.Version, maxLag, h)
+
+	// jobs
+	if err := healthXactions(h); err != nil {
+		h.add(healthWarning, hcXaction, "", "failed to query jobs: "+err.Error())
+	}
+
+	h.fin()
+	if flagIsSet(c, jsonFlag) {
+		if err := teb.Print(h, "", teb.Jopts(true)); err != nil {
+			return err
+		}
+	} else {
+		printHealth(c, h)
+	}
+	if h.Critical > 0 {
+		return fmt.Errorf("cluster health: %d critical issue%s", h.Critical, cos.Plural(h.Critical))
+	}
+	return nil
+}
+
+func healthNodes(smap *cluster.Smap, bmdVer, maxLag int64, h *cluHealth) {
+	wg := cos.NewLimitedWaitGroup(sys.NumCPU(), smap.CountTargets()+smap.CountProxies())
+	for _, nodeMap := range []cluster.NodeMap{smap.Pmap, smap.Tmap} {
+		for _, si := range nodeMap {
+			if smap.InMaintOrDecomm(si) {
+				continue
+			}
+			wg.Add(1)
+			go func(si *cluster.Snode) {
+				defer wg.Done()
+				if nbmd, err := api.GetNodeBMD(apiBP, si.ID()); err == nil {
+					if lag := bmdVer - nbmd.Version; lag > 0 {
+						h.add(healthSev(lag, 1, maxLag+1), hcBMDVer, si.StringEx(),
+							fmt.Sprintf("BMD v%d is behind primary's v%d", nbmd.Version, bmdVer))
+					}
+				}
+				if !si.IsTarget() {
+					return
+				}
+				mpl, err := api.GetMountpaths(apiBP, si)
+				if err != nil {
+					return // (unreachable nodes are reported elsewhere)
+				}
+				for _, mpath := range mpl.Disabled {
+					h.add(healthWarning, hcMountpath, si.StringEx(), "disabled mountpath "+mpath)
+				}
+			}(si)
+		}
+	}
+	wg.Wait()
+}
+
+// running jobs that have already been aborted on some of the targets
+func healthXactions(h *cluHealth) error {
+	xs, err := queryXactions(xact.ArgsMsg{})
+	if err != nil {
+		return err
+	}
+	type xinfo struct {
+		kind             string
+		running, aborted []string
+	}
+	all := make(map[string]*xinfo, 8)
+	for tid, snaps := range xs {
+		for _, snap := range snaps {
+			xi, ok := all[snap.ID]
+			if !ok {
+				xi = &xinfo{kind: snap.Kind}
+				all[snap.ID] = xi
+			}
+			switch {
+			case snap.Running():
+				xi.running = append(xi.running, tid)
+			case snap.IsAborted():
+				xi.aborted = append(xi.aborted, tid)
+			}
+		}
+	}
+	for xid, xi := range all {
+		if len(xi.running) == 0 || len(xi.aborted) == 0 {
+			continue
+		}
+		sort.Strings(xi.aborted)
+		h.add(healthCritical, hcXaction, "",
+			fmt.Sprintf("%s[%s] is running on %d target%s but has been aborted on: %v",
+				xi.kind, xid, len(xi.running), cos.Plural(len(xi.running)), xi.aborted))
+	}
+	return nil
+}
+
+func printHealth(c *cli.Context, h *cluHealth) {
+	if len(h.Issues) > 0 {
+		tw := &tabwriter.Writer{}
+		tw.Init(c.App.Writer, 0, 8, 2, ' ', 0)
+		if !flagIsSet(c, noHeaderFlag) {
+			fmt.Fprintln(tw, "SEVERITY\tCHECK\tNODE\tDETAILS")
+		}
+		for _, is := range h.Issues {
+			node := is.Node
+			if node == "" {
+				node = "-"
+			}
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", is.Severity, is.Check, node, is.Details)
+		}
+		tw.Flush()
+		fmt.Fprintln(c.App.Writer)
+	}
+	switch h.Status {
+	case healthOK:
+		actionDone(c, "Cluster health: OK")
+	default:
+		msg := fmt.Sprintf("Cluster health: %s (%d critical, %d warning%s)",
+			h.Status, h.Critical, h.Warnings, cos.Plural(h.Warnings))
+		fmt.Fprintln(c.App.Writer, msg)
+	}
+}
@@ -0,0 +1,42 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"testing"
+
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestClusterHealth(t *testing.T) {
+	tests := []struct {
+		val, warn, crit int64
+		sev             string
+	}{
+		{79, 80, 90, healthOK},
+		{80, 80, 90, healthWarning},
+		{90, 80, 90, healthCritical},
+		{1, 1, 2, healthWarning}, // version lag within '--max-version-lag 1'
+		{2, 1, 2, healthCritical},
+		{1, 1, 1, healthCritical}, // '--max-version-lag 0'
+	}
+	for _, test := range tests {
+		sev := healthSev(test.val, test.warn, test.crit)
+		tassert.Errorf(t, sev == test.sev, "healthSev(%d, %d, %d): expected %s, got %s",
+			test.val, test.warn, test.crit, test.sev, sev)
+	}
+
+	h := &cluHealth{}
+	h.fin()
+	tassert.Errorf(t, h.Status == healthOK, "expected %s, got %s", healthOK, h.Status)
+
+	h.add(healthOK, hcCapacity, "t1", "")
+	h.add(healthWarning, hcMaint, "t2", "")
+	h.add(healthCritical, hcSmapVer, "p1", "")
+	h.fin()
+	tassert.Errorf(t, h.Status == healthCritical && h.Critical == 1 && h.Warnings == 1 && len(h.Issues) == 2,
+		"unexpected %+v", h)
+	tassert.Errorf(t, h.Issues[0].Severity == healthCritical, "expected critical issues first")
+}
@@ -0,0 +1,49 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"flag"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+	"github.com/urfave/cli"
+)
+
+// common test setup
+
+// CLI context with the given (parsed) flags, if any; discards all output
+func newTestContext(fset *flag.FlagSet) *cli.Context {
+	if fset == nil {
+		fset = flag.NewFlagSet("test", flag.ContinueOnError)
+	}
+	return cli.NewContext(&cli.App{Writer: io.Discard, ErrWriter: io.Discard}, fset, nil)
+}
+
+// point the CLI (`apiBP`) at a mock cluster for the duration of the test
+func mockCluster(t *testing.T, handler http.HandlerFunc) {
+	srv := httptest.NewServer(handler)
+	saved := apiBP
+	apiBP = api.BaseParams{Client: &http.Client{}, URL: srv.URL}
+	t.Cleanup(func() {
+		apiBP = saved
+		srv.Close()
+	})
+}
+
+// create (or overwrite) dir/name, including intermediate directories; return the full path
+func writeTestFile(t *testing.T, dir, name, content string) string {
+	fqn := filepath.Join(dir, filepath.FromSlash(name))
+	tassert.CheckFatal(t, cos.CreateDir(filepath.Dir(fqn)))
+	tassert.CheckFatal(t, os.WriteFile(fqn, []byte(content), cos.PermRWR))
+	return fqn
+}
@@ -13,10 +13,12 @@ import (
 	"github.com/NVIDIA/aistore/api/authn"
 	"github.com/NVIDIA/aistore/api/env"
 	"github.com/NVIDIA/aistore/cmd/cli/config"
+	"github.com/NVIDIA/aistore/cmd/cli/teb"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/tools/docker"
+	"github.com/urfave/cli"
 )
 
 var loggedUserToken string
@@ -27,29 +29,61 @@ func Init() (err error) {
 		return err
 	}
 	k8sDetected = detectK8s()
-	initClusterParams()
+	initClusterParams(nil)
 	return nil
 }
 
 // private
 
-func initClusterParams() {
-	loggedUserToken = authn.LoadToken("")
+// `ais --profile NAME ...`: re-initialize cluster (and AuthN) parameters using the named profile
+func initProfile(c *cli.Context) (err error) {
+	name := c.GlobalString(profileFlag.Name)
+	if name == "" {
+		return nil
+	}
+	if curProfile, err = cfg.Profile(name); err != nil {
+		return err
+	}
+	initClusterParams(curProfile)
+	units := cfg.Defaults.Units
+	if curProfile.Defaults.Units != "" {
+		units = curProfile.Defaults.Units
+	}
+	teb.Init(os.Stdout, cfg.NoColor, units)
+	return nil
+}
+
+func initClusterParams(profile *config.ProfileConfig) {
+	var (
+		tokenFile  string
+		skipVerify = cfg.Cluster.SkipVerifyCrt
+		authnURL   = cliAuthnURL(cfg)
+	)
+	if profile != nil {
+		tokenFile = profile.TokenFile
+		skipVerify = profile.SkipVerifyCrt
+		if profile.AuthURL != "" {
+			authnURL = profile.AuthURL
+		}
+		clusterURL = profile.URL
+	} else {
+		clusterURL = _clusterURL(cfg)
+	}
+	loggedUserToken = authn.LoadToken(tokenFile)
 
-	clusterURL = _clusterURL(cfg)
 	defaultHTTPClient = cmn.NewClient(cmn.TransportArgs{
 		DialTimeout: cfg.Timeout.TCPTimeout,
 		Timeout:     cfg.Timeout.HTTPTimeout,
 		UseHTTPS:    cos.IsHTTPS(clusterURL),
-		SkipVerify:  cfg.Cluster.SkipVerifyCrt,
+		SkipVerify:  skipVerify,
 	})
 
-	if authnURL := cliAuthnURL(cfg); authnURL != "" {
+	if authnURL != "" {
 		authnHTTPClient = cmn.NewClient(cmn.TransportArgs{
 			DialTimeout: cfg.Timeout.TCPTimeout,
 			Timeout:     cfg.Timeout.HTTPTimeout,
 			UseHTTPS:    cos.IsHTTPS(authnURL),
-			SkipVerify:  cfg.Cluster.SkipVerifyCrt,
+			SkipVerify:  skipVerify,
 		})
 
 		authParams = api.BaseParams{
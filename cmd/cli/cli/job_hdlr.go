@@ -12,17 +12,23 @@ import (
 	"io"
 	"net/http"
 	"os"
+	"regexp"
+	"sort"
+	"strconv"
 	"strings"
+	"text/tabwriter"
 	"time"
 
 	"github.com/NVIDIA/aistore/api"
 	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmd/cli/teb"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/ext/dload"
 	"github.com/NVIDIA/aistore/ext/dsort"
 	"github.com/NVIDIA/aistore/ext/etl"
+	"github.com/NVIDIA/aistore/space"
 	"github.com/NVIDIA/aistore/xact"
 	jsoniter "github.com/json-iterator/go"
 	"github.com/urfave/cli"
@@ -51,6 +57,7 @@ var (
 	startCommonFlags = []cli.Flag{
 		waitFlag,
 		waitJobXactFinishedFlag,
+		jsonFlag,
 	}
 	startSpecialFlags = map[string][]cli.Flag{
 		cmdDownload: {
@@ -58,24 +65,44 @@ var (
 			descJobFlag,
 			limitConnectionsFlag,
 			objectsListFlag,
+			dloadManifestFlag,
+			dloadCreateBucketsFlag,
 			dloadProgressFlag,
 			progressFlag,
 			waitFlag,
 			waitJobXactFinishedFlag,
 			limitBytesPerHourFlag,
 			syncFlag,
+			dloadValidateCksumFlag,
+			dloadNotifyURLFlag,
 			unitsFlag,
 		},
 		cmdDsort: {
 			dsortSpecFlag,
+			dsortSetFlag,
 		},
 		commandPrefetch: append(
 			listrangeFlags,
 			dryRunFlag,
+			prefetchThrottleFlag,
+			prefetchLimitBphFlag,
+			unitsFlag,
 		),
 		cmdLRU: {
 			lruBucketsFlag,
+			lruTargetFlag,
+			lruAboveFlag,
+			lruBelowFlag,
 			forceFlag,
+			waitFlag,
+			waitJobXactFinishedFlag,
+			unitsFlag,
+		},
+		cmdExpirySweep: {
+			lruBucketsFlag,
+			waitFlag,
+			waitJobXactFinishedFlag,
+			unitsFlag,
 		},
 	}
 
@@ -89,8 +116,11 @@ var (
 		BashComplete: suggestTargetNodes,
 	}
 	jobStartSub = cli.Command{
-		Name:  commandStart,
-		Usage: "run batch job",
+		Name:      commandStart,
+		Usage:     "run batch job",
+		ArgsUsage: jobKindArgument,
+		Flags:     startCommonFlags,
+		Action:    startAnyXactionHandler,
 		Subcommands: []cli.Command{
 			{
 				Name:         commandPrefetch,
@@ -121,6 +151,14 @@ var (
 				Action:       startLRUHandler,
 				BashComplete: bucketCompletions(bcmplop{}),
 			},
+			{
+				Name:         cmdExpirySweep,
+				Usage:        "remove expired objects (see 'ais put --expire' and 'ais object set-custom --expire')",
+				ArgsUsage:    optionalBucketArgument,
+				Flags:        startSpecialFlags[cmdExpirySweep],
+				Action:       startExpirySweepHandler,
+				BashComplete: bucketCompletions(bcmplop{}),
+			},
 			{
 				Name:  commandETL,
 				Usage: "start ETL",
@@ -148,6 +186,8 @@ var (
 	stopCmdsFlags = []cli.Flag{
 		allRunningJobsFlag,
 		regexJobsFlag,
+		dsortCleanupFlag,
+		yesFlag,
 	}
 	jobStopSub = cli.Command{
 		Name:         commandStop,
@@ -165,10 +205,13 @@ var (
 		refreshFlag,
 		progressFlag,
 		waitJobXactFinishedFlag,
+		allRunningJobsFlag,
+		regexJobsFlag,
 	}
 	jobWaitSub = cli.Command{
-		Name:         commandWait,
-		Usage:        "wait for a specific batch job to complete (" + tabHelpOpt + ")",
+		Name: commandWait,
+		Usage: "wait for a specific batch job to complete (" + tabHelpOpt + "),\n" +
+			indent1 + "or for all currently running jobs (option '--all', optionally filtered by job kind via '--regex')",
 		ArgsUsage:    jobShowStopWaitArgument,
 		Flags:        waitCmdsFlags,
 		Action:       waitJobHandler,
@@ -272,12 +315,49 @@ func startXactionHandler(c *cli.Context) (err error) {
 }
 
 func startXactionKind(c *cli.Context, xname string) (err error) {
+	return _startXactionKind(c, xname, c.Args())
+}
+
+// `ais job start KIND [args]` (generic dispatch by xaction kind or display name)
+func startAnyXactionHandler(c *cli.Context) error {
+	// (no usage errors - this action runs in the context of the `start` command's subcommands)
+	seeHelp := fmt.Sprintf("see '%s %s %s --help'", cliName, commandJob, commandStart)
+	if c.NArg() == 0 {
+		return cli.ShowAppHelp(c)
+	}
+	name := c.Args().First()
+	kind, xname := xact.GetKindName(name)
+	if kind == "" {
+		return fmt.Errorf("unrecognized job kind %q (%s)", name, seeHelp)
+	}
+	if !xact.Table[kind].Startable {
+		return fmt.Errorf("%q cannot be started (%s)", xname, seeHelp)
+	}
+	if c.NArg() < 2 && xact.IsSameScope(kind, xact.ScopeB) {
+		return fmt.Errorf("%q requires bucket to run: missing %s", xname, bucketArgument)
+	}
+	if kind == apc.ActResilver {
+		var (
+			sid string
+			err error
+		)
+		if c.NArg() > 1 {
+			if sid, _, err = getNodeIDName(c, c.Args().Get(1)); err != nil {
+				return err
+			}
+		}
+		return startXaction(c, kind, cmn.Bck{}, sid)
+	}
+	return _startXactionKind(c, kind, c.Args().Tail())
+}
+
+func _startXactionKind(c *cli.Context, xname string, args cli.Args) (err error) {
 	var bck cmn.Bck
-	if c.NArg() == 0 && xact.IsSameScope(xname, xact.ScopeB) {
-		return missingArgumentsError(c, c.Command.ArgsUsage)
+	if len(args) == 0 && xact.IsSameScope(xname, xact.ScopeB) {
+		return missingArgumentsError(c, bucketArgument)
 	}
-	if c.NArg() > 0 && xact.IsSameScope(xname, xact.ScopeB, xact.ScopeGB) {
-		bck, err = parseBckURI(c, c.Args().First(), true /*require provider*/)
+	if len(args) > 0 && xact.IsSameScope(xname, xact.ScopeB, xact.ScopeGB) {
+		bck, err = parseBckURI(c, args.First(), true /*require provider*/)
 		if err != nil {
 			return err
 		}
@@ -310,6 +390,9 @@ func startXaction(c *cli.Context, xname string, bck cmn.Bck, sid string) error {
 	if err != nil {
 		return err
 	}
+	if flagIsSet(c, jsonFlag) {
+		return startedXactJSON(c, xname, xid)
+	}
 	if xid == "" {
 		warn := fmt.Sprintf("The operation returned an empty UUID (a no-op?). %s\n",
 			toShowMsg(c, "", "To investigate", false))
@@ -330,14 +413,86 @@ func startXaction(c *cli.Context, xname string, bck cmn.Bck, sid string) error {
 	return waitJob(c, xname, xid, bck)
 }
 
+// `ais job start KIND [args] --json [--wait]`:
+// optionally wait for the job to finish, and print structured result for scripts to branch on
+type jobResult struct {
+	UUID     string `json:"uuid"`
+	Kind     string `json:"kind"`
+	State    string `json:"state"` // enum { jobStateNoop, ... }
+	Duration string `json:"duration"`
+	Bytes    int64  `json:"bytes,string"`
+	Objects  int64  `json:"objects,string"`
+	Err      string `json:"error,omitempty"`
+}
+
+const (
+	jobStateNoop     = "noop" // the operation returned an empty UUID
+	jobStateRunning  = "running"
+	jobStateIdle     = "idle" // (on-demand xactions in-between requests)
+	jobStateFinished = "finished"
+	jobStateAborted  = "aborted"
+)
+
+func startedXactJSON(c *cli.Context, xname, xid string) error {
+	kind, _ := xact.GetKindName(xname)
+	res := &jobResult{UUID: xid, Kind: kind, State: jobStateNoop}
+	if xid == "" {
+		return teb.Print(res, "", teb.Jopts(true))
+	}
+	xargs := xact.ArgsMsg{ID: xid, Kind: kind, Timeout: -1 /*long*/}
+	if flagIsSet(c, waitFlag) || flagIsSet(c, waitJobXactFinishedFlag) {
+		if flagIsSet(c, waitJobXactFinishedFlag) {
+			xargs.Timeout = parseDurationFlag(c, waitJobXactFinishedFlag)
+		}
+		err := api.WaitForXactionNode(apiBP, xargs, func(xs xact.MultiSnap) bool {
+			_, snap, _ := xs.RunningTarget(xid)
+			return snap == nil || snap.IsAborted()
+		})
+		if err != nil {
+			res.Err = err.Error()
+		}
+	}
+	xs, err := api.QueryXactionSnaps(apiBP, xact.ArgsMsg{ID: xid})
+	if err != nil {
+		return err
+	}
+	res.fill(xs)
+	if err := teb.Print(res, "", teb.Jopts(true)); err != nil {
+		return err
+	}
+	if res.State == jobStateAborted {
+		return fmt.Errorf("%s was aborted", jobName(xname, xid))
+	}
+	return nil
+}
+
+func (res *jobResult) fill(xs xact.MultiSnap) {
+	res.State = jobStateRunning
+	if _, snap, _ := xs.RunningTarget(res.UUID); snap == nil {
+		res.State = jobStateFinished
+	}
+	if aborted, _ := xs.IsAborted(res.UUID); aborted {
+		res.State = jobStateAborted
+	}
+	if dur, err := xs.TotalRunningTime(res.UUID); err == nil {
+		res.Duration = dur.String()
+	}
+	res.Objects, _, _ = xs.ObjCounts(res.UUID)
+	res.Bytes, _, _ = xs.ByteCounts(res.UUID)
+}
+
 func startDownloadHandler(c *cli.Context) error {
 	var (
-		description      = parseStrFlag(c, descJobFlag)
-		timeout          = parseStrFlag(c, dloadTimeoutFlag)
-		objectsListPath  = parseStrFlag(c, objectsListFlag)
-		progressInterval = parseStrFlag(c, dloadProgressFlag)
-		id               string
+		objectsListPath = parseStrFlag(c, objectsListFlag)
+		id              string
 	)
+	if flagIsSet(c, dloadManifestFlag) {
+		if c.NArg() > 0 {
+			return incorrectUsageMsg(c, "flag %s cannot be used together with %s", qflprn(dloadManifestFlag),
+				startDownloadArgument)
+		}
+		return startDownloadManifest(c)
+	}
 	if c.NArg() == 0 {
 		return missingArgumentsError(c, c.Command.ArgsUsage)
 	}
@@ -365,25 +520,11 @@ func startDownloadHandler(c *cli.Context) error {
 		return err
 	}
 
-	limitBPH, err := parseSizeFlag(c, limitBytesPerHourFlag)
+	basePayload, err := dloadBase(c)
 	if err != nil {
 		return err
 	}
-
-	if _, err := time.ParseDuration(progressInterval); err != nil {
-		return err
-	}
-
-	basePayload := dload.Base{
-		Bck:              bck,
-		Timeout:          timeout,
-		Description:      description,
-		ProgressInterval: progressInterval,
-		Limits: dload.Limits{
-			Connections:  parseIntFlag(c, limitConnectionsFlag),
-			BytesPerHour: int(limitBPH),
-		},
-	}
+	basePayload.Bck = bck
 
 	if basePayload.Bck.Props, err = api.HeadBucket(apiBP, basePayload.Bck, true /* don't add */); err != nil {
 		if !cmn.IsStatusNotFound(err) {
@@ -502,6 +643,33 @@ func startDownloadHandler(c *cli.Context) error {
 	return bgDownload(c, id)
 }
 
+// common (job-level) part of the download request
+func dloadBase(c *cli.Context) (base dload.Base, err error) {
+	progressInterval := parseStrFlag(c, dloadProgressFlag)
+	if _, err = time.ParseDuration(progressInterval); err != nil {
+		return
+	}
+	limitBPH, err := parseSizeFlag(c, limitBytesPerHourFlag)
+	if err != nil {
+		return
+	}
+	base = dload.Base{
+		Timeout:          parseStrFlag(c, dloadTimeoutFlag),
+		Description:      parseStrFlag(c, descJobFlag),
+		ProgressInterval: progressInterval,
+		Limits: dload.Limits{
+			Connections:  parseIntFlag(c, limitConnectionsFlag),
+			BytesPerHour: int(limitBPH),
+		},
+		ValidateCksum: flagIsSet(c, dloadValidateCksumFlag),
+		NotifyURL:     parseStrFlag(c, dloadNotifyURLFlag),
+	}
+	if base.NotifyURL != "" {
+		err = dload.ValidateNotifyURL(base.NotifyURL)
+	}
+	return
+}
+
 func pbDownload(c *cli.Context, id string) (err error) {
 	refreshRate := _refreshRate(c)
 	downloadingResult, err := newDownloaderPB(apiBP, id, refreshRate).run()
@@ -657,6 +825,11 @@ func startDsortHandler(c *cli.Context) (err error) {
 			)
 		}
 	}
+	if overrides := c.StringSlice(dsortSetFlag.Name); len(overrides) > 0 {
+		if err := setDsortSpec(c, &rs, overrides); err != nil {
+			return err
+		}
+	}
 
 	if id, err = api.StartDSort(apiBP, rs); err != nil {
 		return
@@ -666,12 +839,87 @@ func startDsortHandler(c *cli.Context) (err error) {
 	return
 }
 
+// apply `--set key=value` overrides, where the (nested) keys are dSort spec's JSON tags
+func setDsortSpec(c *cli.Context, rs *dsort.RequestSpec, overrides []string) error {
+	for _, kv := range overrides {
+		key, value, ok := strings.Cut(kv, "=")
+		key = strings.TrimSpace(key)
+		if !ok || key == "" {
+			return fmt.Errorf("invalid %s %q: expecting key=value", qflprn(dsortSetFlag), kv)
+		}
+		value = strings.TrimSpace(value)
+
+		// buckets
+		switch key {
+		case "bck", "output_bck":
+			bck, err := parseBckURI(c, value, true /*require provider*/)
+			if err != nil {
+				return err
+			}
+			if key == "bck" {
+				rs.Bck = bck
+			} else {
+				rs.OutputBck = bck
+			}
+			continue
+		}
+
+		var found bool
+		err := cmn.IterFields(rs, func(tag string, field cmn.IterField) (error, bool) {
+			// (embedded `cmn.DSortConf` has no JSON tag of its own)
+			if strings.TrimPrefix(tag, ".") != key {
+				return nil, false
+			}
+			found = true
+			return field.SetValue(value), true
+		}, cmn.IterOpts{OnlyRead: false})
+		if err != nil {
+			return fmt.Errorf("failed to set %q: %v", key, err)
+		}
+		if !found {
+			return fmt.Errorf("invalid %s: %q is not a dSort specification field", qflprn(dsortSetFlag), key)
+		}
+	}
+	return nil
+}
+
 func startLRUHandler(c *cli.Context) (err error) {
-	if !flagIsSet(c, lruBucketsFlag) {
+	scoped := flagIsSet(c, lruTargetFlag) || flagIsSet(c, lruAboveFlag) || flagIsSet(c, lruBelowFlag)
+	if !flagIsSet(c, lruBucketsFlag) && !scoped {
 		return startXactionHandler(c)
 	}
 
-	if flagIsSet(c, forceFlag) {
+	xargs := xact.ArgsMsg{Kind: apc.ActLRU, Force: flagIsSet(c, forceFlag)}
+	if xargs.LowWM, xargs.HighWM, err = parseLRUWMs(c); err != nil {
+		return
+	}
+	if flagIsSet(c, lruTargetFlag) {
+		tid := parseStrFlag(c, lruTargetFlag)
+		if getNodeType(c, tid) != apc.Target {
+			return fmt.Errorf("%s: node %q does not exist or is not a target", qflprn(lruTargetFlag), tid)
+		}
+		xargs.DaemonID = tid
+	}
+	if flagIsSet(c, lruBucketsFlag) {
+		bckArgs := splitCsv(parseStrFlag(c, lruBucketsFlag))
+		xargs.Buckets = make([]cmn.Bck, len(bckArgs))
+		for idx, bckArg := range bckArgs {
+			bck, err := parseBckURI(c, bckArg, true /*require provider*/)
+			if err != nil {
+				return err
+			}
+			props, err := headBucket(bck, true /*don't add*/)
+			if err != nil {
+				return err
+			}
+			if !props.LRU.Enabled && !xargs.Force {
+				return fmt.Errorf("LRU is disabled for bucket %s (use %s to evict it anyway)", bck.Cname(""), qflprn(forceFlag))
+			}
+			xargs.Buckets[idx] = bck
+		}
+	}
+
+	if flagIsSet(c, forceFlag) && len(xargs.Buckets) > 0 {
 		warn := fmt.Sprintf("LRU eviction with %s option will evict buckets _ignoring_ their respective `lru.enabled` properties.",
 			qflprn(forceFlag))
 		if ok := confirm(c, "Would you like to continue?", warn); !ok {
@@ -679,29 +927,165 @@ func startLRUHandler(c *cli.Context) (err error) {
 		}
 	}
 
-	s := parseStrFlag(c, lruBucketsFlag)
-	bckArgs := splitCsv(s)
-	buckets := make([]cmn.Bck, len(bckArgs))
-	for idx, bckArg := range bckArgs {
+	var id string
+	if id, err = api.StartXaction(apiBP, xargs); err != nil {
+		return
+	}
+	if !flagIsSet(c, waitFlag) && !flagIsSet(c, waitJobXactFinishedFlag) {
+		fmt.Fprintf(c.App.Writer, "Started %s %s. %s\n", apc.ActLRU, id, toMonitorMsg(c, id, ""))
+		return
+	}
+
+	// wait and report
+	fmt.Fprintf(c.App.Writer, "Started %s[%s] ...\n", apc.ActLRU, id)
+	wargs := xact.ArgsMsg{ID: id, Kind: apc.ActLRU}
+	if flagIsSet(c, waitJobXactFinishedFlag) {
+		wargs.Timeout = parseDurationFlag(c, waitJobXactFinishedFlag)
+	}
+	if err = waitXact(apiBP, wargs); err != nil {
+		return
+	}
+	return lruReport(c, id)
+}
+
+// remove bucket's expired objects (one sweep per bucket)
+func startExpirySweepHandler(c *cli.Context) error {
+	var bckArgs []string
+	if flagIsSet(c, lruBucketsFlag) {
+		bckArgs = splitCsv(parseStrFlag(c, lruBucketsFlag))
+	}
+	bckArgs = append(bckArgs, c.Args()...)
+	if len(bckArgs) == 0 {
+		return missingArgumentsError(c, "bucket name (or "+qflprn(lruBucketsFlag)+")")
+	}
+	wait := flagIsSet(c, waitFlag) || flagIsSet(c, waitJobXactFinishedFlag)
+	for _, bckArg := range bckArgs {
 		bck, err := parseBckURI(c, bckArg, true /*require provider*/)
 		if err != nil {
 			return err
 		}
-		buckets[idx] = bck
+		if _, err := headBucket(bck, true /*don't add*/); err != nil {
+			return err
+		}
+		xid, err := api.StartXaction(apiBP, xact.ArgsMsg{Kind: apc.ActExpirySweep, Bck: bck})
+		if err != nil {
+			return err
+		}
+		if !wait {
+			actionDone(c, fmt.Sprintf("Started %s[%s] %s. %s", apc.ActExpirySweep, xid, bck.Cname(""), toMonitorMsg(c, xid, "")))
+			continue
+		}
+		wargs := xact.ArgsMsg{ID: xid, Kind: apc.ActExpirySweep}
+		if flagIsSet(c, waitJobXactFinishedFlag) {
+			wargs.Timeout = parseDurationFlag(c, waitJobXactFinishedFlag)
+		}
+		if err := waitXact(apiBP, wargs); err != nil {
+			return err
+		}
+		expirySweepReport(c, xid, bck)
 	}
+	return nil
+}
 
+// upon completion: removed objects, summed up across targets
+func expirySweepReport(c *cli.Context, xid string, bck cmn.Bck) {
 	var (
-		id    string
-		xargs = xact.ArgsMsg{Kind: apc.ActLRU, Buckets: buckets, Force: flagIsSet(c, forceFlag)}
+		objs, size int64
+		units, _   = parseUnitsFlag(c, unitsFlag)
 	)
-	if id, err = api.StartXaction(apiBP, xargs); err != nil {
+	snaps, err := api.QueryXactionSnaps(apiBP, xact.ArgsMsg{ID: xid, Kind: apc.ActExpirySweep})
+	if err != nil {
+		actionWarn(c, fmt.Sprintf("failed to get %s[%s] stats: %v", apc.ActExpirySweep, xid, err))
 		return
 	}
+	for _, tsnaps := range snaps {
+		for _, snap := range tsnaps {
+			if snap.ID == xid {
+				objs += snap.Stats.Objs
+				size += snap.Stats.Bytes
+			}
+		}
+	}
+	actionDone(c, fmt.Sprintf("%s: removed %d expired object%s (%s)", bck.Cname(""), objs, cos.Plural(int(objs)),
+		teb.FmtSize(size, units, 2)))
+}
 
-	fmt.Fprintf(c.App.Writer, "Started %s %s. %s\n", apc.ActLRU, id, toMonitorMsg(c, id, ""))
+// '--above' (high) and '--below' (low) watermarks, e.g. "85%" or "85";
+// zero when not specified (and then defaults to the configured value)
+func parseLRUWMs(c *cli.Context) (lwm, hwm int64, err error) {
+	if flagIsSet(c, lruBelowFlag) {
+		if lwm, err = parseWM(parseStrFlag(c, lruBelowFlag)); err != nil {
+			return 0, 0, fmt.Errorf("invalid %s: %v", qflprn(lruBelowFlag), err)
+		}
+	}
+	if flagIsSet(c, lruAboveFlag) {
+		if hwm, err = parseWM(parseStrFlag(c, lruAboveFlag)); err != nil {
+			return 0, 0, fmt.Errorf("invalid %s: %v", qflprn(lruAboveFlag), err)
+		}
+	}
+	if lwm > 0 && hwm > 0 && lwm >= hwm {
+		err = fmt.Errorf("%s (%d%%) must be less than %s (%d%%)", qflprn(lruBelowFlag), lwm, qflprn(lruAboveFlag), hwm)
+	}
 	return
 }
 
+func parseWM(s string) (int64, error) {
+	v, err := strconv.ParseInt(strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(s), "%")), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%q is not a percentage", s)
+	}
+	if v <= 0 || v > 100 {
+		return 0, fmt.Errorf("%q is out of range (expecting 1%% to 100%%)", s)
+	}
+	return v, nil
+}
+
+// upon completion: evicted size per target, per mountpath
+func lruReport(c *cli.Context, xid string) error {
+	snaps, err := api.QueryXactionSnaps(apiBP, xact.ArgsMsg{ID: xid, Kind: apc.ActLRU})
+	if err != nil {
+		return fmt.Errorf("failed to get %s[%s] stats: %v", apc.ActLRU, xid, err)
+	}
+	var (
+		units, _ = parseUnitsFlag(c, unitsFlag)
+		tids     = make([]string, 0, len(snaps))
+		total    int64
+		tw       = &tabwriter.Writer{}
+	)
+	for tid := range snaps {
+		tids = append(tids, tid)
+	}
+	sort.Strings(tids)
+	tw.Init(c.App.Writer, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "TARGET\tMOUNTPATH\tEVICTED")
+	for _, tid := range tids {
+		for _, snap := range snaps[tid] {
+			if snap.ID != xid {
+				continue
+			}
+			ext := &space.ExtLRUStats{}
+			if snap.Ext == nil || cos.MorphMarshal(snap.Ext, ext) != nil || len(ext.Evicted) == 0 {
+				fmt.Fprintf(tw, "%s\t%s\t%s\n", tid, teb.NotSetVal, teb.FmtSize(0, units, 2))
+				continue
+			}
+			mpaths := make([]string, 0, len(ext.Evicted))
+			for mpath := range ext.Evicted {
+				mpaths = append(mpaths, mpath)
+			}
+			sort.Strings(mpaths)
+			for _, mpath := range mpaths {
+				fmt.Fprintf(tw, "%s\t%s\t%s\n", tid, mpath, teb.FmtSize(ext.Evicted[mpath], units, 2))
+				total += ext.Evicted[mpath]
+			}
+		}
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	actionDone(c, fmt.Sprintf("Evicted %s in total", teb.FmtSize(total, units, 2)))
+	return nil
+}
+
 func startPrefetchHandler(c *cli.Context) (err error) {
 	printDryRunHeader(c)
 
@@ -721,6 +1105,9 @@ func startPrefetchHandler(c *cli.Context) (err error) {
 	if _, err = headBucket(bck, false /* don't add */); err != nil {
 		return
 	}
+	if _, err = prefetchMsg(c, cmn.ListRange{}); err != nil { // fail fast
+		return
+	}
 
 	if flagIsSet(c, listFlag) || flagIsSet(c, templateFlag) {
 		return listrange(c, bck)
@@ -728,6 +1115,52 @@ func startPrefetchHandler(c *cli.Context) (err error) {
 	return missingArgumentsError(c, "object list or range")
 }
 
+func prefetchMsg(c *cli.Context, lr cmn.ListRange) (*cmn.PrefetchMsg, error) {
+	msg := &cmn.PrefetchMsg{ListRange: lr, Throttle: parseStrFlag(c, prefetchThrottleFlag)}
+	if flagIsSet(c, prefetchLimitBphFlag) {
+		bph, err := parseSizeFlag(c, prefetchLimitBphFlag)
+		if err != nil {
+			return nil, err
+		}
+		msg.BytesPerHour = bph
+	}
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// upon completion: prefetched and skipped (already present) objects, summed up across targets
+func prefetchReport(c *cli.Context, xids []string) {
+	var (
+		objs, size, skipped, skippedSize int64
+		units, _                         = parseUnitsFlag(c, unitsFlag)
+	)
+	for _, xid := range xids {
+		snaps, err := api.QueryXactionSnaps(apiBP, xact.ArgsMsg{ID: xid, Kind: apc.ActPrefetchObjects})
+		if err != nil {
+			actionWarn(c, fmt.Sprintf("failed to get prefetch[%s] stats: %v", xid, err))
+			return
+		}
+		for _, tsnaps := range snaps {
+			for _, snap := range tsnaps {
+				if snap.ID != xid {
+					continue
+				}
+				objs += snap.Stats.Objs
+				size += snap.Stats.Bytes
+				ext := &xact.ExtPrefetchStats{}
+				if snap.Ext != nil && cos.MorphMarshal(snap.Ext, ext) == nil {
+					skipped += ext.SkippedCnt
+					skippedSize += ext.SkippedSize
+				}
+			}
+		}
+	}
+	fmt.Fprintf(c.App.Writer, "Prefetched %d object%s (%s), skipped %d already present (%s)\n",
+		objs, cos.Plural(int(objs)), teb.FmtSize(size, units, 2), skipped, teb.FmtSize(skippedSize, units, 2))
+}
+
 //
 // job stop
 //
@@ -776,6 +1209,9 @@ func stopJobHandler(c *cli.Context) error {
 	if name == "" && xid != "" {
 		name, otherID = xid2Name(xid)
 	}
+	if flagIsSet(c, dsortCleanupFlag) && name != cmdDsort {
+		return incorrectUsageMsg(c, "%s applies only to %s jobs", qflprn(dsortCleanupFlag), cmdDsort)
+	}
 
 	// specialized stop
 	switch name {
@@ -826,7 +1262,7 @@ func stopJobHandler(c *cli.Context) error {
 			return cannotExecuteError(c, errors.New("missing "+jobIDArgument), msg)
 		}
 
-		// stop all xactions of a given kind (TODO: bck)
+		// stop all xactions of a given kind (and bucket, if specified)
 		return stopXactionKind(c, xactKind, xname, bck)
 	}
 
@@ -871,28 +1307,47 @@ func stopJobHandler(c *cli.Context) error {
 	return nil
 }
 
-// TODO: `bck` must provide additional filtering (NIY)
+// stop all running xactions of a given kind (and, optionally, bucket) cluster-wide
 func stopXactionKind(c *cli.Context, xactKind, xname string, bck cmn.Bck) error {
-	xactIDs, err := api.GetAllRunningXactions(apiBP, xactKind)
+	xs, err := queryXactions(xact.ArgsMsg{Kind: xactKind, Bck: bck, OnlyRunning: true})
 	if err != nil {
 		return err
 	}
+	xactIDs := extractXactIDsForKind(xs, xactKind)
 	if len(xactIDs) == 0 {
-		actionDone(c, fmt.Sprintf("No running '%s' jobs, nothing to do", xname))
+		actionDone(c, fmt.Sprintf("No running '%s' jobs, nothing to do", formatXactMsg("", xname, bck)))
 		return nil
 	}
-	for _, ki := range xactIDs {
+	if flagIsSet(c, allRunningJobsFlag) && !flagIsSet(c, yesFlag) {
+		prompt := fmt.Sprintf("Stop %d running '%s' job%s?", len(xactIDs), formatXactMsg("", xname, bck),
+			cos.Plural(len(xactIDs)))
+		if ok := confirm(c, prompt); !ok {
+			return nil
+		}
+	}
+	var nstopped, nfinished int
+	for _, xactID := range xactIDs {
 		var (
-			i      = strings.IndexByte(ki, xact.LeftID[0])
-			xactID = ki[i+1 : len(ki)-1] // extract UUID from "name[UUID]"
-			args   = xact.ArgsMsg{ID: xactID, Kind: xactKind, Bck: bck}
-			msg    = formatXactMsg(xactID, xname, bck)
+			args = xact.ArgsMsg{ID: xactID, Kind: xactKind, Bck: bck}
+			msg  = formatXactMsg(xactID, xname, bck)
 		)
 		if err := api.AbortXaction(apiBP, args); err != nil {
 			actionWarn(c, fmt.Sprintf("failed to stop %s: %v", msg, err))
-		} else {
-			actionDone(c, "Stopped "+msg)
+			continue
+		}
+		// the xaction may have finished in the meantime
+		if snap, err := getXactSnap(xact.ArgsMsg{ID: xactID, Kind: xactKind}); err == nil && snap != nil &&
+			snap.Finished() && !snap.IsAborted() {
+			fmt.Fprintf(c.App.Writer, "%s already finished, nothing to do\n", msg)
+			nfinished++
+			continue
 		}
+		actionDone(c, "Stopped "+msg)
+		nstopped++
+	}
+	if len(xactIDs) > 1 {
+		fmt.Fprintf(c.App.Writer, "Total: %d stopped, %d already finished, %d failed\n",
+			nstopped, nfinished, len(xactIDs)-nstopped-nfinished)
 	}
 	return nil
 }
@@ -954,8 +1409,7 @@ func stopDsortRegex(c *cli.Context, regex string) error {
 
 	var cnt int
 	for _, dsort := range dsortLst {
-		if err = api.AbortDSort(apiBP, dsort.ID); err == nil {
-			actionDone(c, fmt.Sprintf("Stopped dsort job %s", dsort.ID))
+		if err = stopDsortHandler(c, dsort.ID); err == nil {
 			cnt++
 		} else {
 			actionWarn(c, fmt.Sprintf("failed to stop dsort job %q: %v", dsort.ID, err))
@@ -970,12 +1424,20 @@ func stopDsortRegex(c *cli.Context, regex string) error {
 	return nil
 }
 
-func stopDsortHandler(c *cli.Context, id string) (err error) {
-	if err = api.AbortDSort(apiBP, id); err != nil {
-		return
+func stopDsortHandler(c *cli.Context, id string) error {
+	if !flagIsSet(c, dsortCleanupFlag) {
+		if err := api.AbortDSort(apiBP, id); err != nil {
+			return err
+		}
+		actionDone(c, fmt.Sprintf("Stopped dsort job %s", id))
+		return nil
 	}
-	actionDone(c, fmt.Sprintf("Stopped dsort job %s\n", id))
-	return
+	removed, err := api.AbortDSortCleanup(apiBP, id)
+	if err != nil {
+		return err
+	}
+	actionDone(c, fmt.Sprintf("Stopped dsort job %s and removed %d partial output shard%s", id, removed, cos.Plural(removed)))
+	return nil
 }
 
 //
@@ -992,6 +1454,13 @@ func waitJobHandler(c *cli.Context) error {
 	if err != nil {
 		return err
 	}
+	if flagIsSet(c, allRunningJobsFlag) {
+		if xid != "" {
+			return incorrectUsageMsg(c, "%s argument ('%s') and flag %s are mutually exclusive",
+				jobIDArgument, xid, qflprn(allRunningJobsFlag))
+		}
+		return waitAllJobs(c, name, parseStrFlag(c, regexJobsFlag))
+	}
 	if name == "" && xid == "" {
 		return missingArgumentsError(c, c.Command.ArgsUsage)
 	}
@@ -1175,6 +1644,242 @@ func waitDsortHandler(c *cli.Context, id string) error {
 	return nil
 }
 
+//
+// `job wait --all`
+//
+
+const (
+	waitStatusRunning = "running"
+	waitStatusDone    = "done"
+	waitStatusFailed  = "failed"
+	waitStatusAborted = "aborted"
+	waitStatusTimeout = "timed out"
+)
+
+type waitJobStatus struct {
+	Kind    string
+	ID      string
+	Status  string
+	Elapsed cos.Duration // job's own running time (as reported by the cluster upon the last poll)
+	Err     string
+}
+
+func (j *waitJobStatus) failed() bool { return j.Status != waitStatusDone }
+
+// wait for all currently running jobs (optionally, of a given kind and/or matching regex);
+// return error if any of them fails, gets aborted, or does not finish in time
+func waitAllJobs(c *cli.Context, name, regex string) error {
+	var rex *regexp.Regexp
+	if regex != "" {
+		var err error
+		if rex, err = regexp.Compile(regex); err != nil {
+			return fmt.Errorf("invalid regex %q: %v", regex, err)
+		}
+	}
+	jobs, err := runningJobs(name, rex)
+	if err != nil {
+		return err
+	}
+	if len(jobs) == 0 {
+		actionDone(c, "No running jobs, nothing to do")
+		return nil
+	}
+
+	var (
+		refreshRate = _refreshRate(c)
+		started     = time.Now()
+		timeout     time.Duration
+		pending     = len(jobs)
+	)
+	if flagIsSet(c, waitJobXactFinishedFlag) {
+		timeout = parseDurationFlag(c, waitJobXactFinishedFlag)
+	}
+	fmt.Fprintf(c.App.Writer, "Waiting for %d job%s ", len(jobs), cos.Plural(len(jobs)))
+	for {
+		for _, j := range jobs {
+			if j.Status != waitStatusRunning {
+				continue
+			}
+			if err := j.poll(); err != nil {
+				j.Status, j.Err = waitStatusFailed, err.Error()
+			}
+			if j.Status != waitStatusRunning {
+				pending--
+			}
+		}
+		if pending == 0 {
+			break
+		}
+		if timeout != 0 && time.Since(started) > timeout {
+			for _, j := range jobs {
+				if j.Status == waitStatusRunning {
+					j.Status = waitStatusTimeout
+				}
+			}
+			break
+		}
+		time.Sleep(refreshRate)
+		fmt.Fprint(c.App.Writer, ".")
+	}
+	fmt.Fprintln(c.App.Writer)
+	fmt.Fprintln(c.App.Writer)
+
+	if err := teb.Print(jobs, teb.JobWaitAllTmpl); err != nil {
+		return err
+	}
+	var nfailed int
+	for _, j := range jobs {
+		if j.failed() {
+			nfailed++
+		}
+	}
+	if nfailed > 0 {
+		return fmt.Errorf("%d (out of %d) job%s did not finish successfully", nfailed, len(jobs), cos.Plural(len(jobs)))
+	}
+	return nil
+}
+
+// enumerate running xactions, downloads, and dsorts
+func runningJobs(name string, rex *regexp.Regexp) (jobs []*waitJobStatus, err error) {
+	var (
+		xactKind string
+		match    = func(kind string) bool { return rex == nil || rex.MatchString(kind) }
+	)
+	switch name {
+	case "", cmdDownload, cmdDsort:
+	default:
+		if xactKind, _ = xact.GetKindName(name); xactKind == "" {
+			return nil, fmt.Errorf("unrecognized job name %q", name)
+		}
+	}
+	if name == "" || name == cmdDownload {
+		dlList, err := api.DownloadGetList(apiBP, "", true /*onlyActive*/)
+		if err != nil {
+			return nil, err
+		}
+		if match(cmdDownload) {
+			for _, dl := range dlList {
+				jobs = append(jobs, &waitJobStatus{Kind: cmdDownload, ID: dl.ID, Status: waitStatusRunning})
+			}
+		}
+	}
+	if name == "" || name == cmdDsort {
+		dsortLst, err := api.ListDSort(apiBP, "", true /*onlyActive*/)
+		if err != nil {
+			return nil, err
+		}
+		if match(cmdDsort) {
+			for _, dsort := range dsortLst {
+				jobs = append(jobs, &waitJobStatus{Kind: cmdDsort, ID: dsort.ID, Status: waitStatusRunning})
+			}
+		}
+	}
+	if name == cmdDownload || name == cmdDsort {
+		return
+	}
+	xactIDs, err := api.GetAllRunningXactions(apiBP, xactKind)
+	if err != nil {
+		return nil, err
+	}
+	for _, ki := range xactIDs {
+		var (
+			i    = strings.IndexByte(ki, xact.LeftID[0])
+			kind = ki[:i]
+			id   = ki[i+1 : len(ki)-1] // extract UUID from "kind[UUID]"
+		)
+		// skip on-demand xactions that linger (idle) in-between requests
+		// (downloads, in particular, are already accounted for - see above)
+		if xact.Table[kind].Idles {
+			continue
+		}
+		if !match(kind) && !match(xact.Table[kind].DisplayName) {
+			continue
+		}
+		jobs = append(jobs, &waitJobStatus{Kind: kind, ID: id, Status: waitStatusRunning})
+	}
+	return
+}
+
+func (j *waitJobStatus) poll() error {
+	switch j.Kind {
+	case cmdDownload:
+		resp, err := api.DownloadStatus(apiBP, j.ID, true /*onlyActive*/)
+		if err != nil {
+			return err
+		}
+		j.elapsed(resp.StartedTime, resp.FinishedTime)
+		switch {
+		case resp.Aborted:
+			j.Status = waitStatusAborted
+		case !resp.JobFinished():
+		case resp.ErrorCnt > 0:
+			j.Status = waitStatusFailed
+			j.Err = fmt.Sprintf("%d error%s", resp.ErrorCnt, cos.Plural(resp.ErrorCnt))
+		default:
+			j.Status = waitStatusDone
+		}
+	case cmdDsort:
+		resp, err := api.MetricsDSort(apiBP, j.ID)
+		if err != nil {
+			return err
+		}
+		var (
+			finished   = true
+			start, end time.Time
+		)
+		for _, tm := range resp {
+			if s := tm.Extraction.Start; !s.IsZero() && (start.IsZero() || s.Before(start)) {
+				start = s
+			}
+			if e := tm.Creation.End; e.After(end) {
+				end = e
+			}
+			finished = finished && tm.Creation.Finished
+		}
+		if !finished {
+			end = time.Time{} // still running
+		}
+		j.elapsed(start, end)
+		for _, tm := range resp {
+			if tm.Aborted.Load() {
+				j.Status = waitStatusAborted
+				if len(tm.Errors) > 0 {
+					j.Err = tm.Errors[0]
+				}
+				return nil
+			}
+		}
+		if finished {
+			j.Status = waitStatusDone
+		}
+	default:
+		status, err := api.GetOneXactionStatus(apiBP, xact.ArgsMsg{ID: j.ID, Kind: j.Kind})
+		if err != nil {
+			if herr, ok := err.(*cmn.ErrHTTP); ok && herr.Status == http.StatusNotFound {
+				j.Status = waitStatusDone // (finished and gone)
+				return nil
+			}
+			return err
+		}
+		if xs, err := api.QueryXactionSnaps(apiBP, xact.ArgsMsg{ID: j.ID, Kind: j.Kind}); err == nil {
+			if d, err := xs.TotalRunningTime(j.ID); err == nil {
+				j.Elapsed = cos.Duration(d)
+			}
+		}
+		switch {
+		case status.Aborted():
+			j.Status, j.Err = waitStatusAborted, status.ErrMsg
+		case status.Finished():
+			if status.ErrMsg != "" {
+				j.Status, j.Err = waitStatusFailed, status.ErrMsg
+			} else {
+				j.Status = waitStatusDone
+			}
+		}
+	}
+	return nil
+}
+
 //
 // job remove
 //
@@ -1343,3 +2048,14 @@ func xid2Name(xid string) (name, otherID string) {
 	}
 	return
 }
+
+// running time of the job itself (not the time spent waiting for it)
+func (j *waitJobStatus) elapsed(start, end time.Time) {
+	switch {
+	case start.IsZero():
+	case end.IsZero():
+		j.Elapsed = cos.Duration(time.Since(start))
+	default:
+		j.Elapsed = cos.Duration(end.Sub(start))
+	}
+}
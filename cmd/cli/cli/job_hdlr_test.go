@@ -0,0 +1,23 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"testing"
+
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestParseWM(t *testing.T) {
+	for in, exp := range map[string]int64{"85%": 85, "70": 70, " 100 %": 100, "1%": 1} {
+		v, err := parseWM(in)
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, v == exp, "%q: expected %d, got %d", in, exp, v)
+	}
+	for _, in := range []string{"", "0%", "101", "-5%", "85%%", "high"} {
+		_, err := parseWM(in)
+		tassert.Errorf(t, err != nil, "%q: expected error", in)
+	}
+}
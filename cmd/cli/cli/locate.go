@@ -0,0 +1,219 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+// This file implements `ais object locate` (placement preview).
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cmd/cli/teb"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/urfave/cli"
+)
+
+type (
+	// where a given object would be stored: HRW target and, for erasure-coded buckets,
+	// the targets that would store its slices and/or replicas (in the slice order)
+	objPlacement struct {
+		Name      string   `json:"name"`
+		Target    string   `json:"target"`
+		ECTargets []string `json:"ec_targets,omitempty"`
+	}
+	placementAll struct {
+		Objects []*objPlacement `json:"objects,omitempty"`
+		Targets map[string]int  `json:"targets"` // target ID => number of objects
+	}
+)
+
+func locateHandler(c *cli.Context) error {
+	if c.NArg() == 0 {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	var (
+		uri      = c.Args().Get(0)
+		listObjs = parseStrFlag(c, listFlag)
+		tmplObjs = parseStrFlag(c, templateFlag)
+		multi    = listObjs != "" || tmplObjs != ""
+	)
+	bck, objName, err := parseBckObjectURI(c, uri, multi /*optObjName*/)
+	if err != nil {
+		return err
+	}
+	switch {
+	case listObjs != "" && tmplObjs != "":
+		return incorrectUsageMsg(c, errFmtExclusive, qflprn(listFlag), qflprn(templateFlag))
+	case multi && objName != "":
+		return incorrectUsageMsg(c, "object name in %q cannot be used together with %s or %s",
+			uri, qflprn(listFlag), qflprn(templateFlag))
+	}
+	smap, err := getClusterMap(c)
+	if err != nil {
+		return err
+	}
+	var ecTargets int
+	if !bck.IsHTTP() {
+		p, err := headBucket(bck, true /* don't add */)
+		if err != nil {
+			return err
+		}
+		if p.EC.Enabled {
+			ecTargets = p.EC.RequiredEncodeTargets()
+		}
+	}
+
+	var (
+		summary = flagIsSet(c, locateSummaryFlag)
+		all     = placementAll{Targets: make(map[string]int, smap.CountActiveTs())}
+	)
+	for _, tsi := range smap.Tmap {
+		if !tsi.InMaintOrDecomm() && !tsi.InProbation() {
+			all.Targets[tsi.ID()] = 0
+		}
+	}
+	add := func(name string) error {
+		pl, err := locateObj(bck, name, smap, ecTargets)
+		if err != nil {
+			return err
+		}
+		all.Targets[pl.Target]++
+		if !summary {
+			all.Objects = append(all.Objects, pl)
+		}
+		return nil
+	}
+	switch {
+	case objName != "":
+		err = add(objName)
+	case listObjs == "-":
+		err = locateStdin(add)
+	case listObjs != "":
+		for _, name := range splitCsv(listObjs) {
+			if err = add(name); err != nil {
+				break
+			}
+		}
+	default:
+		var pt cos.ParsedTemplate
+		if pt, err = cos.NewParsedTemplate(tmplObjs); err != nil {
+			return err
+		}
+		pt.InitIter()
+		for name, hasNext := pt.Next(); hasNext && err == nil; name, hasNext = pt.Next() {
+			err = add(name)
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	if flagIsSet(c, jsonFlag) {
+		return teb.Print(all, "", teb.Jopts(true))
+	}
+	tw := &tabwriter.Writer{}
+	tw.Init(c.App.Writer, 0, 8, 2, ' ', 0)
+	if len(all.Objects) > 0 {
+		if !flagIsSet(c, noHeaderFlag) {
+			if ecTargets > 0 {
+				fmt.Fprintln(tw, "OBJECT\tTARGET\tEC TARGETS")
+			} else {
+				fmt.Fprintln(tw, "OBJECT\tTARGET")
+			}
+		}
+		for _, pl := range all.Objects {
+			fmt.Fprintf(tw, "%s\t%s", pl.Name, cluster.Tname(pl.Target))
+			for i, tid := range pl.ECTargets {
+				sep := ", "
+				if i == 0 {
+					sep = "\t"
+				}
+				fmt.Fprint(tw, sep+cluster.Tname(tid))
+			}
+			fmt.Fprintln(tw)
+		}
+	}
+	if multi || summary {
+		if len(all.Objects) > 0 {
+			fmt.Fprintln(tw)
+		}
+		all.fprintSummary(tw, flagIsSet(c, noHeaderFlag))
+	}
+	return tw.Flush()
+}
+
+func locateStdin(add func(name string) error) error {
+	sl := newStdinList(os.Stdin, stdinListBatch)
+	for {
+		names, err := sl.next()
+		if err != nil || len(names) == 0 {
+			return err
+		}
+		for _, name := range names {
+			if err := add(name); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// runs the cluster's placement function (HRW) client-side
+func locateObj(bck cmn.Bck, objName string, smap *cluster.Smap, ecTargets int) (*objPlacement, error) {
+	var (
+		pl    = &objPlacement{Name: objName}
+		uname = bck.MakeUname(objName)
+	)
+	if ecTargets == 0 {
+		tsi, err := cluster.HrwTarget(uname, smap)
+		if err != nil {
+			return nil, err
+		}
+		pl.Target = tsi.ID()
+		return pl, nil
+	}
+	sis, err := cluster.HrwTargetList(uname, smap, ecTargets)
+	if err != nil {
+		return nil, err
+	}
+	if len(sis) < ecTargets { // (HrwTargetList returns "as many as possible" when asked for all)
+		return nil, fmt.Errorf("%v: required %d, available %d, %s", cmn.ErrNotEnoughTargets, ecTargets, len(sis), smap)
+	}
+	pl.Target = sis[0].ID()
+	pl.ECTargets = make([]string, 0, len(sis)-1)
+	for _, tsi := range sis[1:] {
+		pl.ECTargets = append(pl.ECTargets, tsi.ID())
+	}
+	return pl, nil
+}
+
+// per-target distribution, including targets that get nothing
+func (all *placementAll) fprintSummary(tw *tabwriter.Writer, noHeader bool) {
+	var (
+		tids  = make([]string, 0, len(all.Targets))
+		total int
+		max   int
+	)
+	for tid, n := range all.Targets {
+		tids = append(tids, tid)
+		total += n
+		max = cos.Max(max, n)
+	}
+	sort.Strings(tids)
+	if !noHeader {
+		fmt.Fprintln(tw, "TARGET\tOBJECTS\tSHARE")
+	}
+	for _, tid := range tids {
+		n := all.Targets[tid]
+		fmt.Fprintf(tw, "%s\t%d\t%.1f%%\n", cluster.Tname(tid), n, float64(n)*100/float64(cos.Max(total, 1)))
+	}
+	if len(tids) > 0 && total > 0 {
+		avg := float64(total) / float64(len(tids))
+		fmt.Fprintf(tw, "%d object%s, %d target%s (max/avg: %.2f)\n",
+			total, cos.Plural(total), len(tids), cos.Plural(len(tids)), float64(max)/avg)
+	}
+}
@@ -0,0 +1,62 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+	"text/tabwriter"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestLocateObj(t *testing.T) {
+	var (
+		bck  = cmn.Bck{Name: "b", Provider: apc.AIS}
+		smap = &cluster.Smap{Tmap: make(cluster.NodeMap, 4)}
+	)
+	for _, tid := range []string{"t1", "t2", "t3", "t4"} {
+		smap.Tmap[tid] = cluster.NewSnode(tid, apc.Target, cluster.NetInfo{}, cluster.NetInfo{}, cluster.NetInfo{})
+	}
+	smap.Tmap["t4"].Flags = cluster.NodeFlagMaint
+
+	all := placementAll{Targets: map[string]int{"t1": 0, "t2": 0, "t3": 0}}
+	for i := 0; i < 100; i++ {
+		name := fmt.Sprintf("shard-%02d.tar", i)
+		pl, err := locateObj(bck, name, smap, 0)
+		tassert.CheckFatal(t, err)
+		tsi, err := cluster.HrwTarget(bck.MakeUname(name), smap)
+		tassert.CheckFatal(t, err)
+		tassert.Fatalf(t, pl.Target == tsi.ID() && pl.Target != "t4", "%s: unexpected target %s", name, pl.Target)
+		all.Targets[pl.Target]++
+
+		// EC: 1 data + 1 parity slice, plus the main replica
+		pl, err = locateObj(bck, name, smap, 3)
+		tassert.CheckFatal(t, err)
+		tassert.Fatalf(t, pl.Target == tsi.ID() && len(pl.ECTargets) == 2, "%s: unexpected EC placement %+v", name, pl)
+		for _, tid := range pl.ECTargets {
+			tassert.Fatalf(t, tid != pl.Target && tid != "t4", "%s: unexpected EC target %s", name, tid)
+		}
+	}
+	_, err := locateObj(bck, "o", smap, 4)
+	tassert.Errorf(t, err != nil, "expecting not enough targets (3 active) for 4-way EC")
+
+	var (
+		out bytes.Buffer
+		tw  = &tabwriter.Writer{}
+	)
+	tw.Init(&out, 0, 8, 2, ' ', 0)
+	all.fprintSummary(tw, false)
+	tw.Flush()
+	for _, tid := range []string{"t1", "t2", "t3"} {
+		tassert.Errorf(t, strings.Contains(out.String(), cluster.Tname(tid)), "missing %s in:\n%s", tid, out.String())
+	}
+	tassert.Errorf(t, strings.Contains(out.String(), "100 objects, 3 targets"), "unexpected summary:\n%s", out.String())
+}
@@ -5,7 +5,40 @@
  */
 package cli
 
-import "github.com/urfave/cli"
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/urfave/cli"
+)
+
+const logReconnectInterval = 2 * time.Second
+
+type (
+	// follows (tails) a single node's log, reconnecting when the node (or the primary) restarts
+	logFollower struct {
+		w      io.Writer
+		mu     *sync.Mutex // serializes output lines from all followers
+		node   *cluster.Snode
+		prefix string
+		sev    string
+		offset int64 // absolute offset in the node's log; -1 when not known (tail)
+	}
+	// writes complete lines prefixed with node ID
+	logLineWriter struct {
+		lf      *logFollower
+		partial []byte
+	}
+)
 
 var logCmd = cli.Command{
 	Name:  commandLog,
@@ -14,3 +47,125 @@ var logCmd = cli.Command{
 		makeAlias(showCmdLog, "", true, commandShow), // alias for `ais show`
 	},
 }
+
+func parseLogSev(c *cli.Context) (sev string, err error) {
+	sev = strings.ToLower(parseStrFlag(c, logSevFlag))
+	if sev != "" {
+		switch sev[0] {
+		case apc.LogInfo[0], apc.LogWarn[0], apc.LogErr[0]:
+		default:
+			err = fmt.Errorf("invalid log severity, expecting empty string or one of: %s, %s, %s",
+				apc.LogInfo, apc.LogWarn, apc.LogErr)
+		}
+	}
+	return
+}
+
+// `ais show cluster log [NODE_ID] [--follow]`
+func showClusterLogHandler(c *cli.Context) error {
+	if !flagIsSet(c, logFollowFlag) {
+		if c.NArg() == 0 {
+			return fmt.Errorf("missing %s (to tail logs of all nodes, use %s)", c.Command.ArgsUsage, qflprn(logFollowFlag))
+		}
+		return showNodeLogHandler(c)
+	}
+	sev, err := parseLogSev(c)
+	if err != nil {
+		return err
+	}
+	smap, err := getClusterMap(c)
+	if err != nil {
+		return err
+	}
+	var nodes []*cluster.Snode
+	if c.NArg() > 0 {
+		sid, _, err := argNode(c)
+		if err != nil {
+			return err
+		}
+		nodes = append(nodes, smap.GetNode(sid))
+	} else {
+		for _, m := range []cluster.NodeMap{smap.Pmap, smap.Tmap} {
+			for _, si := range m {
+				nodes = append(nodes, si)
+			}
+		}
+	}
+	var (
+		wg sync.WaitGroup
+		mu sync.Mutex
+	)
+	for _, si := range nodes {
+		lf := &logFollower{w: c.App.Writer, mu: &mu, node: si, prefix: si.StringEx() + " ", sev: sev, offset: -1}
+		wg.Add(1)
+		go func() {
+			lf.run()
+			wg.Done()
+		}()
+	}
+	wg.Wait() // until Ctrl-C
+	return nil
+}
+
+func (lf *logFollower) run() {
+	var (
+		lw      = &logLineWriter{lf: lf}
+		errPrev string
+	)
+	for {
+		args := api.GetLogInput{Writer: lw, Severity: lf.sev, Offset: lf.offset, Follow: true}
+		off, err := api.GetDaemonLog(apiBP, lf.node, args)
+		lw.flush()
+		if err == nil {
+			// the node closed the stream (e.g., shutting down)
+			lf.offset = off
+			time.Sleep(logReconnectInterval)
+			continue
+		}
+		if herr, ok := err.(*cmn.ErrHTTP); ok && herr.Status == http.StatusBadRequest && lf.offset > 0 {
+			// the node rejected the offset: restarted with a new (or rotated) log -
+			// the next time around, start from the beginning
+			lf.offset = 0
+		} else {
+			lf.offset = off
+		}
+		if s := err.Error(); s != errPrev {
+			lf.println(fmt.Sprintf("(disconnected: %v - reconnecting...)", err))
+			errPrev = s
+		}
+		time.Sleep(logReconnectInterval)
+	}
+}
+
+func (lf *logFollower) println(line string) {
+	lf.mu.Lock()
+	fmt.Fprintln(lf.w, lf.prefix+line)
+	lf.mu.Unlock()
+}
+
+func (lw *logLineWriter) Write(p []byte) (int, error) {
+	b := p
+	for {
+		i := bytes.IndexByte(b, '\n')
+		if i < 0 {
+			lw.partial = append(lw.partial, b...)
+			break
+		}
+		if len(lw.partial) > 0 {
+			lw.partial = append(lw.partial, b[:i]...)
+			lw.lf.println(string(lw.partial))
+			lw.partial = lw.partial[:0]
+		} else {
+			lw.lf.println(string(b[:i]))
+		}
+		b = b[i+1:]
+	}
+	return len(p), nil
+}
+
+func (lw *logLineWriter) flush() {
+	if len(lw.partial) > 0 {
+		lw.lf.println(string(lw.partial))
+		lw.partial = lw.partial[:0]
+	}
+}
@@ -0,0 +1,253 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+// This file implements `ais cluster add-remove-nodes apply` (batch membership changes).
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/NVIDIA/aistore/api"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cmd/cli/teb"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/xact"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/urfave/cli"
+	"gopkg.in/yaml.v2"
+)
+
+const membershipSkip = "skip"
+
+type (
+	// membership file (JSON or YAML), e.g.:
+	// {"join": [{"addr": "10.0.0.5:51081", "role": "target"}], "decommission": ["t[xyz]"]}
+	membershipJoin struct {
+		Addr      string `json:"addr"`
+		Role      string `json:"role"`
+		Probation bool   `json:"probation,omitempty"`
+	}
+	membershipSpec struct {
+		Join         []membershipJoin `json:"join"`
+		Decommission []string         `json:"decommission"` // node IDs or names, e.g. "t[xyz]"
+	}
+	// reconciliation plan: one step per entry in the spec
+	membershipStep struct {
+		join   *membershipJoin
+		node   *cluster.Snode // decommission, or already a member (skip)
+		action string         // cmdJoin | cmdNodeDecommission | membershipSkip
+		note   string
+	}
+)
+
+func membershipApplyHandler(c *cli.Context) error {
+	if c.NArg() == 0 {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	spec, err := loadMembershipSpec(c.Args().Get(0))
+	if err != nil {
+		return err
+	}
+	smap, err := getClusterMap(c)
+	if err != nil {
+		return err
+	}
+	plan, err := planMembership(spec, smap)
+	if err != nil {
+		return err
+	}
+
+	// report the plan
+	var nj, nd int
+	for _, step := range plan {
+		switch step.action {
+		case cmdJoin:
+			nj++
+		case cmdNodeDecommission:
+			nd++
+		}
+	}
+	tw := &tabwriter.Writer{}
+	tw.Init(c.App.Writer, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "ACTION\tNODE\tDETAILS")
+	for _, step := range plan {
+		fmt.Fprintln(tw, step.String())
+	}
+	tw.Flush()
+	fmt.Fprintln(c.App.Writer)
+
+	if nj+nd == 0 {
+		actionDone(c, "Nothing to do: cluster membership already matches "+c.Args().Get(0))
+		return nil
+	}
+	summary := fmt.Sprintf("%d node%s to join, %d to decommission", nj, cos.Plural(nj), nd)
+	if flagIsSet(c, dryRunFlag) {
+		actionDone(c, dryRunHeader+" "+summary+" (no changes made)")
+		return nil
+	}
+	if !flagIsSet(c, yesFlag) {
+		warn := summary
+		if nd > 0 {
+			warn += ". Decommissioning cannot be undone!"
+		}
+		if ok := confirm(c, "Proceed?", warn); !ok {
+			return nil
+		}
+	}
+
+	// execute: joins first (to add capacity before taking it away), then decommissions,
+	// one at a time, waiting for the preceding rebalance (if any) before removing a target
+	var (
+		rebID string
+		done  int
+		total = nj + nd
+	)
+	for _, step := range plan {
+		switch step.action {
+		case cmdJoin:
+			var node *cluster.Snode
+			rebID, node, err = joinNode(step.join.Addr, step.join.Role, step.join.Probation)
+			if err != nil {
+				return fmt.Errorf("failed to join %s %s: %v (%d of %d changes done)", step.join.Role, step.join.Addr, err, done, total)
+			}
+			fmt.Fprintf(c.App.Writer, "%s %s joined the cluster\n", node.StringEx(), step.join.Addr)
+		case cmdNodeDecommission:
+			if rebID != "" && step.node.IsTarget() {
+				fmt.Fprintf(c.App.Writer, "Waiting for rebalance %s to finish...\n", rebID)
+				if err := waitXact(apiBP, xact.ArgsMsg{ID: rebID, Kind: apc.ActRebalance}); err != nil {
+					return fmt.Errorf("rebalance %s failed: %v (%d of %d changes done)", rebID, err, done, total)
+				}
+			}
+			actValue := &apc.ActValRmNode{DaemonID: step.node.ID(), SkipRebalance: step.node.IsProxy()}
+			rebID, err = api.DecommissionNode(apiBP, actValue)
+			if err != nil {
+				return fmt.Errorf("failed to decommission %s: %v (%d of %d changes done)", step.node.StringEx(), err, done, total)
+			}
+			fmt.Fprintf(c.App.Writer, "%s is being decommissioned\n", step.node.StringEx())
+		default:
+			continue
+		}
+		done++
+	}
+	actionDone(c, fmt.Sprintf("Done: %d joined, %d decommissioned", nj, nd))
+	if rebID != "" {
+		fmt.Fprintf(c.App.Writer, fmtRebalanceStarted, rebID)
+	}
+	return nil
+}
+
+func loadMembershipSpec(path string) (*membershipSpec, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	spec := &membershipSpec{}
+	if errj := jsoniter.Unmarshal(b, spec); errj != nil {
+		var ydoc any
+		if erry := yaml.Unmarshal(b, &ydoc); erry != nil {
+			return nil, fmt.Errorf("%s: failed to parse as JSON (%v) or YAML (%v)", path, errj, erry)
+		}
+		spec = &membershipSpec{}
+		if err := jsoniter.Unmarshal(cos.MustMarshal(yamlToJSON(ydoc)), spec); err != nil {
+			return nil, fmt.Errorf("%s: %v", path, err)
+		}
+	}
+	if len(spec.Join) == 0 && len(spec.Decommission) == 0 {
+		return nil, fmt.Errorf("%s: expecting 'join' and/or 'decommission' lists", path)
+	}
+	for i := range spec.Join {
+		j := &spec.Join[i]
+		if _, _, err := parseJoinAddr(j.Addr); err != nil {
+			return nil, fmt.Errorf("%s: join[%d]: %v", path, i, err)
+		}
+		if j.Role, err = parseRole(j.Role); err != nil {
+			return nil, fmt.Errorf("%s: join[%d] (%s): %v", path, i, j.Addr, err)
+		}
+		if j.Probation && j.Role != apc.Target {
+			return nil, fmt.Errorf("%s: join[%d] (%s): probation is valid only for targets", path, i, j.Addr)
+		}
+	}
+	return spec, nil
+}
+
+// reconcile: join only those that are not members yet, decommission only those that still are
+func planMembership(spec *membershipSpec, smap *cluster.Smap) ([]*membershipStep, error) {
+	var (
+		plan     = make([]*membershipStep, 0, len(spec.Join)+len(spec.Decommission))
+		seen     = make(cos.StrSet, len(plan))
+		ntj, ntd int
+	)
+	for i := range spec.Join {
+		j := &spec.Join[i]
+		host, port, _ := parseJoinAddr(j.Addr) // (validated)
+		if seen.Contains(host + ":" + port) {
+			return nil, fmt.Errorf("duplicate join address %q", j.Addr)
+		}
+		seen.Add(host + ":" + port)
+		step := &membershipStep{join: j, action: cmdJoin}
+		if node := findNodeByAddr(smap, host, port); node != nil {
+			step.action, step.node, step.note = membershipSkip, node, "already a member"
+		} else if j.Role == apc.Target && !j.Probation {
+			ntj++
+		}
+		plan = append(plan, step)
+	}
+	for _, name := range spec.Decommission {
+		sid := cluster.N2ID(name)
+		if seen.Contains(sid) {
+			return nil, fmt.Errorf("duplicate node %q in the decommission list", name)
+		}
+		seen.Add(sid)
+		node := smap.GetNode(sid)
+		if node == nil {
+			plan = append(plan, &membershipStep{action: membershipSkip, note: name + ": not a member"})
+			continue
+		}
+		if smap.IsPrimary(node) {
+			return nil, fmt.Errorf("%s is primary (cannot decommission the primary node)", node.StringEx())
+		}
+		if node.IsTarget() && !smap.InMaintOrDecomm(node) {
+			ntd++
+		}
+		plan = append(plan, &membershipStep{node: node, action: cmdNodeDecommission})
+	}
+	if ntd > 0 && ntd >= smap.CountActiveTs()+ntj {
+		return nil, errors.New("refusing to decommission all targets (the cluster must retain at least one active target)")
+	}
+	return plan, nil
+}
+
+func findNodeByAddr(smap *cluster.Smap, host, port string) *cluster.Snode {
+	for _, nm := range []cluster.NodeMap{smap.Pmap, smap.Tmap} {
+		for _, node := range nm {
+			if (node.ControlNet.Hostname == host && node.ControlNet.Port == port) ||
+				(node.PubNet.Hostname == host && node.PubNet.Port == port) {
+				return node
+			}
+		}
+	}
+	return nil
+}
+
+func (step *membershipStep) String() string {
+	switch step.action {
+	case cmdJoin:
+		details := step.join.Role
+		if step.join.Probation {
+			details += ", in probation"
+		}
+		return cmdJoin + "\t" + step.join.Addr + "\t" + details
+	case cmdNodeDecommission:
+		return cmdNodeDecommission + "\t" + step.node.StringEx() + "\t" + step.node.PubNet.Hostname + ":" + step.node.PubNet.Port
+	default:
+		if step.join != nil {
+			return membershipSkip + "\t" + step.join.Addr + "\t" + step.note + " (" + step.node.StringEx() + ")"
+		}
+		return membershipSkip + "\t" + teb.NotSetVal + "\t" + step.note
+	}
+}
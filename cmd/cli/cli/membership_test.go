@@ -0,0 +1,59 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestPlanMembership(t *testing.T) {
+	var (
+		smap = &cluster.Smap{Tmap: make(cluster.NodeMap), Pmap: make(cluster.NodeMap)}
+		ni   = func(port string) cluster.NetInfo { return cluster.NetInfo{Hostname: "10.0.0.1", Port: port} }
+	)
+	smap.Primary = cluster.NewSnode("p1", apc.Proxy, ni("8080"), ni("9080"), ni("10080"))
+	smap.Pmap.Add(smap.Primary)
+	smap.Pmap.Add(cluster.NewSnode("p2", apc.Proxy, ni("8081"), ni("9081"), ni("10081")))
+	smap.Tmap.Add(cluster.NewSnode("t1", apc.Target, ni("8082"), ni("9082"), ni("10082")))
+	smap.Tmap.Add(cluster.NewSnode("t2", apc.Target, ni("8083"), ni("9083"), ni("10083")))
+
+	dir := t.TempDir()
+	yml := writeTestFile(t, dir, "m.yaml", "join:\n- addr: 10.0.0.1:9082\n  role: t\n- addr: 10.0.0.2:8080\n  role: target\ndecommission:\n- t[t1]\n- p2\n- t9\n")
+	spec, err := loadMembershipSpec(yml)
+	tassert.CheckFatal(t, err)
+	plan, err := planMembership(spec, smap)
+	tassert.CheckFatal(t, err)
+	actions := make([]string, 0, len(plan))
+	for _, step := range plan {
+		actions = append(actions, step.action)
+	}
+	expected := []string{membershipSkip, cmdJoin, cmdNodeDecommission, cmdNodeDecommission, membershipSkip}
+	tassert.Errorf(t, reflect.DeepEqual(actions, expected), "expected %v, got %v", expected, actions)
+
+	for _, content := range []string{
+		`{"join": [{"addr": "10.0.0.2", "role": "target"}]}`,       // no port
+		`{"join": [{"addr": "10.0.0.2:8080", "role": "gateway"}]}`, // invalid role
+		`{"join": [{"addr": "10.0.0.2:8080", "role": "proxy", "probation": true}]}`,
+		`{}`,
+	} {
+		_, err := loadMembershipSpec(writeTestFile(t, dir, "bad.json", content))
+		tassert.Errorf(t, err != nil, "%s: expected error", content)
+	}
+	for _, content := range []string{
+		`{"decommission": ["p1"]}`,       // primary
+		`{"decommission": ["t1", "t2"]}`, // all targets
+		`{"decommission": ["t1", "t[t1]"]}`,
+	} {
+		spec, err := loadMembershipSpec(writeTestFile(t, dir, "m.json", content))
+		tassert.CheckFatal(t, err)
+		_, err = planMembership(spec, smap)
+		tassert.Errorf(t, err != nil, "%s: expected error", content)
+	}
+}
@@ -9,6 +9,8 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"os"
+	"strconv"
 	"strings"
 	"time"
 
@@ -58,6 +60,12 @@ func multiobjTCO(c *cli.Context, bckFrom, bckTo cmn.Bck, listObjs, tmplObjs, etl
 		text  = "Copying objects"
 	)
 	if etlName != "" {
+		if msg.BatchSize, err = etlBatchSize(c, etlName); err != nil {
+			return err
+		}
+		if err = etlErrorsTo(c, &msg.Transform); err != nil {
+			return err
+		}
 		msg.Name = etlName
 		text = "Transforming objects"
 		xkind = apc.ActETLObjects
@@ -102,8 +110,11 @@ func multiobjTCO(c *cli.Context, bckFrom, bckTo cmn.Bck, listObjs, tmplObjs, etl
 	xargs := xact.ArgsMsg{ID: xid, Kind: xkind, Timeout: timeout}
 	if err = waitXact(apiBP, xargs); err != nil {
 		fmt.Fprintf(c.App.Writer, fmtXactFailed, text, bckFrom, bckTo)
-	} else {
-		fmt.Fprint(c.App.Writer, fmtXactSucceeded)
+		return err
+	}
+	fmt.Fprint(c.App.Writer, fmtXactSucceeded)
+	if etlName != "" && !msg.DryRun {
+		err = etlThroughput(c, xargs, etlName, msg.BatchSize)
 	}
 	return err
 }
@@ -118,6 +129,20 @@ func listrange(c *cli.Context, bck cmn.Bck) (err error) {
 		return incorrectUsageMsg(c, errFmtExclusive, qflprn(listFlag), qflprn(templateFlag))
 	}
 	debug.Assert(flagIsSet(c, listFlag) || flagIsSet(c, templateFlag))
+	if flagIsSet(c, showTemplateFlag) {
+		if !flagIsSet(c, templateFlag) {
+			return incorrectUsageMsg(c, "%s requires %s", qflprn(showTemplateFlag), qflprn(templateFlag))
+		}
+		tmpl := parseStrFlag(c, templateFlag)
+		pt, err := cos.NewParsedTemplate(tmpl)
+		if err != nil {
+			return err
+		}
+		return showTemplate(c, tmpl, &pt)
+	}
+	if isStdinList(c) {
+		return listrangeStdin(c, bck)
+	}
 	if flagIsSet(c, listFlag) {
 		xid, xname, text, num, err = _listOp(c, bck)
 	} else {
@@ -160,22 +185,89 @@ func listrange(c *cli.Context, bck cmn.Bck) (err error) {
 		return err
 	}
 	fmt.Fprint(c.App.Writer, fmtXactSucceeded)
+	if c.Command.Name == commandPrefetch {
+		prefetchReport(c, []string{xid})
+	}
 	return
 }
 
+// `--list -`: object names from STDIN, one batch (and one job) at a time
+func listrangeStdin(c *cli.Context, bck cmn.Bck) error {
+	if flagIsSet(c, progressFlag) {
+		return incorrectUsageMsg(c, "%s cannot be used with object names from STDIN (the total is unknown)", qflprn(progressFlag))
+	}
+	var (
+		sl    = newStdinList(os.Stdin, stdinListBatch)
+		wait  = flagIsSet(c, waitFlag) || flagIsSet(c, waitJobXactFinishedFlag)
+		total int64
+		xids  []string
+	)
+	for {
+		names, err := sl.next()
+		if err != nil {
+			return err
+		}
+		if len(names) == 0 {
+			break
+		}
+		if flagIsSet(c, dryRunFlag) {
+			_dryRunList(c, bck, names)
+			return nil
+		}
+		xid, xname, text, num, err := _listDo(c, bck, names)
+		if err != nil {
+			return err
+		}
+		total += num
+		if !wait {
+			fmt.Fprintln(c.App.Writer, text)
+			continue
+		}
+		var timeout time.Duration
+		if flagIsSet(c, waitJobXactFinishedFlag) {
+			timeout = parseDurationFlag(c, waitJobXactFinishedFlag)
+		}
+		fmt.Fprintln(c.App.Writer, text+" ...")
+		if err := waitXact(apiBP, xact.ArgsMsg{ID: xid, Kind: xname, Timeout: timeout}); err != nil {
+			return err
+		}
+		xids = append(xids, xid)
+	}
+	if total == 0 {
+		return errors.New("no object names in STDIN")
+	}
+	if wait {
+		fmt.Fprint(c.App.Writer, fmtXactSucceeded)
+		if c.Command.Name == commandPrefetch {
+			prefetchReport(c, xids)
+		}
+	}
+	return nil
+}
+
+func _dryRunList(c *cli.Context, bck cmn.Bck, names []string) {
+	limitedLineWriter(c.App.Writer,
+		dryRunExamplesCnt, strings.ToUpper(c.Command.Name)+" "+bck.Cname("")+"/%s\n", names)
+}
+
 // `--list` flag
 func _listOp(c *cli.Context, bck cmn.Bck) (xid, xname, text string, num int64, err error) {
 	var (
-		kind     string
 		arg      = parseStrFlag(c, listFlag)
 		fileList = splitCsv(arg)
 	)
 	if flagIsSet(c, dryRunFlag) {
-		limitedLineWriter(c.App.Writer,
-			dryRunExamplesCnt, strings.ToUpper(c.Command.Name)+" "+bck.Cname("")+"/%s\n", fileList)
+		_dryRunList(c, bck, fileList)
 		return
 	}
-	var action string
+	return _listDo(c, bck, fileList)
+}
+
+func _listDo(c *cli.Context, bck cmn.Bck, fileList []string) (xid, xname, text string, num int64, err error) {
+	var (
+		kind   string
+		action string
+	)
 	switch c.Command.Name {
 	case commandRemove:
 		xid, err = api.DeleteList(apiBP, bck, fileList)
@@ -185,7 +277,11 @@ func _listOp(c *cli.Context, bck cmn.Bck) (xid, xname, text string, num int64, e
 		if err = ensureHasProvider(bck); err != nil {
 			return
 		}
-		xid, err = api.PrefetchList(apiBP, bck, fileList)
+		var msg *cmn.PrefetchMsg
+		if msg, err = prefetchMsg(c, cmn.ListRange{ObjNames: fileList}); err != nil {
+			return
+		}
+		xid, err = api.Prefetch(apiBP, bck, msg)
 		kind = apc.ActPrefetchObjects
 		action = "prefetch"
 	case commandEvict:
@@ -224,6 +320,7 @@ func _rangeOp(c *cli.Context, bck cmn.Bck) (xid, xname, text string, num int64,
 		fmt.Fprintf(c.App.Writer, "invalid template %q: %v\n", rangeStr, err)
 		return
 	}
+	warnTemplateCap(c, &pt)
 	// [DRY-RUN]
 	if flagIsSet(c, dryRunFlag) {
 		objs := pt.ToSlice(dryRunExamplesCnt)
@@ -245,7 +342,11 @@ func _rangeOp(c *cli.Context, bck cmn.Bck) (xid, xname, text string, num int64,
 		if err = ensureHasProvider(bck); err != nil {
 			return
 		}
-		xid, err = api.PrefetchRange(apiBP, bck, rangeStr)
+		var msg *cmn.PrefetchMsg
+		if msg, err = prefetchMsg(c, cmn.ListRange{Template: rangeStr}); err != nil {
+			return
+		}
+		xid, err = api.Prefetch(apiBP, bck, msg)
 		kind = apc.ActPrefetchObjects
 		action = "prefetch"
 	case commandEvict:
@@ -306,3 +407,70 @@ func multiobjArg(c *cli.Context, command string) error {
 	}
 	return nil
 }
+
+//
+// template preview (`--show-template`) and expansion cap (`--template-cap`)
+//
+
+const (
+	templateSampleCnt  = 5
+	templateCapDefault = "100000"
+)
+
+func showTemplate(c *cli.Context, tmpl string, pt *cos.ParsedTemplate) error {
+	if len(pt.Ranges) == 0 {
+		fmt.Fprintf(c.App.Writer, "Template %q has no ranges: selects all names that start with %q\n", tmpl, pt.Prefix)
+		return nil
+	}
+	cnt := pt.Count()
+	fmt.Fprintf(c.App.Writer, "Template %q expands to %d name%s:\n", tmpl, cnt, cos.Plural(int(cnt)))
+	names := pt.ToSlice(templateSampleCnt)
+	for _, name := range names {
+		fmt.Fprintln(c.App.Writer, indent1+name)
+	}
+	if n := int64(len(names)); cnt > n {
+		if cnt > n+1 {
+			fmt.Fprintf(c.App.Writer, "%s... (%d more)\n", indent1, cnt-n-1)
+		}
+		fmt.Fprintln(c.App.Writer, indent1+templateLast(pt))
+	}
+	warnTemplateCap(c, pt)
+	return nil
+}
+
+// the last name in the expansion (without iterating)
+func templateLast(pt *cos.ParsedTemplate) string {
+	var sb strings.Builder
+	sb.WriteString(pt.Prefix)
+	for _, tr := range pt.Ranges {
+		last := tr.Start + (tr.End-tr.Start)/tr.Step*tr.Step
+		sb.WriteString(fmt.Sprintf("%0*d%s", tr.DigitCount, last, tr.Gap))
+	}
+	return sb.String()
+}
+
+func warnTemplateCap(c *cli.Context, pt *cos.ParsedTemplate) {
+	if len(pt.Ranges) == 0 {
+		return
+	}
+	limit, err := parseTemplateCap(c)
+	if err != nil {
+		actionWarn(c, err.Error())
+		return
+	}
+	if cnt := pt.Count(); limit > 0 && cnt > limit {
+		actionWarn(c, fmt.Sprintf("template expands to %d names (exceeds %s=%d)", cnt, flprn(templateCapFlag), limit))
+	}
+}
+
+func parseTemplateCap(c *cli.Context) (int64, error) {
+	s := templateCapDefault
+	if v := parseStrFlag(c, templateCapFlag); v != "" { // (command line or configured default)
+		s = v
+	}
+	limit, err := strconv.ParseInt(s, 10, 64)
+	if err != nil || limit < 0 {
+		return 0, fmt.Errorf("invalid %s=%q (expecting non-negative integer)", flprn(templateCapFlag), s)
+	}
+	return limit, nil
+}
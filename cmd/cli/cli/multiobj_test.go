@@ -0,0 +1,23 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"testing"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestTemplateLast(t *testing.T) {
+	for _, tmpl := range []string{"shard-{0..9999}.tar", "prefix-{0010..0013..2}-gap-{1..2}-suffix", "a-{1..10..3}", "x-{07..07}"} {
+		pt, err := cos.NewParsedTemplate(tmpl)
+		tassert.CheckFatal(t, err)
+		all := pt.ToSlice()
+		tassert.Fatalf(t, int64(len(all)) == pt.Count(), "%q: count %d vs %d", tmpl, len(all), pt.Count())
+		last := templateLast(&pt)
+		tassert.Errorf(t, last == all[len(all)-1], "%q: expected %q, got %q", tmpl, all[len(all)-1], last)
+	}
+}
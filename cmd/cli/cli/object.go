@@ -7,10 +7,12 @@ package cli
 
 import (
 	"fmt"
+	"net/http"
 	"os"
 	"path/filepath"
 	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/NVIDIA/aistore/api"
@@ -18,8 +20,10 @@ import (
 	"github.com/NVIDIA/aistore/cluster"
 	"github.com/NVIDIA/aistore/cmd/cli/teb"
 	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/xact"
 	jsoniter "github.com/json-iterator/go"
 	"github.com/urfave/cli"
 	"github.com/vbauerster/mpb/v4"
@@ -48,6 +52,10 @@ func promote(c *cli.Context, bck cmn.Bck, objName, fqn string) error {
 			SrcIsNotFshare: flagIsSet(c, notFshareFlag),
 			OverwriteDst:   flagIsSet(c, overwriteFlag),
 			DeleteSrc:      flagIsSet(c, deleteSrcFlag),
+			// checksum is computed target-side while reading the source file (no client round trip)
+			ComputeCksum:    flagIsSet(c, promoteCksumFlag) || flagIsSet(c, promoteVerifyFlag),
+			VerifyCksum:     flagIsSet(c, promoteVerifyFlag),
+			ContinueOnError: flagIsSet(c, promoteContOnErrFlag),
 		},
 	}
 	xid, err := api.Promote(promoteArgs)
@@ -63,8 +71,48 @@ func promote(c *cli.Context, bck cmn.Bck, objName, fqn string) error {
 	}
 	// alternatively, print(fmtXactStatusCheck, apc.ActPromote, ...)
 	msg := fmt.Sprintf("%spromoted %q => %s%s\n", s1, fqn, bck.Cname(""), s2)
-	actionDone(c, msg)
-	return nil
+	if xid == "" || !flagIsSet(c, promoteContOnErrFlag) {
+		actionDone(c, msg)
+		return nil
+	}
+	// continuing on error: wait for the job to finish and report failures (if any)
+	if err := waitXact(apiBP, xact.ArgsMsg{ID: xid, Kind: apc.ActPromote}); err != nil {
+		return err
+	}
+	return promoteReport(c, xid, msg)
+}
+
+// summed up across targets
+func promoteReport(c *cli.Context, xid, msg string) error {
+	snaps, err := api.QueryXactionSnaps(apiBP, xact.ArgsMsg{ID: xid, Kind: apc.ActPromote})
+	if err != nil {
+		return err
+	}
+	var (
+		cnt    int64
+		failed []string
+	)
+	for _, tsnaps := range snaps {
+		for _, snap := range tsnaps {
+			ext := &xact.ExtPromoteStats{}
+			if snap.ID != xid || snap.Ext == nil || cos.MorphMarshal(snap.Ext, ext) != nil {
+				continue
+			}
+			cnt += ext.ErrCount
+			failed = append(failed, ext.Failed...)
+		}
+	}
+	if cnt == 0 {
+		actionDone(c, msg)
+		return nil
+	}
+	for _, fqn := range failed {
+		fmt.Fprintln(c.App.ErrWriter, "\t"+fqn)
+	}
+	if n := int64(len(failed)); n < cnt {
+		fmt.Fprintf(c.App.ErrWriter, "\t(and %d more - see the targets' logs)\n", cnt-n)
+	}
+	return fmt.Errorf("failed to promote %d file%s (job %q)", cnt, cos.Plural(int(cnt)), xid)
 }
 
 func setCustomProps(c *cli.Context, bck cmn.Bck, objName string) (err error) {
@@ -76,7 +124,7 @@ func setCustomProps(c *cli.Context, bck cmn.Bck, objName string) (err error) {
 			return
 		}
 	} else {
-		if len(propArgs) == 0 {
+		if len(propArgs) == 0 && !flagIsSet(c, objExpireFlag) {
 			err = missingArgumentsError(c, "property key-value pairs")
 			return
 		}
@@ -90,6 +138,7 @@ func setCustomProps(c *cli.Context, bck cmn.Bck, objName string) (err error) {
 			props[nv[0]] = nv[1]
 		}
 	}
+	props = addExpiresMD(c, props)
 	setNewCustom := flagIsSet(c, setNewCustomMDFlag)
 	if err = api.SetObjectCustomProps(apiBP, bck, objName, props, setNewCustom); err != nil {
 		return
@@ -100,6 +149,103 @@ func setCustomProps(c *cli.Context, bck cmn.Bck, objName string) (err error) {
 	return nil
 }
 
+type customEntry struct {
+	props   cos.StrKVs
+	objName string
+}
+
+// `set-custom --from-file`: apply custom properties to multiple objects in parallel
+func setCustomPropsBatch(c *cli.Context, bck cmn.Bck) error {
+	entries, err := parseCustomManifest(parseStrFlag(c, customMDFromFileFlag))
+	if err != nil {
+		return err
+	}
+	if len(entries) == 0 {
+		return fmt.Errorf("%s: no objects to update", parseStrFlag(c, customMDFromFileFlag))
+	}
+	for i := range entries {
+		entries[i].props = addExpiresMD(c, entries[i].props)
+	}
+	var (
+		errCount     atomic.Int32
+		mu           sync.Mutex
+		setNewCustom = flagIsSet(c, setNewCustomMDFlag)
+		verbose      = flagIsSet(c, verboseFlag)
+		numWorkers   = cos.Max(parseIntFlag(c, concurrencyFlag), 1)
+		wg           = cos.NewLimitedWaitGroup(numWorkers, len(entries))
+	)
+	for _, e := range entries {
+		wg.Add(1)
+		go func(e customEntry) {
+			defer wg.Done()
+			err := api.SetObjectCustomProps(apiBP, bck, e.objName, e.props, setNewCustom)
+			mu.Lock()
+			if err != nil {
+				errCount.Inc()
+				fmt.Fprintf(c.App.ErrWriter, "%s: %v\n", bck.Cname(e.objName), err)
+			} else if verbose {
+				fmt.Fprintf(c.App.Writer, "%s: %s\n", bck.Cname(e.objName), cmn.CustomMD2S(e.props))
+			}
+			mu.Unlock()
+		}(e)
+	}
+	wg.Wait()
+
+	l, n := len(entries), int(errCount.Load())
+	if n > 0 {
+		return fmt.Errorf("failed to update custom props of %d (out of %d) object%s in %s", n, l, cos.Plural(l), bck)
+	}
+	actionDone(c, fmt.Sprintf("Custom props of %d object%s in %s successfully updated.", l, cos.Plural(l), bck))
+	return nil
+}
+
+// manifest formats:
+// - JSON:  {"obj1": {"key1": "value1", ...}, "obj2": {...}, ...}
+// - CSV:   obj1,key1=value1,key2=value2 (one object per line; empty lines and '#' comments are skipped)
+func parseCustomManifest(path string) (entries []customEntry, err error) {
+	b, err := os.ReadFile(cos.ExpandPath(path))
+	if err != nil {
+		return nil, err
+	}
+	if isJSON(strings.TrimSpace(string(b))) {
+		var m map[string]cos.StrKVs
+		if err := jsoniter.Unmarshal(b, &m); err != nil {
+			return nil, fmt.Errorf("%s: invalid JSON manifest: %v", path, err)
+		}
+		entries = make([]customEntry, 0, len(m))
+		for objName, props := range m {
+			if len(props) == 0 {
+				return nil, fmt.Errorf("%s: no custom properties for object %q", path, objName)
+			}
+			entries = append(entries, customEntry{objName: objName, props: props})
+		}
+		sort.Slice(entries, func(i, j int) bool { return entries[i].objName < entries[j].objName })
+		return entries, nil
+	}
+	lines := strings.Split(string(b), "\n")
+	entries = make([]customEntry, 0, len(lines))
+	for i, line := range lines {
+		line = strings.TrimSpace(line)
+		if line == "" || line[0] == '#' {
+			continue
+		}
+		fields := splitCsv(line)
+		if len(fields) < 2 || fields[0] == "" {
+			return nil, fmt.Errorf("%s:%d: expecting 'OBJECT_NAME,KEY=VALUE[,KEY=VALUE...]', got %q", path, i+1, line)
+		}
+		props := make(cos.StrKVs, len(fields)-1)
+		for _, kv := range fields[1:] {
+			k, v, ok := strings.Cut(kv, keyAndValueSeparator)
+			if k = strings.TrimSpace(k); !ok || k == "" {
+				return nil, fmt.Errorf("%s:%d: invalid custom property %q", path, i+1, kv)
+			}
+			props[k] = strings.TrimSpace(v)
+		}
+		entries = append(entries, customEntry{objName: fields[0], props: props})
+	}
+	return entries, nil
+}
+
 // replace common abbreviations (such as `~/`) and return an absolute path
 func absPath(fileName string) (path string, err error) {
 	path = cos.ExpandPath(fileName)
@@ -143,6 +289,18 @@ func putAny(c *cli.Context, bck cmn.Bck, objName, fileName string) error {
 			return fmt.Errorf("when writing directly from standard input destination object name (in %s) is required",
 				c.Command.ArgsUsage)
 		}
+		if flagIsSet(c, skipIfSameFlag) {
+			return incorrectUsageMsg(c, "%s cannot be used when writing from standard input", qflprn(skipIfSameFlag))
+		}
+		if flagIsSet(c, preserveAttrsFlag) {
+			return incorrectUsageMsg(c, "%s cannot be used when writing from standard input", qflprn(preserveAttrsFlag))
+		}
+		if flagIsSet(c, spillThresholdFlag) {
+			return putStdinSpill(c, bck, objName)
+		}
+		if flagIsSet(c, stdinSizeFlag) {
+			return putStdinSized(c, bck, objName)
+		}
 		chunkSize, err := parseSizeFlag(c, chunkSizeFlag)
 		if err != nil {
 			return err
@@ -162,13 +320,19 @@ func putAny(c *cli.Context, bck cmn.Bck, objName, fileName string) error {
 			return err
 		}
 		cksumType := cksum.Type() // can be none
-		if err := putAppendChunks(c, bck, objName, os.Stdin, cksumType, chunkSize); err != nil {
+		if err := putAppendChunks(c, bck, objName, stdinReader(), cksumType, chunkSize); err != nil {
 			return err
 		}
 		actionDone(c, fmt.Sprintf("PUT (standard input) => %s\n", bck.Cname(objName)))
 		return nil
 	}
 
+	if flagIsSet(c, spillThresholdFlag) {
+		return incorrectUsageMsg(c, "%s applies only when writing from standard input", qflprn(spillThresholdFlag))
+	}
+	if flagIsSet(c, stdinSizeFlag) {
+		return incorrectUsageMsg(c, "%s applies only when writing from standard input", qflprn(stdinSizeFlag))
+	}
 	path, err := absPath(fileName)
 	if err != nil {
 		return err
@@ -176,8 +340,15 @@ func putAny(c *cli.Context, bck cmn.Bck, objName, fileName string) error {
 
 	// 2. inline "range" w/ no flag, e.g.: "/tmp/www/test{0..2}{0..2}.txt" ais://nnn/www
 	if pt, err := cos.ParseBashTemplate(path); err == nil {
+		if flagIsSet(c, showTemplateFlag) {
+			return showTemplate(c, path, &pt)
+		}
 		return putRange(c, pt, bck, rangeTrimPrefix(pt), objName /* subdir name */)
 	}
+	if flagIsSet(c, showTemplateFlag) {
+		return incorrectUsageMsg(c, "%s requires a template (e.g., %s or inline: \"/tmp/shard-{0..9}.tar\")",
+			qflprn(showTemplateFlag), qflprn(templateFileFlag))
+	}
 
 	// 3. inline "list" w/ no flag: "FILE[,FILE...]" BUCKET/[OBJECT_NAME]
 	if _, err := os.Stat(fileName); err != nil {
@@ -205,6 +376,10 @@ func putAny(c *cli.Context, bck cmn.Bck, objName, fileName string) error {
 
 		// single-file PUT
 		if err := putRegular(c, bck, objName, path, finfo); err != nil {
+			if err == errSkippedSame {
+				actionDone(c, fmt.Sprintf("PUT %q => %s: %v\n", fileName, bck.Cname(objName), err))
+				return nil
+			}
 			return err
 		}
 		actionDone(c, fmt.Sprintf("PUT %q => %s\n", fileName, bck.Cname(objName)))
@@ -213,12 +388,16 @@ func putAny(c *cli.Context, bck cmn.Bck, objName, fileName string) error {
 
 	// 5. directory
 	recurs := flagIsSet(c, recursFlag)
-	files, err := lsFobj(c, path, "", objName, recurs)
+	flt, err := newFobjFilter(c)
+	if err != nil {
+		return err
+	}
+	files, err := lsFobj(c, path, "", objName, recurs, flt)
 	if err != nil {
 		return err
 	}
 	tag := _putFrom(fmt.Sprintf(" from %q", fileName), recurs)
-	return putFobjs(c, files, bck, tag)
+	return putFobjs(c, files, bck, tag, flt.count(), flt.countIgnored())
 }
 
 func _putFrom(s string, recurs bool) (tag string) {
@@ -235,32 +414,41 @@ func putList(c *cli.Context, fnames []string, bck cmn.Bck, appendPrefixSubdir st
 		allFiles = make([]fobj, 0, len(fnames))
 		recurs   = flagIsSet(c, recursFlag)
 	)
+	flt, err := newFobjFilter(c)
+	if err != nil {
+		return err
+	}
 	for _, n := range fnames {
-		files, err := lsFobj(c, n, "", appendPrefixSubdir, recurs)
+		files, err := lsFobj(c, n, "", appendPrefixSubdir, recurs, flt)
 		if err != nil {
 			return err
 		}
 		allFiles = append(allFiles, files...)
 	}
 	tag := _putFrom(" ", recurs)
-	return putFobjs(c, allFiles, bck, tag)
+	return putFobjs(c, allFiles, bck, tag, flt.count(), flt.countIgnored())
 }
 
 func putRange(c *cli.Context, pt cos.ParsedTemplate, bck cmn.Bck, trimPrefix, appendPrefixSubdir string) (err error) {
+	warnTemplateCap(c, &pt)
 	var (
 		allFiles = make([]fobj, 0, pt.Count())
 		recurs   = flagIsSet(c, recursFlag)
 	)
+	flt, err := newFobjFilter(c)
+	if err != nil {
+		return err
+	}
 	pt.InitIter()
 	for n, hasNext := pt.Next(); hasNext; n, hasNext = pt.Next() {
-		files, err := lsFobj(c, n, trimPrefix, appendPrefixSubdir, recurs)
+		files, err := lsFobj(c, n, trimPrefix, appendPrefixSubdir, recurs, flt)
 		if err != nil {
 			return err
 		}
 		allFiles = append(allFiles, files...)
 	}
 	tag := _putFrom(" ", recurs)
-	return putFobjs(c, allFiles, bck, tag)
+	return putFobjs(c, allFiles, bck, tag, flt.count(), flt.countIgnored())
 }
 
 func concatObject(c *cli.Context, bck cmn.Bck, objName string, fileNames []string) error {
@@ -275,7 +463,7 @@ func concatObject(c *cli.Context, bck cmn.Bck, objName string, fileNames []strin
 		name       = bck.Cname(objName)
 	)
 	for i, fileName := range fileNames {
-		fsl, err := lsFobj(c, fileName, "", "", flagIsSet(c, recursFlag))
+		fsl, err := lsFobj(c, fileName, "", "", flagIsSet(c, recursFlag), nil /*filter*/)
 		if err != nil {
 			return err
 		}
@@ -385,6 +573,13 @@ func showObjProps(c *cli.Context, bck cmn.Bck, object string) error {
 	if flagIsSet(c, objNotCachedPropsFlag) {
 		fltPresence = apc.FltExists
 	}
+	rt, err := parseFormatTemplate(c, cmn.ObjectProps{})
+	if err != nil {
+		return err
+	}
+	if rt != nil && flagIsSet(c, jsonFlag) {
+		return incorrectUsageMsg(c, errFmtExclusive, qflprn(formatTemplateFlag), qflprn(jsonFlag))
+	}
 	objProps, err := api.HeadObject(apiBP, bck, object, fltPresence)
 	if err != nil {
 		if !cmn.IsStatusNotFound(err) {
@@ -400,6 +595,9 @@ func showObjProps(c *cli.Context, bck cmn.Bck, object string) error {
 		opts := teb.Jopts(true)
 		return teb.Print(objProps, teb.PropsSimpleTmpl, opts)
 	}
+	if rt != nil {
+		return rt.Print(objProps)
+	}
 	if flagIsSet(c, allPropsFlag) {
 		propsFlag = apc.GetPropsAll
 	} else if flagIsSet(c, objPropsFlag) {
@@ -414,9 +612,11 @@ func showObjProps(c *cli.Context, bck cmn.Bck, object string) error {
 			selectedProps = apc.GetPropsDefaultAIS
 		} else if bck.IsCloud() {
 			selectedProps = apc.GetPropsDefaultCloud
+		} else if bck.IsHTTP() {
+			selectedProps = append(apc.GetPropsDefaultCloud, apc.GetPropsOrigURL)
 		}
 	} else if cos.StringInSlice("all", propsFlag) {
-		selectedProps = apc.GetPropsAll
+		selectedProps = append(apc.GetPropsAll, apc.GetPropsOrigURL, teb.ObjPropTTL)
 	} else {
 		selectedProps = propsFlag
 	}
@@ -437,12 +637,48 @@ func showObjProps(c *cli.Context, bck cmn.Bck, object string) error {
 	return teb.Print(propNVs, teb.PropsSimpleTmpl)
 }
 
+// list all available versions of a given remote object
+func showObjVersions(c *cli.Context, bck cmn.Bck, p *cmn.BucketProps, object string) error {
+	if err := checkVersioning(bck, p); err != nil {
+		return err
+	}
+	vers, err := api.GetObjectVersions(apiBP, bck, object)
+	if err != nil {
+		if cmn.IsStatusNotFound(err) {
+			return newErrMsgCause(err, "%q not found in %s", object, bck.Cname(""))
+		}
+		return versioningErr(bck, err)
+	}
+	if flagIsSet(c, jsonFlag) {
+		return teb.Print(vers, "", teb.Jopts(true))
+	}
+	return teb.Print(vers, teb.ObjVersionsTmpl)
+}
+
+// object versions (`--versions`, `--version-id`) require versioned remote bucket
+func checkVersioning(bck cmn.Bck, p *cmn.BucketProps) error {
+	if !bck.IsRemote() && (p == nil || p.BackendBck.IsEmpty()) {
+		return fmt.Errorf("versioning not available: %s is not a remote bucket", bck.Cname(""))
+	}
+	if p != nil && !p.Versioning.Enabled {
+		return fmt.Errorf("versioning not available: %s is not versioned", bck.Cname(""))
+	}
+	return nil
+}
+
+func versioningErr(bck cmn.Bck, err error) error {
+	if herr, ok := err.(*cmn.ErrHTTP); ok && herr.Status == http.StatusNotImplemented {
+		return fmt.Errorf("versioning not available: %s backend does not support object versions", bck.Cname(""))
+	}
+	return err
+}
+
 func propVal(op *cmn.ObjectProps, name string) (v string) {
 	switch name {
 	case apc.GetPropsName:
 		v = op.Bck.Cname(op.Name)
 	case apc.GetPropsSize:
-		v = cos.ToSizeIEC(op.Size, 2)
+		v = teb.FmtSize(op.Size, "", 2)
 	case apc.GetPropsChecksum:
 		v = op.Cksum.String()
 	case apc.GetPropsAtime:
@@ -470,6 +706,14 @@ func propVal(op *cmn.ObjectProps, name string) (v string) {
 		}
 	case apc.GetPropsLocation:
 		v = op.Location
+	case apc.GetPropsOrigURL:
+		// HTTP buckets only; otherwise empty (and omitted)
+		v, _ = op.GetCustomKey(cmn.OrigURLObjMD)
+	case teb.ObjPropTTL:
+		// objects with expiration time only; otherwise omitted
+		if expires, ok := cmn.ObjExpires(op.GetCustomMD()); ok {
+			v = teb.FmtTTL(expires, time.Now())
+		}
 	default:
 		debug.Assert(false, name)
 	}
@@ -6,15 +6,21 @@
 package cli
 
 import (
+	"errors"
 	"fmt"
+	"math"
+	"os"
 	"path/filepath"
-	"time"
+	"sort"
+	"strings"
 
 	"github.com/NVIDIA/aistore/api"
 	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmd/cli/teb"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/xact"
 	"github.com/urfave/cli"
 )
 
@@ -30,17 +36,35 @@ var (
 		commandGet: {
 			offsetFlag,
 			lengthFlag,
-			archpathOptionalFlag,
+			archpathGetFlag,
+			archToDirFlag,
 			cksumFlag,
+			verifyOnlyFlag,
+			xferTimeoutFlag,
 			yesFlag,
 			checkObjCachedFlag,
+			objVersionIDFlag,
 			refreshFlag,
 			progressFlag,
+			restoreAttrsFlag,
+			getExtMapFlag,
+			getTimingFlag,
+			jsonFlag,
+			noFailoverFlag,
 			// multi-object options (passed to list-objects)
 			getObjPrefixFlag,
+			listFlag,
 			getObjCachedFlag,
 			listArchFlag,
 			objLimitFlag,
+			stripPrefixFlag,
+			flattenFlag,
+			overwriteFlag,
+			toTarFlag,
+			noIgnoreFlag,
+			continueOnErrorFlag,
+			maxErrorsFlag,
+			errorRateFlag,
 			unitsFlag,
 			verboseFlag,
 		},
@@ -48,13 +72,22 @@ var (
 		commandPut: append(
 			listrangeFileFlags,
 			chunkSizeFlag,
+			partWorkersFlag,
+			spillThresholdFlag,
+			stdinSizeFlag,
+			xferTimeoutFlag,
 			concurrencyFlag,
 			dryRunFlag,
 			recursFlag,
+			putIncludeFlag,
+			putExcludeFlag,
+			noIgnoreFlag,
 			verboseFlag,
 			yesFlag,
 			includeSrcBucketNameFlag,
 			continueOnErrorFlag,
+			maxErrorsFlag,
+			errorRateFlag,
 			unitsFlag,
 			// arch
 			sourceBckFlag,
@@ -64,9 +97,21 @@ var (
 			// cksum
 			skipVerCksumFlag,
 			putObjDfltCksumFlag,
+			skipIfSameFlag,
+			putDedupeFlag,
+			preserveAttrsFlag,
+			objExpireFlag,
+			// append
+			appendObjFlag,
+			appendHandleFlag,
+			flushFlag,
 		),
 		commandSetCustom: {
+			objExpireFlag,
 			setNewCustomMDFlag,
+			customMDFromFileFlag,
+			concurrencyFlag,
+			verboseFlag,
 		},
 		commandPromote: {
 			recursFlag,
@@ -75,18 +120,31 @@ var (
 			deleteSrcFlag,
 			targetIDFlag,
 			verboseFlag,
+			promoteCksumFlag,
+			promoteVerifyFlag,
+			promoteContOnErrFlag,
 		},
 		commandConcat: {
 			recursFlag,
 			unitsFlag,
 			progressFlag,
 		},
+		commandLocate: {
+			listFlag,
+			templateFlag,
+			locateSummaryFlag,
+			jsonFlag,
+			noHeaderFlag,
+		},
 		commandCat: {
 			offsetFlag,
 			lengthFlag,
 			archpathOptionalFlag,
 			cksumFlag,
 			forceFlag,
+			headFlag,
+			tailFlag,
+			recordsFlag,
 		},
 	}
 
@@ -98,7 +156,7 @@ var (
 			indent4 + "\t- use '--prefix' to get multiple objects in one shot (empty prefix for the entire bucket).",
 		ArgsUsage:    getObjectArgument,
 		Flags:        objectCmdsFlags[commandGet],
-		Action:       getHandler,
+		Action:       withXferTimeout(getHandler),
 		BashComplete: bucketCompletions(bcmplop{separator: true}),
 	}
 
@@ -112,7 +170,7 @@ var (
 			indent4 + "\t- use '--archpath' to APPEND to an existing tar-formatted object.",
 		ArgsUsage:    putObjectArgument,
 		Flags:        append(objectCmdsFlags[commandPut], putObjCksumFlags...),
-		Action:       putHandler,
+		Action:       withXferTimeout(putHandler),
 		BashComplete: putPromoteObjectCompletions,
 	}
 
@@ -165,6 +223,17 @@ var (
 				Flags:     objectCmdsFlags[commandConcat],
 				Action:    concatHandler,
 			},
+			{
+				Name: commandLocate,
+				Usage: "show target(s) that store (or would store) given object(s), without reading or writing anything, e.g.:\n" +
+					indent4 + "\t- 'ais object locate ais://abc/obj'\t- show the target that (would) store the object;\n" +
+					indent4 + "\t- 'ais object locate ais://abc --template \"shard-{0000..9999}.tar\" --summary'\t" +
+					"- show resulting distribution of objects across targets",
+				ArgsUsage:    locateObjectArgument,
+				Flags:        objectCmdsFlags[commandLocate],
+				Action:       locateHandler,
+				BashComplete: bucketCompletions(bcmplop{separator: true}),
+			},
 			{
 				Name:         commandCat,
 				Usage:        "cat an object (i.e., print its contents to STDOUT)",
@@ -287,6 +356,16 @@ func createArchMultiObjHandler(c *cli.Context) (err error) {
 		return incorrectUsageMsg(c, fmt.Sprintf("%s and %s options are mutually exclusive",
 			flprn(listFlag), flprn(templateFlag)))
 	}
+	if flagIsSet(c, showTemplateFlag) {
+		if template == "" {
+			return incorrectUsageMsg(c, "%s requires %s", qflprn(showTemplateFlag), qflprn(templateFlag))
+		}
+		pt, err := cos.NewParsedTemplate(template)
+		if err != nil {
+			return err
+		}
+		return showTemplate(c, template, &pt)
+	}
 	if bckTo, objName, err = parseBckObjectURI(c, c.Args().Get(0), true /*optional objName*/); err != nil {
 		return
 	}
@@ -301,33 +380,168 @@ func createArchMultiObjHandler(c *cli.Context) (err error) {
 	msg.InclSrcBname = flagIsSet(c, includeSrcBucketNameFlag)
 	msg.AllowAppendToExisting = flagIsSet(c, allowAppendToExistingFlag)
 	msg.ContinueOnError = flagIsSet(c, continueOnErrorFlag)
+	if msg.ErrLimits, err = parseErrLimits(c); err != nil {
+		return err
+	}
 
-	if list != "" {
+	// format: explicit or by extension
+	if msg.Mime, err = archFormat(c, bckTo, objName); err != nil {
+		return err
+	}
+	// only tar supports appending
+	if msg.AllowAppendToExisting && msg.Mime != cos.ExtTar {
+		return fmt.Errorf("cannot append to %s: appending is supported only for %s archives (not %s)",
+			bckTo.Cname(objName), cos.ExtTar, msg.Mime)
+	}
+
+	var (
+		cnt, size int64
+		appended  = msg.AllowAppendToExisting
+	)
+	switch {
+	case list == fileStdIO:
+		cnt, size, err = archStdin(bckFrom, &msg)
+	case list != "":
 		msg.ListRange.ObjNames = splitCsv(list)
-		_, err = api.CreateArchMultiObj(apiBP, bckFrom, msg)
-	} else {
+		cnt, size, err = archDo(bckFrom, &msg)
+	default:
+		if pt, errV := cos.NewParsedTemplate(template); errV == nil {
+			warnTemplateCap(c, &pt)
+		}
 		msg.ListRange.Template = template
-		_, err = api.CreateArchMultiObj(apiBP, bckFrom, msg)
+		cnt, size, err = archDo(bckFrom, &msg)
 	}
 	if err != nil {
 		return err
 	}
-	for i := 0; i < 3; i++ {
-		time.Sleep(time.Second)
-		_, err = api.HeadObject(apiBP, bckTo, objName, apc.FltPresentNoProps)
-		if err == nil {
-			fmt.Fprintf(c.App.Writer, "Created archive %q\n", bckTo.Cname(objName))
-			return nil
-		}
+	if _, err = api.HeadObject(apiBP, bckTo, objName, apc.FltPresentNoProps); err != nil {
+		return fmt.Errorf("archive %s not found: %v", bckTo.Cname(objName), err)
+	}
+	if appended {
+		fmt.Fprintf(c.App.Writer, "Appended %d object%s (%s) to %s archive %s\n",
+			cnt, cos.Plural(int(cnt)), teb.FmtSize(size, "", 2), msg.Mime, bckTo.Cname(objName))
+	} else {
+		fmt.Fprintf(c.App.Writer, "Created %s archive %s (%d object%s, %s)\n",
+			msg.Mime, bckTo.Cname(objName), cnt, cos.Plural(int(cnt)), teb.FmtSize(size, "", 2))
 	}
-	fmt.Fprintf(c.App.Writer, "Creating archive %q ...\n", bckTo.Cname(objName))
 	return nil
 }
 
+func archDo(bckFrom cmn.Bck, msg *cmn.ArchiveMsg) (cnt, size int64, err error) {
+	xid, err := api.CreateArchMultiObj(apiBP, bckFrom, *msg)
+	if err != nil {
+		return 0, 0, err
+	}
+	// wait for the archiving job(s) - all selected objects get archived by a single
+	// (per target) xaction; the latter counts archived objects as "received"
+	for _, id := range strings.Split(xid, xact.UUIDSepa) {
+		xargs := xact.ArgsMsg{ID: id, Kind: apc.ActArchive}
+		if err := waitXact(apiBP, xargs); err != nil {
+			return 0, 0, err
+		}
+		snaps, err := api.QueryXactionSnaps(apiBP, xargs)
+		if err != nil {
+			return 0, 0, err
+		}
+		if msg.ErrLimits.IsSet() {
+			if err := archAbortErr(snaps, xargs); err != nil {
+				return 0, 0, err
+			}
+		}
+		_, _, n := snaps.ObjCounts(id)
+		_, _, b := snaps.ByteCounts(id)
+		cnt, size = cnt+n, size+b
+	}
+	return cnt, size, nil
+}
+
+// `--list -`: tar archives get built incrementally - one batch of names at a time
+// (the first batch creates the archive unless '--append-to-arch', the rest append);
+// other formats do not support appending and require reading all names upfront
+func archStdin(bckFrom cmn.Bck, msg *cmn.ArchiveMsg) (cnt, size int64, err error) {
+	batch := stdinListBatch
+	if msg.Mime != cos.ExtTar {
+		batch = math.MaxInt
+	}
+	sl := newStdinList(os.Stdin, batch)
+	for {
+		names, err := sl.next()
+		if err != nil {
+			return cnt, size, err
+		}
+		if len(names) == 0 {
+			break
+		}
+		msg.ListRange.ObjNames = names
+		n, b, err := archDo(bckFrom, msg)
+		if err != nil {
+			return cnt, size, err
+		}
+		cnt, size = cnt+n, size+b
+		msg.AllowAppendToExisting = true
+	}
+	if len(msg.ListRange.ObjNames) == 0 {
+		return 0, 0, errors.New("no object names in STDIN")
+	}
+	return cnt, size, nil
+}
+
+// with '--max-errors' and/or '--error-rate', targets abort archiving once the limit is exceeded
+func archAbortErr(snaps xact.MultiSnap, xargs xact.ArgsMsg) error {
+	var aborted []string
+	for tid, tsnaps := range snaps {
+		for _, snap := range tsnaps {
+			if snap.ID == xargs.ID && snap.IsAborted() {
+				aborted = append(aborted, tid)
+			}
+		}
+	}
+	if len(aborted) == 0 {
+		return nil
+	}
+	sort.Strings(aborted)
+	err := fmt.Errorf("archiving aborted on %d target%s %v (error limit exceeded)", len(aborted), cos.Plural(len(aborted)), aborted)
+	// best effort (not all xactions are tracked by IC)
+	if status, errV := api.GetOneXactionStatus(apiBP, xargs); errV == nil && status.ErrMsg != "" {
+		err = fmt.Errorf("archiving aborted on %d target%s %v: %s", len(aborted), cos.Plural(len(aborted)), aborted, status.ErrMsg)
+	}
+	return err
+}
+
+// archive format (one of the cos.ArchExtensions) is either specified explicitly (`--format`)
+// or determined by the archive's name; when both, they must agree
+func archFormat(c *cli.Context, bck cmn.Bck, archName string) (string, error) {
+	byName, errN := cos.Mime("", archName)
+	if !flagIsSet(c, archFormatFlag) {
+		if errN != nil {
+			return "", fmt.Errorf("cannot determine format of the archive %s: expecting one of the extensions (%s) or %s",
+				bck.Cname(archName), strings.Join(cos.ArchExtensions, ", "), qflprn(archFormatFlag))
+		}
+		return byName, nil
+	}
+	format := parseStrFlag(c, archFormatFlag)
+	if !strings.HasPrefix(format, ".") {
+		format = "." + format
+	}
+	if !cos.StringInSlice(format, cos.ArchExtensions) {
+		return "", fmt.Errorf("invalid %s %q: expecting one of: %s",
+			qflprn(archFormatFlag), parseStrFlag(c, archFormatFlag), strings.Join(cos.ArchExtensions, ", "))
+	}
+	if errN == nil && byName != format {
+		if !(cos.IsGzipped(archName) && (format == cos.ExtTgz || format == cos.ExtTarTgz)) {
+			return "", fmt.Errorf("archive name %q conflicts with the specified %s %q", archName, qflprn(archFormatFlag), format)
+		}
+	}
+	return format, nil
+}
+
 func putHandler(c *cli.Context) (err error) {
 	if c.NArg() == 0 {
 		return missingArgumentsError(c, c.Command.ArgsUsage)
 	}
+	if err := validateExpire(c); err != nil {
+		return err
+	}
 	if flagIsSet(c, progressFlag) || flagIsSet(c, listFileFlag) || flagIsSet(c, templateFileFlag) {
 		// --progress steals STDOUT while multi-object produces scary looking errors w/ no cluster
 		if _, err = api.GetClusterMap(apiBP); err != nil {
@@ -337,6 +551,8 @@ func putHandler(c *cli.Context) (err error) {
 	switch {
 	case flagIsSet(c, createArchFlag): // 1. archive
 		return createArchMultiObjHandler(c)
+	case c.NArg() == 1 && flagIsSet(c, flushFlag) && !flagIsSet(c, appendObjFlag): // BUCKET/OBJECT --flush
+		return flushHandler(c)
 	case c.NArg() == 1: // 2. BUCKET/[OBJECT_NAME] --list|--template
 		if flagIsSet(c, listFileFlag) && flagIsSet(c, templateFileFlag) {
 			return incorrectUsageMsg(c, errFmtExclusive, qflprn(listFileFlag), qflprn(templateFileFlag))
@@ -362,6 +578,9 @@ func putHandler(c *cli.Context) (err error) {
 		if err != nil {
 			return err
 		}
+		if flagIsSet(c, showTemplateFlag) {
+			return showTemplate(c, tmplObjs, &pt)
+		}
 		return putRange(c, pt, bck, rangeTrimPrefix(pt), objName /* subdir name */)
 	default: // 3. FILE|DIRECTORY|DIRECTORY/PATTERN BUCKET/[OBJECT_NAME]
 		return put(c)
@@ -385,6 +604,9 @@ func put(c *cli.Context) error {
 	if flagIsSet(c, dryRunFlag) {
 		return putDryRun(c, bck, objName, fileName)
 	}
+	if flagIsSet(c, appendObjFlag) {
+		return appendObj(c, bck, objName, fileName)
+	}
 	return putAny(c, bck, objName, fileName)
 }
 
@@ -446,10 +668,23 @@ func setCustomPropsHandler(c *cli.Context) (err error) {
 	if c.NArg() == 0 {
 		return missingArgumentsError(c, c.Command.ArgsUsage)
 	}
+	if err := validateExpire(c); err != nil {
+		return err
+	}
 	uri := c.Args().Get(0)
 	bck, objName, err := parseBckObjectURI(c, uri, true /* optional objName */)
 	if err != nil {
 		return err
 	}
+	if flagIsSet(c, customMDFromFileFlag) {
+		if objName != "" {
+			return incorrectUsageMsg(c, "object name in %q and %s cannot be used together (hint: specify bucket only)",
+				uri, qflprn(customMDFromFileFlag))
+		}
+		if c.NArg() > 1 {
+			return incorrectUsageMsg(c, "", c.Args()[1:])
+		}
+		return setCustomPropsBatch(c, bck)
+	}
 	return setCustomProps(c, bck, objName)
 }
@@ -0,0 +1,42 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"bytes"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+	"github.com/NVIDIA/aistore/xact"
+)
+
+func TestPromoteReport(t *testing.T) {
+	const xid = "xid-1"
+	snaps := xact.MultiSnap{
+		"t1": {{ID: xid, Ext: &xact.ExtPromoteStats{Failed: []string{"/tmp/a", "/tmp/b"}, ErrCount: 3}}},
+		"t2": {{ID: xid}, {ID: "other", Ext: &xact.ExtPromoteStats{ErrCount: 5}}},
+	}
+	mockCluster(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.Write(cos.MustMarshal(snaps))
+	})
+	var (
+		errOut bytes.Buffer
+		c      = newTestContext(nil)
+	)
+	c.App.ErrWriter = &errOut
+	err := promoteReport(c, xid, "promoted")
+	tassert.Errorf(t, err != nil && strings.Contains(err.Error(), "failed to promote 3 files"), "unexpected error: %v", err)
+	out := errOut.String()
+	tassert.Errorf(t, strings.Contains(out, "/tmp/a") && strings.Contains(out, "/tmp/b"), "missing failed files: %q", out)
+	tassert.Errorf(t, strings.Contains(out, "and 1 more"), "missing remainder: %q", out)
+
+	// no failures
+	snaps = xact.MultiSnap{"t1": {&cluster.Snap{ID: xid}}}
+	tassert.CheckError(t, promoteReport(c, xid, "promoted"))
+}
@@ -45,6 +45,8 @@ var (
 		unitsFlag,
 		averageSizeFlag,
 	)
+	// (copy, to not share the backing array with other `append(showPerfFlags, ...)`)
+	showPerfDeltaFlags = append(append(make([]cli.Flag, 0, len(showPerfFlags)+2), showPerfFlags...), perfDeltaFlag, jsonFlag)
 
 	// alias
 	perfCmd = cli.Command{
@@ -59,7 +61,7 @@ var (
 		Name:      commandPerf,
 		Usage:     showPerfArgument,
 		ArgsUsage: optionalTargetIDArgument,
-		Flags:     showPerfFlags,
+		Flags:     showPerfDeltaFlags,
 		Action:    showPerfHandler,
 		Subcommands: []cli.Command{
 			showCounters,
@@ -76,7 +78,7 @@ var (
 			indent2 + "\t- (GET, PUT, etc.) cumulative and average sizes;\n" +
 			indent2 + "\t- associated error counters, if any, and more.",
 		ArgsUsage:    optionalTargetIDArgument,
-		Flags:        showPerfFlags,
+		Flags:        showPerfDeltaFlags,
 		Action:       showCountersHandler,
 		BashComplete: suggestTargetNodes,
 	}
@@ -107,6 +109,10 @@ var (
 )
 
 func showPerfHandler(c *cli.Context) error {
+	if flagIsSet(c, perfDeltaFlag) {
+		// rates subsume throughput; latencies are always computed over the sampling interval
+		return showCountersHandler(c)
+	}
 	allPerfTabs = true // global (TODO: consider passing as param)
 
 	if c.NArg() > 1 && strings.HasPrefix(c.Args().Get(1), "-") {
@@ -153,7 +159,74 @@ func showCountersHandler(c *cli.Context) error {
 			selected[name] = kind
 		}
 	}
-	return showPerfTab(c, selected, nil, cmdShowCounters, nil, false)
+	if !flagIsSet(c, perfDeltaFlag) {
+		return showPerfTab(c, selected, nil, cmdShowCounters, nil, false)
+	}
+	// rates: sizes become bytes/s; all columns get tallied up
+	totals := make(map[string]int64, len(selected))
+	for name, kind := range selected {
+		if kind == stats.KindSize {
+			selected[name] = stats.KindComputedThroughput
+		}
+		totals[name] = 0
+	}
+	return showPerfTab(c, selected, _delta, cmdShowCounters, totals, false)
+}
+
+// update mapBegin <= (counter or size delta)/s
+func _delta(c *cli.Context, metrics cos.StrKVs, mapBegin, mapEnd teb.StstMap, elapsed time.Duration) (idle bool) {
+	var (
+		seconds = cos.MaxI64(int64(elapsed.Seconds()), 1)
+		num     int
+	)
+	for tid, begin := range mapBegin {
+		end := mapEnd[tid]
+		if end == nil {
+			warn := fmt.Sprintf("missing %s in the get-stats-and-status results\n", cluster.Tname(tid))
+			actionWarn(c, warn)
+			continue
+		}
+		for name, v := range begin.Tracker {
+			if _, ok := metrics[name]; !ok {
+				continue
+			}
+			vend := end.Tracker[name]
+			v.Value = cos.MaxI64(vend.Value-v.Value, 0) / seconds // (negative when target restarts)
+			begin.Tracker[name] = v
+			if v.Value != 0 {
+				num++
+			}
+		}
+	}
+	idle = num == 0
+	return
+}
+
+// `--delta --json`
+type perfRates struct {
+	Interval string                      `json:"interval"`
+	Targets  map[string]map[string]int64 `json:"targets"`
+	Total    map[string]int64            `json:"total,omitempty"`
+}
+
+func _jsonRates(st teb.StstMap, metrics cos.StrKVs, sid string, totals map[string]int64, sleep time.Duration) *perfRates {
+	out := &perfRates{Interval: sleep.String(), Targets: make(map[string]map[string]int64, len(st))}
+	for tid, ds := range st {
+		if (sid != "" && tid != sid) || ds.Status != teb.NodeOnline {
+			continue
+		}
+		rates := make(map[string]int64, len(metrics))
+		for name, v := range ds.Tracker {
+			if _, ok := metrics[name]; ok {
+				rates[name] = v.Value
+			}
+		}
+		out.Targets[tid] = rates
+	}
+	if len(out.Targets) > 1 {
+		out.Total = totals
+	}
+	return out
 }
 
 func showThroughputHandler(c *cli.Context) error {
@@ -295,6 +368,13 @@ func showPerfTab(c *cli.Context, metrics cos.StrKVs, cb perfcb, tag string, tota
 	if inclAvgSize {
 		avgSize = true // caller override
 	}
+	var (
+		rates = flagIsSet(c, perfDeltaFlag)
+		usejs = flagIsSet(c, jsonFlag)
+	)
+	if usejs && !rates {
+		return incorrectUsageMsg(c, "%s requires %s", qflprn(jsonFlag), qflprn(perfDeltaFlag))
+	}
 	sid, _, err := argNode(c)
 	if err != nil {
 		return err
@@ -368,9 +448,11 @@ func showPerfTab(c *cli.Context, metrics cos.StrKVs, cb perfcb, tag string, tota
 		}
 
 		idle := cb(c, metrics, mapBegin, mapEnd, sleep) // call back to recompute
-		if !idle {
+		switch {
+		case usejs: // (no captions and notes)
+		case !idle:
 			perfCptn(c, tag)
-		} else {
+		default:
 			s := "cluster"
 			if sid != "" {
 				s = "target"
@@ -389,6 +471,8 @@ func showPerfTab(c *cli.Context, metrics cos.StrKVs, cb perfcb, tag string, tota
 				} else {
 					actionNote(c, fmt.Sprintf("%q matching latency metrics have zero values\n", regexStr))
 				}
+			case cmdShowCounters:
+				actionNote(c, fmt.Sprintf("the %s is idle: all counters remained unchanged over the last %v\n", s, sleep))
 			}
 		}
 
@@ -410,9 +494,18 @@ func showPerfTab(c *cli.Context, metrics cos.StrKVs, cb perfcb, tag string, tota
 			}
 		}
 
+		if usejs {
+			err = teb.Print(_jsonRates(mapBegin, metrics, sid, totals, sleep), "", teb.Jopts(true))
+			if err != nil || !refresh {
+				return err
+			}
+			ini = mapEnd
+			continue
+		}
+
 		ctx := teb.PerfTabCtx{Smap: smap, Sid: sid, Metrics: metrics, Regex: regex, Units: units,
 			Totals: totals, TotalsHdr: totalsHdr,
-			AllCols: allCols, AvgSize: avgSize}
+			AllCols: allCols, AvgSize: avgSize, Rates: rates}
 		table, _, err := teb.NewPerformanceTab(mapBegin, &ctx)
 		if err != nil {
 			return err
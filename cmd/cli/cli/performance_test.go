@@ -0,0 +1,39 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmd/cli/teb"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/stats"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestPerfDelta(t *testing.T) {
+	var (
+		begin, end teb.StstMap
+		c          = newTestContext(nil)
+		metrics    = cos.StrKVs{stats.GetCount: stats.KindCounter, stats.GetSize: stats.KindComputedThroughput}
+	)
+	tassert.CheckFatal(t, cos.JSON.UnmarshalFromString(`{
+		"t1": {"status": "online", "tracker": {"get.n": 100, "get.size": 1000, "put.n": 7}},
+		"t2": {"status": "online", "tracker": {"get.n": 900, "get.size": 0}}}`, &begin))
+	tassert.CheckFatal(t, cos.JSON.UnmarshalFromString(`{
+		"t1": {"status": "online", "tracker": {"get.n": 150, "get.size": 6000, "put.n": 9}},
+		"t2": {"status": "online", "tracker": {"get.n": 3, "get.size": 0}}}`, &end))
+
+	idle := _delta(c, metrics, begin, end, 10*time.Second)
+	tassert.Errorf(t, !idle, "expected non-idle")
+	tassert.Errorf(t, begin["t1"].Tracker[stats.GetCount].Value == 5, "get.n: %d", begin["t1"].Tracker[stats.GetCount].Value)
+	tassert.Errorf(t, begin["t1"].Tracker[stats.GetSize].Value == 500, "get.size: %d", begin["t1"].Tracker[stats.GetSize].Value)
+	tassert.Errorf(t, begin["t1"].Tracker["put.n"].Value == 7, "unselected metric must not change")
+	tassert.Errorf(t, begin["t2"].Tracker[stats.GetCount].Value == 0, "restarted target: %d", begin["t2"].Tracker[stats.GetCount].Value)
+
+	out := _jsonRates(begin, metrics, "" /*sid*/, map[string]int64{stats.GetCount: 5}, 10*time.Second)
+	tassert.Errorf(t, len(out.Targets) == 2 && len(out.Targets["t1"]) == 2 && out.Total != nil, "unexpected %+v", out)
+}
@@ -7,6 +7,7 @@ package cli
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"io"
 	"os"
@@ -16,10 +17,13 @@ import (
 	"time"
 
 	"github.com/NVIDIA/aistore/api"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmd/cli/config"
 	"github.com/NVIDIA/aistore/cmd/cli/teb"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/atomic"
 	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/jsp"
 	"github.com/urfave/cli"
 	"github.com/vbauerster/mpb/v4"
 	"github.com/vbauerster/mpb/v4/decor"
@@ -34,10 +38,19 @@ type (
 		refresh   time.Duration
 		cksum     *cos.Cksum
 		totalSize int64
+		filtered  int // filtered out by '--include' and/or '--exclude'
+		ignored   int // skipped via `.aisignore`
+		elim      cmn.ErrLimits
+	}
+	// '--dedupe': identical file to be created as a server-side copy of the (already uploaded) `src`
+	fdup struct {
+		fobj
+		src string
 	}
 	uctx struct {
 		wg            cos.WG
 		errCount      atomic.Int32 // uploads failed so far
+		skipCount     atomic.Int32 // skipped as unchanged (`--skip-if-same`)
 		processedCnt  atomic.Int32 // files processed so far
 		processedSize atomic.Int64 // size of already processed files
 		barObjs       *mpb.Bar
@@ -47,16 +60,41 @@ type (
 		lastReport    time.Time
 		reportEvery   time.Duration
 		mx            sync.Mutex
+		warnOnce      sync.Once
 		verbose       bool
 		showProgress  bool
+		// abort-on-error ('--max-errors', '--error-rate')
+		elim    cmn.ErrLimits
+		trigger string
+		aborted atomic.Bool
 	}
 )
 
-func putFobjs(c *cli.Context, files []fobj, bck cmn.Bck, fromTag string) error {
+func putFobjs(c *cli.Context, files []fobj, bck cmn.Bck, fromTag string, filtered, ignored int) error {
 	if len(files) == 0 {
+		if n := filtered + ignored; n > 0 {
+			return fmt.Errorf("no files to PUT: all %d file%s filtered out "+
+				"(hint: check %s and/or %s patterns, %s files, or use %s)",
+				n, cos.Plural(n), qflprn(putIncludeFlag), qflprn(putExcludeFlag), aisIgnoreFname, qflprn(noIgnoreFlag))
+		}
 		return fmt.Errorf("no files to PUT (hint: check filename pattern and/or source directory name)")
 	}
 
+	elim, err := parseErrLimits(c)
+	if err != nil {
+		return err
+	}
+
+	var dups []fdup
+	if flagIsSet(c, putDedupeFlag) {
+		if bck.IsRemote() {
+			return incorrectUsageMsg(c, "%s is not supported for remote buckets (%s)", qflprn(putDedupeFlag), bck)
+		}
+		if files, dups, err = dedupeFobjs(files); err != nil {
+			return err
+		}
+	}
+
 	// calculate total size, group by extension
 	totalSize, extSizes := groupByExt(files)
 	totalCount := int64(len(files))
@@ -69,12 +107,21 @@ func putFobjs(c *cli.Context, files []fobj, bck cmn.Bck, fromTag string) error {
 		if i < len(files) {
 			fmt.Fprintf(c.App.Writer, "(and %d more)\n", len(files)-i)
 		}
+		if filtered > 0 {
+			fmt.Fprintf(c.App.Writer, "(filtered out %d file%s)\n", filtered, cos.Plural(filtered))
+		}
+		if ignored > 0 {
+			fmt.Fprintf(c.App.Writer, "(ignored %d file%s via %s)\n", ignored, cos.Plural(ignored), aisIgnoreFname)
+		}
+		if len(dups) > 0 {
+			fmt.Fprintln(c.App.Writer, dupsSummary(dups, "to copy server-side"))
+		}
 		return nil
 	}
 
 	var (
 		units, errU = parseUnitsFlag(c, unitsFlag)
-		tmpl        = teb.MultiPutTmpl + strconv.FormatInt(totalCount, 10) + "\t " + cos.ToSizeIEC(totalSize, 2) + "\n"
+		tmpl        = teb.MultiPutTmpl + strconv.FormatInt(totalCount, 10) + "\t " + teb.FmtSize(totalSize, units, 2) + "\n"
 		opts        = teb.Opts{AltMap: teb.FuncMapUnits(units)}
 	)
 	if errU != nil {
@@ -91,8 +138,14 @@ func putFobjs(c *cli.Context, files []fobj, bck cmn.Bck, fromTag string) error {
 
 	// ask a user for confirmation
 	if !flagIsSet(c, yesFlag) {
-		l := len(files)
-		if ok := confirm(c, fmt.Sprintf("PUT %d file%s => %s?", l, cos.Plural(l), bck)); !ok {
+		var (
+			l      = len(files)
+			prompt = fmt.Sprintf("PUT %d file%s => %s", l, cos.Plural(l), bck)
+		)
+		if len(dups) > 0 {
+			prompt += " (plus " + dupsSummary(dups, "to copy server-side") + ")"
+		}
+		if ok := confirm(c, prompt+"?"); !ok {
 			fmt.Fprintln(c.App.Writer, "Operation canceled")
 			return nil
 		}
@@ -107,8 +160,14 @@ func putFobjs(c *cli.Context, files []fobj, bck cmn.Bck, fromTag string) error {
 		refresh:   refresh,
 		cksum:     cksum,
 		totalSize: totalSize,
+		filtered:  filtered,
+		ignored:   ignored,
+		elim:      elim,
 	}
-	return _putFobjs(c, params)
+	if err := _putFobjs(c, params); err != nil || len(dups) == 0 {
+		return err
+	}
+	return copyDups(c, params, dups)
 }
 
 // PUT fobj-s in parallel
@@ -119,6 +178,7 @@ func _putFobjs(c *cli.Context, p *uparams) error {
 		wg:           cos.NewLimitedWaitGroup(p.workerCnt, 0),
 		lastReport:   time.Now(),
 		reportEvery:  p.refresh,
+		elim:         p.elim,
 	}
 	if u.showProgress {
 		var (
@@ -140,20 +200,116 @@ func _putFobjs(c *cli.Context, p *uparams) error {
 	}
 
 	for _, f := range p.files {
+		if u.aborted.Load() {
+			break
+		}
 		u.wg.Add(1)
 		go u.put(c, p, f)
 	}
 	u.wg.Wait()
 
 	if u.showProgress {
+		if u.aborted.Load() {
+			u.barObjs.Abort(false)
+			u.barSize.Abort(false)
+		}
 		u.progress.Wait()
 		fmt.Fprint(c.App.Writer, u.errSb.String())
 	}
+	if u.aborted.Load() {
+		return u.abortErr("PUT", len(p.files))
+	}
 	if numFailed := u.errCount.Load(); numFailed > 0 {
 		return fmt.Errorf("failed to PUT %d object%s", numFailed, cos.Plural(int(numFailed)))
 	}
-	msg := fmt.Sprintf("PUT %d object%s%s to %q\n", len(p.files), cos.Plural(len(p.files)), p.fromTag, p.bck.Cname(""))
-	actionDone(c, msg)
+	var (
+		skipped = int(u.skipCount.Load())
+		num     = len(p.files) - skipped
+		msg     = fmt.Sprintf("PUT %d object%s%s to %q", num, cos.Plural(num), p.fromTag, p.bck.Cname(""))
+	)
+	if skipped > 0 {
+		msg += fmt.Sprintf(" (skipped %d unchanged)", skipped)
+	}
+	if p.filtered > 0 {
+		msg += fmt.Sprintf(" (filtered out %d file%s)", p.filtered, cos.Plural(p.filtered))
+	}
+	if p.ignored > 0 {
+		msg += fmt.Sprintf(" (ignored %d file%s via %s)", p.ignored, cos.Plural(p.ignored), aisIgnoreFname)
+	}
+	actionDone(c, msg+"\n")
+	return nil
+}
+
+// '--dedupe': group files by size, and then by content (sha256) - only those that share the size;
+// returns the files to upload (in their original order) and the duplicates
+func dedupeFobjs(files []fobj) (uniq []fobj, dups []fdup, _ error) {
+	bySize := make(map[int64]int, len(files))
+	for i := range files {
+		bySize[files[i].size]++
+	}
+	first := make(map[string]string, 8) // size and content hash => object name
+	uniq = make([]fobj, 0, len(files))
+	for _, f := range files {
+		if f.size == 0 || bySize[f.size] < 2 {
+			uniq = append(uniq, f)
+			continue
+		}
+		fh, err := os.Open(f.path)
+		if err != nil {
+			return nil, nil, err
+		}
+		_, ckhash, err := cos.CopyAndChecksum(io.Discard, fh, nil, cos.ChecksumSHA256)
+		fh.Close()
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to compute checksum of %q: %v", f.path, err)
+		}
+		key := strconv.FormatInt(f.size, 10) + "/" + ckhash.Value()
+		if src, ok := first[key]; ok {
+			dups = append(dups, fdup{fobj: f, src: src})
+			continue
+		}
+		first[key] = f.name
+		uniq = append(uniq, f)
+	}
+	return uniq, dups, nil
+}
+
+func dupsSummary(dups []fdup, what string) string {
+	var size int64
+	for i := range dups {
+		size += dups[i].size
+	}
+	return fmt.Sprintf("%d duplicate%s %s, %s", len(dups), cos.Plural(len(dups)), what, teb.FmtSize(size, "", 2))
+}
+
+// after all uploads: create duplicates as server-side copies
+func copyDups(c *cli.Context, p *uparams, dups []fdup) error {
+	var (
+		wg       = cos.NewLimitedWaitGroup(p.workerCnt, 0)
+		verbose  = flagIsSet(c, verboseFlag)
+		errCount atomic.Int32
+		mu       sync.Mutex
+	)
+	for i := range dups {
+		wg.Add(1)
+		go func(d *fdup) {
+			defer wg.Done()
+			err := api.CopyObject(apiBP, p.bck, d.src, d.name)
+			mu.Lock()
+			if err != nil {
+				fmt.Fprintf(c.App.Writer, "Failed to copy %s => %s: %v\n", p.bck.Cname(d.src), d.name, err)
+				errCount.Inc()
+			} else if verbose {
+				fmt.Fprintf(c.App.Writer, "%s -> %s (copy of %s)\n", d.path, d.name, d.src)
+			}
+			mu.Unlock()
+		}(&dups[i])
+	}
+	wg.Wait()
+	if n := int(errCount.Load()); n > 0 {
+		return fmt.Errorf("failed to copy %d (out of %d) duplicate%s", n, len(dups), cos.Plural(len(dups)))
+	}
+	actionDone(c, "Deduplicated: "+dupsSummary(dups, "copied server-side")+" not uploaded")
 	return nil
 }
 
@@ -164,6 +320,35 @@ func _putFobjs(c *cli.Context, p *uparams) error {
 func (u *uctx) put(c *cli.Context, p *uparams, f fobj) {
 	defer u.fini(c, p, f)
 
+	cksum := p.cksum
+	if flagIsSet(c, skipIfSameFlag) {
+		same, computed, fallback, err := isSameAsDst(p.bck, f.name, f.path, cksum)
+		if fallback {
+			u.warnOnce.Do(func() { actionWarn(c, errCksumFallback(p.bck, cksum)) })
+		}
+		switch {
+		case err != nil:
+			str := fmt.Sprintf("Failed to compare %q with %s: %v\n", f.path, p.bck.Cname(f.name), err)
+			if u.showProgress {
+				u.errSb.WriteString(str)
+			} else {
+				fmt.Fprint(c.App.Writer, str)
+			}
+			u.errCount.Inc()
+			return
+		case same:
+			u.skipCount.Inc()
+			if u.showProgress {
+				u.barSize.IncrInt64(f.size)
+			} else if u.verbose {
+				fmt.Fprintf(c.App.Writer, "%s -> %s (unchanged, skipped)\n", f.path, f.name)
+			}
+			return
+		case computed != nil:
+			cksum = computed
+		}
+	}
+
 	fh, err := cos.NewFileHandle(f.path)
 	if err != nil {
 		str := fmt.Sprintf("Failed to open %q: %v\n", f.path, err)
@@ -210,10 +395,16 @@ func (u *uctx) put(c *cli.Context, p *uparams, f fobj) {
 			Bck:        p.bck,
 			ObjName:    f.name,
 			Reader:     countReader,
-			Cksum:      p.cksum,
+			Cksum:      cksum,
 			SkipVC:     flagIsSet(c, skipVerCksumFlag),
 		}
 	)
+	if flagIsSet(c, preserveAttrsFlag) || flagIsSet(c, skipIfSameFlag) {
+		if finfo, err := os.Stat(f.path); err == nil {
+			putArgs.CustomMD = putSrcAttrsMD(c, finfo)
+		}
+	}
+	putArgs.CustomMD = addExpiresMD(c, putArgs.CustomMD)
 	if _, err := api.PutObject(putArgs); err != nil {
 		str := fmt.Sprintf("Failed to PUT %s: %v\n", p.bck.Cname(f.name), err)
 		if u.showProgress {
@@ -235,6 +426,7 @@ func (u *uctx) fini(c *cli.Context, p *uparams, f fobj) {
 	if u.showProgress {
 		u.barObjs.Increment()
 	}
+	u.checkErrLimits()
 	u.wg.Done()
 	if u.reportEvery == 0 {
 		return
@@ -246,13 +438,48 @@ func (u *uctx) fini(c *cli.Context, p *uparams, f fobj) {
 	if !u.showProgress && time.Since(u.lastReport) > u.reportEvery {
 		fmt.Fprintf(
 			c.App.Writer, "Uploaded %d(%d%%) objects, %s (%d%%).\n",
-			total, 100*total/len(p.files), cos.ToSizeIEC(size, 1), 100*size/p.totalSize,
+			total, 100*total/len(p.files), teb.FmtSize(size, "", 1), 100*size/p.totalSize,
 		)
 		u.lastReport = time.Now()
 	}
 	u.mx.Unlock()
 }
 
+// (abort-on-error)
+func parseErrLimits(c *cli.Context) (elim cmn.ErrLimits, err error) {
+	elim.MaxErrors = int64(parseIntFlag(c, maxErrorsFlag))
+	elim.ErrRatePct = parseIntFlag(c, errorRateFlag)
+	if errV := elim.Validate(); errV != nil {
+		err = incorrectUsageMsg(c, "%v", errV)
+	}
+	return
+}
+
+func (u *uctx) checkErrLimits() {
+	if !u.elim.IsSet() || u.aborted.Load() {
+		return
+	}
+	trigger := u.elim.Exceeded(int64(u.errCount.Load()), int64(u.processedCnt.Load()))
+	if trigger == "" {
+		return
+	}
+	u.mx.Lock()
+	if !u.aborted.Load() {
+		u.trigger = trigger
+		u.aborted.Store(true)
+	}
+	u.mx.Unlock()
+}
+
+func (u *uctx) abortErr(verb string, total int) error {
+	var (
+		nerr = int(u.errCount.Load())
+		n    = int(u.processedCnt.Load())
+	)
+	return fmt.Errorf("%s aborted: %s (failed %d, processed %d out of %d object%s)",
+		verb, u.trigger, nerr, n, total, cos.Plural(total))
+}
+
 func putRegular(c *cli.Context, bck cmn.Bck, objName, path string, finfo os.FileInfo) error {
 	var (
 		reader   cos.ReadOpenCloser
@@ -264,6 +491,31 @@ func putRegular(c *cli.Context, bck cmn.Bck, objName, path string, finfo os.File
 	if err != nil {
 		return err
 	}
+	if flagIsSet(c, skipIfSameFlag) {
+		same, computed, fallback, err := isSameAsDst(bck, objName, path, cksum)
+		if fallback {
+			actionWarn(c, errCksumFallback(bck, cksum))
+		}
+		if err != nil {
+			return err
+		}
+		if same {
+			return errSkippedSame
+		}
+		if computed != nil {
+			cksum = computed
+		}
+	}
+	partSize, err := mptPartSize(c, bck, finfo.Size())
+	if err != nil {
+		return err
+	}
+	if partSize > 0 {
+		if flagIsSet(c, preserveAttrsFlag) {
+			return fmt.Errorf("%s cannot be used with multipart upload (%s)", qflprn(preserveAttrsFlag), qflprn(partWorkersFlag))
+		}
+		return putMultipart(c, bck, objName, path, finfo.Size(), partSize)
+	}
 	fh, err := cos.NewFileHandle(path)
 	if err != nil {
 		return err
@@ -285,6 +537,10 @@ func putRegular(c *cli.Context, bck cmn.Bck, objName, path string, finfo os.File
 		Cksum:      cksum,
 		SkipVC:     flagIsSet(c, skipVerCksumFlag),
 	}
+	if flagIsSet(c, preserveAttrsFlag) || flagIsSet(c, skipIfSameFlag) {
+		putArgs.CustomMD = putSrcAttrsMD(c, finfo)
+	}
+	putArgs.CustomMD = addExpiresMD(c, putArgs.CustomMD)
 	_, err = api.PutObject(putArgs)
 	if progress != nil {
 		progress.Wait()
@@ -338,13 +594,284 @@ func appendToArch(c *cli.Context, bck cmn.Bck, objName, path, archPath string, f
 // PUT fixed-sized chunks using `api.AppendObject` and `api.FlushObject`
 func putAppendChunks(c *cli.Context, bck cmn.Bck, objName string, r io.Reader, cksumType string, chunkSize int64) error {
 	var (
-		handle string
-		cksum  = cos.NewCksumHash(cksumType)
-		pi     = newProgIndicator(objName)
+		cksum = cos.NewCksumHash(cksumType)
+		pi    = newProgIndicator(objName)
 	)
 	if flagIsSet(c, progressFlag) {
 		pi.start()
 	}
+	handle, err := appendChunks(c, bck, objName, "" /*handle*/, r, cksum, chunkSize, pi)
+	if err != nil {
+		return err
+	}
+	if flagIsSet(c, progressFlag) {
+		pi.stop()
+	}
+	if cksumType != cos.ChecksumNone {
+		cksum.Finalize()
+	}
+	return api.FlushObject(api.FlushArgs{
+		BaseParams: apiBP,
+		Bck:        bck,
+		Object:     objName,
+		Handle:     handle,
+		Cksum:      cksum.Clone(),
+	})
+}
+
+// PUT standard input with known content length (see `spillThresholdFlag`)
+func putStdinSpill(c *cli.Context, bck cmn.Bck, objName string) error {
+	if flagIsSet(c, chunkSizeFlag) {
+		return incorrectUsageMsg(c, "%s and %s are mutually exclusive", qflprn(spillThresholdFlag), qflprn(chunkSizeFlag))
+	}
+	threshold, err := parseSizeFlag(c, spillThresholdFlag)
+	if err != nil {
+		return err
+	}
+	cksum, err := cksumToCompute(c, bck)
+	if err != nil {
+		return err
+	}
+	if flagIsSet(c, verboseFlag) {
+		actionWarn(c, "To terminate input, press Ctrl-D two or more times")
+	}
+	var (
+		progress *mpb.Progress
+		bars     []*mpb.Bar
+		putArgs  = api.PutArgs{
+			BaseParams: apiBP,
+			Bck:        bck,
+			ObjName:    objName,
+			SkipVC:     flagIsSet(c, skipVerCksumFlag),
+			CustomMD:   addExpiresMD(c, nil),
+		}
+		sb = &spillBuf{threshold: threshold}
+	)
+	defer sb.cleanup()
+
+	// the size becomes known once the input ends
+	sb.setSize = func(size int64) {
+		putArgs.Size = uint64(size)
+		if flagIsSet(c, progressFlag) {
+			progress, bars = simpleBar(barArgs{barType: sizeArg, barText: objName, total: size})
+		}
+	}
+	ckh := cos.NewCksumHash(cksum.Type())
+	if err := sb.readFrom(stdinReader(), ckh); err != nil {
+		return err
+	}
+	if ckh.Type() != cos.ChecksumNone {
+		ckh.Finalize()
+		putArgs.Cksum = ckh.Clone()
+	}
+	reader := sb.open()
+	if progress != nil {
+		reader = cos.NewCallbackReadOpenCloser(reader, func(n int, _ error) { bars[0].IncrBy(n) })
+	}
+	putArgs.Reader = reader
+	_, err = api.PutObject(putArgs)
+	if progress != nil {
+		progress.Wait()
+	}
+	if err != nil {
+		return err
+	}
+	actionDone(c, fmt.Sprintf("PUT (standard input, size %s) => %s\n",
+		teb.FmtSize(sb.size, "", 2), bck.Cname(objName)))
+	return nil
+}
+
+// PUT standard input of declared size (see `stdinSizeFlag`), optionally with
+// expected checksum to be validated by the target upon receipt
+func putStdinSized(c *cli.Context, bck cmn.Bck, objName string) error {
+	if flagIsSet(c, spillThresholdFlag) || flagIsSet(c, chunkSizeFlag) {
+		return incorrectUsageMsg(c, "%s cannot be used together with %s or %s",
+			qflprn(stdinSizeFlag), qflprn(spillThresholdFlag), qflprn(chunkSizeFlag))
+	}
+	size, err := parseSizeFlag(c, stdinSizeFlag)
+	if err != nil {
+		return err
+	}
+	if size <= 0 {
+		return fmt.Errorf("invalid %s value %q: expecting positive size", qflprn(stdinSizeFlag), parseStrFlag(c, stdinSizeFlag))
+	}
+	cksum, err := cksumToCompute(c, bck)
+	if err != nil {
+		return err
+	}
+	if cksum != nil && cksum.Value() == "" {
+		// a stream of data cannot be checksummed prior to sending it
+		return fmt.Errorf("%s requires expected checksum value (e.g., '--%s HEX'), cannot compute it in advance",
+			qflprn(stdinSizeFlag), cos.ChecksumSHA256)
+	}
+	var (
+		progress *mpb.Progress
+		bars     []*mpb.Bar
+		reader   cos.ReadOpenCloser = &sizedReader{r: stdinReader(), size: size}
+	)
+	if flagIsSet(c, progressFlag) {
+		progress, bars = simpleBar(barArgs{barType: sizeArg, barText: objName, total: size})
+		reader = cos.NewCallbackReadOpenCloser(reader, func(n int, _ error) { bars[0].IncrBy(n) })
+	}
+	putArgs := api.PutArgs{
+		BaseParams: apiBP,
+		Bck:        bck,
+		ObjName:    objName,
+		Reader:     reader,
+		Size:       uint64(size),
+		Cksum:      cksum,
+		SkipVC:     flagIsSet(c, skipVerCksumFlag),
+		CustomMD:   addExpiresMD(c, nil),
+	}
+	_, err = api.PutObject(putArgs)
+	if progress != nil {
+		progress.Wait()
+	}
+	if err != nil {
+		var errSize *errStdinSize
+		switch {
+		case errors.As(err, &errSize):
+			return errSize
+		case cos.IsErrBadDataCksum(err):
+			return fmt.Errorf("checksum verification failed: %v", err)
+		default:
+			return fmt.Errorf("failed to PUT %s: %v", bck.Cname(objName), err)
+		}
+	}
+	msg := fmt.Sprintf("PUT (standard input, size %s) => %s", teb.FmtSize(size, "", 2), bck.Cname(objName))
+	if cksum != nil {
+		msg += fmt.Sprintf(" (%s validated)", cksum.Type())
+	}
+	actionDone(c, msg+"\n")
+	return nil
+}
+
+// sizedReader reads exactly `size` bytes of (non-seekable) input, and fails
+// the transfer if the input ends prematurely or has more to offer
+type (
+	sizedReader struct {
+		r    io.Reader
+		size int64
+		off  int64
+	}
+	errStdinSize struct {
+		size int64
+		n    int64
+		more bool
+	}
+)
+
+// interface guard
+var _ cos.ReadOpenCloser = (*sizedReader)(nil)
+
+func (sr *sizedReader) Read(p []byte) (n int, err error) {
+	if sr.off >= sr.size {
+		var b [1]byte
+		if k, _ := io.ReadFull(sr.r, b[:]); k > 0 {
+			return 0, &errStdinSize{size: sr.size, n: sr.off + int64(k), more: true}
+		}
+		return 0, io.EOF
+	}
+	if rem := sr.size - sr.off; int64(len(p)) > rem {
+		p = p[:rem]
+	}
+	n, err = sr.r.Read(p)
+	sr.off += int64(n)
+	if err == io.EOF && sr.off < sr.size {
+		err = &errStdinSize{size: sr.size, n: sr.off}
+	}
+	return n, err
+}
+
+func (*sizedReader) Close() error { return nil }
+
+func (*sizedReader) Open() (cos.ReadOpenCloser, error) {
+	return nil, errors.New("cannot re-read standard input (retry is not supported)")
+}
+
+func (e *errStdinSize) Error() string {
+	if e.more {
+		return fmt.Sprintf("standard input exceeds declared size %d", e.size)
+	}
+	return fmt.Sprintf("standard input ended prematurely: read %d bytes, declared size %d", e.n, e.size)
+}
+
+// spillBuf buffers a stream of unknown size: in memory up to `threshold`,
+// and the rest in a temporary file
+type (
+	spillBuf struct {
+		file      *os.File
+		setSize   cos.SetSizeFunc
+		mem       bytes.Buffer
+		threshold int64
+		size      int64
+	}
+	spillReader struct {
+		sb *spillBuf
+		r  io.Reader
+	}
+)
+
+// interface guard
+var _ cos.ReadOpenCloser = (*spillReader)(nil)
+
+func (sb *spillBuf) Write(p []byte) (n int, err error) {
+	if room := sb.threshold - int64(sb.mem.Len()); room > 0 {
+		k := int(cos.MinI64(room, int64(len(p))))
+		sb.mem.Write(p[:k])
+		n, p = k, p[k:]
+	}
+	if len(p) > 0 {
+		if sb.file == nil {
+			if sb.file, err = os.CreateTemp("", "ais-put-spill-"); err != nil {
+				return n, err
+			}
+		}
+		var m int
+		m, err = sb.file.Write(p)
+		n += m
+	}
+	sb.size += int64(n)
+	return n, err
+}
+
+// read (and checksum) the stream to the end, and report the resulting size
+func (sb *spillBuf) readFrom(r io.Reader, ckh *cos.CksumHash) (err error) {
+	var w io.Writer = sb
+	if ckh.Type() != cos.ChecksumNone {
+		w = cos.NewWriterMulti(ckh.H, sb)
+	}
+	if _, err = io.Copy(w, r); err != nil {
+		return err
+	}
+	if sb.setSize != nil {
+		sb.setSize(sb.size)
+	}
+	return nil
+}
+
+func (sb *spillBuf) open() cos.ReadOpenCloser {
+	rs := []io.Reader{bytes.NewReader(sb.mem.Bytes())}
+	if sb.file != nil {
+		rs = append(rs, io.NewSectionReader(sb.file, 0, sb.size-int64(sb.mem.Len())))
+	}
+	return &spillReader{sb: sb, r: io.MultiReader(rs...)}
+}
+
+func (sb *spillBuf) cleanup() {
+	if sb.file != nil {
+		sb.file.Close()
+		os.Remove(sb.file.Name())
+	}
+}
+
+func (sr *spillReader) Read(p []byte) (int, error)        { return sr.r.Read(p) }
+func (*spillReader) Close() error                         { return nil }
+func (sr *spillReader) Open() (cos.ReadOpenCloser, error) { return sr.sb.open(), nil }
+
+// APPEND fixed-sized chunks; returns the resulting handle (to continue appending or flush)
+func appendChunks(c *cli.Context, bck cmn.Bck, objName, handle string, r io.Reader, cksum *cos.CksumHash,
+	chunkSize int64, pi *progIndicator) (string, error) {
 	for {
 		var (
 			b      = bytes.NewBuffer(nil)
@@ -352,19 +879,19 @@ func putAppendChunks(c *cli.Context, bck cmn.Bck, objName string, r io.Reader, c
 			err    error
 			reader cos.ReadOpenCloser
 		)
-		if cksumType != cos.ChecksumNone {
+		if cksum != nil && cksum.Type() != cos.ChecksumNone {
 			n, err = io.CopyN(cos.NewWriterMulti(cksum.H, b), r, chunkSize)
 		} else {
 			n, err = io.CopyN(b, r, chunkSize)
 		}
 		if err != nil && err != io.EOF {
-			return err
+			return handle, err
 		}
 		if n == 0 {
 			break
 		}
 		reader = cos.NewByteHandle(b.Bytes())
-		if flagIsSet(c, progressFlag) {
+		if pi != nil && flagIsSet(c, progressFlag) {
 			actualChunkOffset := atomic.NewInt64(0)
 			reader = cos.NewCallbackReadOpenCloser(reader, func(n int, _ error) {
 				if n == 0 {
@@ -390,23 +917,144 @@ func putAppendChunks(c *cli.Context, bck cmn.Bck, objName string, r io.Reader, c
 			Size:       n,
 		})
 		if err != nil {
-			return err
+			return handle, err
 		}
 	}
+	return handle, nil
+}
+
+//
+// APPEND session: `put --append` (any number of times) followed by `put --flush`
+//
+
+// append handles of the not-yet-flushed objects, one per bck/object,
+// stored in the CLI config directory between invocations
+const appendHandlesFname = "append_handles.json"
+
+func loadAppendHandles() (handles cos.StrKVs, err error) {
+	handles = make(cos.StrKVs, 4)
+	if err = jsp.LoadAppConfig(config.ConfigDir, appendHandlesFname, &handles); err != nil && os.IsNotExist(err) {
+		err = nil
+	}
+	return
+}
 
+func saveAppendHandle(bck cmn.Bck, objName, handle string) error {
+	handles, err := loadAppendHandles()
+	if err != nil {
+		return err
+	}
+	if handle == "" {
+		delete(handles, bck.Cname(objName))
+	} else {
+		handles[bck.Cname(objName)] = handle
+	}
+	return jsp.SaveAppConfig(config.ConfigDir, appendHandlesFname, handles)
+}
+
+// explicit '--append-handle' takes precedence over the stored one
+func appendHandle(c *cli.Context, bck cmn.Bck, objName string) (string, error) {
+	if flagIsSet(c, appendHandleFlag) {
+		return parseStrFlag(c, appendHandleFlag), nil
+	}
+	handles, err := loadAppendHandles()
+	if err != nil {
+		return "", err
+	}
+	return handles[bck.Cname(objName)], nil
+}
+
+// APPEND file (or standard input) to the object; optionally, flush
+func appendObj(c *cli.Context, bck cmn.Bck, objName, fileName string) error {
+	if objName == "" {
+		return incorrectUsageMsg(c, "%s requires destination object name (in %s)", qflprn(appendObjFlag), c.Command.ArgsUsage)
+	}
+	if flagIsSet(c, archpathOptionalFlag) || flagIsSet(c, skipIfSameFlag) {
+		return incorrectUsageMsg(c, "%s cannot be used together with %s or %s",
+			qflprn(appendObjFlag), qflprn(archpathOptionalFlag), qflprn(skipIfSameFlag))
+	}
+	var r io.Reader
+	if fileName == fileStdIO {
+		r = stdinReader()
+	} else {
+		path, err := absPath(fileName)
+		if err != nil {
+			return err
+		}
+		finfo, err := os.Stat(path)
+		if err != nil {
+			return err
+		}
+		if finfo.IsDir() {
+			return fmt.Errorf("cannot append directory %q (expecting a single file or standard input)", fileName)
+		}
+		fh, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer fh.Close()
+		r = fh
+	}
+	handle, err := appendHandle(c, bck, objName)
+	if err != nil {
+		return err
+	}
+	pi := newProgIndicator(objName)
+	if flagIsSet(c, progressFlag) {
+		pi.start()
+	}
+	handle, err = appendChunks(c, bck, objName, handle, r, nil /*cksum*/, defaultChunkSize, pi)
 	if flagIsSet(c, progressFlag) {
 		pi.stop()
 	}
-	if cksumType != cos.ChecksumNone {
-		cksum.Finalize()
+	if err != nil {
+		return err
 	}
-	return api.FlushObject(api.FlushArgs{
+	if flagIsSet(c, flushFlag) {
+		return flushObj(c, bck, objName, handle)
+	}
+	if err := saveAppendHandle(bck, objName, handle); err != nil {
+		actionWarn(c, fmt.Sprintf("failed to store append handle: %v", err))
+		fmt.Fprintf(c.App.Writer, "APPEND %q to %s (handle %s)\n", fileName, bck.Cname(objName), handle)
+	} else {
+		fmt.Fprintf(c.App.Writer, "APPEND %q to %s\n", fileName, bck.Cname(objName))
+	}
+	actionNote(c, fmt.Sprintf("the changes will become visible only after 'ais object put %s %s'",
+		bck.Cname(objName), flprn(flushFlag)))
+	return nil
+}
+
+func flushObj(c *cli.Context, bck cmn.Bck, objName, handle string) error {
+	if handle == "" {
+		return fmt.Errorf("nothing to flush: no pending appends to %s (hint: see %s)", bck.Cname(objName), qflprn(appendHandleFlag))
+	}
+	err := api.FlushObject(api.FlushArgs{
 		BaseParams: apiBP,
 		Bck:        bck,
 		Object:     objName,
 		Handle:     handle,
-		Cksum:      cksum.Clone(),
 	})
+	if err != nil {
+		return err
+	}
+	if err := saveAppendHandle(bck, objName, ""); err != nil {
+		actionWarn(c, fmt.Sprintf("failed to remove append handle: %v", err))
+	}
+	actionDone(c, fmt.Sprintf("FLUSH %s\n", bck.Cname(objName)))
+	return nil
+}
+
+// `put BUCKET/OBJECT --flush`
+func flushHandler(c *cli.Context) error {
+	bck, objName, err := parseBckObjectURI(c, c.Args().Get(0))
+	if err != nil {
+		return err
+	}
+	handle, err := appendHandle(c, bck, objName)
+	if err != nil {
+		return err
+	}
+	return flushObj(c, bck, objName, handle)
 }
 
 //
@@ -459,3 +1107,138 @@ func altCksumToComp(c *cli.Context) []*cos.Cksum {
 	}
 	return cksums
 }
+
+//
+// PUT --skip-if-same
+//
+
+var errSkippedSame = errors.New("unchanged (same size and checksum) - skipped")
+
+// HEAD the destination object and compare it with the local file:
+//   - same size and same checksum (the checksum is computed locally unless already provided);
+//   - or, when checksums cannot be compared (bucket checksum is "none" or differs from the one
+//     requested via '--compute-checksum' and such), same size and same modification time as the one
+//     stored with the object (srcMtimeMD); objects that don't have it are never skipped
+//
+// Returns locally computed checksum (if any) so that subsequent PUT could reuse it.
+func isSameAsDst(bck cmn.Bck, objName, path string, cksum *cos.Cksum) (same bool, computed *cos.Cksum, fallback bool, err error) {
+	var (
+		op    *cmn.ObjectProps
+		finfo os.FileInfo
+	)
+	if op, err = api.HeadObject(apiBP, bck, objName, apc.FltPresent); err != nil {
+		if cmn.IsStatusNotFound(err) {
+			err = nil // nothing to compare with
+		}
+		return
+	}
+	if finfo, err = os.Stat(path); err != nil {
+		return
+	}
+	if op.Size != finfo.Size() {
+		return
+	}
+	if op.Cksum.IsEmpty() || (!cksum.IsEmpty() && cksum.Ty() != op.Cksum.Ty()) {
+		fallback = true
+		// NOTE: not comparing with the object's access time (that any GET updates)
+		if sa, errV := parseSrcAttrsMD(op.GetCustomMD()); errV == nil && !sa.mtime.IsZero() {
+			same = finfo.ModTime().Equal(sa.mtime)
+		}
+		return
+	}
+	local := cksum
+	if local.IsEmpty() || local.Value() == "" {
+		var (
+			fh     *os.File
+			ckhash *cos.CksumHash
+		)
+		if fh, err = os.Open(path); err != nil {
+			return
+		}
+		_, ckhash, err = cos.CopyAndChecksum(io.Discard, fh, nil, op.Cksum.Ty())
+		fh.Close()
+		if err != nil {
+			return
+		}
+		local = ckhash.Clone()
+	}
+	same = local.Equal(op.Cksum)
+	if !cksum.IsEmpty() {
+		computed = local // reuse
+	}
+	return
+}
+
+func errCksumFallback(bck cmn.Bck, cksum *cos.Cksum) string {
+	return fmt.Sprintf("%s: cannot compare checksums (local %s) - comparing size and source modification time (%q) instead;\n"+
+		"objects that were PUT without it (e.g., prior to using %s) will be uploaded again",
+		bck.Cname(""), cksum.Type(), srcMtimeMD, qflprn(skipIfSameFlag))
+}
+
+// --preserve-attrs: source file's mtime and permissions => object's custom metadata
+// (see also: restoreSrcAttrs)
+const (
+	srcMtimeMD = "src.mtime"
+	srcModeMD  = "src.mode"
+)
+
+// --expire: object's expiration time => custom metadata (see also: `ais start expiry-sweep`)
+func addExpiresMD(c *cli.Context, md cos.StrKVs) cos.StrKVs {
+	if !flagIsSet(c, objExpireFlag) {
+		return md
+	}
+	if md == nil {
+		md = make(cos.StrKVs, 1)
+	}
+	md[cmn.ExpiresObjMD] = expiresAt(parseDurationFlag(c, objExpireFlag), time.Now())
+	return md
+}
+
+func validateExpire(c *cli.Context) error {
+	if flagIsSet(c, objExpireFlag) && parseDurationFlag(c, objExpireFlag) <= 0 {
+		return fmt.Errorf("invalid %s value: expecting positive duration, e.g. '7d' or '12h'", qflprn(objExpireFlag))
+	}
+	return nil
+}
+
+func expiresAt(ttl time.Duration, now time.Time) string {
+	return now.Add(ttl).UTC().Format(time.RFC3339)
+}
+
+func srcAttrsMD(finfo os.FileInfo) cos.StrKVs {
+	return cos.StrKVs{
+		srcMtimeMD: finfo.ModTime().UTC().Format(time.RFC3339Nano),
+		srcModeMD:  strconv.FormatUint(uint64(finfo.Mode().Perm()), 8),
+	}
+}
+
+// '--skip-if-same' alone stores (only) the source mtime - for subsequent comparisons (see isSameAsDst)
+func putSrcAttrsMD(c *cli.Context, finfo os.FileInfo) cos.StrKVs {
+	md := srcAttrsMD(finfo)
+	if !flagIsSet(c, preserveAttrsFlag) {
+		delete(md, srcModeMD)
+	}
+	return md
+}
+
+type srcAttrs struct {
+	mtime   time.Time // zero when not stored
+	mode    os.FileMode
+	hasMode bool
+}
+
+func parseSrcAttrsMD(md cos.StrKVs) (sa srcAttrs, err error) {
+	if v, ok := md[srcMtimeMD]; ok {
+		if sa.mtime, err = time.Parse(time.RFC3339Nano, v); err != nil {
+			return sa, fmt.Errorf("invalid %q value %q: %v", srcMtimeMD, v, err)
+		}
+	}
+	if v, ok := md[srcModeMD]; ok {
+		perm, err := strconv.ParseUint(v, 8, 32)
+		if err != nil || perm > uint64(os.ModePerm) {
+			return sa, fmt.Errorf("invalid %q value %q", srcModeMD, v)
+		}
+		sa.mode, sa.hasMode = os.FileMode(perm), true
+	}
+	return sa, nil
+}
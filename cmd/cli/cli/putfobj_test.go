@@ -0,0 +1,160 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"errors"
+	"io"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmd/cli/teb"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestSpillBuf(t *testing.T) {
+	content := strings.Repeat("0123456789", 1000)
+	for _, threshold := range []int64{0, 1, 1000, int64(len(content)), 2 * int64(len(content))} {
+		var (
+			reported int64 = -1
+			sb             = &spillBuf{threshold: threshold}
+			ckh            = cos.NewCksumHash(cos.ChecksumXXHash)
+		)
+		sb.setSize = func(size int64) { reported = size }
+		tassert.CheckFatal(t, sb.readFrom(strings.NewReader(content), ckh))
+		tassert.Fatalf(t, reported == int64(len(content)), "threshold %d: expected size %d, got %d",
+			threshold, len(content), reported)
+		tassert.Errorf(t, int64(sb.mem.Len()) == cos.MinI64(threshold, int64(len(content))),
+			"threshold %d: buffered in memory %d", threshold, sb.mem.Len())
+		tassert.Errorf(t, (sb.file != nil) == (threshold < int64(len(content))),
+			"threshold %d: unexpected spill (%v)", threshold, sb.file != nil)
+
+		// read twice (e.g., upon redirect)
+		r := sb.open()
+		for i := 0; i < 2; i++ {
+			b, err := io.ReadAll(r)
+			tassert.CheckFatal(t, err)
+			tassert.Fatalf(t, string(b) == content, "threshold %d: read %d bytes, expected %d", threshold, len(b), len(content))
+			ro, err := r.Open()
+			tassert.CheckFatal(t, err)
+			r = ro
+		}
+		if sb.file != nil {
+			name := sb.file.Name()
+			sb.cleanup()
+			_, err := os.Stat(name)
+			tassert.Errorf(t, os.IsNotExist(err), "expected %q to be removed", name)
+		}
+	}
+}
+
+func TestSizedReader(t *testing.T) {
+	const content = "0123456789"
+	tests := []struct {
+		size int64
+		fail bool
+	}{
+		{int64(len(content)), false},
+		{int64(len(content)) + 1, true}, // input shorter than declared
+		{int64(len(content)) - 1, true}, // input longer than declared
+	}
+	for _, test := range tests {
+		b, err := io.ReadAll(&sizedReader{r: strings.NewReader(content), size: test.size})
+		if !test.fail {
+			tassert.CheckFatal(t, err)
+			tassert.Errorf(t, string(b) == content, "size %d: read %q", test.size, b)
+			continue
+		}
+		var errSize *errStdinSize
+		tassert.Errorf(t, errors.As(err, &errSize), "size %d: expected size error, got %v", test.size, err)
+	}
+}
+
+func TestSrcAttrsMD(t *testing.T) {
+	f, err := os.CreateTemp(t.TempDir(), "attrs")
+	tassert.CheckFatal(t, err)
+	f.Close()
+	tassert.CheckFatal(t, os.Chmod(f.Name(), 0o640))
+	finfo, err := os.Stat(f.Name())
+	tassert.CheckFatal(t, err)
+
+	sa, err := parseSrcAttrsMD(srcAttrsMD(finfo))
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, sa.hasMode && sa.mode == 0o640, "expected mode 0640, got %s", sa.mode)
+	tassert.Errorf(t, sa.mtime.Equal(finfo.ModTime()), "expected mtime %v, got %v", finfo.ModTime(), sa.mtime)
+
+	sa, err = parseSrcAttrsMD(cos.StrKVs{"etag": "abc"})
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, !sa.hasMode && sa.mtime.IsZero(), "expected no attributes, got %+v", sa)
+
+	for _, md := range []cos.StrKVs{{srcModeMD: "999"}, {srcModeMD: "17777"}, {srcMtimeMD: "yesterday"}} {
+		_, err = parseSrcAttrsMD(md)
+		tassert.Errorf(t, err != nil, "expected error for %v", md)
+	}
+}
+
+func TestErrLimits(t *testing.T) {
+	u := &uctx{elim: cmn.ErrLimits{MaxErrors: 2}}
+	for i := 0; i < 3; i++ {
+		u.errCount.Inc()
+		u.processedCnt.Inc()
+		u.checkErrLimits()
+	}
+	tassert.Fatalf(t, u.aborted.Load(), "expected to abort after 3 errors")
+	err := u.abortErr("PUT", 10)
+	tassert.Errorf(t, strings.Contains(err.Error(), "max-errors 2"), "unexpected %v", err)
+}
+
+func TestObjExpires(t *testing.T) {
+	var fvar DurationFlagVar
+	tassert.CheckFatal(t, fvar.Set("7d"))
+	tassert.Errorf(t, fvar.Value == 7*24*time.Hour, "7d: got %v", fvar.Value)
+	tassert.CheckFatal(t, fvar.Set("36h"))
+	tassert.Errorf(t, fvar.Value == 36*time.Hour, "36h: got %v", fvar.Value)
+	tassert.Errorf(t, fvar.Set("7dd") != nil, "expected error")
+
+	var (
+		now = time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC)
+		md  = cos.StrKVs{cmn.ExpiresObjMD: expiresAt(30*time.Hour, now), "etag": "abc"}
+	)
+	expires, ok := cmn.ObjExpires(md)
+	tassert.Fatalf(t, ok && expires.Equal(now.Add(30*time.Hour)), "unexpected %v, %t", expires, ok)
+
+	custom := cmn.CustomMD2S(md) // as listed
+	tassert.Errorf(t, teb.FmtObjTTL(custom, now) == "1d6h", "got %q", teb.FmtObjTTL(custom, now))
+	tassert.Errorf(t, teb.FmtObjTTL(custom, now.Add(29*time.Hour)) == "1h0m0s", "got %q", teb.FmtObjTTL(custom, now.Add(29*time.Hour)))
+	tassert.Errorf(t, teb.FmtObjTTL(custom, now.Add(31*time.Hour)) == "expired", "expected expired")
+	tassert.Errorf(t, teb.FmtObjTTL("map[etag:abc]", now) == teb.NotSetVal, "expected not-set")
+}
+
+func TestDedupeFobjs(t *testing.T) {
+	var (
+		dir   = t.TempDir()
+		files []fobj
+	)
+	for _, f := range []struct{ name, content string }{
+		{"a", "hello"}, {"b", "world"}, {"c", "hello"}, {"d", "other-size"}, {"e", ""}, {"f", ""}, {"g", "hello"},
+	} {
+		path := writeTestFile(t, dir, f.name, f.content)
+		files = append(files, fobj{path: path, name: "obj-" + f.name, size: int64(len(f.content))})
+	}
+	uniq, dups, err := dedupeFobjs(files)
+	tassert.CheckFatal(t, err)
+	names := make([]string, 0, len(uniq))
+	for _, f := range uniq {
+		names = append(names, f.name)
+	}
+	expected := []string{"obj-a", "obj-b", "obj-d", "obj-e", "obj-f"} // (empty files are never deduped)
+	tassert.Errorf(t, reflect.DeepEqual(names, expected), "expected %v, got %v", expected, names)
+	tassert.Fatalf(t, len(dups) == 2, "expected 2 duplicates, got %d", len(dups))
+	for _, d := range dups {
+		tassert.Errorf(t, d.src == "obj-a", "%s: expected copy of obj-a, got %s", d.name, d.src)
+	}
+}
@@ -0,0 +1,230 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+// This file implements parallel multipart PUT of a single (large) file.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/NVIDIA/aistore/api"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/urfave/cli"
+)
+
+const (
+	mptMaxParts       = 10000 // (same as S3)
+	mptMaxPartRetries = 3
+)
+
+type (
+	mptPut struct {
+		bck      cmn.Bck
+		objName  string
+		uploadID string
+		fh       *os.File
+		etags    []string
+		retries  []int // per part
+		size     int64
+		partSize int64
+		workers  int
+		mu       sync.Mutex
+		err      error // first error
+	}
+)
+
+// Returns part size if the file must be uploaded in parts, zero otherwise.
+// Multipart upload requires:
+// - '--part-workers' (and optionally, '--chunk-size' to specify the part size);
+// - file that is at least two parts in size;
+// - ais:// bucket in the global namespace that does not have a remote backend.
+func mptPartSize(c *cli.Context, bck cmn.Bck, size int64) (int64, error) {
+	if !flagIsSet(c, partWorkersFlag) {
+		return 0, nil
+	}
+	if n := parseIntFlag(c, partWorkersFlag); n < 1 {
+		return 0, incorrectUsageMsg(c, "invalid %s=%d: expecting a positive number", qflprn(partWorkersFlag), n)
+	}
+	partSize := int64(defaultChunkSize)
+	if flagIsSet(c, chunkSizeFlag) {
+		sz, err := parseSizeFlag(c, chunkSizeFlag)
+		if err != nil {
+			return 0, err
+		}
+		if sz <= 0 {
+			return 0, incorrectUsageMsg(c, "part size (in %s) must be positive", qflprn(chunkSizeFlag))
+		}
+		partSize = sz
+	}
+	if size < 2*partSize {
+		return 0, nil // small object - single-stream PUT
+	}
+	if bck.Provider != apc.AIS || !bck.Ns.IsGlobal() {
+		mptFallback(c, bck, "multipart upload is supported only for ais:// buckets in the global namespace")
+		return 0, nil
+	}
+	p, err := headBucket(bck, true /*don't add*/)
+	if err != nil {
+		return 0, err
+	}
+	if !p.BackendBck.IsEmpty() {
+		mptFallback(c, bck, "bucket has remote backend "+p.BackendBck.Cname(""))
+		return 0, nil
+	}
+	if nparts := (size + partSize - 1) / partSize; nparts > mptMaxParts {
+		partSize = (size + mptMaxParts - 1) / mptMaxParts
+		if flagIsSet(c, verboseFlag) {
+			actionNote(c, fmt.Sprintf("%d parts exceed the maximum (%d) - using part size %s",
+				nparts, mptMaxParts, cos.ToSizeIEC(partSize, 2)))
+		}
+	}
+	return partSize, nil
+}
+
+func mptFallback(c *cli.Context, bck cmn.Bck, reason string) {
+	if flagIsSet(c, verboseFlag) {
+		actionNote(c, fmt.Sprintf("%s: %s - using single-stream PUT", bck.Cname(""), reason))
+	}
+}
+
+func putMultipart(c *cli.Context, bck cmn.Bck, objName, path string, size, partSize int64) error {
+	fh, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer fh.Close()
+
+	nparts := int((size + partSize - 1) / partSize)
+	m := &mptPut{
+		bck:      bck,
+		objName:  objName,
+		fh:       fh,
+		etags:    make([]string, nparts),
+		retries:  make([]int, nparts),
+		size:     size,
+		partSize: partSize,
+		workers:  parseIntFlag(c, partWorkersFlag),
+	}
+	if m.uploadID, err = api.StartMptS3(apiBP, bck, objName); err != nil {
+		return err
+	}
+
+	// checksum the entire file while uploading its parts
+	cksumCh := make(chan *cos.Cksum, 1)
+	go func() {
+		_, ckhash, err := cos.CopyAndChecksum(io.Discard, io.NewSectionReader(fh, 0, size), nil, cos.ChecksumMD5)
+		if err != nil {
+			m.setErr(err)
+			cksumCh <- nil
+			return
+		}
+		cksumCh <- ckhash.Clone()
+	}()
+
+	wg := cos.NewLimitedWaitGroup(m.workers, nparts)
+	for i := 0; i < nparts && m.getErr() == nil; i++ {
+		wg.Add(1)
+		go m.putPart(c, i, wg)
+	}
+	wg.Wait()
+	cksum := <-cksumCh
+
+	if err = m.getErr(); err == nil {
+		_, err = api.CompleteMptS3(apiBP, bck, objName, m.uploadID, m.etags)
+	}
+	if err != nil {
+		if errA := api.AbortMptS3(apiBP, bck, objName, m.uploadID); errA != nil {
+			actionWarn(c, fmt.Sprintf("failed to abort multipart upload %q: %v", m.uploadID, errA))
+		}
+		return err
+	}
+	m.reportRetries(c)
+	return m.verify(cksum)
+}
+
+func (m *mptPut) putPart(c *cli.Context, i int, wg cos.WG) {
+	defer wg.Done()
+	off := int64(i) * m.partSize
+	args := &api.PutPartArgs{
+		BaseParams: apiBP,
+		Bck:        m.bck,
+		ObjName:    m.objName,
+		UploadID:   m.uploadID,
+		PartNum:    i + 1,
+		Size:       cos.MinI64(m.partSize, m.size-off),
+	}
+	for {
+		args.Reader = cos.NewSectionHandle(m.fh, off, args.Size, 0)
+		etag, err := api.PutPartS3(args)
+		if err == nil {
+			m.etags[i] = etag
+			if flagIsSet(c, verboseFlag) {
+				fmt.Fprintf(c.App.Writer, "part %d/%d (%s) uploaded\n",
+					args.PartNum, len(m.etags), cos.ToSizeIEC(args.Size, 2))
+			}
+			return
+		}
+		if m.getErr() != nil {
+			return // some other part has already failed
+		}
+		if m.retries[i] >= mptMaxPartRetries {
+			m.setErr(fmt.Errorf("failed to upload part %d of %s (giving up after %d retries): %v",
+				args.PartNum, m.bck.Cname(m.objName), m.retries[i], err))
+			return
+		}
+		m.retries[i]++
+	}
+}
+
+func (m *mptPut) setErr(err error) {
+	m.mu.Lock()
+	if m.err == nil {
+		m.err = err
+	}
+	m.mu.Unlock()
+}
+
+func (m *mptPut) getErr() (err error) {
+	m.mu.Lock()
+	err = m.err
+	m.mu.Unlock()
+	return
+}
+
+func (m *mptPut) reportRetries(c *cli.Context) {
+	var parts []string
+	for i, n := range m.retries {
+		if n > 0 {
+			parts = append(parts, fmt.Sprintf("part %d: %d", i+1, n))
+		}
+	}
+	if len(parts) == 0 {
+		return
+	}
+	actionNote(c, fmt.Sprintf("retried %d part%s (%s)", len(parts), cos.Plural(len(parts)), strings.Join(parts, ", ")))
+}
+
+// the resulting object's checksum (computed by the cluster upon completion) must be
+// identical to the MD5 of the source file
+func (m *mptPut) verify(cksum *cos.Cksum) error {
+	props, err := api.HeadObject(apiBP, m.bck, m.objName, apc.FltPresent)
+	if err != nil {
+		return err
+	}
+	if props.Size != m.size {
+		return fmt.Errorf("multipart upload of %s: size mismatch (%d vs %d source)",
+			m.bck.Cname(m.objName), props.Size, m.size)
+	}
+	if objCksum := props.Checksum(); !cksum.Equal(objCksum) {
+		return fmt.Errorf("multipart upload of %s: checksum mismatch (%s vs %s source)",
+			m.bck.Cname(m.objName), objCksum, cksum)
+	}
+	return nil
+}
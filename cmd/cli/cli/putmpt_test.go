@@ -0,0 +1,89 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"flag"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+	"github.com/urfave/cli"
+)
+
+func TestMptPartSize(t *testing.T) {
+	newCtx := func(workers, chunkSize string) *cli.Context {
+		fset := flag.NewFlagSet("test", flag.ContinueOnError)
+		fset.Int(partWorkersFlag.Name, 0, "")
+		fset.String(chunkSizeFlag.Name, "", "")
+		if workers != "" {
+			tassert.CheckFatal(t, fset.Set(partWorkersFlag.Name, workers))
+		}
+		if chunkSize != "" {
+			tassert.CheckFatal(t, fset.Set(chunkSizeFlag.Name, chunkSize))
+		}
+		return newTestContext(fset)
+	}
+	var (
+		ais = cmn.Bck{Name: "b", Provider: apc.AIS}
+		aws = cmn.Bck{Name: "b", Provider: apc.AWS}
+	)
+	tests := []struct {
+		workers, chunkSize string
+		bck                cmn.Bck
+		size, partSize     int64
+		fail               bool
+	}{
+		{"", "", ais, 100 * cos.MiB, 0, false},           // not requested
+		{"0", "", ais, 100 * cos.MiB, 0, true},           // invalid number of workers
+		{"4", "0", ais, 100 * cos.MiB, 0, true},          // invalid part size
+		{"4", "", ais, 2*defaultChunkSize - 1, 0, false}, // smaller than two (default-size) parts
+		{"4", "1MiB", ais, 2*cos.MiB - 1, 0, false},      // ditto
+		{"4", "1MiB", aws, 100 * cos.MiB, 0, false},      // (fallback) not an ais:// bucket
+		{"4", "", cmn.Bck{Name: "b", Provider: apc.AIS, Ns: cmn.Ns{Name: "ns"}}, 100 * cos.MiB, 0, false},
+	}
+	for _, test := range tests {
+		partSize, err := mptPartSize(newCtx(test.workers, test.chunkSize), test.bck, test.size)
+		if test.fail {
+			tassert.Errorf(t, err != nil, "%+v: expected error", test)
+			continue
+		}
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, partSize == test.partSize, "%+v: expected part size %d, got %d", test, test.partSize, partSize)
+	}
+}
+
+func TestMptVerify(t *testing.T) {
+	const md5 = "9e107d9d372bb6826bd81d3542a419d6"
+	mockCluster(t, func(w http.ResponseWriter, r *http.Request) {
+		size, val := 100, md5
+		switch path.Base(r.URL.Path) {
+		case "short":
+			size = 99
+		case "corrupted":
+			val = strings.Repeat("0", len(md5))
+		}
+		w.Header().Set(apc.HdrObjCksumType, cos.ChecksumMD5)
+		w.Header().Set(apc.HdrObjCksumVal, val)
+		w.Header().Set(cos.HdrContentLength, strconv.Itoa(size))
+	})
+
+	cksum := cos.NewCksum(cos.ChecksumMD5, md5)
+	for objName, errSubstr := range map[string]string{"ok": "", "short": "size mismatch", "corrupted": "checksum mismatch"} {
+		m := &mptPut{bck: cmn.Bck{Name: "b", Provider: apc.AIS}, objName: objName, size: 100}
+		err := m.verify(cksum)
+		if errSubstr == "" {
+			tassert.CheckError(t, err)
+			continue
+		}
+		tassert.Errorf(t, err != nil && strings.Contains(err.Error(), errSubstr), "%s: expected %q, got %v", objName, errSubstr, err)
+	}
+}
@@ -7,6 +7,7 @@ package cli
 
 import (
 	"fmt"
+	"math"
 	"net/http"
 	"sort"
 	"strings"
@@ -18,12 +19,15 @@ import (
 	"github.com/NVIDIA/aistore/cluster"
 	"github.com/NVIDIA/aistore/cmd/cli/teb"
 	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/xact"
 	"github.com/urfave/cli"
 )
 
 const (
-	showRebHdr = "REB ID\t NODE\t OBJECTS RECV\t SIZE RECV\t OBJECTS SENT\t SIZE SENT\t START\t END\t STATE"
+	showRebHdr = "REB ID\t NODE\t OBJECTS RECV\t SIZE RECV\t OBJECTS SENT\t SIZE SENT\t START\t END\t STATE\t PROGRESS\t ETA"
+
+	rebEstimating = "estimating..."
 )
 
 type targetRebSnap struct {
@@ -103,6 +107,7 @@ func showRebalanceHandler(c *cli.Context) error {
 		var (
 			numMigratedObjs   int64 // acknowledged migrations
 			sizeMigratedBytes int64
+			cluProgress       = &rebProgress{}
 			prevID            string
 			allSnaps          = make([]*targetRebSnap, 0, 32)
 		)
@@ -136,7 +141,7 @@ func showRebalanceHandler(c *cli.Context) error {
 			for _, sts := range allSnaps {
 				if flagIsSet(c, allJobsFlag) {
 					if prevID != "" && sts.snap.ID != prevID {
-						fmt.Fprintln(tw, strings.Repeat("\t ", 9 /*colCount*/))
+						fmt.Fprintln(tw, strings.Repeat("\t ", 11 /*colCount*/))
 						numMigratedObjs, sizeMigratedBytes = 0, 0
 					}
 					displayRebStats(tw, sts, units)
@@ -147,6 +152,7 @@ func showRebalanceHandler(c *cli.Context) error {
 					latestAborted = latestAborted || sts.snap.AbortedX
 					latestFinished = latestFinished || !sts.snap.EndTime.IsZero()
 					displayRebStats(tw, sts, units)
+					cluProgress.add(sts.snap)
 				}
 				numMigratedObjs += sts.snap.Stats.Objs
 				sizeMigratedBytes += sts.snap.Stats.Bytes
@@ -161,6 +167,15 @@ func showRebalanceHandler(c *cli.Context) error {
 			fmt.Fprintf(c.App.Writer, "%s: %d objects migrated (total size %s)\n",
 				id, numMigratedObjs, teb.FmtSize(sizeMigratedBytes, units, 1))
 		}
+		if !flagIsSet(c, allJobsFlag) && !latestFinished && prevID != "" {
+			switch pct, eta := cluProgress.get(); pct {
+			case teb.NotSetVal:
+			case rebEstimating:
+				fmt.Fprintf(c.App.Writer, "%s: progress %s\n", id, rebEstimating)
+			default:
+				fmt.Fprintf(c.App.Writer, "%s: %s complete, ETA %s\n", id, pct, eta)
+			}
+		}
 		if !flagIsSet(c, allJobsFlag) {
 			if latestFinished && latestAborted {
 				fmt.Fprintf(c.App.Writer, "\nRebalance %s aborted.\n", id)
@@ -187,12 +202,72 @@ func showRebalanceHandler(c *cli.Context) error {
 }
 
 func displayRebStats(tw *tabwriter.Writer, st *targetRebSnap, units string) {
-	startTime, endTime := teb.FmtStartEnd(st.snap.StartTime, st.snap.EndTime)
+	var (
+		startTime, endTime = teb.FmtStartEnd(st.snap.StartTime, st.snap.EndTime)
+		progress           = &rebProgress{}
+	)
+	progress.add(st.snap)
+	pct, eta := progress.get()
 	fmt.Fprintf(tw,
-		"%s\t %s\t %d\t %s\t %d\t %s\t %s\t %s\t %s\n",
+		"%s\t %s\t %d\t %s\t %d\t %s\t %s\t %s\t %s\t %s\t %s\n",
 		st.snap.ID, st.tid,
 		st.snap.Stats.InObjs, teb.FmtSize(st.snap.Stats.InBytes, units, 2),
 		st.snap.Stats.OutObjs, teb.FmtSize(st.snap.Stats.OutBytes, units, 2),
-		startTime, endTime, teb.FmtXactStatus(st.snap),
+		startTime, endTime, teb.FmtXactStatus(st.snap), pct, eta,
 	)
 }
+
+// percent-complete and ETA, per target or cluster-wide (see xact.ExtRebStats)
+type rebProgress struct {
+	started     time.Time
+	sent, total int64
+	nothing     int // targets with no (progress) stats
+	estimating  int
+	finished    int
+	cnt         int
+}
+
+func (p *rebProgress) add(snap *cluster.Snap) {
+	p.cnt++
+	if p.started.IsZero() || snap.StartTime.Before(p.started) {
+		p.started = snap.StartTime
+	}
+	if !snap.EndTime.IsZero() {
+		p.finished++
+		return
+	}
+	ext := &xact.ExtRebStats{}
+	if snap.Ext == nil || cos.MorphMarshal(snap.Ext, ext) != nil {
+		p.nothing++
+		return
+	}
+	if ext.Estimating {
+		p.estimating++
+		return
+	}
+	p.sent += snap.Stats.OutBytes
+	// (nothing to send when the total is zero)
+	p.total += cos.MaxI64(ext.EstTotalBytes, snap.Stats.OutBytes)
+}
+
+func (p *rebProgress) get() (pct, eta string) {
+	switch {
+	case p.finished == p.cnt:
+		return "100%", teb.NotSetVal
+	case p.nothing > 0:
+		return teb.NotSetVal, teb.NotSetVal
+	case p.estimating > 0:
+		return rebEstimating, rebEstimating
+	case p.total == 0:
+		return "0%", teb.NotSetVal
+	}
+	// (keep it under 100% while still running)
+	ratio := math.Min(float64(p.sent)/float64(p.total), 0.99)
+	pct = fmt.Sprintf("%d%%", int(ratio*100))
+	if ratio == 0 {
+		return pct, rebEstimating
+	}
+	elapsed := time.Since(p.started)
+	remaining := time.Duration(float64(elapsed) * (1 - ratio) / ratio)
+	return pct, remaining.Round(time.Second).String()
+}
@@ -7,8 +7,11 @@ package cli
 
 import (
 	"fmt"
+	"sort"
 
+	"github.com/NVIDIA/aistore/api"
 	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/urfave/cli"
 )
 
@@ -16,10 +19,13 @@ var (
 	storageSvcCmdsFlags = map[string][]cli.Flag{
 		commandMirror: {
 			copiesFlag,
+			spreadFlag,
 		},
 		commandECEncode: {
 			dataSlicesFlag,
 			paritySlicesFlag,
+			forceFlag,
+			refreshFlag,
 		},
 	}
 
@@ -66,9 +72,62 @@ func setCopiesHandler(c *cli.Context) (err error) {
 			return
 		}
 	}
+	if flagIsSet(c, spreadFlag) && copies > 1 {
+		if err = mirrorSpread(c, copies); err != nil {
+			return
+		}
+	}
 	return configureNCopies(c, bck, copies)
 }
 
+// n-way mirror is intra-target: all copies of a given object reside on its (HRW) target,
+// each on a different mountpath; therefore, the achievable spread is determined by the number
+// of available mountpaths of each target (and applies to all objects stored on it)
+func mirrorSpread(c *cli.Context, copies int) error {
+	switch spread := parseStrFlag(c, spreadFlag); spread {
+	case "mountpaths", "mpaths":
+	case "targets", "racks", "nodes":
+		return fmt.Errorf("cannot spread replicas across %s: n-way mirror keeps all copies of a given object "+
+			"on the same target (on distinct mountpaths)\n(hint: for cross-target redundancy, use '%s %s')",
+			spread, cliName, commandECEncode)
+	default:
+		return incorrectUsageMsg(c, "invalid %s value %q (expecting \"mountpaths\")", qflprn(spreadFlag), spread)
+	}
+	smap, err := getClusterMap(c)
+	if err != nil {
+		return err
+	}
+	var (
+		tids  = make([]string, 0, len(smap.Tmap))
+		short int
+	)
+	for tid, tsi := range smap.Tmap {
+		if !smap.InMaintOrDecomm(tsi) {
+			tids = append(tids, tid)
+		}
+	}
+	sort.Strings(tids)
+	for _, tid := range tids {
+		mpl, err := api.GetMountpaths(apiBP, smap.GetTarget(tid))
+		if err != nil {
+			return err
+		}
+		n := len(mpl.Available)
+		if n >= copies {
+			fmt.Fprintf(c.App.Writer, "%s: %d mountpaths - %d distinct copies\n", tid, n, copies)
+		} else {
+			fmt.Fprintf(c.App.Writer, "%s: %d mountpath%s - only %d distinct replica%s\n", tid, n, cos.Plural(n), n, cos.Plural(n))
+			short++
+		}
+	}
+	if short > 0 {
+		warn := fmt.Sprintf("%d target%s cannot accommodate %d distinct copies (objects stored there will have fewer replicas)\n",
+			short, cos.Plural(short), copies)
+		actionWarn(c, warn)
+	}
+	return nil
+}
+
 func ecEncodeHandler(c *cli.Context) (err error) {
 	var (
 		bck cmn.Bck
@@ -90,5 +149,5 @@ func ecEncodeHandler(c *cli.Context) (err error) {
 		return
 	}
 
-	return ecEncode(c, bck, dataSlices, paritySlices)
+	return ecEncode(c, bck, p, dataSlices, paritySlices)
 }
@@ -19,6 +19,7 @@ import (
 var (
 	searchCmdFlags = []cli.Flag{
 		regexFlag,
+		searchLimitFlag,
 	}
 
 	searchCommands []cli.Command
@@ -43,7 +44,7 @@ var (
 		commandStorage:  {"disk", "mountpath", "capacity", "used", "available"},
 		commandBucket:   {"dir", "directory", "container"},
 		commandJob:      {"batch", "async"},
-		commandArch:     {"serialize", "format", "reformat", "compress", "tar", "zip", "gzip"},
+		commandArch:     {"serialize", "format", "reformat", "compress", "tar", "zip", "gzip", "extract", "unpack", "untar", "unzip"},
 		cmdAuthAdd:      {"register", "create"},
 		cmdStgCleanup:   {"remove", "delete", "evict"},
 		cmdDownload:     {"load", "populate", "copy", "cp"},
@@ -95,17 +96,101 @@ func findCmdByKey(key string) (result cos.StrSet) {
 	return
 }
 
+// rank all commands that match all `keys` exactly (or via synonyms) or approximately;
+// exact matches go first, followed by fuzzy ones - all ordered by total match cost
+// (see matchKey) and then alphabetically
 // (compare w/ findCmdMultiKeyAlt)
 func findCmdMultiKey(keys []string) []string {
-	resultSet := findCmdByKey(keys[0])
-	for _, key := range keys[1:] {
-		cmds := findCmdByKey(key)
-		resultSet = resultSet.Intersection(cmds)
+	type ranked struct {
+		cmd  string
+		cost int
 	}
+	var (
+		matches = make([]map[string]int, len(keys)) // per key: actual keyword => cost
+		result  []ranked
+	)
+	for i, key := range keys {
+		matches[i] = matchKey(key)
+	}
+outer:
+	for _, cmd := range cmdStrs {
+		var (
+			words = strings.Split(cmd, " ")
+			total int
+		)
+		for _, m := range matches {
+			best := -1
+			for _, word := range words {
+				if cost, ok := m[word]; ok && (best < 0 || cost < best) {
+					best = cost
+				}
+			}
+			if best < 0 {
+				continue outer
+			}
+			total += best
+		}
+		result = append(result, ranked{cmd, total})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].cost != result[j].cost {
+			return result[i].cost < result[j].cost
+		}
+		return result[i].cmd < result[j].cmd
+	})
+	cmds := make([]string, 0, len(result))
+	for i := range result {
+		cmds = append(cmds, result[i].cmd)
+	}
+	return cmds
+}
 
-	result := resultSet.ToSlice()
-	sort.Strings(result)
-	return result
+// all command keywords that match a given key (directly or via synonyms), mapped to
+// their respective costs: twice the fuzzy cost plus one for matching via synonym
+// (so that exact matches, synonyms included, always rank above fuzzy ones)
+func matchKey(key string) map[string]int {
+	res := make(map[string]int, 4)
+	for kw, words := range keywordMap {
+		cost, ok := fuzzyCost(key, kw)
+		if !ok {
+			continue
+		}
+		for _, word := range words {
+			wcost := cost * 2
+			if word != kw {
+				wcost++
+			}
+			if c, ok := res[word]; !ok || wcost < c {
+				res[word] = wcost
+			}
+		}
+	}
+	return res
+}
+
+// match cost: zero when exact, otherwise Damerau-Levenshtein distance within
+// a threshold that depends on the key's length; a prefix (of at least 3 letters)
+// costs 1 - e.g., "creat" and "buck" match "create" and "bucket", respectively
+func fuzzyCost(key, kw string) (int, bool) {
+	if key == kw {
+		return 0, true
+	}
+	if len(key) >= 3 && strings.HasPrefix(kw, key) {
+		return 1, true
+	}
+	var maxDist int
+	switch {
+	case len(key) < 4:
+		return 0, false
+	case len(key) < 7:
+		maxDist = 1
+	default:
+		maxDist = 2
+	}
+	if d := cos.DamerauLevenstheinDistance(key, kw); d <= maxDist {
+		return d, true
+	}
+	return 0, false
 }
 
 func findCmdMultiKeyAlt(keys ...string) []string {
@@ -162,7 +247,9 @@ func searchCmdHdlr(c *cli.Context) error {
 		}
 		commands = findCmdMultiKey(c.Args())
 	}
-
+	if limit := parseIntFlag(c, searchLimitFlag); limit > 0 && len(commands) > limit {
+		commands = commands[:limit]
+	}
 	return teb.Print(commands, teb.SearchTmpl)
 }
 
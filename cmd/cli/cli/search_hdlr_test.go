@@ -0,0 +1,33 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"testing"
+
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestSearchFuzzyCost(t *testing.T) {
+	tests := []struct {
+		key, kw string
+		cost    int
+		ok      bool
+	}{
+		{"create", "create", 0, true},
+		{"creat", "create", 1, true},
+		{"buck", "bucket", 1, true},
+		{"mountpth", "mountpath", 1, true},
+		{"bukcet", "bucket", 1, true},
+		{"ls", "lru", 0, false},
+		{"cp", "copy", 0, false},
+		{"object", "bucket", 0, false},
+	}
+	for _, test := range tests {
+		cost, ok := fuzzyCost(test.key, test.kw)
+		tassert.Errorf(t, ok == test.ok && (!ok || cost == test.cost), "%q vs %q: expected (%d, %t), got (%d, %t)",
+			test.key, test.kw, test.cost, test.ok, cost, ok)
+	}
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/ext/dsort"
 	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/ios"
 	"github.com/NVIDIA/aistore/xact"
 	"github.com/urfave/cli"
 )
@@ -38,6 +39,8 @@ type (
 		DaemonID  string
 		Mpl       *apc.MountpathList
 		TargetCDF fs.TargetCDF
+		IOstats   map[string]*ios.DiskStats `json:"iostats"` // mountpath => aggregated stats of its disks
+		IOsummary map[string]string         `json:"-"`       // ditto, formatted
 	}
 )
 
@@ -48,6 +51,11 @@ var (
 			jsonFlag,
 			allJobsFlag,
 			regexJobsFlag,
+			jobsSinceFlag,
+			jobsUntilFlag,
+			jobsTreeFlag,
+			jobsBckFlag,
+			activeJobsFlag,
 			noHeaderFlag,
 			verboseFlag,
 			unitsFlag,
@@ -59,17 +67,24 @@ var (
 			objPropsFlag, // --props [list]
 			allPropsFlag,
 			objNotCachedPropsFlag,
+			objVersionsFlag,
 			noHeaderFlag,
 			jsonFlag,
+			formatTemplateFlag,
 		},
 		cmdCluster: append(
 			longRunFlags,
 			jsonFlag,
 			noHeaderFlag,
+			metasyncLagFlag,
+			nodeVersionsFlag,
 		),
 		cmdSmap: append(
 			longRunFlags,
 			jsonFlag,
+			smapHistoryFlag,
+			smapVersionFlag,
+			noHeaderFlag,
 		),
 		cmdBMD: {
 			jsonFlag,
@@ -80,17 +95,24 @@ var (
 		},
 		cmdConfig: {
 			jsonFlag,
+			unitsFlag,
+			showSecretsFlag,
 		},
-		cmdShowRemoteAIS: {
+		cmdShowRemoteAIS: append(
+			longRunFlags,
 			noHeaderFlag,
 			verboseFlag,
 			jsonFlag,
-		},
+		),
 		cmdLog: append(
 			longRunFlags,
 			logSevFlag,
 			logFlushFlag,
 		),
+		cmdLog + ".cluster": {
+			logSevFlag,
+			logFollowFlag,
+		},
 	}
 
 	showCmd = cli.Command{
@@ -163,6 +185,14 @@ var (
 				Flags:     showCmdsFlags[cmdConfig],
 				Action:    showClusterConfigHandler,
 			},
+			{
+				Name:         cmdLog,
+				Usage:        "show log of a given node or, with '--follow', tail logs of all nodes (or the specified node)",
+				ArgsUsage:    optionalNodeIDArgument,
+				Flags:        showCmdsFlags[cmdLog+".cluster"],
+				Action:       showClusterLogHandler,
+				BashComplete: suggestAllNodes,
+			},
 			makeAlias(showCmdPeformance, cliName+" "+commandShow+" "+commandPerf, false /*silent*/, cmdShowStats),
 		},
 	}
@@ -217,16 +247,41 @@ func showJobsHandler(c *cli.Context) error {
 	if err != nil {
 		return err
 	}
+	if flagIsSet(c, activeJobsFlag) && flagIsSet(c, allJobsFlag) {
+		return fmt.Errorf(errFmtExclusive, qflprn(activeJobsFlag), qflprn(allJobsFlag))
+	}
+	if flagIsSet(c, jobsBckFlag) {
+		if !bck.IsEmpty() {
+			return incorrectUsageMsg(c, "bucket specified twice: %s and %s", bck.Cname(""), qflprn(jobsBckFlag))
+		}
+		if bck, err = parseBckURI(c, parseStrFlag(c, jobsBckFlag), true /*require provider*/); err != nil {
+			return err
+		}
+	}
 
 	if name == cmdRebalance {
 		return showRebalanceHandler(c)
 	}
+	if _, err := parseJobsWindow(c); err != nil {
+		return err
+	}
 
 	setLongRunParams(c, 72)
 
+	if flagIsSet(c, jobsTreeFlag) {
+		return showJobTree(c, name, xid, daemonID, bck)
+	}
+	if flagIsSet(c, jsonFlag) {
+		return showJobsJSON(c, name, xid, daemonID, bck)
+	}
+
 	var l int
-	l, err = showJobsDo(c, name, xid, daemonID, bck)
-	if err == nil && l == 0 && !flagIsSet(c, allJobsFlag) {
+	if name == "" && xid == "" && !bck.IsEmpty() {
+		l, err = showBckJobs(c, bck, daemonID)
+	} else {
+		l, err = showJobsDo(c, name, xid, daemonID, bck)
+	}
+	if err == nil && l == 0 && !showAllJobs(c) {
 		n, h := qflprn(allJobsFlag), qflprn(cli.HelpFlag)
 		fmt.Fprintf(c.App.Writer, "No running jobs. "+
 			"Use %s to show all, %s <TAB-TAB> to select, %s for details.\n", n, n, h)
@@ -234,6 +289,56 @@ func showJobsHandler(c *cli.Context) error {
 	return err
 }
 
+// `--json`: exactly one (versioned) document - see `xactsJSON`;
+// downloads and dsorts are excluded unless explicitly named (in which case they have their own respective formats)
+func showJobsJSON(c *cli.Context, name, xid, daemonID string, bck cmn.Bck) error {
+	if name == "" && xid != "" {
+		name, _ = xid2Name(xid)
+	}
+	switch name {
+	case cmdDownload, cmdDsort, commandETL:
+		l, err := showJobsDo(c, name, xid, daemonID, bck)
+		if err == nil && l == 0 {
+			fmt.Fprintln(c.App.Writer, "[]")
+		}
+		return err
+	}
+	var (
+		xactKind, _ = xact.GetKindName(name)
+		xargs       = xact.ArgsMsg{
+			ID:          xid,
+			Kind:        xactKind,
+			DaemonID:    daemonID,
+			Bck:         bck,
+			OnlyRunning: !showAllJobs(c) && !xact.IsValidUUID(xid),
+		}
+	)
+	xs, err := queryXactions(xargs)
+	if err != nil {
+		return err
+	}
+	if xid == "" {
+		filterXactsWindow(c, xs)
+	}
+	if regexStr := parseStrFlag(c, regexJobsFlag); regexStr != "" {
+		regex, err := regexp.Compile(regexStr)
+		if err != nil {
+			return err
+		}
+		for tid, snaps := range xs {
+			filtered := snaps[:0]
+			for _, snap := range snaps {
+				if _, xname := xact.GetKindName(snap.Kind); regex.MatchString(snap.Kind) || regex.MatchString(xname) {
+					filtered = append(filtered, snap)
+				}
+			}
+			xs[tid] = filtered
+		}
+	}
+	_, err = printXactsJSON(xs, daemonID)
+	return err
+}
+
 func showJobsDo(c *cli.Context, name, xid, daemonID string, bck cmn.Bck) (int, error) {
 	if name == "" && xid != "" {
 		name, _ = xid2Name(xid)
@@ -273,6 +378,75 @@ func showJobsDo(c *cli.Context, name, xid, daemonID string, bck cmn.Bck) (int, e
 	return ll, err
 }
 
+// all kinds of jobs (xactions) that have `bck` as their bucket, source, or destination - in one table
+func showBckJobs(c *cli.Context, bck cmn.Bck, daemonID string) (int, error) {
+	var (
+		onlyActive = !showAllJobs(c)
+		xargs      = xact.ArgsMsg{DaemonID: daemonID, Bck: bck, OnlyRunning: onlyActive}
+	)
+	xs, err := queryXactions(xargs)
+	if err != nil {
+		return 0, err
+	}
+	filterXactsWindow(c, xs)
+
+	var regex *regexp.Regexp
+	if regexStr := parseStrFlag(c, regexJobsFlag); regexStr != "" {
+		if regex, err = regexp.Compile(regexStr); err != nil {
+			actionWarn(c, err.Error())
+			regex = nil
+		}
+	}
+	var (
+		numSnaps int
+		dts      = make([]daemonTemplateXactSnaps, 0, len(xs))
+	)
+	for tid, snaps := range xs {
+		if daemonID != "" && daemonID != tid {
+			delete(xs, tid)
+			continue
+		}
+		filtered := snaps[:0]
+		for _, snap := range snaps {
+			if regex != nil {
+				_, xname := xact.GetKindName(snap.Kind)
+				if !regex.MatchString(snap.Kind) && !regex.MatchString(xname) {
+					continue
+				}
+			}
+			filtered = append(filtered, snap)
+		}
+		if len(filtered) == 0 {
+			delete(xs, tid)
+			continue
+		}
+		sort.Slice(filtered, func(i, j int) bool { return filtered[i].StartTime.Before(filtered[j].StartTime) })
+		xs[tid] = filtered
+		numSnaps += len(filtered)
+		dts = append(dts, daemonTemplateXactSnaps{DaemonID: tid, XactSnaps: filtered})
+	}
+	if numSnaps == 0 {
+		return 0, nil
+	}
+	sort.Slice(dts, func(i, j int) bool { return dts[i].DaemonID < dts[j].DaemonID })
+
+	if onlyActive {
+		actionCptn(c, bck.Cname(""), " jobs:")
+	} else {
+		actionCptn(c, bck.Cname(""), " jobs (including finished):")
+	}
+	units, errU := parseUnitsFlag(c, unitsFlag)
+	if errU != nil {
+		actionWarn(c, errU.Error())
+		units = ""
+	}
+	tmpl := teb.XactAnyBucketTmpl
+	if flagIsSet(c, noHeaderFlag) {
+		tmpl = teb.XactNoHdrAnyBucketTmpl
+	}
+	return numSnaps, teb.Print(dts, tmpl, teb.Opts{AltMap: teb.FuncMapUnits(units)})
+}
+
 func jobCptn(c *cli.Context, name string, onlyActive bool, xid string, byTarget bool) {
 	if xid != "" {
 		actionCptn(c, jobName(name, xid), "")
@@ -301,7 +475,7 @@ func _showJobs(c *cli.Context, name, xid, daemonID string, bck cmn.Bck, caption
 	default:
 		var (
 			// finished or not, always try to show when xid provided
-			all         = flagIsSet(c, allJobsFlag) || xact.IsValidUUID(xid)
+			all         = showAllJobs(c) || xact.IsValidUUID(xid)
 			onlyActive  = !all
 			xactKind, _ = xact.GetKindName(name)
 			regexStr    = parseStrFlag(c, regexJobsFlag)
@@ -338,13 +512,25 @@ func showDownloads(c *cli.Context, id string, caption bool) (int, error) {
 func showDsorts(c *cli.Context, id string, caption bool) (int, error) {
 	var (
 		usejs      = flagIsSet(c, jsonFlag)
-		onlyActive = !flagIsSet(c, allJobsFlag)
+		onlyActive = !showAllJobs(c)
 	)
 	if id == "" {
 		list, err := api.ListDSort(apiBP, parseStrFlag(c, regexJobsFlag), onlyActive)
+		if err != nil {
+			return 0, err
+		}
+		if tw, _ := parseJobsWindow(c); tw != nil {
+			filtered := list[:0]
+			for _, j := range list {
+				if tw.overlaps(j.StartedTime, j.FinishTime) {
+					filtered = append(filtered, j)
+				}
+			}
+			list = filtered
+		}
 		l := len(list)
-		if err != nil || l == 0 {
-			return l, err
+		if l == 0 {
+			return 0, nil
 		}
 		if caption {
 			jobCptn(c, cmdDsort, onlyActive, id, false)
@@ -382,6 +568,11 @@ func showClusterHandler(c *cli.Context) (err error) {
 
 	setLongRunParams(c)
 
+	if flagIsSet(c, metasyncLagFlag) {
+		teb.MetasyncLagThreshold = int64(parseIntFlag(c, metasyncLagFlag))
+	}
+	teb.ShowVersions = flagIsSet(c, nodeVersionsFlag)
+
 	smap, tstatusMap, pstatusMap, err := fillNodeStatusMap(c, daeType)
 	if err != nil {
 		return err
@@ -407,6 +598,9 @@ func xactList(c *cli.Context, xargs xact.ArgsMsg, caption bool) (int, error) {
 	if err != nil {
 		return 0, err
 	}
+	if xargs.ID == "" {
+		filterXactsWindow(c, xs)
+	}
 	var numSnaps int
 	for _, snaps := range xs {
 		numSnaps += len(snaps)
@@ -415,6 +609,10 @@ func xactList(c *cli.Context, xargs xact.ArgsMsg, caption bool) (int, error) {
 		return 0, nil
 	}
 
+	if flagIsSet(c, jsonFlag) {
+		return printXactsJSON(xs, xargs.DaemonID)
+	}
+
 	var (
 		ll           int
 		allXactKinds = extractXactKinds(xs)
@@ -549,9 +747,16 @@ func showObjectHandler(c *cli.Context) (err error) {
 	if err != nil {
 		return err
 	}
-	if _, err := headBucket(bck, true /* don't add */); err != nil {
+	p, err := headBucket(bck, true /* don't add */)
+	if err != nil {
 		return err
 	}
+	if flagIsSet(c, objVersionsFlag) {
+		if flagIsSet(c, formatTemplateFlag) {
+			return incorrectUsageMsg(c, errFmtExclusive, qflprn(objVersionsFlag), qflprn(formatTemplateFlag))
+		}
+		return showObjVersions(c, bck, p, object)
+	}
 	return showObjProps(c, bck, object)
 }
 
@@ -574,6 +779,12 @@ func showSmapHandler(c *cli.Context) error {
 	if err != nil {
 		return err
 	}
+	if flagIsSet(c, smapHistoryFlag) || flagIsSet(c, smapVersionFlag) {
+		if sid == "" {
+			sid, sname = smap.Primary.ID(), smap.Primary.StringEx()
+		}
+		return smapHistory(c, sid, sname)
+	}
 	if sid != "" {
 		actionCptn(c, "Cluster map from: ", sname)
 	}
@@ -602,6 +813,10 @@ func showConfigHandler(c *cli.Context) (err error) {
 }
 
 func showClusterConfig(c *cli.Context, section string) error {
+	units, errU := parseUnitsFlag(c, unitsFlag)
+	if errU != nil {
+		return errU
+	}
 	var (
 		usejs          = flagIsSet(c, jsonFlag)
 		cluConfig, err = api.GetClusterConfig(apiBP)
@@ -609,6 +824,9 @@ func showClusterConfig(c *cli.Context, section string) error {
 	if err != nil {
 		return err
 	}
+	if !showSecrets(c) {
+		redactConfig(cluConfig)
+	}
 
 	if usejs && section != "" {
 		if printSectionJSON(c, cluConfig, section) {
@@ -620,7 +838,7 @@ func showClusterConfig(c *cli.Context, section string) error {
 	if usejs {
 		return teb.Print(cluConfig, "", teb.Jopts(usejs))
 	}
-	flat := flattenConfig(cluConfig, section)
+	flat := flattenConfig(cluConfig, section, units)
 	err = teb.Print(flat, teb.ConfigTmpl)
 	if err == nil && section == "" {
 		msg := fmt.Sprintf("(Hint: use '[SECTION] %s' to show config section(s), see %s for details)",
@@ -640,6 +858,10 @@ func showNodeConfig(c *cli.Context) error {
 	if c.NArg() == 0 {
 		return missingArgumentsError(c, c.Command.ArgsUsage)
 	}
+	units, errU := parseUnitsFlag(c, unitsFlag)
+	if errU != nil {
+		return errU
+	}
 
 	sid, sname, err := argNode(c)
 	if err != nil {
@@ -654,6 +876,10 @@ func showNodeConfig(c *cli.Context) error {
 	if err != nil {
 		return err
 	}
+	reveal := showSecrets(c)
+	if !reveal {
+		redactConfig(config)
+	}
 
 	data := struct {
 		ClusterConfigDiff []propDiff
@@ -720,18 +946,21 @@ func showNodeConfig(c *cli.Context) error {
 	// fill-in `data`
 	switch scope {
 	case cfgScopeLocal:
-		data.LocalConfigPairs = flattenConfig(config.LocalConfig, section)
+		data.LocalConfigPairs = flattenConfig(config.LocalConfig, section, units)
 	default: // cfgScopeInherited | cfgScopeAll
 		cluConf, err := api.GetClusterConfig(apiBP)
 		if err != nil {
 			return err
 		}
+		if !reveal {
+			redactConfig(cluConf)
+		}
 		// diff cluster <=> this node
-		flatNode := flattenConfig(config.ClusterConfig, section)
-		flatCluster := flattenConfig(cluConf, section)
+		flatNode := flattenConfig(config.ClusterConfig, section, units)
+		flatCluster := flattenConfig(cluConf, section, units)
 		data.ClusterConfigDiff = diffConfigs(flatNode, flatCluster)
 		if scope == cfgScopeAll {
-			data.LocalConfigPairs = flattenConfig(config.LocalConfig, section)
+			data.LocalConfigPairs = flattenConfig(config.LocalConfig, section, units)
 		}
 	}
 	// show "flat" diff-s
@@ -764,14 +993,9 @@ func showNodeLogHandler(c *cli.Context) error {
 
 	firstIteration := setLongRunParams(c, 0)
 
-	sev := strings.ToLower(parseStrFlag(c, logSevFlag))
-	if sev != "" {
-		switch sev[0] {
-		case apc.LogInfo[0], apc.LogWarn[0], apc.LogErr[0]:
-		default:
-			return fmt.Errorf("invalid log severity, expecting empty string or one of: %s, %s, %s",
-				apc.LogInfo, apc.LogWarn, apc.LogErr)
-		}
+	sev, err := parseLogSev(c)
+	if err != nil {
+		return err
 	}
 	if firstIteration && flagIsSet(c, logFlushFlag) {
 		var (
@@ -804,14 +1028,19 @@ func showNodeLogHandler(c *cli.Context) error {
 }
 
 func showRemoteAISHandler(c *cli.Context) error {
+	setLongRunParams(c)
+
 	all, err := api.GetRemoteAIS(apiBP)
 	if err != nil {
 		return err
 	}
-	tw := &tabwriter.Writer{}
+	var (
+		now = time.Now()
+		tw  = &tabwriter.Writer{}
+	)
 	tw.Init(c.App.Writer, 0, 8, 2, ' ', 0)
 	if !flagIsSet(c, noHeaderFlag) {
-		fmt.Fprintln(tw, "UUID\tURL\tAlias\tPrimary\tSmap\tTargets\tUptime")
+		fmt.Fprintln(tw, "UUID\tURL\tAlias\tPrimary\tSmap\tTargets\tOnline\tLast Contact\tUptime")
 	}
 	for _, ra := range all.A {
 		uptime := teb.UnknownStatusVal
@@ -825,17 +1054,25 @@ func showRemoteAISHandler(c *cli.Context) error {
 			ns, _ := strconv.ParseInt(clutime, 10, 64)
 			uptime = time.Duration(ns).String()
 		}
+		online, lastContact := "no", fmtLastContact(ra.LastContact, now)
+		if ra.Online {
+			online = "yes"
+		}
 		if ra.Smap != nil {
-			fmt.Fprintf(tw, "%s\t%s\t%s\t%s\tv%d\t%d\t%s\n",
-				ra.UUID, ra.URL, ra.Alias, ra.Smap.Primary, ra.Smap.Version, ra.Smap.CountTargets(), uptime)
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%s\tv%d\t%d\t%s\t%s\t%s\n",
+				ra.UUID, ra.URL, ra.Alias, ra.Smap.Primary, ra.Smap.Version, ra.Smap.CountTargets(),
+				online, lastContact, uptime)
+			if !ra.Online {
+				actionWarn(c, fmt.Sprintf(warnRemAisOffline, ra.Alias+"["+ra.UUID+"]")+"\n")
+			}
 		} else {
 			url := ra.URL
 			if len(url) > 0 && url[0] == '[' && !strings.Contains(url, " ") {
 				url = strings.Replace(url, "[", "", 1)
 				url = strings.Replace(url, "]", "", 1)
 			}
-			fmt.Fprintf(tw, "<%s>\t%s\t%s\t%s\t%s\t%s\t%s\n", ra.UUID, url, ra.Alias,
-				teb.UnknownStatusVal, teb.UnknownStatusVal, teb.UnknownStatusVal, uptime)
+			fmt.Fprintf(tw, "<%s>\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n", ra.UUID, url, ra.Alias,
+				teb.UnknownStatusVal, teb.UnknownStatusVal, teb.UnknownStatusVal, online, lastContact, uptime)
 
 			warn := fmt.Sprintf(warnRemAisOffline, url)
 			actionWarn(c, warn+"\n")
@@ -860,3 +1097,16 @@ func showRemoteAISHandler(c *cli.Context) error {
 	}
 	return nil
 }
+
+// last successful contact with a remote cluster (as per the cluster's own periodic or on-demand probing)
+func fmtLastContact(unixnano int64, now time.Time) string {
+	if unixnano == 0 {
+		return teb.UnknownStatusVal
+	}
+	t := time.Unix(0, unixnano)
+	ago := now.Sub(t).Round(time.Second)
+	if ago < 0 {
+		ago = 0
+	}
+	return t.Format(time.Stamp) + " (" + ago.String() + " ago)"
+}
@@ -0,0 +1,21 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmd/cli/teb"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestFmtLastContact(t *testing.T) {
+	now := time.Now()
+	tassert.Errorf(t, fmtLastContact(0, now) == teb.UnknownStatusVal, "expected unknown")
+	s := fmtLastContact(now.Add(-90*time.Second).UnixNano(), now)
+	tassert.Errorf(t, strings.HasSuffix(s, " (1m30s ago)"), "unexpected %q", s)
+}
@@ -9,6 +9,8 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"text/tabwriter"
+	"time"
 
 	"github.com/NVIDIA/aistore/api"
 	"github.com/NVIDIA/aistore/api/env"
@@ -118,3 +120,54 @@ func smapFromNode(primarySmap *cluster.Smap, sid string, usejs bool) error {
 	}
 	return teb.Print(body, teb.SmapTmpl, teb.Jopts(usejs))
 }
+
+// `ais show cluster smap [NODE] --history | --smap-version N`
+func smapHistory(c *cli.Context, sid, sname string) error {
+	if flagIsSet(c, smapVersionFlag) {
+		if flagIsSet(c, smapHistoryFlag) {
+			return incorrectUsageMsg(c, errFmtExclusive, qflprn(smapHistoryFlag), qflprn(smapVersionFlag))
+		}
+		ver := int64(parseIntFlag(c, smapVersionFlag))
+		smap, err := api.GetRetainedSmap(apiBP, sid, ver)
+		if err != nil {
+			return err
+		}
+		actionCptn(c, "Cluster map retained by: ", sname)
+		return teb.Print(teb.SmapHelper{Smap: smap}, teb.SmapTmpl, teb.Jopts(flagIsSet(c, jsonFlag)))
+	}
+	hist, err := api.GetSmapHistory(apiBP, sid)
+	if err != nil {
+		return err
+	}
+	if flagIsSet(c, jsonFlag) {
+		return teb.Print(hist, "", teb.Jopts(true))
+	}
+	if len(hist) == 0 {
+		fmt.Fprintf(c.App.Writer, "%s: no Smap history\n", sname)
+		return nil
+	}
+	actionCptn(c, "Smap history from: ", sname)
+	tw := &tabwriter.Writer{}
+	tw.Init(c.App.Writer, 0, 8, 2, ' ', 0)
+	if !flagIsSet(c, noHeaderFlag) {
+		fmt.Fprintln(tw, "VERSION\tTIME\tCAUSE\tRETAINED\tCHANGES")
+	}
+	// newest first
+	for i := len(hist) - 1; i >= 0; i-- {
+		e := &hist[i]
+		changes := "-"
+		if len(e.Diff) > 0 {
+			changes = strings.Join(e.Diff, "; ")
+		}
+		cause := e.Cause
+		if cause == "" {
+			cause = "-"
+		}
+		retained := "no"
+		if e.Retained {
+			retained = "yes"
+		}
+		fmt.Fprintf(tw, "v%d\t%s\t%s\t%s\t%s\n", e.Version, time.Unix(0, e.Time).Format(time.Stamp), cause, retained, changes)
+	}
+	return tw.Flush()
+}
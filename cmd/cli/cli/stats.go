@@ -158,6 +158,8 @@ func _status(node *cluster.Snode, mu *sync.Mutex, out teb.StstMap) {
 			daeStatus.Status = apc.NodeMaintenance
 		case node.Flags.IsSet(cluster.NodeFlagDecomm):
 			daeStatus.Status = apc.NodeDecommission
+		case node.Flags.IsSet(cluster.NodeFlagProbation):
+			daeStatus.Status = apc.NodeProbation
 		}
 	}
 
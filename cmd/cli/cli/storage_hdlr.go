@@ -7,6 +7,8 @@ package cli
 
 import (
 	"fmt"
+	"math"
+	"path/filepath"
 	"regexp"
 	"sort"
 	"time"
@@ -17,6 +19,9 @@ import (
 	"github.com/NVIDIA/aistore/cmd/cli/teb"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/ios"
+	"github.com/NVIDIA/aistore/space"
+	"github.com/NVIDIA/aistore/stats"
 	"github.com/NVIDIA/aistore/sys"
 	"github.com/NVIDIA/aistore/xact"
 	"github.com/urfave/cli"
@@ -33,14 +38,18 @@ type bsummCtx struct {
 var (
 	mpathCmdsFlags = map[string][]cli.Flag{
 		cmdMpathAttach: {
-			forceFlag,
+			mpathAttachForceFlag,
 		},
 		cmdMpathEnable: {},
 		cmdMpathDetach: {
 			noResilverFlag,
+			mpathDDForceFlag,
+			mpathCapThresholdFlag,
 		},
 		cmdMpathDisable: {
 			noResilverFlag,
+			mpathDDForceFlag,
+			mpathCapThresholdFlag,
 		},
 	}
 
@@ -90,6 +99,8 @@ var (
 	cleanupFlags = []cli.Flag{
 		waitFlag,
 		waitJobXactFinishedFlag,
+		cleanupOlderThanFlag,
+		dryRunFlag,
 	}
 	cleanupCmd = cli.Command{
 		Name:         cmdStgCleanup,
@@ -116,6 +127,9 @@ var (
 		commandStorage: append(
 			longRunFlags,
 			jsonFlag,
+			capWindowFlag,
+			capHorizonFlag,
+			noHeaderFlag,
 		),
 		cmdShowDisk: append(
 			longRunFlags,
@@ -127,10 +141,13 @@ var (
 		cmdMountpath: append(
 			longRunFlags,
 			jsonFlag,
+			unitsFlag,
 		),
 		cmdStgValidate: append(
 			longRunFlags,
 			waitJobXactFinishedFlag,
+			repairFlag,
+			yesFlag,
 		),
 	}
 
@@ -184,9 +201,164 @@ var (
 )
 
 func showStorageHandler(c *cli.Context) (err error) {
+	if flagIsSet(c, capWindowFlag) {
+		return showCapForecast(c)
+	}
 	return showDiskStats(c, "") // all targets, all disks
 }
 
+//
+// validate --repair
+//
+
+type repairJob struct {
+	what  string
+	start func() (string, error)
+	kind  string
+	wait  bool // to finish before starting the next one
+}
+
+func validateRepair(c *cli.Context, queryBcks cmn.QueryBcks) error {
+	var bckSums []*bucketHealth
+	fValidate := func() (err error) {
+		bckSums, err = collectObjectHealth(queryBcks)
+		return
+	}
+	if err := cmn.WaitForFunc(fValidate, longClientTimeout); err != nil {
+		return err
+	}
+	if err := teb.Print(bckSums, teb.BucketSummaryValidateTmpl); err != nil {
+		return err
+	}
+	smap, err := getClusterMap(c)
+	if err != nil {
+		return err
+	}
+	jobs, manual := planRepair(smap, bckSums)
+
+	fmt.Fprintln(c.App.Writer)
+	if len(jobs) == 0 && len(manual) == 0 {
+		actionDone(c, "No issues found - nothing to repair")
+		return nil
+	}
+	if len(jobs) > 0 {
+		fmt.Fprintln(c.App.Writer, "To repair:")
+		for _, job := range jobs {
+			fmt.Fprintln(c.App.Writer, indent1+job.what)
+		}
+		if !flagIsSet(c, yesFlag) && !confirm(c, "Proceed?") {
+			jobs = jobs[:0]
+		}
+	}
+
+	scheduled := make([]string, 0, len(jobs))
+	for _, job := range jobs {
+		xid, err := job.start()
+		if err != nil {
+			manual = append(manual, fmt.Sprintf("%s (failed to start: %v)", job.what, err))
+			continue
+		}
+		scheduled = append(scheduled, fmt.Sprintf("%s [%s[%s]]", job.what, job.kind, xid))
+		if job.wait {
+			fmt.Fprintf(c.App.Writer, "Waiting for %s[%s] to finish...\n", job.kind, xid)
+			if err := waitXact(apiBP, xact.ArgsMsg{ID: xid, Kind: job.kind}); err != nil {
+				return err
+			}
+		}
+	}
+	if len(scheduled) > 0 {
+		fmt.Fprintln(c.App.Writer, "Scheduled for repair:")
+		for _, s := range scheduled {
+			fmt.Fprintln(c.App.Writer, indent1+s)
+		}
+	}
+	if len(manual) > 0 {
+		fmt.Fprintln(c.App.Writer, "Require manual intervention:")
+		for _, s := range manual {
+			fmt.Fprintln(c.App.Writer, indent1+s)
+		}
+	}
+	return nil
+}
+
+// map detected problems to corrective jobs:
+// - misplaced objects => (global) rebalance or, in a single-target cluster, resilver
+// - missing copies    => n-way mirroring, provided all targets have enough mountpaths
+// - EC buckets        => EC encoding (to restore missing slices) upon rebalance
+func planRepair(smap *cluster.Smap, bckSums []*bucketHealth) (jobs []repairJob, manual []string) {
+	var (
+		misplaced uint64
+		nbck      int
+		minMpaths = -1
+	)
+	for _, bsum := range bckSums {
+		if bsum.Misplaced > 0 {
+			misplaced += bsum.Misplaced
+			nbck++
+		}
+	}
+	if misplaced > 0 {
+		kind := apc.ActRebalance
+		if smap.CountActiveTs() == 1 {
+			kind = apc.ActResilver
+		}
+		jobs = append(jobs, repairJob{
+			what:  fmt.Sprintf("%d misplaced object%s in %d bucket%s: %s", misplaced, cos.Plural(int(misplaced)), nbck, cos.Plural(nbck), kind),
+			start: func() (string, error) { return api.StartXaction(apiBP, xact.ArgsMsg{Kind: kind}) },
+			kind:  kind,
+			wait:  true,
+		})
+	}
+	for _, bsum := range bckSums {
+		var (
+			bck   = bsum.Bck
+			props = bsum.props
+		)
+		if bsum.MissingCopies > 0 {
+			copies := int(props.Mirror.Copies)
+			if minMpaths < 0 {
+				minMpaths = minAvailMpaths(smap)
+			}
+			if copies > minMpaths {
+				manual = append(manual, fmt.Sprintf("%s: %d object%s with missing copies (configured %d copies, min number of mountpaths per target %d)",
+					bck.Cname(""), bsum.MissingCopies, cos.Plural(int(bsum.MissingCopies)), copies, minMpaths))
+			} else {
+				jobs = append(jobs, repairJob{
+					what:  fmt.Sprintf("%s: %d object%s with missing copies: %s", bck.Cname(""), bsum.MissingCopies, cos.Plural(int(bsum.MissingCopies)), apc.ActMakeNCopies),
+					start: func() (string, error) { return api.MakeNCopies(apiBP, bck, copies) },
+					kind:  apc.ActMakeNCopies,
+				})
+			}
+		}
+		if bsum.Misplaced > 0 && props.EC.Enabled {
+			jobs = append(jobs, repairJob{
+				what: fmt.Sprintf("%s: erasure coding (%d:%d): %s", bck.Cname(""), props.EC.DataSlices, props.EC.ParitySlices, apc.ActECEncode),
+				start: func() (string, error) {
+					return api.ECEncodeBucket(apiBP, bck, props.EC.DataSlices, props.EC.ParitySlices)
+				},
+				kind: apc.ActECEncode,
+			})
+		}
+	}
+	return
+}
+
+// the minimum number of available mountpaths across active targets
+func minAvailMpaths(smap *cluster.Smap) (n int) {
+	n = math.MaxInt32
+	for _, tsi := range smap.Tmap {
+		if tsi.InMaintOrDecomm() {
+			continue
+		}
+		mpl, err := api.GetMountpaths(apiBP, tsi)
+		if err != nil {
+			continue
+		}
+		n = cos.Min(n, len(mpl.Available))
+	}
+	return
+}
+
 //
 // cleanup
 //
@@ -205,12 +377,16 @@ func cleanupStorageHandler(c *cli.Context) (err error) {
 			return
 		}
 	}
-	xargs := xact.ArgsMsg{Kind: apc.ActStoreCleanup, Bck: bck}
+	xargs := xact.ArgsMsg{Kind: apc.ActStoreCleanup, Bck: bck, DryRun: flagIsSet(c, dryRunFlag)}
+	if flagIsSet(c, cleanupOlderThanFlag) {
+		xargs.OlderThan = parseDurationFlag(c, cleanupOlderThanFlag)
+	}
 	if id, err = api.StartXaction(apiBP, xargs); err != nil {
 		return
 	}
 
-	if !flagIsSet(c, waitFlag) && !flagIsSet(c, waitJobXactFinishedFlag) {
+	// dry-run: always wait to report reclaimable space
+	if !xargs.DryRun && !flagIsSet(c, waitFlag) && !flagIsSet(c, waitJobXactFinishedFlag) {
 		if id != "" {
 			fmt.Fprintf(c.App.Writer, "Started storage cleanup %q. %s\n", id, toMonitorMsg(c, id, ""))
 		} else {
@@ -223,10 +399,62 @@ func cleanupStorageHandler(c *cli.Context) (err error) {
 	if flagIsSet(c, waitJobXactFinishedFlag) {
 		xargs.Timeout = parseDurationFlag(c, waitJobXactFinishedFlag)
 	}
+	xargs.ID = id
 	if err := waitXact(apiBP, xargs); err != nil {
 		return err
 	}
+	if xargs.DryRun {
+		return showCleanupStats(c, id, true)
+	}
 	fmt.Fprint(c.App.Writer, fmtXactSucceeded)
+	return showCleanupStats(c, id, false)
+}
+
+// per-target, per-mountpath breakdown of removed (or reclaimable) space (see space.ExtClnStats)
+func showCleanupStats(c *cli.Context, id string, dryRun bool) error {
+	xsnaps, err := api.QueryXactionSnaps(apiBP, xact.ArgsMsg{ID: id})
+	if err != nil {
+		return err
+	}
+	var (
+		files, size, deleted int64
+		tids                 = make([]string, 0, len(xsnaps))
+		what                 = "freed"
+	)
+	if dryRun {
+		what = "reclaimable"
+	}
+	for tid := range xsnaps {
+		tids = append(tids, tid)
+	}
+	sort.Strings(tids)
+	for _, tid := range tids {
+		for _, snap := range xsnaps[tid] {
+			if snap.ID != id {
+				continue
+			}
+			ext := &space.ExtClnStats{}
+			if snap.Ext == nil || cos.MorphMarshal(snap.Ext, ext) != nil || len(ext.Mpaths) == 0 {
+				continue
+			}
+			mpaths := make([]string, 0, len(ext.Mpaths))
+			for mpath := range ext.Mpaths {
+				mpaths = append(mpaths, mpath)
+			}
+			sort.Strings(mpaths)
+			fmt.Fprintf(c.App.Writer, "%s:\n", cluster.Tname(tid))
+			for _, mpath := range mpaths {
+				st := ext.Mpaths[mpath]
+				fmt.Fprintf(c.App.Writer, "  %s: %d file%s, %s %s, %s in 'deleted'\n", mpath, st.Files, cos.Plural(int(st.Files)),
+					teb.FmtSize(st.Size, "", 2), what, teb.FmtSize(st.Deleted, "", 2))
+				files += st.Files
+				size += st.Size
+				deleted += st.Deleted
+			}
+		}
+	}
+	fmt.Fprintf(c.App.Writer, "Total %s: %s (%d file%s) plus %s in 'deleted'\n", what, teb.FmtSize(size, "", 2),
+		files, cos.Plural(int(files)), teb.FmtSize(deleted, "", 2))
 	return nil
 }
 
@@ -295,12 +523,8 @@ func showDiskStats(c *cli.Context, tid string) error {
 			totalsHdr = tgtTotal
 		}
 		tally := teb.DiskStatsHelper{TargetID: totalsHdr}
-		for _, ds := range dsh {
-			tally.Stat.RBps += ds.Stat.RBps
-			tally.Stat.Ravg += ds.Stat.Ravg
-			tally.Stat.WBps += ds.Stat.WBps
-			tally.Stat.Wavg += ds.Stat.Wavg
-			tally.Stat.Util += ds.Stat.Util
+		for i := range dsh {
+			teb.AddDiskStats(&tally.Stat, &dsh[i].Stat)
 		}
 		tally.Stat.Ravg = cos.DivRound(tally.Stat.Ravg, l)
 		tally.Stat.Wavg = cos.DivRound(tally.Stat.Wavg, l)
@@ -376,10 +600,14 @@ func showMpathHandler(c *cli.Context) error {
 		smap            *cluster.Smap
 		nodes           []*cluster.Snode
 		sid, sname, err = argNode(c)
+		units, errU     = parseUnitsFlag(c, unitsFlag)
 	)
 	if err != nil {
 		return err
 	}
+	if errU != nil {
+		return errU
+	}
 	setLongRunParams(c)
 
 	smap, tstatusMap, _, err := fillNodeStatusMap(c, apc.Target)
@@ -413,11 +641,15 @@ func showMpathHandler(c *cli.Context) error {
 			if err != nil {
 				erCh <- err
 			} else {
-				mpCh <- &targetMpath{
+				tmp := &targetMpath{
 					DaemonID:  node.ID(),
 					Mpl:       mpl,
 					TargetCDF: tstatusMap[node.ID()].TargetCDF,
 				}
+				if dstats, err := api.GetDiskStats(apiBP, node.ID()); err == nil {
+					tmp.addIOstats(dstats, units)
+				}
+				mpCh <- tmp
 			}
 			wg.Done()
 		}(node)
@@ -440,6 +672,36 @@ func showMpathHandler(c *cli.Context) error {
 	return teb.Print(mpls, teb.MpathListTmpl, teb.Jopts(usejs))
 }
 
+// aggregate disk stats on a per-mountpath basis (see teb.AddDiskStats)
+func (tmp *targetMpath) addIOstats(dstats ios.AllDiskStats, units string) {
+	tmp.IOstats = make(map[string]*ios.DiskStats, len(tmp.TargetCDF.Mountpaths))
+	tmp.IOsummary = make(map[string]string, len(tmp.TargetCDF.Mountpaths))
+	for mpath, cdf := range tmp.TargetCDF.Mountpaths {
+		if len(cdf.Disks) == 0 {
+			continue
+		}
+		var (
+			mstats = &ios.DiskStats{}
+			n      int64
+		)
+		for _, disk := range cdf.Disks {
+			if ds, ok := dstats[disk]; ok {
+				teb.AddDiskStats(mstats, &ds)
+				n++
+			}
+		}
+		if n == 0 {
+			continue
+		}
+		mstats.Util = cos.DivRound(mstats.Util, n)
+		tmp.IOstats[mpath] = mstats
+		tmp.IOsummary[mpath] = fmt.Sprintf("\t util %d%%, latency p50/p95/p99 %s/%s/%s, queue %d", mstats.Util,
+			teb.FmtStatValue("", stats.KindLatency, mstats.Lat50, units),
+			teb.FmtStatValue("", stats.KindLatency, mstats.Lat95, units),
+			teb.FmtStatValue("", stats.KindLatency, mstats.Lat99, units), mstats.Aqu)
+	}
+}
+
 func mpathAttachHandler(c *cli.Context) (err error)  { return mpathAction(c, apc.ActMountpathAttach) }
 func mpathEnableHandler(c *cli.Context) (err error)  { return mpathAction(c, apc.ActMountpathEnable) }
 func mpathDetachHandler(c *cli.Context) (err error)  { return mpathAction(c, apc.ActMountpathDetach) }
@@ -486,16 +748,20 @@ func mpathAction(c *cli.Context, action string) error {
 		switch action {
 		case apc.ActMountpathAttach:
 			acted = "attached"
-			err = api.AttachMountpath(apiBP, si, mountpath, flagIsSet(c, forceFlag))
+			err = api.AttachMountpath(apiBP, si, mountpath, flagIsSet(c, mpathAttachForceFlag))
 		case apc.ActMountpathEnable:
 			acted = "enabled"
 			err = api.EnableMountpath(apiBP, si, mountpath)
 		case apc.ActMountpathDetach:
 			acted = "detached"
-			err = api.DetachMountpath(apiBP, si, mountpath, flagIsSet(c, noResilverFlag))
+			if err = mpathPrecheckDD(c, si, mountpath, "detach"); err == nil {
+				err = api.DetachMountpath(apiBP, si, mountpath, flagIsSet(c, noResilverFlag))
+			}
 		case apc.ActMountpathDisable:
 			acted = "disabled"
-			err = api.DisableMountpath(apiBP, si, mountpath, flagIsSet(c, noResilverFlag))
+			if err = mpathPrecheckDD(c, si, mountpath, "disable"); err == nil {
+				err = api.DisableMountpath(apiBP, si, mountpath, flagIsSet(c, noResilverFlag))
+			}
 		default:
 			return incorrectUsageMsg(c, "invalid mountpath action %q", action)
 		}
@@ -506,3 +772,84 @@ func mpathAction(c *cli.Context, action string) error {
 	}
 	return nil
 }
+
+// prior to disabling (detaching) a mountpath:
+//   - refuse to remove the last usable one (unless forced)
+//   - show projected used capacity of the remaining mountpaths assuming resilvering
+//     (i.e., the data getting redistributed in proportion to the mountpaths' sizes)
+func mpathPrecheckDD(c *cli.Context, si *cluster.Snode, mountpath, verb string) error {
+	mpl, err := api.GetMountpaths(apiBP, si)
+	if err != nil {
+		return err
+	}
+	mountpath = filepath.Clean(mountpath)
+	if !cos.StringInSlice(mountpath, mpl.Available) {
+		return nil // (the target will tell)
+	}
+	if len(mpl.Available) == 1 {
+		if !flagIsSet(c, mpathDDForceFlag) {
+			return fmt.Errorf("cannot %s %q - the last usable mountpath of target %s (use %s to override)",
+				verb, mountpath, si.StringEx(), qflprn(mpathDDForceFlag))
+		}
+		actionWarn(c, fmt.Sprintf("%s %q - the last usable mountpath: target %s won't be able to store data",
+			verb, mountpath, si.StringEx()))
+		return nil
+	}
+
+	tstatus, err := api.GetStatsAndStatus(apiBP, si)
+	if err != nil {
+		return err
+	}
+	cdfs := tstatus.TargetCDF.Mountpaths
+	rcdf, ok := cdfs[mountpath]
+	if !ok {
+		return nil
+	}
+	var (
+		total     uint64
+		remaining = make([]string, 0, len(mpl.Available)-1)
+		threshold = int64(parseIntFlag(c, mpathCapThresholdFlag))
+	)
+	for _, mpath := range mpl.Available {
+		// (skipping mountpaths that report no capacity, e.g. just attached)
+		if cdf, ok := cdfs[mpath]; ok && mpath != mountpath && cdf.Used+cdf.Avail > 0 {
+			remaining = append(remaining, mpath)
+			total += cdf.Used + cdf.Avail
+		}
+	}
+	if total == 0 {
+		return nil
+	}
+	if threshold == 0 {
+		config, err := api.GetClusterConfig(apiBP)
+		if err != nil {
+			return err
+		}
+		threshold = config.Space.HighWM
+	}
+	sort.Strings(remaining)
+
+	resilver := !flagIsSet(c, noResilverFlag)
+	fmt.Fprintf(c.App.Writer, "Projected capacity usage after %s %q (%s used):\n", verb, mountpath, teb.FmtSize(int64(rcdf.Used), "", 2))
+	over := make([]string, 0, len(remaining))
+	for _, mpath := range remaining {
+		var (
+			cdf  = cdfs[mpath]
+			size = cdf.Used + cdf.Avail
+			used = cdf.Used
+		)
+		if resilver {
+			used += uint64(float64(rcdf.Used) * float64(size) / float64(total))
+		}
+		pct := int64(cos.MinU64(used, size) * 100 / size)
+		fmt.Fprintf(c.App.Writer, "%s%s: %d%% => %d%% used (%s available)\n", indent1, mpath, cdf.PctUsed, pct,
+			teb.FmtSize(int64(size-cos.MinU64(used, size)), "", 2))
+		if pct >= threshold {
+			over = append(over, mpath)
+		}
+	}
+	if len(over) > 0 {
+		actionWarn(c, fmt.Sprintf("%s %q will push %v over the %d%% capacity threshold", verb, mountpath, over, threshold))
+	}
+	return nil
+}
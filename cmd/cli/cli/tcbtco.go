@@ -7,16 +7,20 @@ package cli
 import (
 	"fmt"
 	"net/http"
+	"os"
 	"strings"
 	"time"
 
 	"github.com/NVIDIA/aistore/api"
 	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmd/cli/config"
+	"github.com/NVIDIA/aistore/cmd/cli/teb"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/jsp"
+	"github.com/NVIDIA/aistore/ext/etl"
 	"github.com/NVIDIA/aistore/xact"
-	jsoniter "github.com/json-iterator/go"
 	"github.com/urfave/cli"
 )
 
@@ -129,33 +133,27 @@ func etlBucket(c *cli.Context, etlName string, bckFrom, bckTo cmn.Bck) error {
 	if c.NArg() == 0 {
 		return missingArgumentsError(c, c.Command.ArgsUsage)
 	}
+	batchSize, err := etlBatchSize(c, etlName)
+	if err != nil {
+		return err
+	}
 	msg := &apc.TCBMsg{
-		Transform: apc.Transform{Name: etlName},
+		Transform: apc.Transform{Name: etlName, BatchSize: batchSize},
 		CopyBckMsg: apc.CopyBckMsg{
 			Prepend: parseStrFlag(c, copyPrependFlag),
 			Prefix:  parseStrFlag(c, copyObjPrefixFlag),
 			DryRun:  flagIsSet(c, copyDryRunFlag),
 			Force:   flagIsSet(c, forceFlag),
 		},
+		ContinueOnError: flagIsSet(c, continueOnErrorFlag),
+	}
+	if err := etlErrorsTo(c, &msg.Transform); err != nil {
+		return err
 	}
 	if flagIsSet(c, etlExtFlag) {
-		mapStr := parseStrFlag(c, etlExtFlag)
-		extMap := make(cos.StrKVs, 1)
-		err := jsoniter.UnmarshalFromString(mapStr, &extMap)
-		if err != nil {
-			// add quotation marks and reparse
-			tmp := strings.ReplaceAll(mapStr, " ", "")
-			tmp = strings.ReplaceAll(tmp, "{", "{\"")
-			tmp = strings.ReplaceAll(tmp, "}", "\"}")
-			tmp = strings.ReplaceAll(tmp, ":", "\":\"")
-			tmp = strings.ReplaceAll(tmp, ",", "\",\"")
-			if jsoniter.UnmarshalFromString(tmp, &extMap) == nil {
-				err = nil
-			}
-		}
+		extMap, err := parseExtMap(c, etlExtFlag)
 		if err != nil {
-			return fmt.Errorf("Invalid format --%s=%q. Usage examples: {jpg:txt}, \"{in1:out1,in2:out2}\"",
-				etlExtFlag.GetName(), mapStr)
+			return err
 		}
 		msg.Ext = extMap
 	}
@@ -190,7 +188,7 @@ func etlBucket(c *cli.Context, etlName string, bckFrom, bckTo cmn.Bck) error {
 		return err
 	}
 	if !flagIsSet(c, copyDryRunFlag) {
-		return nil
+		return etlThroughput(c, xargs, etlName, batchSize)
 	}
 
 	// [DRY-RUN]
@@ -220,6 +218,122 @@ func handleETLHTTPError(err error, etlName string) error {
 	return err
 }
 
+// returns the number of objects to transform per ETL request (zero: one object at a time);
+// falls back to single-object requests (with a warning) if the ETL does not support batching
+func etlBatchSize(c *cli.Context, etlName string) (int, error) {
+	if !flagIsSet(c, etlBatchSizeFlag) {
+		return 0, nil
+	}
+	n := parseIntFlag(c, etlBatchSizeFlag)
+	if n < 0 || n > apc.MaxETLBatchSize {
+		return 0, fmt.Errorf("invalid %s=%d (expecting 0 <= batch-size <= %d)", flprn(etlBatchSizeFlag), n, apc.MaxETLBatchSize)
+	}
+	if n <= 1 {
+		return 0, nil
+	}
+	initMsg, err := api.ETLGetInitMsg(apiBP, etlName)
+	if err != nil {
+		return 0, handleETLHTTPError(err, etlName)
+	}
+	if spec, ok := initMsg.(*etl.InitSpecMsg); ok && spec.Batch {
+		return n, nil
+	}
+	warn := fmt.Sprintf("ETL[%s] does not declare batch support - ignoring %s and transforming one object per request",
+		etlName, qflprn(etlBatchSizeFlag))
+	actionWarn(c, warn)
+	return 0, nil
+}
+
+// where to store per-object transformation failures, if requested
+func etlErrorsTo(c *cli.Context, transform *apc.Transform) error {
+	if !flagIsSet(c, etlErrorsToFlag) {
+		if flagIsSet(c, etlMaxErrorsFlag) {
+			return fmt.Errorf("option %s requires %s", qflprn(etlMaxErrorsFlag), qflprn(etlErrorsToFlag))
+		}
+		return nil
+	}
+	uri := parseStrFlag(c, etlErrorsToFlag)
+	bck, _, err := parseBckObjectURI(c, uri, true /*optional prefix*/)
+	if err != nil {
+		return err
+	}
+	if _, err := headBucket(bck, false /* add */); err != nil {
+		return err
+	}
+	transform.ErrsTo = uri
+	if flagIsSet(c, etlMaxErrorsFlag) {
+		if transform.MaxErrs = parseIntFlag(c, etlMaxErrorsFlag); transform.MaxErrs < 0 {
+			return fmt.Errorf("invalid %s=%d (expecting non-negative)", flprn(etlMaxErrorsFlag), transform.MaxErrs)
+		}
+	}
+	return nil
+}
+
+// per-ETL throughput (objects/s) of the most recent job that ran without batching;
+// serves as the baseline to compare with when transforming in batches
+const etlBaselineFname = "etl_baseline.json"
+
+// completion summary: cluster-wide throughput of a finished ETL job
+func etlThroughput(c *cli.Context, xargs xact.ArgsMsg, etlName string, batchSize int) error {
+	snaps, err := api.QueryXactionSnaps(apiBP, xargs)
+	if err != nil {
+		return err
+	}
+	var (
+		started, ended time.Time
+		objs, size     int64
+	)
+	for _, tsnaps := range snaps {
+		for _, snap := range tsnaps {
+			if snap.ID != xargs.ID {
+				continue
+			}
+			objs += snap.Stats.Objs
+			size += snap.Stats.Bytes
+			if started.IsZero() || snap.StartTime.Before(started) {
+				started = snap.StartTime
+			}
+			if snap.EndTime.After(ended) {
+				ended = snap.EndTime
+			}
+		}
+	}
+	if started.IsZero() || ended.Before(started) || objs == 0 {
+		return nil
+	}
+	elapsed := ended.Sub(started)
+	if elapsed <= 0 {
+		elapsed = time.Millisecond
+	}
+	secs := elapsed.Seconds()
+	rate := float64(objs) / secs
+	fmt.Fprintf(c.App.Writer, "Transformed %d object%s (%s) in %v: %.1f objects/s, %s/s\n",
+		objs, cos.Plural(int(objs)), teb.FmtSize(size, "", 2), elapsed.Round(time.Millisecond),
+		rate, teb.FmtSize(int64(float64(size)/secs), "", 2))
+
+	// compare with the baseline (or record one)
+	baseline := make(map[string]float64, 4)
+	if err := jsp.LoadAppConfig(config.ConfigDir, etlBaselineFname, &baseline); err != nil && !os.IsNotExist(err) {
+		actionWarn(c, fmt.Sprintf("failed to load ETL baseline: %v", err))
+		return nil
+	}
+	if batchSize <= 1 {
+		baseline[etlName] = rate
+		if err := jsp.SaveAppConfig(config.ConfigDir, etlBaselineFname, baseline); err != nil {
+			actionWarn(c, fmt.Sprintf("failed to store ETL baseline: %v", err))
+		}
+		return nil
+	}
+	base, ok := baseline[etlName]
+	if !ok || base <= 0 {
+		actionNote(c, fmt.Sprintf("no baseline to compare with - run ETL[%s] once without %s", etlName, qflprn(etlBatchSizeFlag)))
+		return nil
+	}
+	fmt.Fprintf(c.App.Writer, "Batching (up to %d objects per request): %.2fx the throughput of one object per request (%.1f objects/s)\n",
+		batchSize, rate/base, base)
+	return nil
+}
+
 //
 // common for both (cp | etl)
 //
@@ -274,6 +388,9 @@ func tcbtco(c *cli.Context, etlName string, bckFrom, bckTo cmn.Bck) (err error)
 	}
 
 	// (II) multi-object TCO
+	if isStdinList(c) {
+		return errStdinList(c)
+	}
 	listObjs := parseStrFlag(c, listFlag)
 	tmplObjs := parseStrFlag(c, templateFlag)
 
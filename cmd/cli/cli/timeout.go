@@ -31,4 +31,7 @@ const (
 
 	// job wait: start printing "."(s)
 	wasFast = refreshRateDefault
+
+	// show storage --window: flag targets projected to fill up within (default '--horizon')
+	capDefaultHorizon = 7 * 24 * time.Hour
 )
@@ -8,6 +8,7 @@ package cli
 import (
 	"bufio"
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"io"
@@ -243,9 +244,11 @@ func getPrefixFromPrimary() string {
 	return scheme + apc.BckProviderSeparator
 }
 
+// (configured `defaults.refresh`, if any, replaces the default interval but does not
+// by itself enable continuous monitoring - see `flagDefault`)
 func _refreshRate(c *cli.Context) time.Duration {
 	refreshRate := refreshRateDefault
-	if flagIsSet(c, refreshFlag) {
+	if _, ok := flagDefault(c, fl1n(refreshFlag.GetName())); ok || flagIsSet(c, refreshFlag) {
 		duration := parseDurationFlag(c, refreshFlag)
 		refreshRate = cos.MaxDuration(duration, refreshRateMinDur)
 	}
@@ -261,6 +264,81 @@ func splitCsv(s string) (lst []string) {
 	return
 }
 
+// Parse extension mapping (ETL '--ext', GET '--ext-map')
+func parseExtMap(c *cli.Context, flag cli.StringFlag) (cos.StrKVs, error) {
+	mapStr := parseStrFlag(c, flag)
+	extMap, ok := strToExtMap(mapStr)
+	if !ok {
+		return nil, fmt.Errorf("invalid format %s=%q. Usage examples: {jpg:txt}, \"{in1:out1,in2:out2}\", \"in1=out1,in2=out2\"",
+			flprn(flag), mapStr)
+	}
+	return extMap, nil
+}
+
+// accepted formats: "old=new[,old2=new2]", "{old:new,old2:new2}", and JSON
+func strToExtMap(mapStr string) (cos.StrKVs, bool) {
+	extMap := make(cos.StrKVs, 1)
+	if !strings.Contains(mapStr, "{") && strings.Contains(mapStr, "=") {
+		for _, kv := range splitCsv(mapStr) {
+			from, to, ok := strings.Cut(kv, "=")
+			from, to = strings.TrimLeft(strings.TrimSpace(from), "."), strings.TrimSpace(to)
+			if !ok || from == "" || to == "" {
+				return nil, false
+			}
+			extMap[from] = to
+		}
+		return extMap, true
+	}
+	if err := jsoniter.UnmarshalFromString(mapStr, &extMap); err != nil {
+		// add quotation marks and reparse
+		tmp := strings.ReplaceAll(mapStr, " ", "")
+		tmp = strings.ReplaceAll(tmp, "{", "{\"")
+		tmp = strings.ReplaceAll(tmp, "}", "\"}")
+		tmp = strings.ReplaceAll(tmp, ":", "\":\"")
+		tmp = strings.ReplaceAll(tmp, ",", "\",\"")
+		if jsoniter.UnmarshalFromString(tmp, &extMap) != nil {
+			return nil, false
+		}
+	}
+	return extMap, len(extMap) > 0
+}
+
+// replace object name's extension (same rules as ETL's apc.TCBMsg.ToName)
+func mapObjExt(objName string, extMap cos.StrKVs) string {
+	msg := apc.TCBMsg{Ext: extMap}
+	return msg.ToName(objName)
+}
+
+// `--list -` reads object names from STDIN (one per line) - in batches, to support arbitrarily long lists
+const stdinListBatch = 10000
+
+type stdinList struct {
+	sc    *bufio.Scanner
+	batch int
+}
+
+func isStdinList(c *cli.Context) bool { return parseStrFlag(c, listFlag) == fileStdIO }
+
+func errStdinList(c *cli.Context) error {
+	return incorrectUsageMsg(c, "'%s -' (object names from STDIN) is not supported by %q", flprn(listFlag), c.Command.Name)
+}
+
+func newStdinList(r io.Reader, batch int) *stdinList {
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 0, 4*cos.KiB), cos.MiB)
+	return &stdinList{sc: sc, batch: batch}
+}
+
+// returns the next batch of (non-empty) names; empty batch upon EOF
+func (sl *stdinList) next() (names []string, err error) {
+	for len(names) < sl.batch && sl.sc.Scan() {
+		if name := strings.TrimSpace(sl.sc.Text()); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names, sl.sc.Err()
+}
+
 // Convert a list of "key value" and "key=value" pairs into a map
 func makePairs(args []string) (nvs cos.StrKVs, err error) {
 	var (
@@ -525,14 +603,14 @@ func headBucket(bck cmn.Bck, dontAddBckMD bool) (p *cmn.BucketProps, err error)
 	}
 	if herr, ok := err.(*cmn.ErrHTTP); ok {
 		if herr.Status == http.StatusNotFound {
-			err = fmt.Errorf("bucket %q does not exist", bck)
+			err = cmn.NewErrNotFound("bucket %q", bck)
 		} else if herr.Message != "" {
-			err = errors.New(herr.Message)
+			err = newErrMsgCause(herr, herr.Message)
 		} else {
-			err = fmt.Errorf("failed to HEAD bucket %q: %s", bck, herr.Message)
+			err = newErrMsgCause(herr, "failed to HEAD bucket %q: %s", bck, herr.Message)
 		}
 	} else {
-		err = fmt.Errorf("failed to HEAD bucket %q: %v", bck, err)
+		err = fmt.Errorf("failed to HEAD bucket %q: %w", bck, err)
 	}
 	return
 }
@@ -699,11 +777,12 @@ func parseURLtoBck(strURL string) (bck cmn.Bck) {
 }
 
 // see also authNConfPairs
-func flattenConfig(cfg any, section string) (flat nvpairList) {
+// (sizes are rendered in the specified `units`, "" for the configured default)
+func flattenConfig(cfg any, section, units string) (flat nvpairList) {
 	flat = make(nvpairList, 0, 40)
 	cmn.IterFields(cfg, func(tag string, field cmn.IterField) (error, bool) {
 		if section == "" || strings.HasPrefix(tag, section) {
-			v := _toStr(field.Value())
+			v := _toStr(field.Value(), units)
 			flat = append(flat, nvpair{tag, v})
 		}
 		return nil, false
@@ -711,27 +790,67 @@ func flattenConfig(cfg any, section string) (flat nvpairList) {
 	return flat
 }
 
-// NOTE: remove secrets if any
-func _toStr(v any) (s string) {
-	m, ok := v.(map[string]any)
-	if !ok {
-		return fmt.Sprintf("%v", v)
+func _toStr(v any, units string) (s string) {
+	if siz, ok := v.(cos.SizeIEC); ok {
+		return teb.FmtSize(int64(siz), units, 0)
+	}
+	return fmt.Sprintf("%v", v) // for custom formatting, see e.g. AliasConfig.String()
+}
+
+// '--format-template' (validated against the zero value of the row type)
+func parseFormatTemplate(c *cli.Context, row any) (*teb.RowTemplate, error) {
+	if !flagIsSet(c, formatTemplateFlag) {
+		return nil, nil
+	}
+	units, err := parseUnitsFlag(c, unitsFlag)
+	if err != nil {
+		return nil, err
+	}
+	return teb.NewRowTemplate(parseStrFlag(c, formatTemplateFlag), row, units)
+}
+
+// '--show-secrets' (with confirmation)
+func showSecrets(c *cli.Context) bool {
+	if !flagIsSet(c, showSecretsFlag) {
+		return false
+	}
+	warn := "secret values (credentials, keys) will be shown in plain text."
+	return confirm(c, "Proceed?", warn)
+}
+
+// in place: replace secret values (see cmn.ConfigSecrets) with redactedVal,
+// including those that are nested within maps (e.g., "backend.conf")
+func redactConfig(cfg any) {
+	cmn.IterFields(cfg, func(tag string, field cmn.IterField) (error, bool) {
+		switch v := field.Value().(type) {
+		case string:
+			if v != "" && cmn.IsConfigSecret(tag) {
+				field.SetValue(redactedVal, true /*force*/)
+			}
+		case map[string]any:
+			redactMap(v)
+		}
+		return nil, false
+	}, cmn.IterOpts{OnlyRead: false})
+}
+
+func redactVal(name, value string) string {
+	if value != "" && cmn.IsConfigSecret(name) {
+		return redactedVal
 	}
-	// prune
-	for k, vv := range m {
-		if strings.Contains(strings.ToLower(k), "secret") {
-			delete(m, k)
+	return value
+}
+
+func redactMap(m map[string]any) {
+	for k, v := range m {
+		if mm, ok := v.(map[string]any); ok {
+			redactMap(mm)
 			continue
 		}
-		if mm, ok := vv.(map[string]any); ok {
-			for kk := range mm {
-				if strings.Contains(strings.ToLower(kk), "secret") {
-					delete(mm, kk)
-				}
-			}
+		if v != nil && v != "" && cmn.IsConfigSecret(k) {
+			m[k] = redactedVal
 		}
 	}
-	return fmt.Sprintf("%v", m) // for custom formatting, see e.g. AliasConfig.String()
 }
 
 func diffConfigs(actual, original nvpairList) []propDiff {
@@ -846,7 +965,7 @@ func authNConfPairs(conf *authn.Config, prefix string) (nvpairList, error) {
 		if prefix != "" && !strings.HasPrefix(tag, prefix) {
 			return nil, false
 		}
-		v := _toStr(field.Value())
+		v := _toStr(field.Value(), "")
 		flat = append(flat, nvpair{Name: tag, Value: v})
 		return nil, false
 	})
@@ -1017,3 +1136,38 @@ func parseSource(rawURL string) (source dlSource, err error) {
 		backend: cloudSource,
 	}, err
 }
+
+// `--timeout` (xferTimeoutFlag): bound the entire get (put) operation via cancelable
+// API context that aborts in-flight transfers - not only the higher-level logic
+func withXferTimeout(action func(c *cli.Context) error) func(c *cli.Context) error {
+	return func(c *cli.Context) error {
+		if !flagIsSet(c, xferTimeoutFlag) {
+			return action(c)
+		}
+		timeout := parseDurationFlag(c, xferTimeoutFlag)
+		if timeout <= 0 {
+			return fmt.Errorf("invalid %s=%v (expecting positive duration)", flprn(xferTimeoutFlag), timeout)
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		apiBP.Ctx = ctx
+		err := action(c)
+		apiBP.Ctx = nil
+		cancel()
+		if err != nil && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			if errors.Is(err, context.DeadlineExceeded) {
+				err = fmt.Errorf("%s=%v: %w", flprn(xferTimeoutFlag), timeout, err)
+			} else {
+				err = fmt.Errorf("%w (%s=%v): %v", context.DeadlineExceeded, flprn(xferTimeoutFlag), timeout, err)
+			}
+		}
+		return err
+	}
+}
+
+// STDIN that can be aborted by `--timeout` (see above)
+func stdinReader() io.Reader {
+	if apiBP.Ctx != nil {
+		return cos.NewCtxReader(apiBP.Ctx, os.Stdin)
+	}
+	return os.Stdin
+}
@@ -6,6 +6,7 @@ package cli
 
 import (
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/NVIDIA/aistore/api/apc"
@@ -465,3 +466,55 @@ func TestParseBckObjectURI(t *testing.T) {
 		tassert.Errorf(t, err != nil, "expected error on %s (bck: %q, obj_name: %q)", test.uri, bck, objName)
 	}
 }
+
+func TestStdinList(t *testing.T) {
+	sl := newStdinList(strings.NewReader("a\n\n  b  \r\nc\nd\ne"), 2)
+	var batches [][]string
+	for {
+		names, err := sl.next()
+		tassert.CheckFatal(t, err)
+		if len(names) == 0 {
+			break
+		}
+		batches = append(batches, names)
+	}
+	expected := [][]string{{"a", "b"}, {"c", "d"}, {"e"}}
+	tassert.Errorf(t, reflect.DeepEqual(batches, expected), "expected %v, got %v", expected, batches)
+}
+
+func TestRedactConfig(t *testing.T) {
+	config := &cmn.ClusterConfig{}
+	config.Auth.Secret = "aBcDeF"
+	config.Auth.Enabled = true
+	config.Backend.Conf = map[string]any{
+		"aws":    map[string]any{"access_key": "AKIA", "region": "us-west-2"},
+		"remote": map[string]any{"token": "xyz"},
+	}
+	redactConfig(config)
+	tassert.Errorf(t, config.Auth.Secret == redactedVal, "auth.secret not redacted: %q", config.Auth.Secret)
+	tassert.Errorf(t, config.Auth.Enabled, "auth.enabled must not change")
+	aws := config.Backend.Conf["aws"].(map[string]any)
+	tassert.Errorf(t, aws["access_key"] == redactedVal && aws["region"] == "us-west-2", "unexpected %v", aws)
+	remote := config.Backend.Conf["remote"].(map[string]any)
+	tassert.Errorf(t, remote["token"] == redactedVal, "unexpected %v", remote)
+
+	for _, nv := range flattenConfig(config, "auth", "") {
+		tassert.Errorf(t, !strings.Contains(nv.Value, "aBcDeF"), "secret leaked: %s=%s", nv.Name, nv.Value)
+	}
+	tassert.Errorf(t, redactVal("net.http.server_key", "/etc/key.pem") == "/etc/key.pem", "must not redact file paths")
+}
+
+func TestExtMap(t *testing.T) {
+	for _, in := range []string{"jpg=txt,png=txt", " .jpg = txt , png=.txt", "{jpg:txt,png:txt}", `{"jpg":"txt","png":"txt"}`} {
+		extMap, ok := strToExtMap(in)
+		tassert.Fatalf(t, ok && len(extMap) == 2, "%q: unexpected %v", in, extMap)
+		tassert.Errorf(t, mapObjExt("a/b.jpg", extMap) == "a/b.txt", "%q: got %q", in, mapObjExt("a/b.jpg", extMap))
+		tassert.Errorf(t, mapObjExt("a/b.png", extMap) == "a/b.txt", "%q: got %q", in, mapObjExt("a/b.png", extMap))
+		tassert.Errorf(t, mapObjExt("a.jpg/b", extMap) == "a.jpg/b", "%q: got %q", in, mapObjExt("a.jpg/b", extMap))
+		tassert.Errorf(t, mapObjExt("b.gif", extMap) == "b.gif", "%q: got %q", in, mapObjExt("b.gif", extMap))
+	}
+	for _, in := range []string{"", "jpg", "jpg=", "=txt", "{jpg"} {
+		_, ok := strToExtMap(in)
+		tassert.Errorf(t, !ok, "%q: expected error", in)
+	}
+}
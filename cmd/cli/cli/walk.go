@@ -7,6 +7,7 @@ package cli
 import (
 	"fmt"
 	"os"
+	"path"
 	"path/filepath"
 	"strings"
 
@@ -32,11 +33,23 @@ type (
 	}
 	// recursive walk
 	walkCtx struct {
+		flt          *fobjFilter
+		ign          *aisIgnore
 		pattern      string
+		root         string
 		trimPrefix   string
 		appendPrefix string
 		files        []fobj
 	}
+	// include/exclude glob patterns matched against file paths relative to the walk's root
+	// (see putIncludeFlag and putExcludeFlag)
+	fobjFilter struct {
+		include   []string
+		exclude   []string
+		filtered  int  // number of files filtered out
+		aisignore bool // consult `.aisignore` files (unless '--no-ignore')
+		ignored   int  // number of files skipped via `.aisignore`
+	}
 
 	fobjSlice []fobj // sortable
 )
@@ -50,7 +63,7 @@ func cutPrefixFromPath(path, base string) string {
 
 // Returns files from the 'path' directory. No recursion.
 // If shell-filename matching pattern is used, includes only the matching files.
-func listDir(path, trimPrefix, appendPrefix, pattern string) ([]fobj, error) {
+func listDir(path, trimPrefix, appendPrefix, pattern string, flt *fobjFilter, ign *aisIgnore) ([]fobj, error) {
 	var (
 		files         []fobj
 		dentries, err = os.ReadDir(path)
@@ -65,6 +78,15 @@ func listDir(path, trimPrefix, appendPrefix, pattern string) ([]fobj, error) {
 		if matched, err := filepath.Match(pattern, filepath.Base(dent.Name())); !matched || err != nil {
 			continue
 		}
+		if ignored, err := ign.ignored(dent.Name(), false); ignored || err != nil {
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if !flt.match(dent.Name()) {
+			continue
+		}
 		if finfo, err := dent.Info(); err == nil {
 			debug.Assert(finfo.Name() == dent.Name())
 			fullPath := filepath.Join(path, dent.Name())
@@ -81,9 +103,12 @@ func listDir(path, trimPrefix, appendPrefix, pattern string) ([]fobj, error) {
 
 // Recursively traverses the 'path' dir.
 // If shell-filename matching pattern is used, includes only the matching files.
-func listRecurs(path, trimPrefix, appendPrefix, pattern string) ([]fobj, error) {
+func listRecurs(path, trimPrefix, appendPrefix, pattern string, flt *fobjFilter, ign *aisIgnore) ([]fobj, error) {
 	ctx := &walkCtx{
+		flt:          flt,
+		ign:          ign,
 		pattern:      pattern,
+		root:         path,
 		trimPrefix:   trimPrefix,
 		appendPrefix: appendPrefix,
 	}
@@ -97,9 +122,10 @@ func listRecurs(path, trimPrefix, appendPrefix, pattern string) ([]fobj, error)
 // - path with optional wildcard(s)
 // - base to generate object names
 // - recursive flag
+// - include/exclude filter (optional; applies to directories)
 // returns:
 // - list of triplets (file or dir path, object name, size) that match
-func lsFobj(c *cli.Context, path, trimPrefix, appendPrefix string, recursive bool) (fobjSlice, error) {
+func lsFobj(c *cli.Context, path, trimPrefix, appendPrefix string, recursive bool, flt *fobjFilter) (fobjSlice, error) {
 	debug.Assert(trimPrefix == "" || strings.HasPrefix(path, trimPrefix))
 	var (
 		pattern   string
@@ -146,10 +172,12 @@ func lsFobj(c *cli.Context, path, trimPrefix, appendPrefix string, recursive boo
 	if trimPrefix == "" {
 		trimPrefix = path
 	}
+	ign := flt.newIgnore(path)
+	defer flt.addIgnored(ign)
 	if !recursive {
-		return listDir(path, trimPrefix, appendPrefix, pattern)
+		return listDir(path, trimPrefix, appendPrefix, pattern, flt, ign)
 	}
-	return listRecurs(path, trimPrefix, appendPrefix, pattern)
+	return listRecurs(path, trimPrefix, appendPrefix, pattern, flt, ign)
 }
 
 func groupByExt(files []fobj) (int64, map[string]counter) {
@@ -180,12 +208,28 @@ func (w *walkCtx) do(fqn string, info os.FileInfo, err error) error {
 		}
 		return fmt.Errorf("filepath-walk invoked with err: %v", err)
 	}
+	rel := filepath.ToSlash(cutPrefixFromPath(fqn, w.root))
 	if info.IsDir() {
+		if rel == "" {
+			return nil
+		}
+		if ignored, err := w.ign.ignored(rel, true); ignored || err != nil {
+			if err != nil {
+				return err
+			}
+			return filepath.SkipDir
+		}
 		return nil
 	}
 	if matched, _ := filepath.Match(w.pattern, filepath.Base(fqn)); !matched {
 		return nil
 	}
+	if ignored, err := w.ign.ignored(rel, false); ignored || err != nil {
+		return err
+	}
+	if !w.flt.match(rel) {
+		return nil
+	}
 	fo := fobj{
 		name: w.appendPrefix + cutPrefixFromPath(fqn, w.trimPrefix), // empty strings ignored
 		path: fqn,
@@ -195,6 +239,122 @@ func (w *walkCtx) do(fqn string, info os.FileInfo, err error) error {
 	return nil
 }
 
+////////////////
+// fobjFilter //
+////////////////
+
+func newFobjFilter(c *cli.Context) (*fobjFilter, error) {
+	flt := &fobjFilter{
+		include:   c.StringSlice(putIncludeFlag.Name),
+		exclude:   c.StringSlice(putExcludeFlag.Name),
+		aisignore: !flagIsSet(c, noIgnoreFlag),
+	}
+	if len(flt.include) == 0 && len(flt.exclude) == 0 && !flt.aisignore {
+		return nil, nil
+	}
+	for _, patterns := range [][]string{flt.include, flt.exclude} {
+		for i, pattern := range patterns {
+			normalized, err := normGlob(pattern)
+			if err != nil {
+				return nil, err
+			}
+			patterns[i] = normalized
+		}
+	}
+	return flt, nil
+}
+
+func normGlob(pattern string) (string, error) {
+	normalized := strings.Trim(filepath.ToSlash(pattern), "/")
+	if _, err := path.Match(normalized, ""); err != nil || normalized == "" {
+		return "", fmt.Errorf("invalid filename pattern %q", pattern)
+	}
+	// no slashes: match the name at any depth
+	if !strings.Contains(normalized, "/") {
+		normalized = "**/" + normalized
+	}
+	return normalized, nil
+}
+
+// exclude takes precedence; nil filter matches everything
+func (flt *fobjFilter) match(rel string) bool {
+	if flt == nil {
+		return true
+	}
+	if matchAnyGlob(flt.exclude, rel) || (len(flt.include) > 0 && !matchAnyGlob(flt.include, rel)) {
+		flt.filtered++
+		return false
+	}
+	return true
+}
+
+func (flt *fobjFilter) count() int {
+	if flt == nil {
+		return 0
+	}
+	return flt.filtered
+}
+
+func (flt *fobjFilter) countIgnored() int {
+	if flt == nil {
+		return 0
+	}
+	return flt.ignored
+}
+
+// `.aisignore` files are looked up in the (source) directory and its subdirectories
+func (flt *fobjFilter) newIgnore(dir string) *aisIgnore {
+	if flt == nil || !flt.aisignore {
+		return nil
+	}
+	return newAisIgnore(dir)
+}
+
+func (flt *fobjFilter) addIgnored(ign *aisIgnore) {
+	if ign != nil {
+		flt.ignored += ign.count()
+	}
+}
+
+// match the relative path or any of its parent directories
+// (e.g., pattern ".git" matches ".git/objects/pack/xyz")
+func matchAnyGlob(patterns []string, rel string) bool {
+	for _, pattern := range patterns {
+		for dir := rel; dir != "." && dir != "/" && dir != ""; dir = path.Dir(dir) {
+			if globMatch(pattern, dir) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// slash-separated shell pattern where '**' matches zero or more path components
+func globMatch(pattern, name string) bool {
+	return _globMatch(strings.Split(pattern, "/"), strings.Split(name, "/"))
+}
+
+func _globMatch(pattern, parts []string) bool {
+	for len(pattern) > 0 {
+		if pattern[0] == "**" {
+			for i := 0; i <= len(parts); i++ {
+				if _globMatch(pattern[1:], parts[i:]) {
+					return true
+				}
+			}
+			return false
+		}
+		if len(parts) == 0 {
+			return false
+		}
+		if matched, _ := path.Match(pattern[0], parts[0]); !matched {
+			return false
+		}
+		pattern, parts = pattern[1:], parts[1:]
+	}
+	return len(parts) == 0
+}
+
 ///////////////
 // fobjSlice //
 ///////////////
@@ -0,0 +1,46 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"testing"
+
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestFobjFilter(t *testing.T) {
+	tests := []struct {
+		include, exclude []string
+		rel              string
+		match            bool
+	}{
+		{nil, []string{".git"}, ".git/objects/pack/abc", false},
+		{nil, []string{".git"}, "src/.gitignore", true},
+		{nil, []string{"*.tmp"}, "a/b/c.tmp", false},
+		{nil, []string{"a/*.tmp"}, "a/b/c.tmp", true},
+		{nil, []string{"a/**/*.tmp"}, "a/b/c.tmp", false},
+		{nil, []string{"a/**/*.tmp"}, "a/c.tmp", false},
+		{[]string{"src/**/*.go"}, nil, "src/cli/main.go", true},
+		{[]string{"src/**/*.go"}, nil, "docs/main.go", false},
+		{[]string{"docs"}, nil, "docs/img/x.png", true},
+		{[]string{"*.go"}, []string{"vendor"}, "vendor/x/y.go", false}, // exclude takes precedence
+		{[]string{"*.go"}, []string{"vendor"}, "x/y.go", true},
+	}
+	for _, test := range tests {
+		flt := &fobjFilter{}
+		for _, p := range test.include {
+			p, err := normGlob(p)
+			tassert.CheckFatal(t, err)
+			flt.include = append(flt.include, p)
+		}
+		for _, p := range test.exclude {
+			p, err := normGlob(p)
+			tassert.CheckFatal(t, err)
+			flt.exclude = append(flt.exclude, p)
+		}
+		tassert.Errorf(t, flt.match(test.rel) == test.match, "include %v, exclude %v: %q expected match=%t",
+			test.include, test.exclude, test.rel, test.match)
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"text/tabwriter"
 	"time"
 
 	"github.com/NVIDIA/aistore/api"
@@ -157,7 +158,7 @@ func flattenXactStats(snap *cluster.Snap, units string) nvpairList {
 			if strings.HasSuffix(k, ".size") {
 				val := v.(string)
 				if i, err := strconv.ParseInt(val, 10, 64); err == nil {
-					value = cos.ToSizeIEC(i, 2)
+					value = teb.FmtSize(i, units, 2)
 				}
 			}
 			if value == "" {
@@ -185,6 +186,197 @@ func getXactSnap(xargs xact.ArgsMsg) (*cluster.Snap, error) {
 	return nil, nil
 }
 
+// `ais show job --json`: stable, versioned schema for monitoring tools to consume.
+// The field names below are part of the (CLI) API and must not change across minor releases;
+// new fields can be added, in which case `xactsJSONVersion` is incremented.
+const xactsJSONVersion = 1
+
+type (
+	xactsJSON struct {
+		Version  int         `json:"version"`
+		Xactions []*xactJSON `json:"xactions"`
+	}
+	// one per (xaction, node) pair
+	xactJSON struct {
+		Kind      string     `json:"kind"`
+		ID        string     `json:"id"`
+		Node      string     `json:"node"`
+		Bucket    string     `json:"bucket,omitempty"`
+		SrcBucket string     `json:"src_bucket,omitempty"`
+		DstBucket string     `json:"dst_bucket,omitempty"`
+		StartTime time.Time  `json:"start_time"`
+		EndTime   *time.Time `json:"end_time,omitempty"` // omitted when running
+		Bytes     int64      `json:"bytes"`
+		Objects   int64      `json:"objects"`
+		Error     string     `json:"error,omitempty"`
+		State     string     `json:"state"` // one of: "running", "idle", "finished", "aborted" (see `xactState`)
+	}
+)
+
+// stable (JSON) state of a given xaction - compare with `teb.FmtXactStatus` (display)
+func xactState(snap *cluster.Snap) string {
+	switch {
+	case snap.AbortedX:
+		return jobStateAborted
+	case !snap.EndTime.IsZero():
+		return jobStateFinished
+	case snap.IsIdle():
+		return jobStateIdle
+	default:
+		return jobStateRunning
+	}
+}
+
+func fmtXactState(state string) string {
+	switch state {
+	case jobStateAborted:
+		return teb.XactStateAborted
+	case jobStateFinished:
+		return teb.XactStateFinished
+	case jobStateIdle:
+		return teb.XactStateIdle
+	default:
+		return teb.XactStateRunning
+	}
+}
+
+func printXactsJSON(xs xact.MultiSnap, daemonID string) (int, error) {
+	out := &xactsJSON{Version: xactsJSONVersion, Xactions: []*xactJSON{}}
+	out.add(xs, daemonID)
+	return len(out.Xactions), teb.Print(out, "", teb.Jopts(true))
+}
+
+func (out *xactsJSON) add(xs xact.MultiSnap, daemonID string) {
+	errs := make(map[string]string, 4) // by xaction ID
+	tids := make([]string, 0, len(xs))
+	for tid := range xs {
+		tids = append(tids, tid)
+	}
+	sort.Strings(tids)
+	for _, tid := range tids {
+		if daemonID != "" && daemonID != tid {
+			continue
+		}
+		for _, snap := range xs[tid] {
+			x := &xactJSON{
+				Kind:      snap.Kind,
+				ID:        snap.ID,
+				Node:      tid,
+				StartTime: snap.StartTime,
+				Bytes:     snap.Stats.Bytes,
+				Objects:   snap.Stats.Objs,
+				State:     xactState(snap),
+			}
+			if !snap.Bck.IsEmpty() {
+				x.Bucket = snap.Bck.Cname("")
+			}
+			if !snap.SrcBck.IsEmpty() {
+				x.SrcBucket, x.DstBucket = snap.SrcBck.Cname(""), snap.DstBck.Cname("")
+			}
+			if !snap.EndTime.IsZero() {
+				end := snap.EndTime
+				x.EndTime = &end
+			}
+			if snap.IsAborted() {
+				errMsg, ok := errs[snap.ID]
+				if !ok {
+					// best effort (not all xactions are tracked by IC)
+					if status, err := api.GetOneXactionStatus(apiBP, xact.ArgsMsg{ID: snap.ID, Kind: snap.Kind}); err == nil {
+						errMsg = status.ErrMsg
+					}
+					errs[snap.ID] = errMsg
+				}
+				x.Error = errMsg
+			}
+			out.Xactions = append(out.Xactions, x)
+		}
+	}
+}
+
+// time window to select jobs that were running at any point in-between `since` and `until`
+// (see `jobsSinceFlag`, `jobsUntilFlag`)
+type jobsWindow struct {
+	since, until time.Time
+}
+
+var untilLayouts = []string{time.RFC3339, "2006-01-02T15:04:05", "2006-01-02 15:04:05", "2006-01-02 15:04", "2006-01-02"}
+
+// returns nil when neither `--since` nor `--until` is specified
+func parseJobsWindow(c *cli.Context) (*jobsWindow, error) {
+	if !flagIsSet(c, jobsSinceFlag) && !flagIsSet(c, jobsUntilFlag) {
+		return nil, nil
+	}
+	tw := &jobsWindow{}
+	if flagIsSet(c, jobsSinceFlag) {
+		dur := parseDurationFlag(c, jobsSinceFlag)
+		if dur <= 0 {
+			return nil, fmt.Errorf("invalid %s value: expecting positive duration", qflprn(jobsSinceFlag))
+		}
+		tw.since = time.Now().Add(-dur)
+	}
+	if flagIsSet(c, jobsUntilFlag) {
+		var (
+			val = parseStrFlag(c, jobsUntilFlag)
+			err error
+		)
+		for _, layout := range untilLayouts {
+			if layout == time.RFC3339 {
+				tw.until, err = time.Parse(layout, val)
+			} else {
+				tw.until, err = time.ParseInLocation(layout, val, time.Local)
+			}
+			if err == nil {
+				break
+			}
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s value %q: expecting timestamp in one of the formats: %v",
+				qflprn(jobsUntilFlag), val, untilLayouts)
+		}
+		if !tw.since.IsZero() && tw.until.Before(tw.since) {
+			return nil, fmt.Errorf("empty time window: %s precedes %s", qflprn(jobsUntilFlag), qflprn(jobsSinceFlag))
+		}
+	}
+	return tw, nil
+}
+
+// whether a job that started at `start` and ended at `end` (zero if still running)
+// was running at any point within the window
+func (tw *jobsWindow) overlaps(start, end time.Time) bool {
+	if !tw.since.IsZero() && !end.IsZero() && end.Before(tw.since) {
+		return false
+	}
+	if !tw.until.IsZero() && start.After(tw.until) {
+		return false
+	}
+	return true
+}
+
+// time window (if specified) implies showing finished jobs as well, unless explicitly '--active'
+func showAllJobs(c *cli.Context) bool {
+	if flagIsSet(c, activeJobsFlag) {
+		return false
+	}
+	return flagIsSet(c, allJobsFlag) || flagIsSet(c, jobsSinceFlag) || flagIsSet(c, jobsUntilFlag)
+}
+
+// (in place) keep only those that ran within the time window, if specified
+func filterXactsWindow(c *cli.Context, xs xact.MultiSnap) {
+	tw, _ := parseJobsWindow(c)
+	if tw == nil {
+		return
+	}
+	for tid, snaps := range xs {
+		filtered := snaps[:0]
+		for _, snap := range snaps {
+			if tw.overlaps(snap.StartTime, snap.EndTime) {
+				filtered = append(filtered, snap)
+			}
+		}
+		xs[tid] = filtered
+	}
+}
+
 func queryXactions(xargs xact.ArgsMsg) (xs xact.MultiSnap, err error) {
 	orig := apiBP.Client.Timeout
 	if !xargs.OnlyRunning {
@@ -282,3 +474,278 @@ func extractXactIDsForKind(xs xact.MultiSnap, xactKind string) (xactIDs []string
 	})
 	return
 }
+
+//
+// `ais show job --tree`
+//
+
+type jobTreeNode struct {
+	Kind     string         `json:"kind"`
+	ID       string         `json:"id"`
+	ParentID string         `json:"parent_id,omitempty"`
+	Bucket   string         `json:"bucket,omitempty"`
+	Objects  int64          `json:"objects"`
+	Bytes    int64          `json:"bytes"`
+	State    string         `json:"state"`
+	Nodes    []*xactJSON    `json:"nodes"`              // per-target instances
+	Children []*jobTreeNode `json:"children,omitempty"` // jobs spawned by this one
+	start    time.Time
+	end      time.Time
+}
+
+// group snaps by job ID and nest jobs under their respective parents;
+// jobs whose parent is not present (e.g., finished and already gone) are returned as roots
+func buildJobTree(xs xact.MultiSnap, daemonID string) (roots []*jobTreeNode) {
+	all := make(map[string]*jobTreeNode, 8)
+	for tid, snaps := range xs {
+		if daemonID != "" && daemonID != tid {
+			continue
+		}
+		for _, snap := range snaps {
+			jn, ok := all[snap.ID]
+			if !ok {
+				jn = &jobTreeNode{Kind: snap.Kind, ID: snap.ID}
+				all[snap.ID] = jn
+			}
+			if jn.ParentID == "" && snap.ParentID != snap.ID {
+				jn.ParentID = snap.ParentID
+			}
+			x := &xactJSON{
+				Kind:      snap.Kind,
+				ID:        snap.ID,
+				Node:      tid,
+				StartTime: snap.StartTime,
+				Bytes:     snap.Stats.Bytes,
+				Objects:   snap.Stats.Objs,
+				State:     xactState(snap),
+			}
+			if !snap.Bck.IsEmpty() {
+				x.Bucket = snap.Bck.Cname("")
+			}
+			if !snap.SrcBck.IsEmpty() {
+				x.SrcBucket, x.DstBucket = snap.SrcBck.Cname(""), snap.DstBck.Cname("")
+			}
+			if !snap.EndTime.IsZero() {
+				end := snap.EndTime
+				x.EndTime = &end
+			}
+			jn.add(x)
+		}
+	}
+	for _, jn := range all {
+		jn.fin()
+		if parent, ok := all[jn.ParentID]; ok && !jn.isAncestorOf(parent, all) {
+			parent.Children = append(parent.Children, jn)
+		} else {
+			roots = append(roots, jn)
+		}
+	}
+	for _, jn := range all {
+		sortJobTree(jn.Children)
+	}
+	sortJobTree(roots)
+	return
+}
+
+func (jn *jobTreeNode) add(x *xactJSON) {
+	jn.Nodes = append(jn.Nodes, x)
+	jn.Objects += x.Objects
+	jn.Bytes += x.Bytes
+	if jn.Bucket == "" {
+		if x.SrcBucket != "" {
+			jn.Bucket = x.SrcBucket + " => " + x.DstBucket
+		} else {
+			jn.Bucket = x.Bucket
+		}
+	}
+	if jn.start.IsZero() || x.StartTime.Before(jn.start) {
+		jn.start = x.StartTime
+	}
+}
+
+// the job is running if running anywhere; otherwise, aborted if aborted anywhere
+func (jn *jobTreeNode) fin() {
+	var running, aborted, idle bool
+	for _, x := range jn.Nodes {
+		switch x.State {
+		case jobStateRunning:
+			running = true
+		case jobStateAborted:
+			aborted = true
+		case jobStateIdle:
+			idle = true
+		}
+		if x.EndTime != nil && x.EndTime.After(jn.end) {
+			jn.end = *x.EndTime
+		}
+	}
+	switch {
+	case running:
+		jn.State, jn.end = jobStateRunning, time.Time{}
+	case idle:
+		jn.State, jn.end = jobStateIdle, time.Time{}
+	case aborted:
+		jn.State = jobStateAborted
+	default:
+		jn.State = jobStateFinished
+	}
+	sort.Slice(jn.Nodes, func(i, j int) bool { return jn.Nodes[i].Node < jn.Nodes[j].Node })
+}
+
+// (paranoid) guard against parent-child cycles
+func (jn *jobTreeNode) isAncestorOf(other *jobTreeNode, all map[string]*jobTreeNode) bool {
+	for i, n := 0, other; n != nil && i < len(all); i++ {
+		if n.ID == jn.ID {
+			return true
+		}
+		n = all[n.ParentID]
+	}
+	return false
+}
+
+func sortJobTree(jns []*jobTreeNode) {
+	sort.Slice(jns, func(i, j int) bool {
+		if !jns[i].start.Equal(jns[j].start) {
+			return jns[i].start.Before(jns[j].start)
+		}
+		return jns[i].ID < jns[j].ID
+	})
+}
+
+// find the job (subtree) by ID, or select subtrees that contain jobs of a given kind
+func filterJobTree(roots []*jobTreeNode, xid, kind string) []*jobTreeNode {
+	if xid == "" && kind == "" {
+		return roots
+	}
+	var out []*jobTreeNode
+	for _, jn := range roots {
+		if xid != "" {
+			if found := jn.find(xid); found != nil {
+				return []*jobTreeNode{found}
+			}
+			continue
+		}
+		if jn.hasKind(kind) {
+			out = append(out, jn)
+		}
+	}
+	return out
+}
+
+func (jn *jobTreeNode) find(xid string) *jobTreeNode {
+	if jn.ID == xid {
+		return jn
+	}
+	for _, child := range jn.Children {
+		if found := child.find(xid); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+func (jn *jobTreeNode) hasKind(kind string) bool {
+	if jn.Kind == kind {
+		return true
+	}
+	for _, child := range jn.Children {
+		if child.hasKind(kind) {
+			return true
+		}
+	}
+	return false
+}
+
+func showJobTree(c *cli.Context, name, xid, daemonID string, bck cmn.Bck) error {
+	if _, err := parseJobsWindow(c); err != nil {
+		return err
+	}
+	var (
+		kind string
+		// children may be of any kind, and may have finished while the parent is still running -
+		// hence, querying all (and filtering afterwards)
+		xargs = xact.ArgsMsg{DaemonID: daemonID, Bck: bck}
+	)
+	if name != "" {
+		kind, _ = xact.GetKindName(name)
+		if kind == "" {
+			return fmt.Errorf("%s is not supported with %s", qflprn(jobsTreeFlag), name)
+		}
+	}
+	xs, err := queryXactions(xargs)
+	if err != nil {
+		return err
+	}
+	roots := filterJobTree(buildJobTree(xs, daemonID), xid, kind)
+	if xid == "" {
+		roots = filterJobRoots(c, roots)
+	}
+	if flagIsSet(c, jsonFlag) {
+		if roots == nil {
+			roots = []*jobTreeNode{}
+		}
+		return teb.Print(roots, "", teb.Jopts(true))
+	}
+	if len(roots) == 0 {
+		if xid != "" {
+			return fmt.Errorf("job %q not found", xid)
+		}
+		n := qflprn(allJobsFlag)
+		fmt.Fprintf(c.App.Writer, "No running jobs. Use %s to show all.\n", n)
+		return nil
+	}
+	printJobTree(c, roots)
+	return nil
+}
+
+// top-level only: running jobs (unless '--all'), and the '--since'/'--until' window
+func filterJobRoots(c *cli.Context, roots []*jobTreeNode) []*jobTreeNode {
+	tw, _ := parseJobsWindow(c)
+	all := showAllJobs(c)
+	out := roots[:0]
+	for _, jn := range roots {
+		if !all && jn.State != jobStateRunning && jn.State != jobStateIdle {
+			continue
+		}
+		if tw != nil && !tw.overlaps(jn.start, jn.end) {
+			continue
+		}
+		out = append(out, jn)
+	}
+	return out
+}
+
+func printJobTree(c *cli.Context, roots []*jobTreeNode) {
+	units, err := parseUnitsFlag(c, unitsFlag)
+	if err != nil {
+		actionWarn(c, err.Error())
+		units = ""
+	}
+	tw := &tabwriter.Writer{}
+	tw.Init(c.App.Writer, 0, 8, 2, ' ', 0)
+	if !flagIsSet(c, noHeaderFlag) {
+		fmt.Fprintln(tw, "JOB\tBUCKET\tOBJECTS\tBYTES\tSTART\tEND\tSTATE")
+	}
+	var walk func(jn *jobTreeNode, indent string)
+	walk = func(jn *jobTreeNode, indent string) {
+		start, end := teb.FmtStartEnd(jn.start, jn.end)
+		fmt.Fprintf(tw, "%s%s\t%s\t%d\t%s\t%s\t%s\t%s\n", indent, jobName(jn.Kind, jn.ID), jn.Bucket,
+			jn.Objects, teb.FmtSize(jn.Bytes, units, 2), start, end, fmtXactState(jn.State))
+		for _, x := range jn.Nodes {
+			var xend time.Time
+			if x.EndTime != nil {
+				xend = *x.EndTime
+			}
+			start, end := teb.FmtStartEnd(x.StartTime, xend)
+			fmt.Fprintf(tw, "%s  %s\t\t%d\t%s\t%s\t%s\t%s\n", indent, cluster.Tname(x.Node),
+				x.Objects, teb.FmtSize(x.Bytes, units, 2), start, end, fmtXactState(x.State))
+		}
+		for _, child := range jn.Children {
+			walk(child, indent+"    ")
+		}
+	}
+	for _, jn := range roots {
+		walk(jn, "")
+	}
+	tw.Flush()
+}
@@ -0,0 +1,45 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/tools/tassert"
+	"github.com/NVIDIA/aistore/xact"
+)
+
+func TestBuildJobTree(t *testing.T) {
+	now := time.Now()
+	xs := xact.MultiSnap{
+		"t1": {
+			{ID: "cln1", Kind: apc.ActStoreCleanup, StartTime: now, EndTime: now.Add(time.Second)},
+			{ID: "lru1", Kind: apc.ActLRU, ParentID: "cln1", StartTime: now.Add(time.Second), Stats: cluster.Stats{Objs: 3}},
+			{ID: "orphan", Kind: apc.ActLRU, ParentID: "gone", StartTime: now.Add(-time.Second)},
+		},
+		"t2": {
+			{ID: "cln1", Kind: apc.ActStoreCleanup, StartTime: now, EndTime: now.Add(time.Second), AbortedX: true},
+			{ID: "lru1", Kind: apc.ActLRU, ParentID: "cln1", StartTime: now, Stats: cluster.Stats{Objs: 4}},
+		},
+	}
+	roots := buildJobTree(xs, "")
+	tassert.Fatalf(t, len(roots) == 2, "expected 2 roots, got %d", len(roots))
+	tassert.Errorf(t, roots[0].ID == "orphan" && roots[1].ID == "cln1", "unexpected order: %s, %s", roots[0].ID, roots[1].ID)
+
+	cln := roots[1]
+	tassert.Errorf(t, len(cln.Nodes) == 2 && cln.State == jobStateAborted, "unexpected %+v", cln)
+	tassert.Fatalf(t, len(cln.Children) == 1, "expected lru1 nested under cln1")
+	lru := cln.Children[0]
+	tassert.Errorf(t, lru.ID == "lru1" && lru.Objects == 7 && lru.State == jobStateRunning, "unexpected %+v", lru)
+
+	tassert.Errorf(t, len(filterJobTree(roots, "lru1", "")) == 1, "expected to find lru1")
+	tassert.Errorf(t, len(filterJobTree(roots, "", apc.ActLRU)) == 2, "expected both roots to contain LRU")
+
+	roots = buildJobTree(xs, "t2")
+	tassert.Errorf(t, len(roots) == 1 && len(roots[0].Children) == 1, "expected a single tree on t2")
+}
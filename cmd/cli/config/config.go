@@ -9,6 +9,8 @@ import (
 	"os"
 	"path/filepath"
 	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
@@ -46,14 +48,37 @@ type (
 	}
 	AliasConfig cos.StrKVs // (see DefaultAliasConfig below)
 
+	// persistent defaults for selected command-line flags; apply only to commands that
+	// have the flag, and only when it is not explicitly specified in the command line;
+	// `refresh` specifies the interval and does not, by itself, enable continuous monitoring
+	// (except `units` that, in addition, applies to all sizes rendered by commands without '--units')
+	DefaultsConfig struct {
+		Refresh     string `json:"refresh,omitempty"`      // '--refresh' (e.g. "5s")
+		Units       string `json:"units,omitempty"`        // '--units' (iec | si | raw)
+		TemplateCap string `json:"template_cap,omitempty"` // '--template-cap' (e.g. "1000000")
+	}
+
+	// named profile - an alternative cluster endpoint along with its (optional) AuthN URL,
+	// token, and defaults; selected via global `ais --profile NAME ...`
+	ProfileConfig struct {
+		URL           string         `json:"url"`
+		AuthURL       string         `json:"auth_url,omitempty"`
+		TokenFile     string         `json:"token_file,omitempty"`
+		SkipVerifyCrt bool           `json:"skip_verify_crt,omitempty"`
+		Defaults      DefaultsConfig `json:"defaults"`
+	}
+	ProfilesConfig map[string]*ProfileConfig
+
 	// all of the above
 	Config struct {
-		Cluster         ClusterConfig `json:"cluster"`
-		Timeout         TimeoutConfig `json:"timeout"`
-		Auth            AuthConfig    `json:"auth"`
-		Aliases         AliasConfig   `json:"aliases"`
-		DefaultProvider string        `json:"default_provider,omitempty"`
-		NoColor         bool          `json:"no_color"`
+		Cluster         ClusterConfig  `json:"cluster"`
+		Timeout         TimeoutConfig  `json:"timeout"`
+		Auth            AuthConfig     `json:"auth"`
+		Aliases         AliasConfig    `json:"aliases"`
+		Defaults        DefaultsConfig `json:"defaults"`
+		Profiles        ProfilesConfig `json:"profiles,omitempty"`
+		DefaultProvider string         `json:"default_provider,omitempty"`
+		NoColor         bool           `json:"no_color"`
 	}
 )
 
@@ -125,10 +150,97 @@ func (a AliasConfig) String() (s string) {
 	return
 }
 
+////////////////////
+// DefaultsConfig //
+////////////////////
+
+// flag name => configured default value ("" if none)
+func (d *DefaultsConfig) Get(flagName string) string {
+	switch flagName {
+	case "refresh":
+		return d.Refresh
+	case "units":
+		return d.Units
+	case "template-cap":
+		return d.TemplateCap
+	}
+	return ""
+}
+
+func (d *DefaultsConfig) Validate() error {
+	if d.Refresh != "" {
+		v := d.Refresh
+		if _, err := strconv.ParseInt(v, 10, 64); err == nil {
+			v += "s" // (seconds is the default unit - same as DurationFlag)
+		}
+		if _, err := time.ParseDuration(v); err != nil {
+			return fmt.Errorf("invalid defaults.refresh %q: %v", d.Refresh, err)
+		}
+	}
+	if d.TemplateCap != "" {
+		if n, err := strconv.ParseInt(d.TemplateCap, 10, 64); err != nil || n < 0 {
+			return fmt.Errorf("invalid defaults.template_cap %q (expecting non-negative integer)", d.TemplateCap)
+		}
+	}
+	switch d.Units {
+	case "", cos.UnitsIEC, cos.UnitsSI, cos.UnitsRaw:
+	default:
+		return fmt.Errorf("invalid defaults.units %q (expecting one of: %s, %s, %s)",
+			d.Units, cos.UnitsIEC, cos.UnitsSI, cos.UnitsRaw)
+	}
+	return nil
+}
+
+////////////////////
+// ProfilesConfig //
+////////////////////
+
+func (p ProfilesConfig) String() (s string) {
+	names := make([]string, 0, len(p))
+	for name := range p {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	s = "{"
+	for i, name := range names {
+		if i > 0 {
+			s += "; "
+		}
+		s += name + " => " + p[name].URL
+	}
+	s += "}"
+	return
+}
+
+func (p *ProfileConfig) Validate(name string) error {
+	if name == "" || strings.ContainsAny(name, ". \t") {
+		return fmt.Errorf("invalid profile name %q", name)
+	}
+	if err := p.Defaults.Validate(); err != nil {
+		return fmt.Errorf("profile %q: %v", name, err)
+	}
+	return nil
+}
+
 ////////////
 // Config //
 ////////////
 
+// named profile that must exist and must have cluster endpoint
+func (c *Config) Profile(name string) (*ProfileConfig, error) {
+	p, ok := c.Profiles[name]
+	if !ok {
+		if len(c.Profiles) == 0 {
+			return nil, fmt.Errorf("profile %q does not exist (no profiles configured)", name)
+		}
+		return nil, fmt.Errorf("profile %q does not exist (available profiles: %s)", name, c.Profiles)
+	}
+	if p.URL == "" {
+		return nil, fmt.Errorf("profile %q: cluster endpoint (url) is not defined", name)
+	}
+	return p, nil
+}
+
 func (c *Config) validate() (err error) {
 	if c.Timeout.TCPTimeout, err = time.ParseDuration(c.Timeout.TCPTimeoutStr); err != nil {
 		return fmt.Errorf("invalid timeout.tcp_timeout format %q: %v", c.Timeout.TCPTimeoutStr, err)
@@ -142,7 +254,12 @@ func (c *Config) validate() (err error) {
 	if c.Aliases == nil {
 		c.Aliases = DefaultAliasConfig
 	}
-	return nil
+	for name, p := range c.Profiles {
+		if err := p.Validate(name); err != nil {
+			return err
+		}
+	}
+	return c.Defaults.Validate()
 }
 
 func Load() (*Config, error) {
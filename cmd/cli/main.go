@@ -31,14 +31,14 @@ func main() {
 	dispatchInterruptHandler()
 
 	if err := cli.Init(); err != nil {
-		exitf("%v", err)
+		exitf(cli.ExitOther, "%v", err)
 	}
 	if err := cli.Run(cmn.VersionCLI+"."+build, buildtime, os.Args); err != nil {
-		exitf("%v", err)
+		exitf(cli.ExitCode(err), "%v", err)
 	}
 }
 
-func exitf(f string, a ...any) {
+func exitf(code int, f string, a ...any) {
 	fmt.Fprintf(os.Stderr, f+"\n", a...)
-	os.Exit(1)
+	os.Exit(code)
 }
@@ -9,6 +9,7 @@ import (
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/stats"
 )
@@ -27,14 +28,30 @@ const (
 	colVersion   = "VERSION"
 	colBuildTime = "BUILD TIME"
 	colPodName   = "K8s POD"
+	colMsyncLag  = "METASYNC LAG"
 )
 
+// nodes that are more than so many versions behind the primary (see `stats.MetasyncLag*`)
+// get flagged in the METASYNC LAG column
+var MetasyncLagThreshold int64 = 2
+
+// always show VERSION and BUILD TIME columns (by default, shown only when nodes run different versions)
+var ShowVersions bool
+
+var msyncLagNames = []struct{ name, tag string }{
+	{stats.MetasyncLagSmap, "Smap"},
+	{stats.MetasyncLagBMD, "BMD"},
+	{stats.MetasyncLagRMD, "RMD"},
+	{stats.MetasyncLagConfig, "Config"},
+	{stats.MetasyncLagEtlMD, "EtlMD"},
+}
+
 func NewDaeStatus(st *stats.NodeStatus, smap *cluster.Smap, daeType, units string) *Table {
 	switch daeType {
 	case apc.Proxy:
-		return newTableProxies(StstMap{st.Snode.ID(): st}, smap, units)
+		return newTableProxies(StstMap{st.Snode.ID(): st}, smap, units, "")
 	case apc.Target:
-		return newTableTargets(StstMap{st.Snode.ID(): st}, smap, units)
+		return newTableTargets(StstMap{st.Snode.ID(): st}, smap, units, "")
 	default:
 		debug.Assert(false)
 		return nil
@@ -42,11 +59,15 @@ func NewDaeStatus(st *stats.NodeStatus, smap *cluster.Smap, daeType, units strin
 }
 
 func NewDaeMapStatus(ds *StatsAndStatusHelper, smap *cluster.Smap, daeType, units string) *Table {
+	var pver string // to highlight nodes that run a different version
+	if psi, ok := ds.Pmap[smap.Primary.ID()]; ok {
+		pver = psi.Version
+	}
 	switch daeType {
 	case apc.Proxy:
-		return newTableProxies(ds.Pmap, smap, units)
+		return newTableProxies(ds.Pmap, smap, units, pver)
 	case apc.Target:
-		return newTableTargets(ds.Tmap, smap, units)
+		return newTableTargets(ds.Tmap, smap, units, pver)
 	default:
 		debug.Assert(false)
 		return nil
@@ -54,7 +75,7 @@ func NewDaeMapStatus(ds *StatsAndStatusHelper, smap *cluster.Smap, daeType, unit
 }
 
 // proxy(ies)
-func newTableProxies(ps StstMap, smap *cluster.Smap, units string) *Table {
+func newTableProxies(ps StstMap, smap *cluster.Smap, units, pver string) *Table {
 	var (
 		h        = StatsAndStatusHelper{Pmap: ps}
 		pods     = h.pods()
@@ -68,8 +89,9 @@ func newTableProxies(ps StstMap, smap *cluster.Smap, units string) *Table {
 			{name: colUptime},
 			{name: colPodName, hide: len(pods) == 1 && pods[0] == ""},
 			{name: colStatus, hide: len(status) == 1 && status[0] == NodeOnline},
-			{name: colVersion, hide: len(versions) == 1 && len(ps) > 1},
-			{name: colBuildTime, hide: len(versions) == 1 && len(ps) > 1}, // intended
+			{name: colMsyncLag, hide: !h.metasyncLag()},
+			{name: colVersion, hide: !ShowVersions && len(versions) == 1 && len(ps) > 1},
+			{name: colBuildTime, hide: !ShowVersions && len(versions) == 1 && len(ps) > 1}, // intended
 		}
 		table = newTable(cols...)
 	)
@@ -88,7 +110,8 @@ func newTableProxies(ps StstMap, smap *cluster.Smap, units string) *Table {
 				unknownVal,
 				ds.K8sPodName,
 				fcyan(ds.Status),
-				ds.Version,
+				unknownVal,
+				fmtVersion(ds.Version, pver),
 				ds.BuildTime,
 			}
 			table.addRow(row)
@@ -115,7 +138,8 @@ func newTableProxies(ps StstMap, smap *cluster.Smap, units string) *Table {
 			uptime,
 			ds.K8sPodName,
 			ds.Status,
-			ds.Version,
+			fmtMetasyncLag(ds),
+			fmtVersion(ds.Version, pver),
 			ds.BuildTime,
 		}
 		table.addRow(row)
@@ -124,7 +148,7 @@ func newTableProxies(ps StstMap, smap *cluster.Smap, units string) *Table {
 }
 
 // target(s)
-func newTableTargets(ts StstMap, smap *cluster.Smap, units string) *Table {
+func newTableTargets(ts StstMap, smap *cluster.Smap, units, pver string) *Table {
 	var (
 		h        = StatsAndStatusHelper{Tmap: ts}
 		pods     = h.pods()
@@ -141,8 +165,9 @@ func newTableTargets(ts StstMap, smap *cluster.Smap, units string) *Table {
 			{name: colUptime},
 			{name: colPodName, hide: len(pods) == 1 && pods[0] == ""},
 			{name: colStatus, hide: len(status) == 1 && status[0] == NodeOnline},
-			{name: colVersion, hide: len(versions) == 1 && len(ts) > 1},
-			{name: colBuildTime, hide: len(versions) == 1 && len(ts) > 1}, // intended
+			{name: colMsyncLag, hide: !h.metasyncLag()},
+			{name: colVersion, hide: !ShowVersions && len(versions) == 1 && len(ts) > 1},
+			{name: colBuildTime, hide: !ShowVersions && len(versions) == 1 && len(ts) > 1}, // intended
 		}
 		table = newTable(cols...)
 	)
@@ -163,7 +188,8 @@ func newTableTargets(ts StstMap, smap *cluster.Smap, units string) *Table {
 				unknownVal,
 				ds.K8sPodName,
 				fcyan(ds.Status),
-				ds.Version,
+				unknownVal,
+				fmtVersion(ds.Version, pver),
 				ds.BuildTime,
 			}
 			table.addRow(row)
@@ -199,10 +225,46 @@ func newTableTargets(ts StstMap, smap *cluster.Smap, units string) *Table {
 			uptime,
 			ds.K8sPodName,
 			ds.Status,
-			ds.Version,
+			fmtMetasyncLag(ds),
+			fmtVersion(ds.Version, pver),
 			ds.BuildTime,
 		}
 		table.addRow(row)
 	}
 	return table
 }
+
+// highlight partially upgraded (or downgraded) nodes
+func fmtVersion(ver, pver string) string {
+	if pver == "" || ver == "" || ver == pver {
+		return ver
+	}
+	return fred(ver)
+}
+
+// e.g. "Smap 3, BMD 1" (highlighted when above the threshold)
+func fmtMetasyncLag(ds *stats.NodeStatus) string {
+	var (
+		s      string
+		maxLag int64
+	)
+	for _, n := range msyncLagNames {
+		lag := ds.Tracker[n.name].Value
+		if lag <= 0 {
+			continue
+		}
+		if s != "" {
+			s += ", "
+		}
+		s += fmt.Sprintf("%s %d", n.tag, lag)
+		maxLag = cos.MaxI64(maxLag, lag)
+	}
+	switch {
+	case s == "":
+		return unknownVal
+	case maxLag > MetasyncLagThreshold:
+		return fred(s)
+	default:
+		return s
+	}
+}
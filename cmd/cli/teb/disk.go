@@ -9,6 +9,8 @@ import (
 	"strings"
 
 	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/ios"
 	"github.com/NVIDIA/aistore/stats"
 )
 
@@ -19,6 +21,10 @@ const (
 	colWrite    = "WRITE"
 	colWriteAvg = "WRITE(avg size)"
 	colUtil     = "UTIL(%)"
+	colLat50    = "LAT(p50)"
+	colLat95    = "LAT(p95)"
+	colLat99    = "LAT(p99)"
+	colQueue    = "QUEUE"
 )
 
 func NewDiskTab(dsh []DiskStatsHelper, smap *cluster.Smap, regex *regexp.Regexp, units, totalsHdr string) *Table {
@@ -31,6 +37,10 @@ func NewDiskTab(dsh []DiskStatsHelper, smap *cluster.Smap, regex *regexp.Regexp,
 		{name: colWrite},
 		{name: colWriteAvg},
 		{name: colUtil},
+		{name: colLat50},
+		{name: colLat95},
+		{name: colLat99},
+		{name: colQueue},
 	}
 	if regex != nil {
 		cols = _flt(cols, regex)
@@ -63,6 +73,18 @@ func NewDiskTab(dsh []DiskStatsHelper, smap *cluster.Smap, regex *regexp.Regexp,
 		if _idx(cols, colUtil) >= 0 {
 			row = append(row, FmtStatValue("", "", stat.Util, units)+"%")
 		}
+		if _idx(cols, colLat50) >= 0 {
+			row = append(row, FmtStatValue("", stats.KindLatency, stat.Lat50, units))
+		}
+		if _idx(cols, colLat95) >= 0 {
+			row = append(row, FmtStatValue("", stats.KindLatency, stat.Lat95, units))
+		}
+		if _idx(cols, colLat99) >= 0 {
+			row = append(row, FmtStatValue("", stats.KindLatency, stat.Lat99, units))
+		}
+		if _idx(cols, colQueue) >= 0 {
+			row = append(row, FmtStatValue("", "", stat.Aqu, units))
+		}
 
 		if ds.TargetID == totalsHdr {
 			row[len(row)-1] += fcyan(" ---")
@@ -97,3 +119,17 @@ func _idx(cols []*header, name string) int {
 	}
 	return -1
 }
+
+// aggregate disk stats (e.g., all disks of a given target or mountpath):
+// latencies - the slowest disk (max), queue sizes - total
+func AddDiskStats(dst, src *ios.DiskStats) {
+	dst.RBps += src.RBps
+	dst.Ravg += src.Ravg
+	dst.WBps += src.WBps
+	dst.Wavg += src.Wavg
+	dst.Util += src.Util
+	dst.Lat50 = cos.MaxI64(dst.Lat50, src.Lat50)
+	dst.Lat95 = cos.MaxI64(dst.Lat95, src.Lat95)
+	dst.Lat99 = cos.MaxI64(dst.Lat99, src.Lat99)
+	dst.Aqu += src.Aqu
+}
@@ -17,8 +17,9 @@ var (
 	fred, fcyan func(format string, a ...any) string
 )
 
-func Init(w io.Writer, noColor bool) {
+func Init(w io.Writer, noColor bool, units string) {
 	Writer = w
+	defaultUnits = units
 	if noColor {
 		fred, fcyan = fmt.Sprintf, fmt.Sprintf
 	} else {
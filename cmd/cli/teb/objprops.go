@@ -10,12 +10,16 @@ import (
 	"github.com/NVIDIA/aistore/api/apc"
 )
 
+// client-side only: remaining time-to-live of an object that has `cmn.ExpiresObjMD`
+// custom metadata (computed from the listed "custom" property)
+const ObjPropTTL = "ttl"
+
 var (
 	// ObjectPropsMap matches ObjEntry field
 	ObjectPropsMap = map[string]string{
 		apc.GetPropsName:     "{{FormatNameArch $obj.Name $obj.Flags}}",
 		apc.GetPropsSize:     "{{FormatBytesSig $obj.Size 2}}",
-		apc.GetPropsChecksum: "{{$obj.Checksum}}",
+		apc.GetPropsChecksum: "{{FormatObjCksum $obj}}",
 		apc.GetPropsAtime:    "{{$obj.Atime}}",
 		apc.GetPropsVersion:  "{{$obj.Version}}",
 		apc.GetPropsLocation: "{{$obj.Location}}",
@@ -23,6 +27,7 @@ var (
 		apc.GetPropsStatus:   "{{FormatObjStatus $obj}}",
 		apc.GetPropsCopies:   "{{$obj.Copies}}",
 		apc.GetPropsCached:   "{{FormatObjIsCached $obj}}",
+		ObjPropTTL:           "{{FormatObjTTL $obj.Custom}}",
 	}
 )
 
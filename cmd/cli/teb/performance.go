@@ -26,6 +26,7 @@ type PerfTabCtx struct {
 	TotalsHdr string
 	AllCols   bool // show all-zero columns
 	AvgSize   bool // compute average size on the fly (and show it), e.g.: `get.size/get.n`
+	Rates     bool // counters are per-second deltas between successive samples (`--delta`)
 }
 
 func NewPerformanceTab(st StstMap, c *PerfTabCtx) (*Table, int /*numNZ non-zero metrics OR bad status*/, error) {
@@ -87,7 +88,7 @@ func NewPerformanceTab(st StstMap, c *PerfTabCtx) (*Table, int /*numNZ non-zero
 	cols = _addStatus(cols, st)
 
 	// 5. convert metric to column names
-	printedColumns := _metricsToColNames(cols, c.Metrics, n2n)
+	printedColumns := _metricsToColNames(cols, c.Metrics, n2n, c.Rates)
 
 	// 6. regex to filter columns, if spec-ed
 	if c.Regex != nil {
@@ -221,7 +222,7 @@ func _addStatus(cols []*header, st StstMap) []*header {
 // convert metric names to column names
 // NOTE hard-coded translation constants `.lst` => LIST, et al.
 // for naming conventions, see "naming" or "convention" under stats/*
-func _metricsToColNames(cols []*header, metrics, n2n cos.StrKVs) (printedColumns []*header) {
+func _metricsToColNames(cols []*header, metrics, n2n cos.StrKVs, rates bool) (printedColumns []*header) {
 	printedColumns = make([]*header, len(cols))
 	for colIdx, h := range cols {
 		var (
@@ -264,6 +265,8 @@ func _metricsToColNames(cols []*header, metrics, n2n cos.StrKVs) (printedColumns
 			name += "(bw)"
 		case kind == stats.KindLatency:
 			name += "(latency)"
+		case kind == stats.KindCounter && rates:
+			name += "(/s)"
 		case kind == stats.KindSize:
 			if n2n != nil && _present(cols, metrics, h.name, n2n) {
 				name += "(cumulative, average)"
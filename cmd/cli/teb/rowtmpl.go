@@ -0,0 +1,73 @@
+// Package teb contains templates and (templated) tables to format CLI output.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package teb
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"text/template"
+)
+
+// RowTemplate is a user-defined Go text/template (e.g., '{{.Name}}\t{{.Size}}') that renders
+// each row (a listed object, object's properties, etc.) on a separate line, with all
+// the helper functions of the built-in templates (e.g., '{{FormatBytesSig .Size 2}}')
+type RowTemplate struct {
+	t *template.Template
+}
+
+// escape sequences that are likely to be passed literally - within single quotes
+var rowEscapes = strings.NewReplacer(`\t`, "\t", `\n`, "\n")
+
+// NewRowTemplate parses the template and validates it against the (zero value of the) row type,
+// to fail early on unknown fields
+func NewRowTemplate(text string, row any, units string) (*RowTemplate, error) {
+	text = rowEscapes.Replace(text)
+	if !strings.HasSuffix(text, "\n") {
+		text += "\n"
+	}
+	fmap := make(template.FuncMap, len(funcMap))
+	for k, v := range funcMap {
+		fmap[k] = v
+	}
+	for k, v := range FuncMapUnits(units) {
+		fmap[k] = v
+	}
+	t, err := template.New("format-template").Funcs(fmap).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid format template: %v", err)
+	}
+	// NOTE: other execution errors (e.g., nil pointer evaluating ...) may be legitimate with a zero value
+	if err := t.Execute(io.Discard, row); err != nil && strings.Contains(err.Error(), "can't evaluate field") {
+		return nil, fmt.Errorf("invalid format template: %v (available fields: %s)",
+			err, strings.Join(RowFields(row), ", "))
+	}
+	return &RowTemplate{t}, nil
+}
+
+func (rt *RowTemplate) Print(row any) error { return rt.t.Execute(Writer, row) }
+
+// RowFields returns the names of the fields that can be used in the row template, including
+// nested ones (e.g., "EC.DataSlices") and those of the embedded structs
+func RowFields(row any) (names []string) {
+	return _rowFields(reflect.Indirect(reflect.ValueOf(row)).Type(), "", names)
+}
+
+func _rowFields(t reflect.Type, prefix string, names []string) []string {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		switch {
+		case !f.IsExported():
+		case f.Anonymous && f.Type.Kind() == reflect.Struct:
+			names = _rowFields(f.Type, prefix, names)
+		case f.Type.Kind() == reflect.Struct && f.Type.Name() == "":
+			names = _rowFields(f.Type, prefix+f.Name+".", names)
+		default:
+			names = append(names, prefix+f.Name)
+		}
+	}
+	return names
+}
@@ -0,0 +1,54 @@
+// Package teb contains templates and (templated) tables to format CLI output.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package teb
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestFormatTemplate(t *testing.T) {
+	var (
+		out   bytes.Buffer
+		saved = Writer
+	)
+	Writer = &out
+	defer func() { Writer = saved }()
+
+	rt, err := NewRowTemplate(`{{.Name}}\t{{.Size}}\t{{FormatBytesSig .Size 1}}`, cmn.LsoEntry{}, cos.UnitsIEC)
+	tassert.CheckFatal(t, err)
+	for _, en := range []*cmn.LsoEntry{{Name: "a.jpg", Size: 2048}, {Name: "b/c.txt", Size: 1}} {
+		tassert.CheckFatal(t, rt.Print(en))
+	}
+	tassert.Errorf(t, out.String() == "a.jpg\t2048\t2.0KiB\nb/c.txt\t1\t1B\n", "unexpected output %q", out.String())
+
+	// object properties, including embedded and nested fields
+	out.Reset()
+	rt, err = NewRowTemplate("{{.Name}} {{.Ver}} {{.EC.DataSlices}}\n", cmn.ObjectProps{}, "")
+	tassert.CheckFatal(t, err)
+	props := &cmn.ObjectProps{Name: "o", ObjAttrs: cmn.ObjAttrs{Ver: "3"}}
+	props.EC.DataSlices = 2
+	tassert.CheckFatal(t, rt.Print(props))
+	tassert.Errorf(t, out.String() == "o 3 2\n", "unexpected output %q", out.String())
+
+	// invalid
+	_, err = NewRowTemplate("{{.Name}} {{.Sz}}", cmn.LsoEntry{}, "")
+	tassert.Errorf(t, err != nil && strings.Contains(err.Error(), "Sz") && strings.Contains(err.Error(), "Size"),
+		"expected unknown field error listing available fields, got %v", err)
+	_, err = NewRowTemplate("{{.Name", cmn.LsoEntry{}, "")
+	tassert.Errorf(t, err != nil, "expected parse error")
+	_, err = NewRowTemplate("{{NoSuchFunc .Name}}", cmn.LsoEntry{}, "")
+	tassert.Errorf(t, err != nil, "expected unknown function error")
+
+	fields := RowFields(cmn.ObjectProps{})
+	for _, name := range []string{"Bck", "Cksum", "Size", "Name", "Mirror.Copies", "EC.IsECCopy", "Present"} {
+		tassert.Errorf(t, cos.StringInSlice(name, fields), "missing %q in %v", name, fields)
+	}
+}
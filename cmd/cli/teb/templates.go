@@ -24,6 +24,8 @@ const (
 	NotSetVal  = "-"
 
 	UnknownStatusVal = "n/a"
+
+	HeadCksumSuffix = "*" // checksum obtained via HEAD(object) rather than listed
 )
 
 const rebalanceExpirationTime = 5 * time.Minute
@@ -37,10 +39,10 @@ const (
 )
 
 const (
-	xactStateFinished = "Finished"
-	xactStateRunning  = "Running"
-	xactStateIdle     = "Idle"
-	xactStateAborted  = "Aborted"
+	XactStateFinished = "Finished"
+	XactStateRunning  = "Running"
+	XactStateIdle     = "Idle"
+	XactStateAborted  = "Aborted"
 )
 
 // output templates
@@ -162,6 +164,21 @@ const (
 		"{{FormatEnd $xctn.StartTime $xctn.EndTime}}\t " +
 		"{{FormatXactState $xctn}}\n"
 
+	// jobs of any kind that share a given bucket (`ais show job --bck`): one table, bucket column shows src => dst when applicable
+	XactAnyBucketTmpl      = xactBucketHdr + XactNoHdrAnyBucketTmpl
+	XactNoHdrAnyBucketTmpl = "{{range $daemon := . }}" + xactAnyBucketBodyAll + "{{end}}"
+
+	xactAnyBucketBodyAll = "{{range $key, $xctn := $daemon.XactSnaps}}" + xactAnyBucketBodyOne + "{{end}}"
+	xactAnyBucketBodyOne = "{{ $daemon.DaemonID }}\t " +
+		"{{if $xctn.ID}}{{$xctn.ID}}{{else}}-{{end}}\t " +
+		"{{$xctn.Kind}}\t " +
+		"{{if $xctn.SrcBck.Name}}{{FormatBckName $xctn.SrcBck}} => {{FormatBckName $xctn.DstBck}}{{else}}{{FormatBckName $xctn.Bck}}{{end}}\t " +
+		"{{if (eq $xctn.Stats.Objs 0) }}-{{else}}{{$xctn.Stats.Objs}}{{end}}\t " +
+		"{{if (eq $xctn.Stats.Bytes 0) }}-{{else}}{{FormatBytesSig $xctn.Stats.Bytes 2}}{{end}}\t " +
+		"{{FormatStart $xctn.StartTime $xctn.EndTime}}\t " +
+		"{{FormatEnd $xctn.StartTime $xctn.EndTime}}\t " +
+		"{{FormatXactState $xctn}}\n"
+
 	// same as above for: no bucket column
 	XactNoBucketTmpl      = xactNoBucketHdr + XactNoHdrNoBucketTmpl
 	XactNoHdrNoBucketTmpl = "{{range $daemon := . }}" + xactNoBucketBodyAll + "{{end}}"
@@ -247,6 +264,18 @@ const (
 		"{{FormatBckName $v.Bck}}\t {{$v.ObjectCnt}}\t {{$v.Misplaced}}\t {{$v.MissingCopies}}\n" +
 		"{{end}}"
 
+	// `job wait --all`
+	JobWaitAllTmpl = "JOB\t ID\t STATUS\t ELAPSED\t ERROR\n" +
+		"{{range $j := . }}" +
+		"{{$j.Kind}}\t {{$j.ID}}\t {{$j.Status}}\t {{FormatMilli $j.Elapsed}}\t {{if $j.Err}}{{$j.Err}}{{else}}-{{end}}\n" +
+		"{{end}}"
+
+	// `show object --versions`
+	ObjVersionsTmpl = "VERSION ID\t SIZE\t MODIFIED\t LATEST\n" +
+		"{{range $v := . }}" +
+		"{{$v.ID}}\t {{FormatBytesSig $v.Size 2}}\t {{$v.Mtime}}\t {{FormatBool $v.IsLatest}}\n" +
+		"{{end}}"
+
 	// For `object put` mass uploader. A caller adds to the template
 	// total count and size. That is why the template ends with \t
 	MultiPutTmpl = "Files to upload:\nEXTENSION\t COUNT\t SIZE\n" +
@@ -314,6 +343,14 @@ const (
 		"{{ $bck }}\t{{ FormatACL $bck.Access }}\n" +
 		"{{end}}{{end}}"
 
+	AuthNRoleEffectiveTmpl = "Role\t{{ .Role }}\n" +
+		"{{ if .Admin }}Admin\tyes (all operations allowed)\n{{ end }}" +
+		"{{ if ne (len .Perms) 0 }}" +
+		"SCOPE\tOBJECT\tBUCKET\tCLUSTER\n" +
+		"{{ range $p := .Perms }}" +
+		"{{ $p.Scope }}\t{{ JoinList $p.Object }}\t{{ JoinList $p.Bucket }}\t{{ JoinList $p.Cluster }}\n" +
+		"{{end}}{{end}}"
+
 	// `search`
 	SearchTmpl = "{{ JoinListNL . }}\n"
 
@@ -331,6 +368,9 @@ const (
 
 		"{{range $k, $v := $p.TargetCDF.Mountpaths}}" +
 		"{{if (IsEqS $k $mp)}}{{$v.FS}}{{end}}" +
+		"{{end}}" +
+		"{{range $k, $v := $p.IOsummary}}" +
+		"{{if (IsEqS $k $mp)}}{{$v}}{{end}}" +
 		"{{end}}\n" +
 
 		"{{end}}{{end}}" +
@@ -385,16 +425,18 @@ var (
 	// - `altMap template.FuncMap` below
 	funcMap = template.FuncMap{
 		// formatting
-		"FormatBytesSig":    func(size int64, digits int) string { return FmtSize(size, cos.UnitsIEC, digits) },
-		"FormatBytesUns":    func(size uint64, digits int) string { return FmtSize(int64(size), cos.UnitsIEC, digits) },
-		"FormatMAM":         func(u int64) string { return fmt.Sprintf("%-10s", FmtSize(u, cos.UnitsIEC, 2)) },
-		"FormatMilli":       func(dur cos.Duration) string { return fmtMilli(dur, cos.UnitsIEC) },
+		"FormatBytesSig":    func(size int64, digits int) string { return FmtSize(size, "", digits) },
+		"FormatBytesUns":    func(size uint64, digits int) string { return FmtSize(int64(size), "", digits) },
+		"FormatMAM":         func(u int64) string { return fmt.Sprintf("%-10s", FmtSize(u, "", 2)) },
+		"FormatMilli":       func(dur cos.Duration) string { return fmtMilli(dur, "") },
 		"FormatStart":       func(s, e time.Time) string { res, _ := FmtStartEnd(s, e); return res },
 		"FormatEnd":         func(s, e time.Time) string { _, res := FmtStartEnd(s, e); return res },
 		"FormatEC":          FmtEC,
 		"FormatObjStatus":   fmtObjStatus,
 		"FormatObjCustom":   fmtObjCustom,
+		"FormatObjTTL":      func(custom string) string { return FmtObjTTL(custom, time.Now()) },
 		"FormatObjIsCached": fmtObjIsCached,
+		"FormatObjCksum":    fmtObjCksum,
 		"FormatDaemonID":    fmtDaemonID,
 		"FormatSmap":        fmtSmap,
 		"FormatProxiesSumm": fmtProxiesSumm,
@@ -466,6 +508,20 @@ func (h *StatsAndStatusHelper) buildTimes() []string   { return h.toSlice("build
 func (h *StatsAndStatusHelper) rebalance() []string    { return h.toSlice("rebalance_snap") }
 func (h *StatsAndStatusHelper) pods() []string         { return h.toSlice("k8s_pod_name") }
 
+// true if any node is more than MetasyncLagThreshold versions behind the primary
+func (h *StatsAndStatusHelper) metasyncLag() bool {
+	for _, m := range []StstMap{h.Pmap, h.Tmap} {
+		for _, s := range m {
+			for _, n := range msyncLagNames {
+				if s.Tracker[n.name].Value > MetasyncLagThreshold {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
 // internal helper for the methods above
 func (h *StatsAndStatusHelper) toSlice(jtag string) []string {
 	if jtag == "status" {
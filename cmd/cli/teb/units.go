@@ -17,6 +17,11 @@ import (
 	"k8s.io/apimachinery/pkg/util/duration"
 )
 
+// default units (CLI config `defaults.units`) that apply to all sizes and durations
+// rendered with empty ("") units - that is, when '--units' is not specified or
+// not supported by a given command; empty means IEC
+var defaultUnits string
+
 type unitsCtx struct {
 	units string
 }
@@ -56,8 +61,16 @@ func ValidateUnits(units string) error {
 	}
 }
 
+// the single place to resolve ("" => configured default) units
+func resolveUnits(units string) string {
+	if units == "" {
+		return defaultUnits
+	}
+	return units
+}
+
 func FmtSize(size int64, units string, digits int) string {
-	switch units {
+	switch resolveUnits(units) {
 	case "", cos.UnitsIEC:
 		return cos.ToSizeIEC(size, digits)
 	case cos.UnitsSI:
@@ -95,7 +108,7 @@ func FmtStatValue(name, kind string, value int64, units string) string {
 		return fmtDuration(value, units)
 	}
 	// units (enum)
-	switch units {
+	switch resolveUnits(units) {
 	case cos.UnitsRaw:
 		switch kind {
 		case stats.KindSize:
@@ -129,7 +142,7 @@ func FmtStatValue(name, kind string, value int64, units string) string {
 }
 
 func fmtDuration(ns int64, units string) string {
-	if units == cos.UnitsRaw {
+	if resolveUnits(units) == cos.UnitsRaw {
 		return fmt.Sprintf("%dns", ns)
 	}
 	dur := time.Duration(ns)
@@ -140,7 +153,7 @@ func fmtDuration(ns int64, units string) string {
 }
 
 func fmtMilli(val cos.Duration, units string) string {
-	if units == cos.UnitsRaw {
+	if resolveUnits(units) == cos.UnitsRaw {
 		return fmt.Sprintf("%dns", val)
 	}
 	return cos.FormatMilli(time.Duration(val))
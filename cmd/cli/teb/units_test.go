@@ -0,0 +1,48 @@
+// Package teb contains templates and (templated) tables to format CLI output.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package teb
+
+import (
+	"os"
+	"testing"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestFmtSizeUnits(t *testing.T) {
+	tests := []struct {
+		size     int64
+		units    string
+		expected string
+	}{
+		{999, cos.UnitsIEC, "999B"},
+		{1000, cos.UnitsIEC, "1000B"},
+		{1023, cos.UnitsIEC, "1023B"},
+		{1024, cos.UnitsIEC, "1.00KiB"},
+		{999, cos.UnitsSI, "999B"},
+		{1000, cos.UnitsSI, "1.00KB"},
+		{1024, cos.UnitsSI, "1.02KB"},
+		{1000 * 1000, cos.UnitsSI, "1.00MB"},
+		{1024 * 1024, cos.UnitsIEC, "1.00MiB"},
+		{1000, cos.UnitsRaw, "1000"},
+		{1024, cos.UnitsRaw, "1024"},
+	}
+	defer Init(os.Stdout, true, "")
+	for _, dflt := range []string{"", cos.UnitsSI, cos.UnitsRaw} {
+		Init(os.Stdout, true, dflt)
+		for _, test := range tests {
+			// explicitly specified units take precedence over the configured default
+			res := FmtSize(test.size, test.units, 2)
+			tassert.Errorf(t, res == test.expected, "(%d, %q, default %q): expected %q, got %q",
+				test.size, test.units, dflt, test.expected, res)
+			if test.units == dflt || (dflt == "" && test.units == cos.UnitsIEC) {
+				res = FmtSize(test.size, "", 2)
+				tassert.Errorf(t, res == test.expected, "(%d, default %q): expected %q, got %q",
+					test.size, dflt, test.expected, res)
+			}
+		}
+	}
+}
@@ -38,9 +38,20 @@ func fmtObjStatus(obj *cmn.LsoEntry) string {
 }
 
 func fmtObjIsCached(obj *cmn.LsoEntry) string {
+	if obj.IsStale() {
+		return "yes (stale)"
+	}
 	return FmtBool(obj.CheckExists())
 }
 
+// '*' denotes checksum that is not carried by the listing (see `ls --fill-checksum`)
+func fmtObjCksum(obj *cmn.LsoEntry) string {
+	if obj.IsHeadCksum() {
+		return obj.Checksum + HeadCksumSuffix
+	}
+	return obj.Checksum
+}
+
 // FmtBool returns "yes" if true, else "no"
 func FmtBool(t bool) string {
 	if t {
@@ -56,6 +67,37 @@ func fmtObjCustom(custom string) string {
 	return NotSetVal
 }
 
+// FmtObjTTL returns remaining time-to-live given listed custom metadata (see cmn.CustomMD2S)
+// that includes object's expiration time
+func FmtObjTTL(custom string, now time.Time) string {
+	i := strings.Index(custom, cmn.ExpiresObjMD+":")
+	if i < 0 {
+		return NotSetVal
+	}
+	v := custom[i+len(cmn.ExpiresObjMD)+1:]
+	if j := strings.IndexAny(v, " ]"); j >= 0 {
+		v = v[:j]
+	}
+	expires, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		return UnknownStatusVal
+	}
+	return FmtTTL(expires, now)
+}
+
+func FmtTTL(expires, now time.Time) string {
+	ttl := expires.Sub(now)
+	switch {
+	case ttl <= 0:
+		return "expired"
+	case ttl < 24*time.Hour:
+		return ttl.Round(time.Second).String()
+	default:
+		days := ttl / (24 * time.Hour)
+		return fmt.Sprintf("%dd%dh", days, (ttl-days*24*time.Hour)/time.Hour)
+	}
+}
+
 // FmtCopies formats an int to a string, where 0 becomes "-"
 func FmtCopies(copies int) string {
 	if copies == 0 {
@@ -197,15 +239,15 @@ func fmtRebStatus(rebSnap *cluster.Snap) string {
 
 func FmtXactStatus(snap *cluster.Snap) string {
 	if snap.AbortedX {
-		return xactStateAborted
+		return XactStateAborted
 	}
 	if !snap.EndTime.IsZero() {
-		return xactStateFinished
+		return XactStateFinished
 	}
 	if snap.IsIdle() {
-		return xactStateIdle
+		return XactStateIdle
 	}
-	return xactStateRunning
+	return XactStateRunning
 }
 
 func extECGetStats(base *cluster.Snap) *ec.ExtECGetStats {
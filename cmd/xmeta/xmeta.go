@@ -30,10 +30,11 @@ type lomInfo struct {
 }
 
 var flags struct {
-	in, out string
-	format  string
-	extract bool
-	help    bool
+	in, out   string
+	format    string
+	extract   bool
+	forceUUID bool
+	help      bool
 }
 
 const (
@@ -47,6 +48,8 @@ Examples:
 	xmeta -x -in=~/.ais0/.ais.smap -out=/tmp/smap.txt - extract Smap to /tmp/smap.txt
 	xmeta -x -in=./.ais.smap -f smap                  - extract Smap to STDOUT with explicit source format
 	xmeta -in=/tmp/smap.txt -out=/tmp/.ais.smap       - format plain-text /tmp/smap.txt
+	xmeta -in=/tmp/smap.json -out=~/.ais0/.ais.smap -f smap -force-uuid - import Smap (e.g., 'ais cluster export-smap' output)
+	                                                    that has a different UUID (to replace existing ~/.ais0/.ais.smap)
 	# BMD:
 	xmeta -x -in=~/.ais0/.ais.bmd                     - extract BMD to STDOUT
 	xmeta -x -in=~/.ais0/.ais.bmd -out=/tmp/bmd.txt   - extract BMD to /tmp/bmd.txt
@@ -100,7 +103,6 @@ func extractVMD() error    { return extractMeta(&volume.VMD{}) }
 
 // "format*" routines require output filename
 
-func formatSmap() error   { return formatMeta(&cluster.Smap{}) }
 func formatBMD() error    { return formatMeta(&cluster.BMD{}) }
 func formatRMD() error    { return formatMeta(&cluster.RMD{}) }
 func formatConfig() error { return formatMeta(&cmn.ClusterConfig{}) }
@@ -113,6 +115,8 @@ func main() {
 		"true: extract AIS-formatted metadata type, false: pack and AIS-format plain-text metadata")
 	newFlag.StringVar(&flags.in, "in", "", "fully-qualified input filename")
 	newFlag.StringVar(&flags.out, "out", "", "output filename (optional when extracting)")
+	newFlag.BoolVar(&flags.forceUUID, "force-uuid", false,
+		"when formatting Smap: overwrite existing output Smap even if the latter has a different UUID")
 	newFlag.BoolVar(&flags.help, "h", false, "print usage and exit")
 	newFlag.StringVar(&flags.format, "f", "", "override automatic format detection (one of smap, bmd, rmd, conf, vmd, mt, lom)")
 	newFlag.Parse(os.Args[1:])
@@ -224,6 +228,29 @@ func formatMeta(v jsp.Opts) error {
 	return jsp.SaveMeta(flags.out, v, nil)
 }
 
+// validate plain-text Smap (e.g., 'ais cluster export-smap' output) prior to formatting;
+// refuse to replace existing Smap that belongs to a different cluster (unless forced)
+func formatSmap() error {
+	if flags.out == "" {
+		return errors.New("output filename (the -out option) must be defined")
+	}
+	smap := &cluster.Smap{}
+	if _, err := jsp.Load(flags.in, smap, jsp.Plain()); err != nil {
+		return err
+	}
+	if err := smap.Validate(); err != nil {
+		return err
+	}
+	if !flags.forceUUID {
+		existing := &cluster.Smap{}
+		if _, err := jsp.LoadMeta(flags.out, existing); err == nil && existing.UUID != "" && existing.UUID != smap.UUID {
+			return fmt.Errorf("%s UUID %q does not match existing %s UUID %q (use -force-uuid to override)",
+				smap, smap.UUID, existing, existing.UUID)
+		}
+	}
+	return jsp.SaveMeta(flags.out, smap, nil)
+}
+
 func formatECMeta() error {
 	if flags.out == "" {
 		return errors.New("output filename (the -out option) must be defined")
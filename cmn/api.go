@@ -100,6 +100,7 @@ type (
 		WritePolicy *WritePolicyConfToUpdate `json:"write_policy,omitempty"`
 		Extra       *ExtraToUpdate           `json:"extra,omitempty"`
 		Force       bool                     `json:"force,omitempty" copy:"skip" list:"omit"`
+		Reencode    bool                     `json:"reencode,omitempty" copy:"skip" list:"omit"` // allow changing EC slices
 	}
 
 	BackendBckToUpdate struct {
@@ -163,6 +164,46 @@ type (
 		InclSrcBname          bool `json:"isbn"` // include source bucket name into the names of archived objects
 		AllowAppendToExisting bool `json:"aate"` // allow adding a list or a range of objects to an existing archive
 		ContinueOnError       bool `json:"coer"` // on err, keep running arc xaction in a any given multi-object transaction
+		ErrLimits                  // (when set, implies ContinueOnError)
+	}
+
+	// Abort-on-error policy for multi-object operations: abort once the number of errors
+	// exceeds MaxErrors, or once the percentage of failed objects exceeds ErrRatePct
+	// (the latter - only after ErrRateMinSample objects have been processed).
+	// Zero value disables the respective check.
+	ErrLimits struct {
+		MaxErrors  int64 `json:"maxerr,omitempty"`
+		ErrRatePct int   `json:"errpct,omitempty"`
+	}
+
+	// ExtractMsg is used to unpack (extract) archived object's members (files) into
+	// individual objects at the specified (bucket) destination; the members' names
+	// (that is, archived pathnames) are preserved and prefixed with `Prefix`
+	ExtractMsg struct {
+		ToBck  Bck    `json:"tobck"`
+		Prefix string `json:"prefix"` // destination prefix, e.g. "a/b/"
+		Filter string `json:"filter"` // extract only those members that match (shell filename pattern)
+		Mime   string `json:"mime"`   // user-specified mime type takes precedence if defined
+	}
+
+	// RechecksumMsg is used to recompute and store the checksums of the selected (list, range,
+	// or prefix; empty template selects the entire bucket) in-cluster objects
+	// - objects that already have the checksum of the specified type are skipped;
+	// - with DryRun, objects are only counted (and not modified)
+	RechecksumMsg struct {
+		CksumType string `json:"cksum_type"`
+		ListRange
+		DryRun bool `json:"dry_run"`
+	}
+
+	// PrefetchMsg is used to prefetch (warm up) the selected remote objects; optional
+	// pacing hints keep background prefetching from starving user I/O:
+	// - Throttle: one of the PrefetchThrottle* levels (empty - no throttling);
+	// - BytesPerHour: cluster-wide limit (zero - unlimited)
+	PrefetchMsg struct {
+		ListRange
+		Throttle     string `json:"throttle,omitempty"`
+		BytesPerHour int64  `json:"bph,omitempty"`
 	}
 
 	//  Multi-object copy & transform (see also: TCBMsg)
@@ -171,7 +212,6 @@ type (
 		ListRange
 		TxnUUID string `json:"-"`
 		apc.TCBMsg
-		ContinueOnError bool `json:"coer"` // ditto
 	}
 )
 
@@ -392,6 +432,61 @@ func (s AllBsummResults) Finalize(dsize map[string]uint64, testingEnv bool) {
 func (lrm *ListRange) IsList() bool      { return len(lrm.ObjNames) > 0 }
 func (lrm *ListRange) HasTemplate() bool { return lrm.Template != "" }
 
+///////////////
+// ErrLimits //
+///////////////
+
+// minimum number of processed objects to start enforcing ErrRatePct
+const ErrRateMinSample = 100
+
+func (l *ErrLimits) IsSet() bool { return l.MaxErrors > 0 || l.ErrRatePct > 0 }
+
+func (l *ErrLimits) Validate() error {
+	if l.MaxErrors < 0 {
+		return fmt.Errorf("invalid max-errors %d: cannot be negative", l.MaxErrors)
+	}
+	if l.ErrRatePct < 0 || l.ErrRatePct > 100 {
+		return fmt.Errorf("invalid error-rate %d%%: expecting 0 (disabled) to 100", l.ErrRatePct)
+	}
+	return nil
+}
+
+// given `nerr` errors out of `n` processed objects, returns the (human-readable) trigger
+// when either limit is exceeded, or empty string otherwise
+func (l *ErrLimits) Exceeded(nerr, n int64) string {
+	if l.MaxErrors > 0 && nerr > l.MaxErrors {
+		return fmt.Sprintf("%d errors exceeded max-errors %d", nerr, l.MaxErrors)
+	}
+	if l.ErrRatePct > 0 && n >= ErrRateMinSample && nerr*100 > int64(l.ErrRatePct)*n {
+		return fmt.Sprintf("error rate %d%% (%d out of %d) exceeded %d%%", nerr*100/n, nerr, n, l.ErrRatePct)
+	}
+	return ""
+}
+
+/////////////////
+// PrefetchMsg //
+/////////////////
+
+// prefetch throttle levels: the higher the level, the more prefetching yields to user I/O
+const (
+	PrefetchThrottleLow    = "low"
+	PrefetchThrottleMedium = "medium"
+	PrefetchThrottleHigh   = "high"
+)
+
+func (msg *PrefetchMsg) Validate() error {
+	switch msg.Throttle {
+	case "", PrefetchThrottleLow, PrefetchThrottleMedium, PrefetchThrottleHigh:
+	default:
+		return fmt.Errorf("invalid prefetch throttle %q: expecting one of: %s, %s, %s",
+			msg.Throttle, PrefetchThrottleLow, PrefetchThrottleMedium, PrefetchThrottleHigh)
+	}
+	if msg.BytesPerHour < 0 {
+		return fmt.Errorf("invalid prefetch limit %d bytes per hour: cannot be negative", msg.BytesPerHour)
+	}
+	return nil
+}
+
 ////////////////
 // ArchiveMsg //
 ////////////////
@@ -0,0 +1,39 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+
+package cmn
+
+import (
+	"testing"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestPrefetchMsg(t *testing.T) {
+	for _, throttle := range []string{"", PrefetchThrottleLow, PrefetchThrottleMedium, PrefetchThrottleHigh} {
+		msg := PrefetchMsg{Throttle: throttle, BytesPerHour: cos.GiB}
+		tassert.CheckError(t, msg.Validate())
+	}
+	for _, bad := range []PrefetchMsg{{Throttle: "max"}, {BytesPerHour: -1}} {
+		tassert.Errorf(t, bad.Validate() != nil, "expected %+v to be invalid", bad)
+	}
+}
+
+func TestErrLimits(t *testing.T) {
+	elim := ErrLimits{MaxErrors: 5}
+	tassert.Errorf(t, elim.Exceeded(5, 5) == "", "5 errors should not exceed max-errors 5")
+	tassert.Errorf(t, elim.Exceeded(6, 1000) != "", "6 errors should exceed max-errors 5")
+
+	elim = ErrLimits{ErrRatePct: 10}
+	tassert.Errorf(t, elim.Exceeded(50, 50) == "", "error rate must not be enforced on small samples")
+	tassert.Errorf(t, elim.Exceeded(10, 100) == "", "10%% should not exceed 10%%")
+	tassert.Errorf(t, elim.Exceeded(11, 100) != "", "11%% should exceed 10%%")
+
+	for _, bad := range []ErrLimits{{MaxErrors: -1}, {ErrRatePct: 101}} {
+		tassert.Errorf(t, bad.Validate() != nil, "expected %+v to be invalid", bad)
+	}
+}
@@ -61,6 +61,8 @@ type (
 		Timeout    TimeoutConf    `json:"timeout"`
 		Client     ClientConf     `json:"client"`
 		Proxy      ProxyConf      `json:"proxy" allow:"cluster"`
+		Cluster    ClusterConf    `json:"cluster" allow:"cluster"`
+		Metasync   MetasyncConf   `json:"metasync" allow:"cluster"`
 		Space      SpaceConf      `json:"space"`
 		LRU        LRUConf        `json:"lru"`
 		Disk       DiskConf       `json:"disk"`
@@ -119,6 +121,8 @@ type (
 		TCB         *TCBConfToUpdate         `json:"tcb,omitempty"`
 		WritePolicy *WritePolicyConfToUpdate `json:"write_policy,omitempty"`
 		Proxy       *ProxyConfToUpdate       `json:"proxy,omitempty"`
+		Cluster     *ClusterConfToUpdate     `json:"cluster,omitempty"`
+		Metasync    *MetasyncConfToUpdate    `json:"metasync,omitempty"`
 		Features    *feat.Flags              `json:"features,string,omitempty"`
 
 		// LocalConfig
@@ -258,6 +262,32 @@ type (
 		NonElectable *bool   `json:"non_electable,omitempty"`
 	}
 
+	// cluster membership
+	ClusterConf struct {
+		// when a joining node has the same (IP, port) as an existing one (the "duplicate"):
+		// - false: remove the (presumably) older node from the cluster map (default)
+		// - true:  record the conflict and wait for the operator to resolve it
+		//          (see `apc.ActResolveDupNode`)
+		ManualResolveDupNodes bool `json:"manual_resolve_dup_nodes"`
+
+		// number of the most recent cluster map (Smap) versions retained in memory in their entirety;
+		// older versions are remembered only by their version, time, and changes (zero: none retained)
+		SmapHistory int `json:"smap_history"`
+	}
+	ClusterConfToUpdate struct {
+		ManualResolveDupNodes *bool `json:"manual_resolve_dup_nodes,omitempty"`
+		SmapHistory           *int  `json:"smap_history,omitempty"`
+	}
+
+	MetasyncConf struct {
+		// primary retransmitting cluster-level metadata to lagging nodes: space out
+		// consecutive sends by so much time plus random jitter (zero - send all at once)
+		RetransmitInterval cos.Duration `json:"retransmit_interval"`
+	}
+	MetasyncConfToUpdate struct {
+		RetransmitInterval *cos.Duration `json:"retransmit_interval,omitempty"`
+	}
+
 	SpaceConf struct {
 		// Storage Cleanup watermark: used capacity (%) that triggers cleanup
 		// (deleted objects and buckets, extra copies, etc.)
@@ -571,6 +601,24 @@ var Features feat.Flags
 // assorted named fields that require (cluster | node) restart for changes to make an effect
 var ConfigRestartRequired = []string{"auth", "memsys", "net"}
 
+// sensitive (secret) config values, matched against the last (leaf) part of the config name,
+// including names within provider-specific maps (e.g. "backend.conf");
+// new secret fields must be named accordingly or added here
+var ConfigSecrets = []string{"secret", "password", "passwd", "token", "credentials", "access_key", "private_key"}
+
+func IsConfigSecret(name string) bool {
+	if i := strings.LastIndexByte(name, '.'); i >= 0 {
+		name = name[i+1:]
+	}
+	name = strings.ToLower(name)
+	for _, s := range ConfigSecrets {
+		if strings.Contains(name, s) {
+			return true
+		}
+	}
+	return false
+}
+
 // dsort
 const (
 	IgnoreReaction = "ignore"
@@ -937,6 +985,30 @@ func (c BackendConfAIS) String() (s string) {
 	return
 }
 
+//////////////////
+// MetasyncConf //
+//////////////////
+
+func (c *MetasyncConf) Validate() error {
+	if c.RetransmitInterval < 0 {
+		return fmt.Errorf("invalid metasync.retransmit_interval=%s (expecting non-negative)", c.RetransmitInterval)
+	}
+	return nil
+}
+
+/////////////////
+// ClusterConf //
+/////////////////
+
+const MaxSmapHistory = 1024
+
+func (c *ClusterConf) Validate() error {
+	if c.SmapHistory < 0 || c.SmapHistory > MaxSmapHistory {
+		return fmt.Errorf("invalid cluster.smap_history=%d (expecting 0 to %d)", c.SmapHistory, MaxSmapHistory)
+	}
+	return nil
+}
+
 //////////////
 // DiskConf //
 //////////////
@@ -1129,11 +1201,12 @@ func (c *ECConf) ValidateAsProps(arg ...any) (err error) {
 	if required <= targetCnt {
 		return
 	}
+	if c.ParitySlices >= targetCnt {
+		return fmt.Errorf("%v: ec.parity_slices (%d) must be less than the number of targets (%d)",
+			ErrNotEnoughTargets, c.ParitySlices, targetCnt)
+	}
 	err = fmt.Errorf("%v: EC configuration (d=%d, p=%d slices) requires at least %d targets (have %d)",
 		ErrNotEnoughTargets, c.DataSlices, c.ParitySlices, required, targetCnt)
-	if c.ParitySlices > targetCnt {
-		return
-	}
 	return NewErrSoft(err.Error())
 }
 
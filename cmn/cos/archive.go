@@ -17,6 +17,7 @@ const (
 	ExtTar    = ".tar"
 	ExtTgz    = ".tgz"
 	ExtTarTgz = ".tar.gz"
+	ExtTarZst = ".tar.zst"
 	ExtZip    = ".zip"
 
 	// msgpack doesn't have a "common extension", see for instance:
@@ -32,7 +33,7 @@ const (
 
 const TarBlockSize = 512 // Size of each block in a tar stream
 
-var ArchExtensions = []string{ExtTar, ExtTgz, ExtTarTgz, ExtZip, ExtMsgpack}
+var ArchExtensions = []string{ExtTar, ExtTgz, ExtTarTgz, ExtTarZst, ExtZip, ExtMsgpack}
 
 type ErrUnknownMime struct{ detail string }
 
@@ -65,6 +66,9 @@ func byMime(mime string) (string, error) {
 	if strings.Contains(mime, ExtTarTgz[1:]) { // ExtTarTgz contains ExtTar
 		return ExtTarTgz, nil
 	}
+	if strings.Contains(mime, ExtTarZst[1:]) { // ditto
+		return ExtTarZst, nil
+	}
 	for _, ext := range ArchExtensions {
 		if strings.Contains(mime, ext[1:]) {
 			return ext, nil
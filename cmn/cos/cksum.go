@@ -15,6 +15,7 @@ import (
 	"hash/crc32"
 	"io"
 	"sort"
+	"strings"
 
 	"github.com/OneOfOne/xxhash"
 	jsoniter "github.com/json-iterator/go"
@@ -259,6 +260,15 @@ func NewBadDataCksumError(a, b *Cksum, context ...string) error {
 	return &ErrBadCksum{prefix: badDataCksumPrefix, a: a, b: b, context: ctx}
 }
 
+// IsErrBadDataCksum returns true if the error is (or, having been received over HTTP, reports)
+// data checksum mismatch
+func IsErrBadDataCksum(err error) bool {
+	if e, ok := err.(*ErrBadCksum); ok {
+		return e.prefix == badDataCksumPrefix
+	}
+	return strings.Contains(err.Error(), badDataCksumPrefix)
+}
+
 func NewBadMetaCksumError(a, b uint64, context ...string) error {
 	ctx := ""
 	if len(context) > 0 {
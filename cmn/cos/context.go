@@ -5,7 +5,10 @@
 package cos
 
 import (
+	"context"
+	"fmt"
 	"io"
+	"sync"
 )
 
 type (
@@ -14,10 +17,72 @@ type (
 
 	ReadWrapperFunc func(r io.ReadCloser) io.ReadCloser
 	SetSizeFunc     func(size int64)
+
+	ctxReader struct {
+		ctx    context.Context
+		r      io.ReadCloser
+		stopCh chan struct{}
+		once   sync.Once
+	}
 )
 
 const (
 	CtxReadWrapper contextID = "readWrapper" // context key for ReadWrapperFunc
 	CtxSetSize     contextID = "setSize"     // context key for SetSizeFunc
 	CtxOriginalURL contextID = "origURL"     // context key for OriginalURL for HTTP cloud
+	CtxVersionID   contextID = "versionID"   // context key for remote object version (versioned backends)
 )
+
+// ChainReadWrappers composes multiple wrappers (e.g., rate-limit, checksum, progress)
+// into a single one. The wrappers are applied in the declared order: the first one
+// wraps the original reader, the second wraps the first, and so on - which is also
+// the order in which the wrappers see the (entire) byte stream. Nil wrappers are skipped.
+func ChainReadWrappers(wrappers ...ReadWrapperFunc) ReadWrapperFunc {
+	return func(r io.ReadCloser) io.ReadCloser {
+		for _, wrap := range wrappers {
+			if wrap != nil {
+				r = wrap(r)
+			}
+		}
+		return r
+	}
+}
+
+// NewCtxReader returns a reader that honors the context's cancelation and deadline.
+// Once the context is done, the reader aborts promptly - including a read that
+// is already in progress (by closing the underlying reader) - and returns
+// an error that wraps ctx.Err(), e.g. context.DeadlineExceeded.
+func NewCtxReader(ctx context.Context, r io.ReadCloser) io.ReadCloser {
+	if ctx.Done() == nil {
+		return r // never canceled
+	}
+	cr := &ctxReader{ctx: ctx, r: r, stopCh: make(chan struct{})}
+	go cr.watch()
+	return cr
+}
+
+func (cr *ctxReader) watch() {
+	select {
+	case <-cr.ctx.Done():
+		cr.r.Close() // unblock pending read, if any
+	case <-cr.stopCh:
+	}
+}
+
+func (cr *ctxReader) Read(b []byte) (n int, err error) {
+	if errCtx := cr.ctx.Err(); errCtx != nil {
+		return 0, fmt.Errorf("read aborted: %w", errCtx)
+	}
+	n, err = cr.r.Read(b)
+	if err != nil {
+		if errCtx := cr.ctx.Err(); errCtx != nil {
+			err = fmt.Errorf("read aborted: %w", errCtx)
+		}
+	}
+	return n, err
+}
+
+func (cr *ctxReader) Close() error {
+	cr.once.Do(func() { close(cr.stopCh) })
+	return cr.r.Close()
+}
@@ -0,0 +1,126 @@
+// Package cos provides common low-level types and utilities for all aistore projects
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package cos_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+type recReader struct {
+	r     io.ReadCloser
+	name  string
+	order *[]string
+	seen  bytes.Buffer
+}
+
+func (rr *recReader) Read(b []byte) (n int, err error) {
+	n, err = rr.r.Read(b)
+	if n > 0 {
+		if rr.seen.Len() == 0 {
+			*rr.order = append(*rr.order, rr.name)
+		}
+		rr.seen.Write(b[:n])
+	}
+	return
+}
+
+func (rr *recReader) Close() error { return rr.r.Close() }
+
+func TestChainReadWrappers(t *testing.T) {
+	var (
+		content = strings.Repeat("0123456789abcdef", 4096)
+		order   []string
+		readers []*recReader
+	)
+	newWrapper := func(name string) cos.ReadWrapperFunc {
+		return func(r io.ReadCloser) io.ReadCloser {
+			rr := &recReader{r: r, name: name, order: &order}
+			readers = append(readers, rr)
+			return rr
+		}
+	}
+	wrap := cos.ChainReadWrappers(newWrapper("rate-limit"), nil, newWrapper("checksum"), newWrapper("progress"))
+
+	r := wrap(io.NopCloser(strings.NewReader(content)))
+	out, err := io.ReadAll(r)
+	tassert.CheckFatal(t, err)
+	tassert.CheckFatal(t, r.Close())
+
+	tassert.Fatalf(t, string(out) == content, "expected %d bytes, got %d", len(content), len(out))
+	tassert.Fatalf(t, len(readers) == 3, "expected 3 wrappers to be applied, got %d", len(readers))
+	expected := []string{"rate-limit", "checksum", "progress"}
+	for i, name := range expected {
+		tassert.Errorf(t, readers[i].name == name, "wrapper #%d: expected %q, got %q", i, name, readers[i].name)
+		tassert.Errorf(t, order[i] == name, "read order #%d: expected %q, got %q", i, name, order[i])
+		tassert.Errorf(t, readers[i].seen.String() == content, "wrapper %q saw %d bytes, expected %d",
+			readers[i].name, readers[i].seen.Len(), len(content))
+	}
+
+	// no wrappers
+	r = cos.ChainReadWrappers()(io.NopCloser(strings.NewReader(content)))
+	out, err = io.ReadAll(r)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, string(out) == content, "expected unchanged content")
+}
+
+// slow reader: each read blocks until either delay expires or the reader is closed
+type slowReader struct {
+	closed chan struct{}
+	delay  time.Duration
+}
+
+func (sr *slowReader) Read(b []byte) (int, error) {
+	select {
+	case <-time.After(sr.delay):
+		b[0] = 'x'
+		return 1, nil
+	case <-sr.closed:
+		return 0, errors.New("read on closed reader")
+	}
+}
+
+func (sr *slowReader) Close() error {
+	select {
+	case <-sr.closed:
+	default:
+		close(sr.closed)
+	}
+	return nil
+}
+
+func TestCtxReaderDeadline(t *testing.T) {
+	const deadline = 100 * time.Millisecond
+	for _, delay := range []time.Duration{10 * time.Millisecond, time.Minute} {
+		ctx, cancel := context.WithTimeout(context.Background(), deadline)
+		var (
+			started = time.Now()
+			r       = cos.NewCtxReader(ctx, &slowReader{closed: make(chan struct{}), delay: delay})
+		)
+		n, err := io.Copy(io.Discard, r)
+		elapsed := time.Since(started)
+		cancel()
+
+		tassert.Fatalf(t, err != nil, "delay %v: expected error (read %d bytes)", delay, n)
+		tassert.Errorf(t, errors.Is(err, context.DeadlineExceeded), "delay %v: expected deadline exceeded, got %v", delay, err)
+		tassert.Errorf(t, elapsed < deadline+time.Second, "delay %v: took %v to abort", delay, elapsed)
+		if delay < deadline {
+			tassert.Errorf(t, n > 0, "delay %v: expected partial read", delay)
+		}
+		r.Close()
+	}
+
+	// no deadline - no wrapping
+	sr := &slowReader{closed: make(chan struct{})}
+	tassert.Errorf(t, cos.NewCtxReader(context.Background(), sr) == io.ReadCloser(sr), "expected the original reader")
+}
@@ -97,7 +97,7 @@ func (pt *ParsedTemplate) Count() int64 {
 // maxLen specifies maximum objects to be returned
 func (pt *ParsedTemplate) ToSlice(maxLen ...int) []string {
 	var ( //nolint:prealloc // objs is preallocated farther down
-		max  = math.MaxInt64
+		max  = math.MaxInt
 		objs []string
 	)
 	if len(maxLen) > 0 && maxLen[0] >= 0 {
@@ -24,12 +24,13 @@ const (
 	ProxyID = ".ais.proxy_id"
 
 	// metadata
-	Smap        = ".ais.smap"   // Smap persistent file basename
-	Rmd         = ".ais.rmd"    // rmd persistent file basename
-	Bmd         = ".ais.bmd"    // bmd persistent file basename
-	BmdPrevious = Bmd + ".prev" // bmd previous version
-	Vmd         = ".ais.vmd"    // vmd persistent file basename
-	Emd         = ".ais.emd"    // emd persistent file basename
+	Smap        = ".ais.smap"    // Smap persistent file basename
+	SmapHist    = Smap + "_hist" // Smap history: versions, times, and changes (see ais/smaphist.go)
+	Rmd         = ".ais.rmd"     // rmd persistent file basename
+	Bmd         = ".ais.bmd"     // bmd persistent file basename
+	BmdPrevious = Bmd + ".prev"  // bmd previous version
+	Vmd         = ".ais.vmd"     // vmd persistent file basename
+	Emd         = ".ais.emd"     // emd persistent file basename
 
 	// CLI config
 	CliConfig = "cli.json" // see jsp/app.go
@@ -37,6 +37,7 @@ type (
 		Service(name string) (*corev1.Service, error)
 		Node(name string) (*corev1.Node, error)
 		Logs(podName string) ([]byte, error)
+		Events(podName string) (*corev1.EventList, error)
 		Health(podName string) (string, error)
 		Metrics(podName string) (cpuCores float64, freeMem int64, err error)
 		CheckMetricsAvailability() error
@@ -154,6 +155,11 @@ func (c *defaultClient) Logs(podName string) ([]byte, error) {
 	return io.ReadAll(logStream)
 }
 
+func (c *defaultClient) Events(podName string) (*corev1.EventList, error) {
+	opts := metav1.ListOptions{FieldSelector: "involvedObject.kind=Pod,involvedObject.name=" + podName}
+	return c.client.CoreV1().Events(c.namespace).List(context.Background(), opts)
+}
+
 func (c *defaultClient) CheckMetricsAvailability() error {
 	_, err := c.client.CoreV1().RESTClient().Get().AbsPath("/apis/metrics.k8s.io/v1beta1/pods").DoRaw(context.Background())
 	return err
@@ -51,6 +51,10 @@ type (
 // ("object is cached" == "is present" and vice versa)
 func (be *LsoEntry) CheckExists() bool { return be.Flags&apc.EntryIsCached != 0 }
 func (be *LsoEntry) SetPresent()       { be.Flags |= apc.EntryIsCached }
+func (be *LsoEntry) IsStale() bool     { return be.Flags&apc.EntryIsStale != 0 }
+func (be *LsoEntry) SetStale()         { be.Flags |= apc.EntryIsStale }
+func (be *LsoEntry) IsHeadCksum() bool { return be.Flags&apc.EntryHeadCksum != 0 }
+func (be *LsoEntry) SetHeadCksum()     { be.Flags |= apc.EntryHeadCksum }
 
 func (be *LsoEntry) IsStatusOK() bool   { return be.Status() == 0 }
 func (be *LsoEntry) Status() uint16     { return be.Flags & apc.EntryStatusMask }
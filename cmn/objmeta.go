@@ -10,6 +10,7 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn/cos"
@@ -35,6 +36,9 @@ const (
 
 	OrigURLObjMD = "orig_url"
 
+	// object's expiration time (RFC3339), e.g. `ais put --expire`; see also apc.ActExpirySweep
+	ExpiresObjMD = "ais.expires"
+
 	// additional backend
 	LastModified    = "LastModified"
 	ContentEncoding = "ContentEncoding"
@@ -61,6 +65,18 @@ type ObjectProps struct {
 	Present bool `json:"present"`
 }
 
+// a single version of a remote object (versioning-capable backends only)
+// see also: apc.QparamListVersions
+type (
+	ObjVersion struct {
+		ID       string `json:"version_id"`
+		Mtime    string `json:"mtime"` // RFC3339
+		Size     int64  `json:"size,string"`
+		IsLatest bool   `json:"latest,omitempty"`
+	}
+	ObjVersions []*ObjVersion
+)
+
 type (
 	ObjAttrsHolder interface {
 		SizeBytes(special ...bool) int64
@@ -106,6 +122,16 @@ func (oa *ObjAttrs) SetSize(size int64) {
 
 func CustomMD2S(md cos.StrKVs) string { return fmt.Sprintf("%+v", md) }
 
+// returns object's expiration time, if set (and valid)
+func ObjExpires(md cos.StrKVs) (expires time.Time, ok bool) {
+	v, exists := md[ExpiresObjMD]
+	if !exists {
+		return
+	}
+	expires, err := time.Parse(time.RFC3339, v)
+	return expires, err == nil
+}
+
 func (oa *ObjAttrs) GetCustomMD() cos.StrKVs   { return oa.CustomMD }
 func (oa *ObjAttrs) SetCustomMD(md cos.StrKVs) { oa.CustomMD = md }
 
@@ -59,6 +59,13 @@ cat > $AIS_CONF_FILE <<EOL
 		"discovery_url": "${AIS_DISCOVERY_URL}",
 		"non_electable": ${AIS_NON_ELECTABLE:-false}
 	},
+	"cluster": {
+		"manual_resolve_dup_nodes": false,
+		"smap_history":             16
+	},
+	"metasync": {
+		"retransmit_interval": "10ms"
+	},
 	"space": {
 		"cleanupwm":         65,
 		"lowwm":             75,
@@ -13,7 +13,7 @@ import (
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cluster"
 	"github.com/NVIDIA/aistore/cmn"
-	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/atomic"
 	"github.com/NVIDIA/aistore/fs"
 	"github.com/NVIDIA/aistore/fs/mpather"
 	"github.com/NVIDIA/aistore/xact"
@@ -28,13 +28,31 @@ type (
 	}
 	XactBckEncode struct {
 		xact.Base
-		t    cluster.Target
-		bck  *cluster.Bck
-		wg   *sync.WaitGroup // to wait for EC finishes all objects
-		smap *cluster.Smap
+		t      cluster.Target
+		bck    *cluster.Bck
+		wg     *sync.WaitGroup // to wait for EC finishes all objects
+		smap   *cluster.Smap
+		failed struct {
+			names []string // up to maxFailedNames
+			mu    sync.Mutex
+			cnt   atomic.Int64
+		}
+		reencoded  atomic.Int64 // objects migrated from a different (previous) EC configuration
+		remained   atomic.Int64 // objects that were already erasure-coded as per the current configuration
+		replicated atomic.Int64 // (subset of the above) too small to be sliced - stored as full replicas
+	}
+	// extended x-ec-encode statistics
+	ExtECEncodeStats struct {
+		Failed     []string `json:"ec.encode.failed,omitempty"` // names of the (first) objects that failed to encode
+		ErrCount   int64    `json:"ec.encode.err.n,string"`
+		Reencoded  int64    `json:"ec.encode.reencoded.n,string"`
+		Remained   int64    `json:"ec.encode.remained.n,string"`
+		Replicated int64    `json:"ec.encode.replicated.n,string"`
 	}
 )
 
+const maxFailedNames = 32
+
 // interface guard
 var (
 	_ cluster.Xact   = (*XactBckEncode)(nil)
@@ -122,14 +140,27 @@ func (r *XactBckEncode) afterECObj(lom *cluster.LOM, err error) {
 		r.LomAdd(lom)
 	} else if err != errSkipped {
 		glog.Errorf("Failed to erasure-code %s: %v", lom.Cname(), err)
+		if r.failed.cnt.Inc() <= maxFailedNames {
+			r.failed.mu.Lock()
+			r.failed.names = append(r.failed.names, lom.ObjName)
+			r.failed.mu.Unlock()
+		}
 	}
 
 	r.wg.Done()
 }
 
+func (r *XactBckEncode) afterReencode(lom *cluster.LOM, err error) {
+	if err == nil {
+		r.reencoded.Inc()
+	}
+	r.afterECObj(lom, err)
+}
+
 // Walks through all files in 'obj' directory, and calls EC.Encode for every
-// file whose HRW points to this file and the file does not have corresponding
-// metadata file in 'meta' directory
+// file whose HRW points to this file and the file either does not have corresponding
+// metadata file in 'meta' directory or was erasure-coded with a different configuration
+// (in which case the object gets re-encoded, its new generation superseding the old one)
 func (r *XactBckEncode) bckEncode(lom *cluster.LOM, _ []byte) error {
 	_, local, err := lom.HrwTarget(r.smap)
 	if err != nil {
@@ -145,13 +176,23 @@ func (r *XactBckEncode) bckEncode(lom *cluster.LOM, _ []byte) error {
 		glog.Warningf("metadata FQN generation failed %q: %v", lom, err)
 		return nil
 	}
-	err = cos.Stat(mdFQN)
-	// Metadata file exists - the object was already EC'ed before.
-	if err == nil {
-		return nil
-	}
-	if !os.IsNotExist(err) {
-		glog.Warningf("failed to stat %q: %v", mdFQN, err)
+	md, err := LoadMetadata(mdFQN)
+	cb := r.afterECObj
+	switch {
+	case err == nil:
+		// metadata file exists - the object was already EC'ed before
+		ecConf := &lom.Bprops().EC
+		if md.conforms(ecConf, lom.SizeBytes()) {
+			r.remained.Inc()
+			if md.IsCopy {
+				r.replicated.Inc()
+			}
+			return nil
+		}
+		cb = r.afterReencode
+	case os.IsNotExist(err):
+	default:
+		glog.Warningf("failed to load %q: %v", mdFQN, err)
 		return nil
 	}
 
@@ -159,7 +200,7 @@ func (r *XactBckEncode) bckEncode(lom *cluster.LOM, _ []byte) error {
 	// After Walk finishes, the xaction waits until counter drops to zero.
 	// That means all objects have been processed and xaction can finalize.
 	r.beforeECObj()
-	if err = ECM.EncodeObject(lom, r.afterECObj); err != nil {
+	if err = ECM.EncodeObject(lom, cb); err != nil {
 		// something went wrong: abort xaction
 		r.afterECObj(lom, err)
 		if err != errSkipped {
@@ -173,6 +214,18 @@ func (r *XactBckEncode) Snap() (snap *cluster.Snap) {
 	snap = &cluster.Snap{}
 	r.ToSnap(snap)
 
+	ext := &ExtECEncodeStats{
+		ErrCount:   r.failed.cnt.Load(),
+		Reencoded:  r.reencoded.Load(),
+		Remained:   r.remained.Load(),
+		Replicated: r.replicated.Load(),
+	}
+	if ext.ErrCount > 0 {
+		r.failed.mu.Lock()
+		ext.Failed = append([]string(nil), r.failed.names...)
+		r.failed.mu.Unlock()
+	}
+	snap.Ext = ext
 	snap.IdleX = r.IsIdle()
 	return
 }
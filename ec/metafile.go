@@ -10,6 +10,7 @@ import (
 	"os"
 
 	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/fs"
 	"github.com/OneOfOne/xxhash"
@@ -60,6 +61,18 @@ func LoadMetadata(fqn string) (*Metadata, error) {
 	return md, nil
 }
 
+// whether the object was erasure-coded (or replicated) as per the given configuration;
+// for replicated objects only the number of replicas (parity + 1) matters
+func (md *Metadata) conforms(ecConf *cmn.ECConf, size int64) bool {
+	if md.IsCopy != IsECCopy(size, ecConf) {
+		return false
+	}
+	if md.IsCopy {
+		return md.Parity == ecConf.ParitySlices
+	}
+	return md.Data == ecConf.DataSlices && md.Parity == ecConf.ParitySlices
+}
+
 func MetaFromReader(reader io.Reader) (*Metadata, error) {
 	b, err := io.ReadAll(reader)
 	if err != nil {
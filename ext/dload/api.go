@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net/url"
 	"path"
 	"regexp"
 	"strings"
@@ -53,6 +54,7 @@ type (
 		FinishedCnt   int       `json:"finished_cnt"`
 		ScheduledCnt  int       `json:"scheduled_cnt"` // tasks being processed or already processed by dispatched
 		SkippedCnt    int       `json:"skipped_cnt"`   // number of tasks skipped
+		ResumedCnt    int       `json:"resumed_cnt"`   // number of (finished) tasks that resumed interrupted download at least once
 		ErrorCnt      int       `json:"error_cnt"`
 		Total         int       `json:"total"`          // total number of tasks, negative if unknown
 		AllDispatched bool      `json:"all_dispatched"` // if true, dispatcher has already scheduled all tasks for given job
@@ -79,6 +81,12 @@ type (
 		Timeout          string  `json:"timeout"`
 		ProgressInterval string  `json:"progress_interval"`
 		Limits           Limits  `json:"limits"`
+		// validate each downloaded object against the checksum (or MD5 ETag) provided by the source;
+		// re-download upon mismatch
+		ValidateCksum bool `json:"validate_cksum"`
+		// optional callback: upon job completion (or failure), the controlling node POSTs
+		// the final job status (see NotifyPayload) to this URL
+		NotifyURL string `json:"notify_url,omitempty"`
 	}
 
 	SingleObj struct {
@@ -146,6 +154,7 @@ func (j *Job) Aggregate(rhs *Job) {
 	j.FinishedCnt += rhs.FinishedCnt
 	j.ScheduledCnt += rhs.ScheduledCnt
 	j.SkippedCnt += rhs.SkippedCnt
+	j.ResumedCnt += rhs.ResumedCnt
 	j.ErrorCnt += rhs.ErrorCnt
 	j.Total += rhs.Total
 	j.AllDispatched = j.AllDispatched && rhs.AllDispatched
@@ -303,6 +312,17 @@ func (b *Base) Validate() error {
 	if b.Limits.BytesPerHour < 0 {
 		return fmt.Errorf("'limit.bytes_per_hour' must be non-negative (got: %d)", b.Limits.BytesPerHour)
 	}
+	if b.NotifyURL != "" {
+		return ValidateNotifyURL(b.NotifyURL)
+	}
+	return nil
+}
+
+func ValidateNotifyURL(notifyURL string) error {
+	u, err := url.ParseRequestURI(notifyURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("invalid 'notify_url' %q (expecting http(s)://host[:port]/path)", notifyURL)
+	}
 	return nil
 }
 
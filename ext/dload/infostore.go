@@ -96,6 +96,12 @@ func (is *infoStore) incSkipped(id string) {
 	dljob.finishedCnt.Inc()
 }
 
+func (is *infoStore) incResumed(id string) {
+	dljob, err := is.getJob(id)
+	debug.AssertNoErr(err)
+	dljob.resumedCnt.Inc()
+}
+
 func (is *infoStore) incScheduled(id string) {
 	dljob, err := is.getJob(id)
 	debug.AssertNoErr(err)
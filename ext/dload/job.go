@@ -46,6 +46,7 @@ type (
 		Bck() *cmn.Bck
 		Description() string
 		Timeout() time.Duration
+		ValidateCksum() bool
 		ActiveStats() (*StatusResp, error)
 		String() string
 		Notif() cluster.Notif // notifications
@@ -79,6 +80,7 @@ type (
 		description string
 		timeout     time.Duration
 		throt       throttler
+		validate    bool
 	}
 
 	sliceDlJob struct {
@@ -123,6 +125,7 @@ type (
 		finishedCnt   atomic.Int32
 		scheduledCnt  atomic.Int32
 		skippedCnt    atomic.Int32
+		resumedCnt    atomic.Int32
 		errorCnt      atomic.Int32
 		total         int
 		aborted       atomic.Bool
@@ -134,19 +137,21 @@ type (
 // baseDlJob //
 ///////////////
 
-func (j *baseDlJob) init(t cluster.Target, id string, bck *cluster.Bck, timeout, desc string, limits Limits, xdl *Xact) {
+func (j *baseDlJob) init(t cluster.Target, id string, bck *cluster.Bck, base *Base, desc string, xdl *Xact) {
+	limits := base.Limits
 	// TODO: this might be inaccurate if we download 1 or 2 objects because then
 	//  other targets will have limits but will not use them.
 	if limits.BytesPerHour > 0 {
 		limits.BytesPerHour /= t.Sowner().Get().CountActiveTs()
 	}
-	td, _ := time.ParseDuration(timeout)
+	td, _ := time.ParseDuration(base.Timeout)
 	{
 		j.id = id
 		j.bck = bck
 		j.timeout = td
 		j.description = desc
 		j.throt.init(limits)
+		j.validate = base.ValidateCksum
 		j.xdl = xdl
 	}
 }
@@ -156,6 +161,7 @@ func (j *baseDlJob) XactID() string         { return j.xdl.ID() }
 func (j *baseDlJob) Bck() *cmn.Bck          { return j.bck.Bucket() }
 func (j *baseDlJob) Timeout() time.Duration { return j.timeout }
 func (j *baseDlJob) Description() string    { return j.description }
+func (j *baseDlJob) ValidateCksum() bool    { return j.validate }
 func (*baseDlJob) Sync() bool               { return false }
 
 func (j *baseDlJob) String() (s string) {
@@ -235,7 +241,7 @@ func newMultiDlJob(t cluster.Target, id string, bck *cluster.Bck, payload *Multi
 	var objs cos.StrKVs
 
 	mj = &multiDlJob{}
-	mj.baseDlJob.init(t, id, bck, payload.Timeout, payload.Describe(), payload.Limits, xdl)
+	mj.baseDlJob.init(t, id, bck, &payload.Base, payload.Describe(), xdl)
 
 	if objs, err = payload.ExtractPayload(); err != nil {
 		return nil, err
@@ -250,7 +256,7 @@ func newSingleDlJob(t cluster.Target, id string, bck *cluster.Bck, payload *Sing
 	var objs cos.StrKVs
 
 	sj = &singleDlJob{}
-	sj.baseDlJob.init(t, id, bck, payload.Timeout, payload.Describe(), payload.Limits, xdl)
+	sj.baseDlJob.init(t, id, bck, &payload.Base, payload.Describe(), xdl)
 
 	if objs, err = payload.ExtractPayload(); err != nil {
 		return nil, err
@@ -273,7 +279,7 @@ func newRangeDlJob(t cluster.Target, id string, bck *cluster.Bck, payload *Range
 	if rj.pt, err = cos.ParseBashTemplate(payload.Template); err != nil {
 		return nil, err
 	}
-	rj.baseDlJob.init(t, id, bck, payload.Timeout, payload.Describe(), payload.Limits, xdl)
+	rj.baseDlJob.init(t, id, bck, &payload.Base, payload.Describe(), xdl)
 
 	if rj.count, err = countObjects(t, rj.pt, payload.Subdir, rj.bck); err != nil {
 		return nil, err
@@ -337,7 +343,7 @@ func newBackendDlJob(t cluster.Target, id string, bck *cluster.Bck, payload *Bac
 		return nil, errors.New("bucket download does not support HTTP buckets")
 	}
 	bj = &backendDlJob{}
-	bj.baseDlJob.init(t, id, bck, payload.Timeout, payload.Describe(), payload.Limits, xdl)
+	bj.baseDlJob.init(t, id, bck, &payload.Base, payload.Describe(), xdl)
 	{
 		bj.t = t
 		bj.sync = payload.Sync
@@ -421,6 +427,7 @@ func (j *dljob) clone() Job {
 		FinishedCnt:   int(j.finishedCnt.Load()),
 		ScheduledCnt:  int(j.scheduledCnt.Load()),
 		SkippedCnt:    int(j.skippedCnt.Load()),
+		ResumedCnt:    int(j.resumedCnt.Load()),
 		ErrorCnt:      int(j.errorCnt.Load()),
 		Total:         j.total,
 		AllDispatched: j.allDispatched.Load(),
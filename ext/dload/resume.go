@@ -0,0 +1,187 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/3rdparty/glog"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+const hdrContentMD5 = "Content-MD5"
+
+type (
+	// Response body that, upon (network) read error, re-requests the remaining bytes
+	// via HTTP range request and seamlessly continues from where it left off.
+	// Resuming requires the source to support range requests ("Accept-Ranges: bytes")
+	// and to provide a validator (ETag or Last-Modified) - the latter is used with "If-Range"
+	// to make sure the source object hasn't changed in the meantime.
+	resumableBody struct {
+		task      *singleTask
+		body      io.ReadCloser
+		cancel    context.CancelFunc
+		validator string
+		timeout   time.Duration
+		off       int64
+		resumes   int
+	}
+
+	// computes checksum of the downloaded content and validates it upon EOF -
+	// before the content gets committed (see PutObject)
+	cksumReader struct {
+		r        io.ReadCloser
+		ck       *cos.CksumHash
+		expected *cos.Cksum
+		src      string
+		err      error
+	}
+)
+
+// interface guard
+var (
+	_ io.ReadCloser = (*resumableBody)(nil)
+	_ io.ReadCloser = (*cksumReader)(nil)
+)
+
+///////////////////
+// resumableBody //
+///////////////////
+
+func newResumableBody(task *singleTask, resp *http.Response, cancel context.CancelFunc, timeout time.Duration) *resumableBody {
+	rb := &resumableBody{task: task, body: resp.Body, cancel: cancel, timeout: timeout}
+	if resp.Header.Get(cos.HdrAcceptRanges) != "bytes" {
+		return rb
+	}
+	if etag := resp.Header.Get(cos.HdrETag); etag != "" && !strings.HasPrefix(etag, "W/") {
+		rb.validator = etag // (weak ETags cannot be used with If-Range)
+	} else {
+		rb.validator = resp.Header.Get("Last-Modified")
+	}
+	return rb
+}
+
+func (rb *resumableBody) Read(p []byte) (n int, err error) {
+	for {
+		n, err = rb.body.Read(p)
+		rb.off += int64(n)
+		if err == nil || err == io.EOF || !rb.canResume() {
+			return
+		}
+		if errR := rb.resume(err); errR != nil {
+			glog.Warningf("%s: failed to resume at offset %d: %v", rb.task, rb.off, errR)
+			return
+		}
+		if n > 0 {
+			return n, nil
+		}
+	}
+}
+
+func (rb *resumableBody) canResume() bool {
+	return rb.validator != "" && rb.resumes < retryCnt && rb.task.downloadCtx.Err() == nil
+}
+
+func (rb *resumableBody) resume(cause error) error {
+	rb.body.Close()
+	rb.cancel()
+
+	rb.timeout = time.Duration(float64(rb.timeout) * reqTimeoutFactor)
+	ctx, cancel := context.WithTimeout(rb.task.downloadCtx, rb.timeout)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rb.task.obj.link, http.NoBody)
+	if err != nil {
+		cancel()
+		return err
+	}
+	if cos.IsGoogleStorageURL(req.URL) {
+		req.Header.Add("User-Agent", gcsUA)
+	}
+	req.Header.Set(cos.HdrRange, cos.HdrRangeValPrefix+strconv.FormatInt(rb.off, 10)+"-")
+	req.Header.Set("If-Range", rb.validator)
+
+	resp, err := clientForURL(rb.task.obj.link).Do(req)
+	if err != nil {
+		cancel()
+		return err
+	}
+	// anything other than 206 means: the source has changed (If-Range) or ignored the range
+	if resp.StatusCode != http.StatusPartialContent ||
+		!strings.HasPrefix(resp.Header.Get(cos.HdrContentRange), cos.HdrContentRangeValPrefix+strconv.FormatInt(rb.off, 10)+"-") {
+		resp.Body.Close()
+		cancel()
+		return cmn.NewErrHTTP(req, errors.New("cannot resume"), resp.StatusCode)
+	}
+	rb.body, rb.cancel = resp.Body, cancel
+	rb.resumes++
+	glog.Warningf("%s: resumed at offset %d [%d/%d] after: %v", rb.task, rb.off, rb.resumes, retryCnt, cause)
+	return nil
+}
+
+func (rb *resumableBody) Close() error {
+	err := rb.body.Close()
+	rb.cancel()
+	return err
+}
+
+/////////////////
+// cksumReader //
+/////////////////
+
+func (cr *cksumReader) Read(p []byte) (n int, err error) {
+	if cr.err != nil {
+		return 0, cr.err
+	}
+	n, err = cr.r.Read(p)
+	cr.ck.H.Write(p[:n])
+	if err == io.EOF {
+		cr.ck.Finalize()
+		if !cr.ck.Equal(cr.expected) {
+			cr.err = cos.NewBadDataCksumError(cr.expected, &cr.ck.Cksum, cr.src)
+			err = cr.err
+		}
+	}
+	return
+}
+
+func (cr *cksumReader) Close() error { return cr.r.Close() }
+
+// Returns checksum provided by the source (if any), to validate downloaded content.
+// Uses (in that order): custom metadata parsed from cloud-specific headers
+// (see `attrsFromLink`), "Content-MD5", and ETag that is an MD5 (e.g., S3 single-part upload).
+func srcCksum(resp *http.Response, oah cmn.ObjAttrsHolder) *cos.Cksum {
+	if v, ok := oah.GetCustomKey(cmn.MD5ObjMD); ok && isMD5(v) {
+		return cos.NewCksum(cos.ChecksumMD5, strings.Trim(v, "\""))
+	}
+	if v, ok := oah.GetCustomKey(cmn.CRC32CObjMD); ok && v != "" {
+		return cos.NewCksum(cos.ChecksumCRC32C, v)
+	}
+	if v := resp.Header.Get(hdrContentMD5); v != "" {
+		if b, err := base64.StdEncoding.DecodeString(v); err == nil {
+			return cos.NewCksum(cos.ChecksumMD5, hex.EncodeToString(b))
+		}
+	}
+	if etag := strings.Trim(resp.Header.Get(cos.HdrETag), "\""); isMD5(etag) {
+		return cos.NewCksum(cos.ChecksumMD5, etag)
+	}
+	return nil
+}
+
+func isMD5(v string) bool {
+	v = strings.Trim(v, "\"")
+	if len(v) != 32 {
+		return false
+	}
+	_, err := hex.DecodeString(v)
+	return err == nil
+}
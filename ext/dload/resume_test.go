@@ -0,0 +1,139 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+const resumeTestSize = 64 * cos.KiB
+
+func TestResumableBody(t *testing.T) {
+	var (
+		content = bytes.Repeat([]byte("0123456789abcdef"), resumeTestSize/16)
+		modTime = time.Now()
+		cnt     atomic.Int32
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(cos.HdrETag, `"v1"`)
+		if cnt.Inc() > 1 {
+			http.ServeContent(w, r, "obj", modTime, bytes.NewReader(content))
+			return
+		}
+		// first request: promise everything, send half, and drop the connection
+		w.Header().Set(cos.HdrAcceptRanges, "bytes")
+		w.Header().Set(cos.HdrContentLength, "65536")
+		w.WriteHeader(http.StatusOK)
+		w.Write(content[:resumeTestSize/2])
+		w.(http.Flusher).Flush()
+		conn, _, _ := w.(http.Hijacker).Hijack()
+		conn.Close()
+	}))
+	defer srv.Close()
+
+	task := newTestTask(srv.URL)
+	defer task.cancel()
+
+	resp, err := http.Get(srv.URL)
+	tassert.CheckFatal(t, err)
+	body := newResumableBody(task, resp, func() {}, time.Minute)
+	b, err := io.ReadAll(body)
+	body.Close()
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, bytes.Equal(b, content), "content mismatch: got %d bytes, expected %d", len(b), len(content))
+	tassert.Errorf(t, body.resumes == 1, "expected exactly one resume, got %d", body.resumes)
+}
+
+func TestResumableBodyNoRanges(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set(cos.HdrContentLength, "1024")
+		w.WriteHeader(http.StatusOK)
+		w.Write(make([]byte, 512))
+		w.(http.Flusher).Flush()
+		conn, _, _ := w.(http.Hijacker).Hijack()
+		conn.Close()
+	}))
+	defer srv.Close()
+
+	task := newTestTask(srv.URL)
+	defer task.cancel()
+
+	resp, err := http.Get(srv.URL)
+	tassert.CheckFatal(t, err)
+	body := newResumableBody(task, resp, func() {}, time.Minute)
+	_, err = io.ReadAll(body)
+	body.Close()
+	tassert.Errorf(t, err != nil, "expected read error (source does not support ranges)")
+	tassert.Errorf(t, body.resumes == 0, "expected no resumes, got %d", body.resumes)
+}
+
+func TestSrcCksum(t *testing.T) {
+	sum := md5.Sum([]byte("data"))
+	md5hex := hex.EncodeToString(sum[:])
+
+	resp := &http.Response{Header: http.Header{}}
+	resp.Header.Set(cos.HdrETag, `"`+md5hex+`"`)
+	ck := srcCksum(resp, &cmn.ObjAttrs{})
+	tassert.Fatalf(t, ck != nil && ck.Ty() == cos.ChecksumMD5 && ck.Val() == md5hex, "unexpected %v", ck)
+
+	resp = &http.Response{Header: http.Header{}}
+	resp.Header.Set(hdrContentMD5, base64.StdEncoding.EncodeToString(sum[:]))
+	ck = srcCksum(resp, &cmn.ObjAttrs{})
+	tassert.Fatalf(t, ck != nil && ck.Val() == md5hex, "unexpected %v", ck)
+
+	// multipart (non-MD5) ETag
+	resp = &http.Response{Header: http.Header{}}
+	resp.Header.Set(cos.HdrETag, `"`+md5hex+`-3"`)
+	ck = srcCksum(resp, &cmn.ObjAttrs{})
+	tassert.Errorf(t, ck == nil, "expected no checksum, got %v", ck)
+}
+
+// checksum mismatch must fail the read (and, therefore, the PUT) before the content is committed
+func TestCksumReader(t *testing.T) {
+	sum := md5.Sum([]byte("data"))
+	good := cos.NewCksum(cos.ChecksumMD5, hex.EncodeToString(sum[:]))
+	for _, tc := range []struct {
+		content string
+		fail    bool
+	}{{"data", false}, {"corrupted data", true}} {
+		cr := &cksumReader{
+			r:        io.NopCloser(bytes.NewReader([]byte(tc.content))),
+			ck:       cos.NewCksumHash(cos.ChecksumMD5),
+			expected: good,
+			src:      "http://src/obj",
+		}
+		_, err := io.Copy(io.Discard, cr)
+		if tc.fail {
+			tassert.Errorf(t, cos.IsErrBadCksum(err), "%q: expected bad checksum error, got %v", tc.content, err)
+		} else {
+			tassert.CheckError(t, err)
+		}
+	}
+}
+
+func newTestTask(link string) *singleTask {
+	task := &singleTask{
+		obj: dlObj{objName: "obj", link: link},
+		job: &sliceDlJob{baseDlJob: baseDlJob{id: "test", bck: cluster.NewBck("bck", apc.AIS, cmn.NsGlobal)}},
+	}
+	task.downloadCtx, task.cancel = context.WithCancel(context.Background())
+	return task
+}
@@ -43,6 +43,7 @@ type singleTask struct {
 	downloadCtx context.Context    // w/ cancel function
 	getCtx      context.Context    // w/ timeout and size
 	cancel      context.CancelFunc // to cancel the download after the request commences
+	resumed     bool               // download was interrupted and resumed (via range request)
 }
 
 // List of HTTP status codes which we shouldn'task retry (just report the job failed).
@@ -95,6 +96,9 @@ func (task *singleTask) download(lom *cluster.LOM) {
 	}
 
 	dlStore.incFinished(task.jobID())
+	if task.resumed {
+		dlStore.incResumed(task.jobID())
+	}
 
 	task.xdl.statsT.AddMany(
 		cos.NamedVal64{Name: stats.DownloadSize, Value: task.currentSize.Load()},
@@ -107,8 +111,6 @@ func (task *singleTask) tryDownloadLocal(lom *cluster.LOM, timeout time.Duration
 	ctx, cancel := context.WithTimeout(task.downloadCtx, timeout)
 	defer cancel()
 
-	task.getCtx = ctx
-
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, task.obj.link, http.NoBody)
 	if err != nil {
 		return true, err
@@ -124,15 +126,30 @@ func (task *singleTask) tryDownloadLocal(lom *cluster.LOM, timeout time.Duration
 	if err != nil {
 		return false, err
 	}
-	defer resp.Body.Close()
-
 	if resp.StatusCode >= http.StatusBadRequest {
+		resp.Body.Close()
 		return false, cmn.NewErrHTTP(req, errors.New("nil error w/ bad status"), resp.StatusCode)
 	}
 
-	r := task.wrapReader(resp.Body)
-	size := attrsFromLink(task.obj.link, resp, lom)
+	// NOTE: throttling is bounded by the download (not the request) context -
+	// the request itself may get resumed with a new (and longer) timeout
+	task.getCtx = task.downloadCtx
+	body := newResumableBody(task, resp, cancel, timeout)
+	defer body.Close()
+
+	var (
+		r    = task.wrapReader(body)
+		size = attrsFromLink(task.obj.link, resp, lom)
+	)
 	task.setTotalSize(size)
+	if task.job.ValidateCksum() {
+		if expected := srcCksum(resp, lom); expected != nil {
+			// mismatch fails the PUT (and leaves the existing object, if any, intact)
+			r = &cksumReader{r: r, ck: cos.NewCksumHash(expected.Ty()), expected: expected, src: task.obj.link}
+		} else if glog.V(4) {
+			glog.Infof("%s: source provides no checksum - skipping validation", task)
+		}
+	}
 
 	params := cluster.AllocPutObjParams()
 	{
@@ -145,11 +162,12 @@ func (task *singleTask) tryDownloadLocal(lom *cluster.LOM, timeout time.Duration
 	erp := task.xdl.t.PutObject(lom, params)
 	cluster.FreePutObjParams(params)
 	if erp != nil {
-		return true, erp
+		return !cos.IsErrBadCksum(erp), erp // (bad checksum: download again)
 	}
 	if err := lom.Load(true /*cache it*/, false /*locked*/); err != nil {
 		return true, err
 	}
+	task.resumed = body.resumes > 0
 	return false, nil
 }
 
@@ -178,6 +196,8 @@ func (task *singleTask) downloadLocal(lom *cluster.LOM) (err error) {
 				return err
 			}
 			// Otherwise retry...
+		} else if cos.IsErrBadCksum(err) {
+			glog.Warningf("%s [retries: %d/%d]: %v - downloading again...", task, i, retryCnt, err)
 		} else if cos.IsRetriableConnErr(err) {
 			glog.Warningf("%s [retries: %d/%d]: connection failed with (%v), retrying...", task, i, retryCnt, err)
 		} else {
@@ -224,17 +244,23 @@ func (task *singleTask) initialTimeout() time.Duration {
 }
 
 func (task *singleTask) wrapReader(r io.ReadCloser) io.ReadCloser {
-	// Create a custom reader to monitor progress every time we read from response body stream.
-	r = &progressReader{
+	return cos.ChainReadWrappers(task.wrapProgress, task.wrapThrottle)(r)
+}
+
+// Create a custom reader to monitor progress every time we read from response body stream.
+func (task *singleTask) wrapProgress(r io.ReadCloser) io.ReadCloser {
+	return &progressReader{
 		r: r,
 		reporter: func(n int64) {
 			task.currentSize.Add(n)
 			nl.OnProgress(task.job.Notif())
 		},
 	}
-	// Wrap around throttler reader (noop if throttling is disabled).
-	r = task.job.throttler().wrapReader(task.getCtx, r)
-	return r
+}
+
+// Wrap around throttler reader (noop if throttling is disabled).
+func (task *singleTask) wrapThrottle(r io.ReadCloser) io.ReadCloser {
+	return task.job.throttler().wrapReader(task.getCtx, r)
 }
 
 // Probably we need to extend the persistent database (db.go) so that it will contain
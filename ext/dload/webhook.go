@@ -0,0 +1,125 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/NVIDIA/aistore/3rdparty/glog"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/nl"
+)
+
+// final job status
+const (
+	NotifyFinished = "finished"
+	NotifyAborted  = "aborted"
+	NotifyFailed   = "failed"
+)
+
+const (
+	notifyTimeout = 30 * time.Second
+	notifyRetries = 3
+)
+
+// NotifyPayload is the JSON body POSTed to `Base.NotifyURL` upon job completion
+// (or failure); job counters are aggregated across all targets.
+type NotifyPayload struct {
+	Job
+	Status string        `json:"status"`          // one of the Notify* constants (above)
+	Err    string        `json:"error,omitempty"` // job-level error, if any
+	Errs   []TaskErrInfo `json:"download_errors,omitempty"`
+}
+
+// AggregateStats aggregates per-target download statuses reported to the listener
+func AggregateStats(stats *nl.NodeStats) (resp *StatusResp) {
+	stats.Range(func(_ string, status any) bool {
+		dlStatus, ok := status.(*StatusResp)
+		if !ok {
+			dlStatus = &StatusResp{}
+			if err := cos.MorphMarshal(status, dlStatus); err != nil {
+				debug.AssertNoErr(err)
+				return false
+			}
+		}
+		resp = resp.Aggregate(*dlStatus)
+		return true
+	})
+	return
+}
+
+// NotifyCallback returns listener's callback that POSTs the final job status to the
+// given URL; the callback is invoked once (upon job completion) by the controlling node
+func NotifyCallback(notifyURL string) nl.Callback {
+	return func(n nl.Listener) {
+		payload := NewNotifyPayload(n)
+		go func() {
+			if err := PostNotify(notifyURL, payload); err != nil {
+				glog.Errorf("download job %s: failed to notify %q: %v", payload.ID, notifyURL, err)
+			}
+		}()
+	}
+}
+
+func NewNotifyPayload(n nl.Listener) *NotifyPayload {
+	payload := &NotifyPayload{Status: NotifyFinished}
+	if resp := AggregateStats(n.NodeStats()); resp != nil {
+		payload.Job, payload.Errs = resp.Job, resp.Errs
+	}
+	payload.ID = n.UUID()
+	switch err := n.Err(); {
+	case n.Aborted():
+		payload.Status = NotifyAborted
+		if err != nil {
+			payload.Err = err.Error()
+		}
+	case err != nil:
+		payload.Status, payload.Err = NotifyFailed, err.Error()
+	}
+	return payload
+}
+
+// POST the payload, retry a few times upon failure (including non-2xx responses)
+func PostNotify(notifyURL string, payload *NotifyPayload) error {
+	var (
+		body   = cos.MustMarshal(payload)
+		client = clientForURL(notifyURL)
+	)
+	call := func() (int, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), notifyTimeout)
+		defer cancel()
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, notifyURL, bytes.NewReader(body))
+		if err != nil {
+			return 0, err
+		}
+		req.Header.Set(cos.HdrContentType, cos.ContentJSON)
+		resp, err := client.Do(req)
+		if err != nil {
+			return 0, err
+		}
+		cos.DrainReader(resp.Body)
+		resp.Body.Close()
+		if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+			return resp.StatusCode, fmt.Errorf("%s responded with status %d", notifyURL, resp.StatusCode)
+		}
+		return resp.StatusCode, nil
+	}
+	return cmn.NetworkCallWithRetry(&cmn.RetryArgs{
+		Call:      call,
+		Action:    "notify " + notifyURL,
+		Caller:    payload.ID,
+		SoftErr:   notifyRetries,
+		HardErr:   notifyRetries,
+		Sleep:     time.Second,
+		BackOff:   true,
+		Verbosity: cmn.RetryLogQuiet,
+	})
+}
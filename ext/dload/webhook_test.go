@@ -0,0 +1,72 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/tools/tassert"
+	jsoniter "github.com/json-iterator/go"
+)
+
+func TestNotify(t *testing.T) {
+	var (
+		smap = &cluster.Smap{Tmap: make(cluster.NodeMap, 2)}
+		base = Base{Bck: cmn.Bck{Name: "b", Provider: apc.AIS}}
+	)
+	for _, u := range []string{"localhost:8080/cb", "ftp://host/cb", "http://"} {
+		base.NotifyURL = u
+		tassert.Errorf(t, base.Validate() != nil, "expected invalid notify URL %q", u)
+	}
+	base.NotifyURL = "https://host:8080/cb?job=1"
+	tassert.CheckFatal(t, base.Validate())
+
+	for _, tid := range []string{"t1", "t2"} {
+		smap.Tmap[tid] = cluster.NewSnode(tid, apc.Target, cluster.NetInfo{}, cluster.NetInfo{}, cluster.NetInfo{})
+	}
+	nl := NewDownloadNL(PrefixJobID+"x", string(TypeRange), smap, 0)
+	nl.SetStats("t1", &StatusResp{Job: Job{FinishedCnt: 3, Total: 4, ErrorCnt: 1},
+		Errs: []TaskErrInfo{{Name: "o1", Err: "404"}}})
+	nl.SetStats("t2", &StatusResp{Job: Job{FinishedCnt: 5, Total: 5}})
+	nl.SetErr(errors.New("t2: out of space"))
+
+	payload := NewNotifyPayload(nl)
+	tassert.Errorf(t, payload.ID == PrefixJobID+"x", "wrong job ID %q", payload.ID)
+	tassert.Errorf(t, payload.Status == NotifyFailed && payload.Err == "t2: out of space",
+		"expected %q, got %q (%q)", NotifyFailed, payload.Status, payload.Err)
+	tassert.Errorf(t, payload.FinishedCnt == 8 && payload.ErrorCnt == 1 && payload.Total == 9 && len(payload.Errs) == 1,
+		"wrong aggregated status %+v", payload)
+
+	// fail the first call, receive the payload upon retry
+	var (
+		calls    atomic.Int32
+		received NotifyPayload
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Inc() == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		if err := jsoniter.NewDecoder(r.Body).Decode(&received); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer srv.Close()
+
+	started := time.Now()
+	tassert.CheckFatal(t, PostNotify(srv.URL, payload))
+	tassert.Errorf(t, calls.Load() == 2, "expected 2 calls, got %d", calls.Load())
+	tassert.Errorf(t, received.ID == payload.ID && received.Status == NotifyFailed && received.FinishedCnt == 8,
+		"wrong received payload %+v", received)
+	tassert.Errorf(t, time.Since(started) < notifyTimeout, "took too long: %v", time.Since(started))
+}
@@ -375,6 +375,7 @@ func (m *Manager) createShard(s *extract.Shard) (err error) {
 			cluster.FreePutObjParams(params)
 			if err == nil {
 				n = lom.SizeBytes()
+				m.addCreated(shardName)
 			}
 		} else {
 			n, err = io.Copy(io.Discard, r)
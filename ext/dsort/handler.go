@@ -238,9 +238,17 @@ func ProxyAbortSortHandler(w http.ResponseWriter, r *http.Request) {
 		query       = r.URL.Query()
 		managerUUID = query.Get(apc.QparamUUID)
 		path        = apc.URLPathdSortAbort.Join(managerUUID)
-		responses   = broadcastTargets(http.MethodDelete, path, nil, nil, ctx.smapOwner.Get())
+		cleanup     = cos.IsParseBool(query.Get(apc.QparamCleanup))
+		urlParams   url.Values
+	)
+	if cleanup {
+		urlParams = url.Values{apc.QparamCleanup: []string{"true"}}
+	}
+	var (
+		responses   = broadcastTargets(http.MethodDelete, path, urlParams, nil, ctx.smapOwner.Get())
+		allNotFound = true
+		removed     int
 	)
-	allNotFound := true
 	for _, resp := range responses {
 		if resp.statusCode == http.StatusNotFound {
 			continue
@@ -251,12 +259,23 @@ func ProxyAbortSortHandler(w http.ResponseWriter, r *http.Request) {
 			cmn.WriteErr(w, r, resp.err, resp.statusCode)
 			return
 		}
+		if cleanup {
+			var n int
+			if err := js.Unmarshal(resp.res, &n); err != nil {
+				cmn.WriteErr(w, r, err, http.StatusInternalServerError)
+				return
+			}
+			removed += n
+		}
 	}
 	if allNotFound {
 		err := cmn.NewErrNotFound("%s job %q", DSortName, managerUUID)
 		cmn.WriteErr(w, r, err, http.StatusNotFound)
 		return
 	}
+	if cleanup {
+		w.Write(cos.MustMarshal(removed))
+	}
 }
 
 // DELETE /v1/sort
@@ -583,7 +602,8 @@ func recordsHandler(managers *ManagerGroup) http.HandlerFunc {
 
 // abortSortHandler is the handler called for the HTTP endpoint /v1/sort/abort.
 // A valid DELETE to this endpoint aborts currently running sort job and cleans
-// up the state.
+// up the state. With `apc.QparamCleanup`, it also removes output shards written
+// by the job (on this target) and responds with their number.
 func abortSortHandler(w http.ResponseWriter, r *http.Request) {
 	if !checkHTTPMethod(w, r, http.MethodDelete) {
 		return
@@ -600,13 +620,22 @@ func abortSortHandler(w http.ResponseWriter, r *http.Request) {
 		cmn.WriteErrMsg(w, r, s, http.StatusNotFound)
 		return
 	}
-	if dsortManager.Metrics.Archived.Load() {
+	// (cleanup also applies to jobs that have been aborted earlier)
+	cleanup := cos.IsParseBool(r.URL.Query().Get(apc.QparamCleanup))
+	if dsortManager.Metrics.Archived.Load() && !(cleanup && dsortManager.aborted()) {
 		s := fmt.Sprintf("invalid request: %s job %q has already finished", DSortName, managerUUID)
 		cmn.WriteErrMsg(w, r, s, http.StatusGone)
 		return
 	}
 
 	dsortManager.abort(fmt.Errorf("%s has been aborted via API (remotely)", DSortName))
+
+	// wait for the (aborted) job to stop writing and remove its partial output
+	if cleanup {
+		dsortManager.waitForFinish()
+		removed := dsortManager.rmCreated()
+		w.Write(cos.MustMarshal(removed))
+	}
 }
 
 func removeSortHandler(w http.ResponseWriter, r *http.Request) {
@@ -133,6 +133,11 @@ type (
 			mu sync.Mutex
 			m  map[string]struct{} // finished acks: daemonID -> ack
 		}
+		created struct {
+			mu      sync.Mutex
+			names   []string // output shards written by this target (see `rmCreated`)
+			removed bool
+		}
 
 		dsorter        dsorter
 		dsorterStarted sync.WaitGroup
@@ -696,10 +701,74 @@ func (m *Manager) makeRecvShardFunc() transport.RecvObj {
 			m.abort(err)
 			return erp
 		}
+		m.addCreated(hdr.ObjName)
 		return nil
 	}
 }
 
+// addCreated records output shard written by this target, to be removed if the job
+// gets aborted with cleanup (see `rmCreated`) - or right away if the latter has already run
+func (m *Manager) addCreated(shardName string) {
+	m.created.mu.Lock()
+	if !m.created.removed {
+		m.created.names = append(m.created.names, shardName)
+		m.created.mu.Unlock()
+		return
+	}
+	m.created.mu.Unlock()
+	m.rmShard(shardName)
+}
+
+// rmCreated removes all output shards written by this target and returns the number
+// of those that belong (HRW) to it; local copies of the shards that were sent
+// to their respective owners are removed but not counted.
+//
+// PRECONDITION: the job must be aborted and finished.
+func (m *Manager) rmCreated() (n int) {
+	m.created.mu.Lock()
+	names := m.created.names
+	m.created.names, m.created.removed = nil, true
+	m.created.mu.Unlock()
+	for _, shardName := range names {
+		if m.rmShard(shardName) {
+			n++
+		}
+	}
+	glog.Infof("[dsort] %s removed %d partial output shard%s", m.ManagerUUID, n, cos.Plural(n))
+	return
+}
+
+func (m *Manager) rmShard(shardName string) (owned bool) {
+	lom := cluster.AllocLOM(shardName)
+	defer cluster.FreeLOM(lom)
+	if err := lom.InitBck(&m.rs.OutputBck); err != nil {
+		glog.Errorf("[dsort] %s: %v", m.ManagerUUID, err)
+		return false
+	}
+	si, err := cluster.HrwTarget(lom.Uname(), m.smap)
+	if err != nil {
+		glog.Errorf("[dsort] %s: %v", m.ManagerUUID, err)
+		return false
+	}
+	if si.ID() != m.ctx.node.ID() {
+		// local copy only (the owner takes care of the remote backend, if any)
+		lom.Lock(true)
+		err = lom.Remove()
+		lom.Unlock(true)
+		if err != nil {
+			glog.Errorf("[dsort] %s: failed to remove %s: %v", m.ManagerUUID, lom, err)
+		}
+		return false
+	}
+	if errCode, err := m.ctx.t.DeleteObject(lom, false /*evict*/); err != nil {
+		if errCode != http.StatusNotFound && !cmn.IsObjNotExist(err) {
+			glog.Errorf("[dsort] %s: failed to remove %s: %v", m.ManagerUUID, lom, err)
+		}
+		return false
+	}
+	return true
+}
+
 // doWithAbort sends requests through client. If manager aborts during the call
 // request is canceled.
 func (m *Manager) doWithAbort(reqArgs *cmn.HreqArgs) error {
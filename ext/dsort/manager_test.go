@@ -10,9 +10,13 @@ import (
 	"fmt"
 	"io"
 	"math/rand"
+	"os"
 	"testing"
 
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster"
 	"github.com/NVIDIA/aistore/cluster/mock"
+	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/ext/dsort/extract"
 	"github.com/NVIDIA/aistore/fs"
@@ -66,6 +70,51 @@ var _ = Describe("Init", func() {
 	})
 })
 
+var _ = Describe("Cleanup", func() {
+	BeforeEach(func() {
+		Expect(cos.CreateDir(testDir)).NotTo(HaveOccurred())
+		fs.TestNew(nil)
+		_, err := fs.Add(testDir, "daeID")
+		Expect(err).NotTo(HaveOccurred())
+		fs.CSM.Reg(fs.ObjectType, &fs.ObjectContentResolver{})
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(testDir)).NotTo(HaveOccurred())
+	})
+
+	It("should remove created shards and count only owned ones", func() {
+		smap := newTestSmap()
+		for _, tid := range []string{"t1", "t2"} {
+			smap.addTarget(cluster.NewSnode(tid, apc.Target, cluster.NetInfo{}, cluster.NetInfo{}, cluster.NetInfo{}))
+		}
+		bck := cluster.NewBck(testBucket, apc.AIS, cmn.NsGlobal, &cmn.BucketProps{Cksum: cmn.CksumConf{Type: cos.ChecksumXXHash}})
+		m := &Manager{
+			ManagerUUID: "uuid",
+			ctx:         dsortContext{t: mock.NewTarget(mock.NewBaseBownerMock(bck)), node: smap.Tmap["t1"]},
+			smap:        smap.Smap,
+			rs:          &ParsedRequestSpec{OutputBck: *bck.Bucket()},
+		}
+		var owned int
+		for i := 0; i < 20; i++ {
+			shardName := fmt.Sprintf("shard-%d.tar", i)
+			si, err := cluster.HrwTarget(bck.MakeUname(shardName), smap.Smap)
+			Expect(err).NotTo(HaveOccurred())
+			if si.ID() == "t1" {
+				owned++
+			}
+			m.addCreated(shardName)
+		}
+		Expect(owned).To(BeNumerically(">", 0))
+		Expect(m.rmCreated()).To(Equal(owned))
+
+		// shards written after the cleanup are removed right away
+		m.addCreated("late.tar")
+		Expect(m.created.names).To(BeEmpty())
+		Expect(m.rmCreated()).To(BeZero())
+	})
+})
+
 func BenchmarkRecordsMarshal(b *testing.B) {
 	benches := []struct {
 		recordCnt    int
@@ -47,7 +47,8 @@ var (
 )
 
 // supportedExtensions is a list of extensions (archives) supported by dSort
-var supportedExtensions = cos.ArchExtensions
+// (NOTE: a subset of cos.ArchExtensions)
+var supportedExtensions = []string{cos.ExtTar, cos.ExtTgz, cos.ExtTarTgz, cos.ExtZip, cos.ExtMsgpack}
 
 // TODO: maybe this struct should be composed of `type` and `template` where
 // template is interface and each template has it's own struct. Then we could
@@ -15,6 +15,7 @@ import (
 	"github.com/NVIDIA/aistore/ext/etl/runtime"
 	jsoniter "github.com/json-iterator/go"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/kubernetes/scheme"
 )
 
@@ -41,10 +42,16 @@ type (
 		IDX       string       `json:"id"`
 		CommTypeX string       `json:"communication"`
 		Timeout   cos.Duration `json:"timeout"`
+		// the ETL container supports batch requests (hpush only) - see `QparamBatch`
+		Batch bool `json:"batch,omitempty"`
 	}
 	InitSpecMsg struct {
 		InitMsgBase
 		Spec []byte `json:"spec"` // NOTE: eq. `Spec`
+		// when specified, override (or add) the container's HTTP probes;
+		// both probes use the container's `default` port
+		ReadinessPath string `json:"readiness_path,omitempty"`
+		LivenessPath  string `json:"liveness_path,omitempty"`
 	}
 
 	InitCodeMsg struct {
@@ -116,6 +123,15 @@ const (
 
 var commTypes = []string{Hpush, Hpull, Hrev, HpushStdin} // NOTE: must contain all
 
+// Batch request (offline transformation, hpush only): target PUTs a TAR archive
+// containing multiple objects (one entry per object, named after the object) to
+// "/?batch=true", and the ETL container responds with a TAR archive containing
+// transformed objects under the same names.
+const (
+	QparamBatch      = "batch"
+	BatchContentType = "application/x-tar"
+)
+
 ////////////////
 // InitMsg*** //
 ////////////////
@@ -179,6 +195,9 @@ func (m *InitCodeMsg) Validate() error {
 	} else if !cos.StringInSlice(m.CommTypeX, commTypes) {
 		return fmt.Errorf("unsupported comm-type %q (%q)", m.CommTypeX, m.Runtime)
 	}
+	if m.Batch {
+		return fmt.Errorf("batch requests are not supported by the %q runtime (comm-type %q)", m.Runtime, m.CommTypeX)
+	}
 	if m.Funcs.Transform == "" {
 		return fmt.Errorf("transform function cannot be empty (comm-type %q, funcs %+v)", m.CommTypeX, m.Funcs)
 	}
@@ -212,6 +231,11 @@ func (m *InitSpecMsg) Validate() (err error) {
 	if err != nil {
 		return err
 	}
+	for _, path := range []string{m.ReadinessPath, m.LivenessPath} {
+		if path != "" && path[0] != '/' {
+			return cmn.NewErrETL(errCtx, "invalid probe path %q (expecting absolute path, e.g. \"/health\")", path)
+		}
+	}
 
 	if err := validateCommType(m.CommType()); err != nil {
 		return cmn.NewErrETL(errCtx, err.Error())
@@ -219,12 +243,16 @@ func (m *InitSpecMsg) Validate() (err error) {
 	if m.CommType() == "" {
 		m.CommTypeX = Hpush
 	}
+	if m.Batch && m.CommType() != Hpush {
+		return cmn.NewErrETL(errCtx, "batch requests require %q comm-type (have %q)", Hpush, m.CommType())
+	}
 
 	// Check pod specification constraints.
 	if len(pod.Spec.Containers) != 1 {
 		err = cmn.NewErrETL(errCtx, "unsupported number of containers (%d), expected: 1", len(pod.Spec.Containers))
 		return
 	}
+	m.setProbes(pod)
 	container := pod.Spec.Containers[0]
 	if len(container.Ports) != 1 {
 		return cmn.NewErrETL(errCtx, "unsupported number of container ports (%d), expected: 1", len(container.Ports))
@@ -237,7 +265,7 @@ func (m *InitSpecMsg) Validate() (err error) {
 	// Currently we need the `default` port (on which the application runs) to
 	// be same as the `readiness` probe port.
 	if container.ReadinessProbe == nil {
-		return cmn.NewErrETL(errCtx, "readinessProbe section is required in a container spec")
+		return cmn.NewErrETL(errCtx, "readinessProbe section (or readiness path) is required in a container spec")
 	}
 	// TODO: Add support for other health checks.
 	if container.ReadinessProbe.HTTPGet == nil {
@@ -251,9 +279,37 @@ func (m *InitSpecMsg) Validate() (err error) {
 	if container.ReadinessProbe.HTTPGet.Port.StrVal != k8s.Default {
 		return cmn.NewErrETL(errCtx, "readinessProbe port must be the %q port", k8s.Default)
 	}
+	if probe := container.LivenessProbe; probe != nil && probe.HTTPGet != nil && probe.HTTPGet.Path == "" {
+		return cmn.NewErrETL(errCtx, "expected non-empty path for livenessProbe")
+	}
 	return nil
 }
 
+// add or override readiness and liveness probes, if requested
+func (m *InitSpecMsg) setProbes(pod *corev1.Pod) {
+	if len(pod.Spec.Containers) == 0 {
+		return
+	}
+	container := &pod.Spec.Containers[0]
+	if m.ReadinessPath != "" {
+		container.ReadinessProbe = _httpProbe(container.ReadinessProbe, m.ReadinessPath)
+	}
+	if m.LivenessPath != "" {
+		container.LivenessProbe = _httpProbe(container.LivenessProbe, m.LivenessPath)
+	}
+}
+
+func _httpProbe(probe *corev1.Probe, path string) *corev1.Probe {
+	if probe == nil {
+		probe = &corev1.Probe{}
+	}
+	if probe.HTTPGet == nil {
+		probe.HTTPGet = &corev1.HTTPGetAction{Port: intstr.FromString(k8s.Default)}
+	}
+	probe.HTTPGet.Path = path
+	return probe
+}
+
 //////////////
 // InfoList //
 //////////////
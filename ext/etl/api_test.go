@@ -0,0 +1,55 @@
+// Package etl provides utilities to initialize and use transformation pods.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package etl
+
+import (
+	"github.com/NVIDIA/aistore/cmn/k8s"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("InitSpecMsgTest", func() {
+	const specNoProbes = `
+apiVersion: v1
+kind: Pod
+metadata:
+  name: transformer-echo
+spec:
+  containers:
+    - name: server
+      image: aistore/transformer_echo:latest
+      ports:
+        - name: default
+          containerPort: 80
+`
+	newMsg := func() *InitSpecMsg {
+		return &InitSpecMsg{InitMsgBase: InitMsgBase{IDX: "transformer-echo"}, Spec: []byte(specNoProbes)}
+	}
+
+	It("should require readiness probe", func() {
+		Expect(newMsg().Validate()).To(HaveOccurred())
+	})
+
+	It("should add probes given their paths", func() {
+		msg := newMsg()
+		msg.ReadinessPath, msg.LivenessPath = "/ready", "/alive"
+		Expect(msg.Validate()).NotTo(HaveOccurred())
+
+		pod, err := ParsePodSpec(nil, msg.Spec)
+		Expect(err).NotTo(HaveOccurred())
+		msg.setProbes(pod)
+		container := pod.Spec.Containers[0]
+		Expect(container.ReadinessProbe.HTTPGet.Path).To(Equal("/ready"))
+		Expect(container.ReadinessProbe.HTTPGet.Port.StrVal).To(Equal(k8s.Default))
+		Expect(container.LivenessProbe.HTTPGet.Path).To(Equal("/alive"))
+		Expect(container.LivenessProbe.HTTPGet.Port.StrVal).To(Equal(k8s.Default))
+	})
+
+	It("should reject relative probe path", func() {
+		msg := newMsg()
+		msg.ReadinessPath = "ready"
+		Expect(msg.Validate()).To(HaveOccurred())
+	})
+})
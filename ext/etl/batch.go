@@ -0,0 +1,285 @@
+// Package etl provides utilities to initialize and use transformation pods.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package etl
+
+import (
+	"archive/tar"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+const (
+	batchLinger      = 20 * time.Millisecond // max time to wait for a batch to fill up
+	batchMaxInflight = 4                     // max number of concurrent batch requests (per target)
+)
+
+type (
+	// accumulates offline transform requests (see `OfflineDP`) and executes them in batches
+	batcher struct {
+		comm    BatchCommunicator
+		sema    *cos.Semaphore // bounds the number of batch requests in flight
+		timer   *time.Timer
+		pending []*batchReq
+		size    int
+		timeout time.Duration
+		mu      sync.Mutex
+	}
+	// a single object in a batch; the transformed content is streamed directly
+	// from the response - the reader is valid until closed (see batchReader)
+	batchReq struct {
+		bck      *cluster.Bck
+		objName  string
+		r        io.Reader
+		size     int64
+		err      error
+		done     chan struct{} // r (or err) is ready
+		consumed chan struct{} // r has been read and closed by the caller
+	}
+	batchReader struct {
+		req  *batchReq
+		once sync.Once
+	}
+	// called for each transformed object in the batch response, in the order received;
+	// must return only after it's done reading
+	batchCB func(objName string, r io.Reader, size int64) error
+)
+
+//////////////
+// pushComm //
+//////////////
+
+func (pc *pushComm) SupportsBatch() bool { return pc.batch && pc.commType == Hpush }
+
+func (pc *pushComm) OfflineTransformBatch(bck *cluster.Bck, objNames []string, timeout time.Duration, cb batchCB) error {
+	if err := pc.xctn.AbortErr(); err != nil {
+		return cmn.NewErrAborted(pc.String(), "do-batch", err)
+	}
+	var (
+		ctx    = context.Background()
+		cancel context.CancelFunc
+		pr, pw = io.Pipe()
+	)
+	if timeout != 0 {
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	go func() {
+		pw.CloseWithError(pc.writeBatch(bck, objNames, pw))
+	}()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, pc.uri+"/?"+QparamBatch+"=true", pr)
+	if err != nil {
+		pr.Close()
+		return err
+	}
+	req.Header.Set(cos.HdrContentType, BatchContentType)
+	resp, err := pc.t.DataClient().Do(req)
+	if err != nil {
+		pr.CloseWithError(err)
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		b, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("%s: batch request failed: status %d, %q", pc, resp.StatusCode, b)
+	}
+
+	tr := tar.NewReader(resp.Body)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := cb(hdr.Name, tr, hdr.Size); err != nil {
+			return err
+		}
+		pc.xctn.InObjsAdd(1, hdr.Size)
+	}
+}
+
+// write objects into the request body, one object (and one read lock) at a time
+func (pc *pushComm) writeBatch(bck *cluster.Bck, objNames []string, w io.Writer) error {
+	tw := tar.NewWriter(w)
+	for _, objName := range objNames {
+		if err := pc.writeBatchObj(bck, objName, tw); err != nil {
+			return err
+		}
+	}
+	return tw.Close()
+}
+
+func (pc *pushComm) writeBatchObj(bck *cluster.Bck, objName string, tw *tar.Writer) error {
+	lom := cluster.AllocLOM(objName)
+	defer cluster.FreeLOM(lom)
+	if err := lom.InitBck(bck.Bucket()); err != nil {
+		return err
+	}
+	lom.Lock(false)
+	err := lom.Load(false /*cache it*/, true /*locked*/)
+	if err != nil && cmn.IsObjNotExist(err) && bck.IsRemote() {
+		lom.Unlock(false)
+		if _, err = pc.t.GetCold(context.Background(), lom, cmn.OwtGetLock); err != nil {
+			return err
+		}
+		lom.Lock(false)
+		err = lom.Load(false, true)
+	}
+	defer lom.Unlock(false)
+	if err != nil {
+		return err
+	}
+	fh, err := cos.NewFileHandle(lom.FQN)
+	if err != nil {
+		return err
+	}
+	defer cos.Close(fh)
+	hdr := &tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     objName,
+		Size:     lom.SizeBytes(),
+		Mode:     int64(cos.PermRWR),
+		ModTime:  lom.Atime(),
+	}
+	if err := tw.WriteHeader(hdr); err != nil {
+		return err
+	}
+	if _, err := io.Copy(tw, fh); err != nil {
+		return err
+	}
+	pc.xctn.OutObjsAdd(1, lom.SizeBytes())
+	return nil
+}
+
+/////////////
+// batcher //
+/////////////
+
+func newBatcher(comm BatchCommunicator, size int, timeout time.Duration) *batcher {
+	return &batcher{
+		comm:    comm,
+		sema:    cos.NewSemaphore(batchMaxInflight),
+		size:    size,
+		timeout: timeout,
+		pending: make([]*batchReq, 0, size),
+	}
+}
+
+// add the object to the current batch and wait for the latter to get executed;
+// upon success, the caller must read and close the returned reader
+func (b *batcher) do(lom *cluster.LOM) *batchReq {
+	var (
+		prev, full []*batchReq
+		req        = &batchReq{
+			bck:      lom.Bck(),
+			objName:  lom.ObjName,
+			done:     make(chan struct{}),
+			consumed: make(chan struct{}),
+		}
+	)
+	b.mu.Lock()
+	// (all objects in a batch must belong to the same bucket)
+	if len(b.pending) > 0 && !b.pending[0].bck.Equal(req.bck, true, true) {
+		prev = b._take()
+	}
+	b.pending = append(b.pending, req)
+	switch {
+	case len(b.pending) >= b.size:
+		full = b._take()
+	case len(b.pending) == 1:
+		b.timer = time.AfterFunc(batchLinger, b.flush)
+	}
+	b.mu.Unlock()
+
+	// (not inline: exec hands over results one at a time, this caller's included;
+	// the number of requests in flight is bounded by b.sema)
+	if prev != nil {
+		go b.exec(prev)
+	}
+	if full != nil {
+		go b.exec(full)
+	}
+	<-req.done
+	return req
+}
+
+func (b *batcher) _take() (batch []*batchReq) {
+	if b.timer != nil {
+		b.timer.Stop()
+		b.timer = nil
+	}
+	batch, b.pending = b.pending, make([]*batchReq, 0, b.size)
+	return
+}
+
+// timer callback: execute partial batch
+func (b *batcher) flush() {
+	b.mu.Lock()
+	batch := b._take()
+	b.mu.Unlock()
+	if len(batch) > 0 {
+		b.exec(batch)
+	}
+}
+
+// execute the batch request and hand over each transformed object to its
+// (waiting) caller, one at a time
+func (b *batcher) exec(batch []*batchReq) {
+	b.sema.Acquire()
+	defer b.sema.Release()
+
+	var (
+		objNames = make([]string, len(batch))
+		pending  = make(map[string]*batchReq, len(batch))
+		abort    = b.comm.Xact().ChanAbort()
+	)
+	for i, req := range batch {
+		objNames[i] = req.objName
+		pending[req.objName] = req
+	}
+	err := b.comm.OfflineTransformBatch(batch[0].bck, objNames, b.timeout, func(objName string, r io.Reader, size int64) error {
+		req, ok := pending[objName]
+		if !ok {
+			return nil // (unexpected or duplicate - skip)
+		}
+		delete(pending, objName)
+		req.r, req.size = r, size
+		close(req.done)
+		select {
+		case <-req.consumed:
+			return nil
+		case err := <-abort:
+			return cmn.NewErrAborted(b.comm.Xact().Name(), "batch", err)
+		}
+	})
+	if err == nil {
+		err = errors.New("missing in the batch response")
+	}
+	for _, req := range pending {
+		req.err = err
+		close(req.done)
+	}
+}
+
+/////////////////
+// batchReader //
+/////////////////
+
+func (br *batchReader) Read(p []byte) (int, error) { return br.req.r.Read(p) }
+
+func (br *batchReader) Close() error {
+	br.once.Do(func() { close(br.req.consumed) })
+	return nil
+}
@@ -0,0 +1,191 @@
+// Package etl provides utilities to initialize and use transformation pods.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package etl
+
+import (
+	"archive/tar"
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cluster/mock"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/fs"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+)
+
+var _ = Describe("BatchTest", func() {
+	const (
+		numObjs   = 50
+		batchSize = 8
+		objSize   = 1024
+	)
+	var (
+		tmpDir            string
+		tMock             cluster.Target
+		transformerServer *httptest.Server
+		numRequests       atomic.Int32
+
+		bck        = cmn.Bck{Name: "batchBck", Provider: apc.AIS, Ns: cmn.NsGlobal}
+		clusterBck = cluster.NewBck(
+			bck.Name, bck.Provider, bck.Ns,
+			&cmn.BucketProps{Cksum: cmn.CksumConf{Type: cos.ChecksumXXHash}},
+		)
+		bmdMock = mock.NewBaseBownerMock(clusterBck)
+	)
+
+	BeforeEach(func() {
+		var err error
+		tmpDir, err = os.MkdirTemp("", "")
+		Expect(err).NotTo(HaveOccurred())
+		mpath := filepath.Join(tmpDir, "mpath")
+		Expect(cos.CreateDir(mpath)).NotTo(HaveOccurred())
+		fs.TestNew(nil)
+		fs.TestDisableValidation()
+		_, err = fs.Add(mpath, "daeID")
+		Expect(err).NotTo(HaveOccurred())
+
+		tMock = mock.NewTarget(bmdMock)
+		for i := 0; i < numObjs; i++ {
+			lom := &cluster.LOM{ObjName: fmt.Sprintf("obj-%d", i)}
+			Expect(lom.InitBck(clusterBck.Bucket())).NotTo(HaveOccurred())
+			Expect(createRandomFile(lom.FQN, objSize)).NotTo(HaveOccurred())
+			lom.SetAtimeUnix(time.Now().UnixNano())
+			lom.SetSize(objSize)
+			Expect(lom.Persist()).NotTo(HaveOccurred())
+		}
+
+		// transformer: batch requests only; "transforms" each object into its name
+		numRequests.Store(0)
+		transformerServer = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer GinkgoRecover()
+			Expect(r.URL.Query().Get(QparamBatch)).To(Equal("true"))
+			numRequests.Inc()
+			// HTTP/1.x: read the entire request before writing the response
+			var (
+				tr    = tar.NewReader(r.Body)
+				names []string
+			)
+			for {
+				hdr, err := tr.Next()
+				if err == io.EOF {
+					break
+				}
+				Expect(err).NotTo(HaveOccurred())
+				b, err := io.ReadAll(tr)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(len(b)).To(Equal(objSize))
+				names = append(names, hdr.Name)
+			}
+			tw := tar.NewWriter(w)
+			for _, name := range names {
+				out := []byte(name)
+				Expect(tw.WriteHeader(&tar.Header{Typeflag: tar.TypeReg, Name: name, Size: int64(len(out))})).NotTo(HaveOccurred())
+				_, err := tw.Write(out)
+				Expect(err).NotTo(HaveOccurred())
+			}
+			Expect(tw.Close()).NotTo(HaveOccurred())
+		}))
+	})
+
+	AfterEach(func() {
+		_ = os.RemoveAll(tmpDir)
+		transformerServer.Close()
+	})
+
+	It("should transform objects in batches", func() {
+		pod := &corev1.Pod{}
+		pod.SetName("somename")
+		comm := makeCommunicator(commArgs{
+			bootstrapper: &etlBootstrapper{
+				t:    tMock,
+				msg:  InitSpecMsg{InitMsgBase: InitMsgBase{CommTypeX: Hpush, Batch: true}},
+				pod:  pod,
+				uri:  transformerServer.URL,
+				xctn: mock.NewXact(apc.ActETLInline),
+			},
+		})
+		bc, ok := comm.(BatchCommunicator)
+		Expect(ok).To(BeTrue())
+		Expect(bc.SupportsBatch()).To(BeTrue())
+
+		var (
+			b  = newBatcher(bc, batchSize, 0 /*timeout*/)
+			wg = &sync.WaitGroup{}
+		)
+		for i := 0; i < numObjs; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer GinkgoRecover()
+				defer wg.Done()
+				lom := &cluster.LOM{ObjName: fmt.Sprintf("obj-%d", i)}
+				Expect(lom.InitBck(clusterBck.Bucket())).NotTo(HaveOccurred())
+				req := b.do(lom)
+				Expect(req.err).NotTo(HaveOccurred())
+				br := &batchReader{req: req}
+				data, err := io.ReadAll(br)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(br.Close()).NotTo(HaveOccurred())
+				Expect(bytes.Equal(data, []byte(lom.ObjName))).To(BeTrue())
+				Expect(req.size).To(BeEquivalentTo(len(data)))
+			}(i)
+		}
+		wg.Wait()
+		Expect(int(numRequests.Load())).To(BeNumerically(">=", (numObjs+batchSize-1)/batchSize))
+		Expect(int(numRequests.Load())).To(BeNumerically("<", numObjs))
+	})
+
+	// same as TCB with batching: batch-size callers (joggers), each blocking on one object at a time
+	It("should fill up batches with batch-size synchronous callers", func() {
+		pod := &corev1.Pod{}
+		pod.SetName("somename")
+		comm := makeCommunicator(commArgs{
+			bootstrapper: &etlBootstrapper{
+				t:    tMock,
+				msg:  InitSpecMsg{InitMsgBase: InitMsgBase{CommTypeX: Hpush, Batch: true}},
+				pod:  pod,
+				uri:  transformerServer.URL,
+				xctn: mock.NewXact(apc.ActETLInline),
+			},
+		})
+		var (
+			b       = newBatcher(comm.(BatchCommunicator), batchSize, 0 /*timeout*/)
+			wg      = &sync.WaitGroup{}
+			perCall = numObjs / batchSize
+		)
+		for i := 0; i < batchSize; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer GinkgoRecover()
+				defer wg.Done()
+				for j := 0; j < perCall; j++ {
+					lom := &cluster.LOM{ObjName: fmt.Sprintf("obj-%d", i*perCall+j)}
+					Expect(lom.InitBck(clusterBck.Bucket())).NotTo(HaveOccurred())
+					req := b.do(lom)
+					Expect(req.err).NotTo(HaveOccurred())
+					br := &batchReader{req: req}
+					_, err := io.Copy(io.Discard, br)
+					Expect(err).NotTo(HaveOccurred())
+					br.Close()
+				}
+			}(i)
+		}
+		wg.Wait()
+		// mostly full batches (allowing for stragglers that miss the linger time)
+		Expect(int(numRequests.Load())).To(BeNumerically("<=", 2*perCall))
+	})
+})
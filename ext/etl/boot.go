@@ -68,6 +68,7 @@ func (b *etlBootstrapper) _prepSpec() (err error) {
 
 	b._updPodCommand()
 	b._updPodLabels()
+	b.msg.setProbes(b.pod)
 	b._updReady()
 
 	b._setPodEnv()
@@ -187,9 +188,10 @@ func (b *etlBootstrapper) waitPodReady() error {
 			return cmn.NewErrETL(b.errCtx, "%v", err)
 		}
 		err = cmn.NewErrETL(b.errCtx,
-			`%v (pod phase: %q, pod conditions: %s; expected condition: %s)`,
-			err, pod.Status.Phase, podConditionsToString(pod.Status.Conditions),
+			`%v: %s (pod phase: %q, pod conditions: %s; expected condition: %s)%s`,
+			err, podStage(pod), pod.Status.Phase, podConditionsToString(pod.Status.Conditions),
 			podConditionToString(corev1.PodCondition{Type: corev1.PodReady, Status: corev1.ConditionTrue}),
+			podEventsToString(client, pod.Name),
 		)
 	}
 	return err
@@ -297,8 +299,14 @@ func (b *etlBootstrapper) _setSvcLabels() {
 }
 
 func (b *etlBootstrapper) _updReady() {
-	probe := b.pod.Spec.Containers[0].ReadinessProbe
+	container := &b.pod.Spec.Containers[0]
+	_probeDefaults(container.ReadinessProbe)
+	if container.LivenessProbe != nil {
+		_probeDefaults(container.LivenessProbe)
+	}
+}
 
+func _probeDefaults(probe *corev1.Probe) {
 	// If someone already set these values, we don't to touch them.
 	if probe.TimeoutSeconds != 0 || probe.PeriodSeconds != 0 {
 		return
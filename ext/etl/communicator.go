@@ -59,6 +59,13 @@ type (
 		CommStats
 	}
 
+	// (optional) transforms multiple objects in a single request - see `QparamBatch`
+	BatchCommunicator interface {
+		SupportsBatch() bool
+		OfflineTransformBatch(bck *cluster.Bck, objNames []string, timeout time.Duration, cb batchCB) error
+		Xact() cluster.Xact
+	}
+
 	commArgs struct {
 		listener     cluster.Slistener
 		bootstrapper *etlBootstrapper
@@ -78,6 +85,7 @@ type (
 		mem     *memsys.MMSA
 		uri     string
 		command []string
+		batch   bool
 	}
 	redirectComm struct {
 		baseComm
@@ -102,6 +110,8 @@ var (
 	_ Communicator = (*redirectComm)(nil)
 	_ Communicator = (*revProxyComm)(nil)
 
+	_ BatchCommunicator = (*pushComm)(nil)
+
 	_ io.Writer = (*cbWriter)(nil)
 )
 
@@ -125,6 +135,7 @@ func makeCommunicator(args commArgs) Communicator {
 			baseComm: baseComm,
 			mem:      args.bootstrapper.t.PageMM(),
 			uri:      args.bootstrapper.uri,
+			batch:    args.bootstrapper.msg.Batch,
 		}
 	case Hpull:
 		baseComm.commType = Hpull
@@ -176,6 +187,17 @@ func (c *baseComm) OutBytes() int64    { return c.xctn.OutBytes() }
 
 func (c *baseComm) Stop() { c.xctn.Finish(nil) }
 
+// (offline transforms only) non-2xx response: close the body and return the error
+// including the beginning of the transformer's response body
+func (c *baseComm) respErr(resp *http.Response) error {
+	if resp.StatusCode < http.StatusBadRequest {
+		return nil
+	}
+	b, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrOutput))
+	resp.Body.Close()
+	return &ErrTransform{etlName: c.name, status: resp.StatusCode, output: string(b)}
+}
+
 func (c *baseComm) getWithTimeout(url string, size int64, timeout time.Duration, tag string) (r cos.ReadCloseSizer, err error) {
 	if err := c.xctn.AbortErr(); err != nil {
 		return nil, cmn.NewErrAborted(c.String(), "get"+"-"+tag, err)
@@ -197,6 +219,9 @@ func (c *baseComm) getWithTimeout(url string, size int64, timeout time.Duration,
 		goto finish
 	}
 	resp, err = c.t.DataClient().Do(req) //nolint:bodyclose // Closed by the caller.
+	if err == nil {
+		err = c.respErr(resp)
+	}
 finish:
 	if err != nil {
 		if cancel != nil {
@@ -223,7 +248,9 @@ finish:
 // pushComm //
 //////////////
 
-func (pc *pushComm) doRequest(bck *cluster.Bck, objName string, timeout time.Duration) (r cos.ReadCloseSizer, err error) {
+// offline (bucket-to-bucket) transforms convert non-2xx responses into `ErrTransform`
+// (see also: `errCapture`); inline (GET) transforms are not affected
+func (pc *pushComm) doRequest(bck *cluster.Bck, objName string, timeout time.Duration, offline bool) (r cos.ReadCloseSizer, err error) {
 	lom := cluster.AllocLOM(objName)
 	defer cluster.FreeLOM(lom)
 
@@ -231,18 +258,18 @@ func (pc *pushComm) doRequest(bck *cluster.Bck, objName string, timeout time.Dur
 		return nil, err
 	}
 
-	r, err = pc.tryDoRequest(lom, timeout)
+	r, err = pc.tryDoRequest(lom, timeout, offline)
 	if err != nil && cmn.IsObjNotExist(err) && bck.IsRemote() {
 		_, err = pc.t.GetCold(context.Background(), lom, cmn.OwtGetLock)
 		if err != nil {
 			return nil, err
 		}
-		r, err = pc.tryDoRequest(lom, timeout)
+		r, err = pc.tryDoRequest(lom, timeout, offline)
 	}
 	return
 }
 
-func (pc *pushComm) tryDoRequest(lom *cluster.LOM, timeout time.Duration) (cos.ReadCloseSizer, error) {
+func (pc *pushComm) tryDoRequest(lom *cluster.LOM, timeout time.Duration, offline bool) (cos.ReadCloseSizer, error) {
 	if err := pc.xctn.AbortErr(); err != nil {
 		return nil, cmn.NewErrAborted(pc.String(), "do", err)
 	}
@@ -286,6 +313,9 @@ func (pc *pushComm) tryDoRequest(lom *cluster.LOM, timeout time.Duration) (cos.R
 	req.ContentLength = size
 	req.Header.Set(cos.HdrContentType, cos.ContentBinary)
 	resp, err = pc.t.DataClient().Do(req) //nolint:bodyclose // Closed by the caller.
+	if err == nil && offline {
+		err = pc.respErr(resp)
+	}
 finish:
 	if err != nil {
 		if cancel != nil {
@@ -311,7 +341,7 @@ finish:
 func (pc *pushComm) OnlineTransform(w http.ResponseWriter, _ *http.Request, bck *cluster.Bck, objName string) error {
 	var (
 		size   int64
-		r, err = pc.doRequest(bck, objName, 0 /*timeout*/)
+		r, err = pc.doRequest(bck, objName, 0 /*timeout*/, false /*offline*/)
 	)
 	if err != nil {
 		return err
@@ -327,7 +357,7 @@ func (pc *pushComm) OnlineTransform(w http.ResponseWriter, _ *http.Request, bck
 }
 
 func (pc *pushComm) OfflineTransform(bck *cluster.Bck, objName string, timeout time.Duration) (cos.ReadCloseSizer, error) {
-	return pc.doRequest(bck, objName, timeout)
+	return pc.doRequest(bck, objName, timeout, true /*offline*/)
 }
 
 //////////////////
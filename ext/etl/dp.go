@@ -7,6 +7,7 @@ package etl
 import (
 	"time"
 
+	"github.com/NVIDIA/aistore/3rdparty/glog"
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cluster"
 	"github.com/NVIDIA/aistore/cmn"
@@ -19,19 +20,33 @@ import (
 type OfflineDP struct {
 	tcbmsg         *apc.TCBMsg
 	comm           Communicator
+	batch          *batcher    // nil unless batching
+	errs           *errCapture // nil unless capturing per-object failures
 	requestTimeout time.Duration
 }
 
 // interface guard
 var _ cluster.DP = (*OfflineDP)(nil)
 
-func NewOfflineDP(msg *apc.TCBMsg, lsnode *cluster.Snode) (*OfflineDP, error) {
-	comm, err := GetCommunicator(msg.Transform.Name, lsnode)
+func NewOfflineDP(msg *apc.TCBMsg, t cluster.Target) (*OfflineDP, error) {
+	comm, err := GetCommunicator(msg.Transform.Name, t.Snode())
 	if err != nil {
 		return nil, err
 	}
 	pr := &OfflineDP{tcbmsg: msg, comm: comm}
 	pr.requestTimeout = time.Duration(msg.Transform.Timeout)
+	if msg.Transform.BatchSize > 1 {
+		if bc, ok := comm.(BatchCommunicator); ok && bc.SupportsBatch() {
+			pr.batch = newBatcher(bc, msg.Transform.BatchSize, pr.requestTimeout)
+		} else {
+			glog.Warningf("%s: %s does not support batch requests - transforming one object at a time", t, comm)
+		}
+	}
+	if msg.Transform.ErrsTo != "" {
+		if pr.errs, err = newErrCapture(t, msg); err != nil {
+			return nil, err
+		}
+	}
 	return pr, nil
 }
 
@@ -42,6 +57,18 @@ func (dp *OfflineDP) Reader(lom *cluster.LOM) (cos.ReadOpenCloser, cmn.ObjAttrsH
 		err error
 	)
 	debug.Assert(dp.tcbmsg != nil)
+	if dp.batch != nil {
+		req := dp.batch.do(lom)
+		if req.err == nil {
+			lom.SetAtimeUnix(time.Now().UnixNano())
+			oah := &cmn.ObjAttrs{Size: req.size, Cksum: cos.NoneCksum, Atime: lom.AtimeUnix()}
+			return cos.NopOpener(&batchReader{req: req}), oah, nil
+		}
+		// fall back to transforming this one object
+		if glog.FastV(4, glog.SmoduleXs) {
+			glog.Infof("%s: batch request failed for %s: %v", dp.comm, lom, req.err)
+		}
+	}
 	call := func() (int, error) {
 		r, err = dp.comm.OfflineTransform(lom.Bck(), lom.ObjName, dp.requestTimeout)
 		return 0, err
@@ -57,6 +84,9 @@ func (dp *OfflineDP) Reader(lom *cluster.LOM) (cos.ReadOpenCloser, cmn.ObjAttrsH
 		Verbosity: cmn.RetryLogQuiet,
 	})
 	if err != nil {
+		if dp.errs != nil && !cmn.IsErrAborted(err) {
+			dp.errs.add(lom, dp.tcbmsg.Transform.Name, dp.comm.Xact().ID(), err)
+		}
 		return nil, nil, err
 	}
 	lom.SetAtimeUnix(time.Now().UnixNano())
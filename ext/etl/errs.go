@@ -0,0 +1,164 @@
+// Package etl provides utilities to initialize and use transformation pods.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package etl
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/NVIDIA/aistore/3rdparty/glog"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/fs"
+)
+
+const (
+	maxErrOutput = 4 * cos.KiB // max captured transformer's response output (per failed object)
+	errRecordExt = ".err"
+)
+
+type (
+	// transformer responded with non-2xx status
+	ErrTransform struct {
+		etlName string
+		output  string
+		status  int
+	}
+
+	// failed object's record (JSON), stored in the user-specified bucket (see `apc.Transform.ErrsTo`)
+	ErrRecord struct {
+		Time    time.Time `json:"time"`
+		Bucket  string    `json:"bucket"`
+		ObjName string    `json:"name"`
+		ETLName string    `json:"etl"`
+		ETLXid  string    `json:"etl_xid"`
+		Target  string    `json:"target"`
+		Error   string    `json:"error"`
+		Output  string    `json:"output,omitempty"` // transformer's response output (up to 4KiB of HTTP response body)
+		Status  int       `json:"status,omitempty"` // transformer's HTTP status
+	}
+
+	// captures per-object failures
+	errCapture struct {
+		t      cluster.Target
+		bck    *cluster.Bck
+		prefix string
+		max    int64
+		cnt    atomic.Int64
+	}
+)
+
+//////////////////
+// ErrTransform //
+//////////////////
+
+func (e *ErrTransform) Error() string {
+	return fmt.Sprintf("ETL[%s] failed to transform (status %d): %q", e.etlName, e.status, e.output)
+}
+
+////////////////
+// errCapture //
+////////////////
+
+func newErrCapture(t cluster.Target, msg *apc.TCBMsg) (*errCapture, error) {
+	bck, prefix, err := cmn.ParseBckObjectURI(msg.Transform.ErrsTo, cmn.ParseURIOpts{DefaultProvider: apc.AIS})
+	if err != nil {
+		return nil, fmt.Errorf("invalid ETL errors destination %q: %v", msg.Transform.ErrsTo, err)
+	}
+	ec := &errCapture{t: t, bck: cluster.CloneBck(&bck), prefix: prefix, max: int64(msg.Transform.MaxErrs)}
+	if err := ec.bck.Init(t.Bowner()); err != nil {
+		return nil, err
+	}
+	return ec, nil
+}
+
+// store the record; failing that, log the error
+func (ec *errCapture) add(lom *cluster.LOM, etlName, xid string, err error) {
+	n := ec.cnt.Inc()
+	if ec.max > 0 && n > ec.max {
+		if n == ec.max+1 {
+			glog.Warningf("%s: reached max (%d) number of ETL[%s] error records in %s", ec.t, ec.max, etlName, ec.bck)
+		}
+		return
+	}
+	rec := &ErrRecord{
+		Time:    time.Now(),
+		Bucket:  lom.Bck().Cname(""),
+		ObjName: lom.ObjName,
+		ETLName: etlName,
+		ETLXid:  xid,
+		Target:  ec.t.SID(),
+		Error:   err.Error(),
+	}
+	var errT *ErrTransform
+	if errors.As(err, &errT) {
+		rec.Output, rec.Status = errT.output, errT.status
+	}
+	if errV := ec.put(ec.prefix+lom.ObjName+errRecordExt, cos.MustMarshal(rec)); errV != nil {
+		glog.Errorf("%s: failed to store ETL[%s] error record for %s: %v", ec.t, etlName, lom, errV)
+	}
+}
+
+// PUT locally or to the HRW target
+func (ec *errCapture) put(objName string, b []byte) error {
+	lom := cluster.AllocLOM(objName)
+	defer cluster.FreeLOM(lom)
+	if err := lom.InitBck(ec.bck.Bucket()); err != nil {
+		return err
+	}
+	tsi, local, err := lom.HrwTarget(ec.t.Sowner().Get())
+	if err != nil {
+		return err
+	}
+	if local {
+		params := cluster.AllocPutObjParams()
+		{
+			params.WorkTag = fs.WorkfilePut
+			params.Reader = io.NopCloser(bytes.NewReader(b))
+			params.OWT = cmn.OwtPut
+			params.Atime = time.Now()
+		}
+		lom.SetSize(int64(len(b)))
+		err = ec.t.PutObject(lom, params)
+		cluster.FreePutObjParams(params)
+		return err
+	}
+	var (
+		hdr   = make(http.Header, 1)
+		query = ec.bck.AddToQuery(nil)
+	)
+	hdr.Set(apc.HdrT2TPutterID, ec.t.SID())
+	query.Set(apc.QparamOWT, cmn.OwtPut.ToS())
+	reqArgs := cmn.HreqArgs{
+		Method: http.MethodPut,
+		Base:   tsi.URL(cmn.NetIntraData),
+		Path:   apc.URLPathObjects.Join(ec.bck.Name, objName),
+		Query:  query,
+		Header: hdr,
+		Body:   b,
+	}
+	req, _, cancel, err := reqArgs.ReqWithTimeout(cmn.GCO.Get().Timeout.SendFile.D())
+	if err != nil {
+		return err
+	}
+	defer cancel()
+	resp, err := ec.t.DataClient().Do(req)
+	if err != nil {
+		return err
+	}
+	cos.DrainReader(resp.Body)
+	resp.Body.Close()
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("PUT %s/%s => %s: status %d", ec.bck, objName, tsi, resp.StatusCode)
+	}
+	return nil
+}
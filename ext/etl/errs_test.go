@@ -0,0 +1,93 @@
+// Package etl provides utilities to initialize and use transformation pods.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package etl
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cluster/mock"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/fs"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+)
+
+var _ = Describe("ErrTransformTest", func() {
+	const (
+		objName = "errObj"
+		output  = "Traceback (most recent call last): ValueError: boom"
+	)
+	var (
+		tmpDir            string
+		tMock             cluster.Target
+		transformerServer *httptest.Server
+
+		bck        = cmn.Bck{Name: "errBck", Provider: apc.AIS, Ns: cmn.NsGlobal}
+		clusterBck = cluster.NewBck(
+			bck.Name, bck.Provider, bck.Ns,
+			&cmn.BucketProps{Cksum: cmn.CksumConf{Type: cos.ChecksumXXHash}},
+		)
+		bmdMock = mock.NewBaseBownerMock(clusterBck)
+	)
+
+	BeforeEach(func() {
+		var err error
+		tmpDir, err = os.MkdirTemp("", "")
+		Expect(err).NotTo(HaveOccurred())
+		mpath := filepath.Join(tmpDir, "mpath")
+		Expect(cos.CreateDir(mpath)).NotTo(HaveOccurred())
+		fs.TestNew(nil)
+		fs.TestDisableValidation()
+		_, err = fs.Add(mpath, "daeID")
+		Expect(err).NotTo(HaveOccurred())
+
+		tMock = mock.NewTarget(bmdMock)
+		lom := &cluster.LOM{ObjName: objName}
+		Expect(lom.InitBck(clusterBck.Bucket())).NotTo(HaveOccurred())
+		Expect(createRandomFile(lom.FQN, cos.KiB)).NotTo(HaveOccurred())
+		lom.SetAtimeUnix(time.Now().UnixNano())
+		lom.SetSize(cos.KiB)
+		Expect(lom.Persist()).NotTo(HaveOccurred())
+
+		transformerServer = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, output, http.StatusInternalServerError)
+		}))
+	})
+
+	AfterEach(func() {
+		_ = os.RemoveAll(tmpDir)
+		transformerServer.Close()
+	})
+
+	It("should fail offline transformation with transformer's output", func() {
+		pod := &corev1.Pod{}
+		pod.SetName("somename")
+		comm := makeCommunicator(commArgs{
+			bootstrapper: &etlBootstrapper{
+				t:    tMock,
+				msg:  InitSpecMsg{InitMsgBase: InitMsgBase{CommTypeX: Hpush}},
+				pod:  pod,
+				uri:  transformerServer.URL,
+				xctn: mock.NewXact(apc.ActETLInline),
+			},
+		})
+		_, err := comm.OfflineTransform(clusterBck, objName, 0 /*timeout*/)
+		Expect(err).To(HaveOccurred())
+
+		var errT *ErrTransform
+		Expect(errors.As(err, &errT)).To(BeTrue())
+		Expect(errT.status).To(Equal(http.StatusInternalServerError))
+		Expect(errT.output).To(ContainSubstring(output))
+	})
+})
@@ -6,6 +6,7 @@ package etl
 
 import (
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -174,7 +175,7 @@ func InitCode(t cluster.Target, msg *InitCodeMsg, xid string) error {
 	// Start ETL
 	// (the point where InitCode flow converges w/ InitSpec)
 	return InitSpec(t,
-		&InitSpecMsg{msg.InitMsgBase, []byte(podSpec)},
+		&InitSpecMsg{InitMsgBase: msg.InitMsgBase, Spec: []byte(podSpec)},
 		xid,
 		StartOpts{Env: map[string]string{
 			r.CodeEnvName(): string(msg.Code),
@@ -479,3 +480,39 @@ func podConditionToString(cond corev1.PodCondition) string {
 	}
 	return "{" + strings.Join(parts, ", ") + "}"
 }
+
+// distinguish "pod scheduled" from "pod serving"
+func podStage(pod *corev1.Pod) string {
+	for _, cond := range pod.Status.Conditions {
+		if cond.Type == corev1.PodScheduled && cond.Status == corev1.ConditionTrue {
+			container := pod.Spec.Containers[0]
+			if probe := container.ReadinessProbe; probe != nil && probe.HTTPGet != nil {
+				return fmt.Sprintf("pod scheduled on node %q but not serving (readiness probe %q)",
+					pod.Spec.NodeName, probe.HTTPGet.Path)
+			}
+			return fmt.Sprintf("pod scheduled on node %q but not serving", pod.Spec.NodeName)
+		}
+	}
+	return "pod not scheduled"
+}
+
+// the last few pod events, if available
+func podEventsToString(client k8s.Client, podName string) string {
+	const maxEvents = 8
+	events, err := client.Events(podName)
+	if err != nil || events == nil || len(events.Items) == 0 {
+		return ""
+	}
+	items := events.Items
+	sort.Slice(items, func(i, j int) bool { return items[i].LastTimestamp.Before(&items[j].LastTimestamp) })
+	if len(items) > maxEvents {
+		items = items[len(items)-maxEvents:]
+	}
+	parts := make([]string, 0, len(items))
+	for i := range items {
+		ev := &items[i]
+		parts = append(parts, fmt.Sprintf("%s %s %s: %s (x%d)",
+			ev.LastTimestamp.Format(time.RFC3339), ev.Type, ev.Reason, ev.Message, ev.Count))
+	}
+	return "\nlast pod events:\n\t" + strings.Join(parts, "\n\t")
+}
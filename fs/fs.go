@@ -666,6 +666,9 @@ func AddMpath(mpath, tid string, cb func(), force bool) (mi *Mountpath, err erro
 			glog.Errorf("%v - ignoring since force=%t", err, force)
 		}
 	}
+	if err = mi.preflight(config, force); err != nil {
+		return
+	}
 	mfs.mu.Lock()
 	err = mi._cloneAddEnabled(tid, config)
 	if err == nil {
@@ -682,6 +685,54 @@ func AddMpath(mpath, tid string, cb func(), force bool) (mi *Mountpath, err erro
 	return
 }
 
+// pre-flight checks (prior to attaching new mountpath):
+// - must be an existing directory writable by this process - always fatal;
+// - must not be nested with any of the currently disabled mountpaths (the rest is checked by `_checkExists`);
+// - used capacity must be below the configured high watermark - non-fatal with `force`
+func (mi *Mountpath) preflight(config *cmn.Config, force bool) error {
+	finfo, err := os.Stat(mi.Path)
+	if err != nil {
+		return cmn.NewErrInvalidaMountpath(mi.Path, err.Error())
+	}
+	if !finfo.IsDir() {
+		return cmn.NewErrInvalidaMountpath(mi.Path, "not a directory")
+	}
+	fh, err := os.CreateTemp(mi.Path, ".preflight-")
+	if err != nil {
+		return cmn.NewErrInvalidaMountpath(mi.Path, "not writable: "+err.Error())
+	}
+	fh.Close()
+	os.Remove(fh.Name())
+
+	_, disabledPaths := Get()
+	l := len(mi.Path)
+	for mpath := range disabledPaths {
+		if mpath == mi.Path {
+			continue // (see "currently disabled" in `_cloneAddEnabled`)
+		}
+		if err := cmn.IsNestedMpath(mi.Path, l, mpath); err != nil {
+			return cmn.NewErrInvalidaMountpath(mi.Path, err.Error()+" (disabled)")
+		}
+	}
+
+	if config.Space.HighWM <= 0 {
+		return nil
+	}
+	c, err := mi.getCapacity(config, true /*refresh*/)
+	if err != nil {
+		return cmn.NewErrInvalidaMountpath(mi.Path, "failed to get capacity: "+err.Error())
+	}
+	if int64(c.PctUsed) >= config.Space.HighWM {
+		err := cmn.NewErrInvalidaMountpath(mi.Path, fmt.Sprintf("insufficient free space: used %d%% (%s available) >= high watermark %d%%",
+			c.PctUsed, cos.ToSizeIEC(int64(c.Avail), 2), config.Space.HighWM))
+		if !force {
+			return err
+		}
+		glog.Warningf("%v - proceeding anyway since force=%t", err, force)
+	}
+	return nil
+}
+
 // (used only in tests - compare with EnableMpath below)
 func Enable(mpath string) (enabledMpath *Mountpath, err error) {
 	var cleanMpath string
@@ -5,6 +5,8 @@
 package fs_test
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/NVIDIA/aistore/api/apc"
@@ -73,6 +75,29 @@ func TestMountpathAddAlreadyAdded(t *testing.T) {
 	tools.AssertMountpathCount(t, 1, 0)
 }
 
+func TestMountpathAttachPreflight(t *testing.T) {
+	initFS()
+
+	// not a directory
+	dir := t.TempDir()
+	fqn := filepath.Join(dir, "file")
+	tassert.CheckFatal(t, os.WriteFile(fqn, []byte("data"), cos.PermRWR))
+	_, err := fs.AddMpath(fqn, "daeID", func() {}, false /*force*/)
+	tassert.Errorf(t, err != nil, "attaching regular file %q as mountpath succeeded", fqn)
+
+	// nested under a disabled mountpath
+	mpath := filepath.Join(dir, "mp")
+	tools.AddMpath(t, mpath)
+	_, err = fs.Disable(mpath)
+	tassert.CheckFatal(t, err)
+	nested := filepath.Join(mpath, "nested")
+	tassert.CheckFatal(t, cos.CreateDir(nested))
+	_, err = fs.AddMpath(nested, "daeID", func() {}, true /*force*/)
+	tassert.Errorf(t, err != nil, "attaching mountpath %q nested under disabled %q succeeded", nested, mpath)
+
+	tools.AssertMountpathCount(t, 0, 1)
+}
+
 func TestMountpathRemoveNonExisting(t *testing.T) {
 	initFS()
 
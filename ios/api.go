@@ -6,6 +6,12 @@
 package ios
 
 type (
-	DiskStats    struct{ RBps, Ravg, WBps, Wavg, Util int64 }
+	DiskStats struct {
+		RBps, Ravg, WBps, Wavg, Util int64
+		// I/O latency percentiles (ns) over the recent window of iostat intervals (see latWindow)
+		Lat50, Lat95, Lat99 int64
+		// average queue size (number of in-flight I/Os) during the last interval
+		Aqu int64
+	}
 	AllDiskStats map[string]DiskStats
 )
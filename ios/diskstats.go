@@ -14,6 +14,7 @@ type (
 		IOMs() int64
 		WriteMs() int64
 		ReadMs() int64
+		IOMsWeighted() int64
 	}
 
 	diskBlockStats map[string]diskBlockStat
@@ -50,3 +50,4 @@ func (dbs dblockStat) WriteBytes() int64 { return dbs.writeBytes }
 func (dbs dblockStat) IOMs() int64       { return dbs.ioMs }
 func (dbs dblockStat) WriteMs() int64    { return dbs.writeMs }
 func (dbs dblockStat) ReadMs() int64     { return dbs.readMs }
+func (dblockStat) IOMsWeighted() int64   { return 0 } // TODO: not implemented
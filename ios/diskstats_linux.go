@@ -103,3 +103,5 @@ func (dbs dblockStat) WriteBytes() int64 { return dbs.writeSectors * sectorSize
 func (dbs dblockStat) IOMs() int64       { return dbs.ioMs }
 func (dbs dblockStat) WriteMs() int64    { return dbs.writeMs }
 func (dbs dblockStat) ReadMs() int64     { return dbs.readMs }
+
+func (dbs dblockStat) IOMsWeighted() int64 { return dbs.ioMsWeighted }
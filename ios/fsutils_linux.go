@@ -19,12 +19,12 @@ func GetFSStats(path string) (blocks, bavail uint64, bsize int64, err error) {
 	if err != nil {
 		return
 	}
-	return fsStats.Blocks, fsStats.Bavail, fsStats.Bsize, nil
+	return fsStats.Blocks, fsStats.Bavail, int64(fsStats.Bsize), nil //nolint:unconvert // int32 on 32-bit platforms
 }
 
 func GetATime(osfi os.FileInfo) time.Time {
 	stat := osfi.Sys().(*syscall.Stat_t)
-	atime := time.Unix(stat.Atim.Sec, stat.Atim.Nsec)
+	atime := time.Unix(stat.Atim.Unix()) // (int32 fields on 32-bit platforms)
 	// NOTE: see https://en.wikipedia.org/wiki/Stat_(system_call)#Criticism_of_atime
 	return atime
 }
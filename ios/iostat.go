@@ -47,6 +47,11 @@ type (
 		writes map[string]int64 // completed write requests
 		wbps   map[string]int64 // write B/s
 		wavg   map[string]int64 // average write size
+		iomsw  map[string]int64 // weighted IO millis
+		aqu    map[string]int64 // average queue size
+		lat50  map[string]int64 // I/O latency percentiles (ns)
+		lat95  map[string]int64
+		lat99  map[string]int64
 
 		mpathUtil   map[string]int64 // Average utilization of the disks, range [0, 100].
 		mpathUtilRO MpathUtil        // Read-only copy of `mpathUtil`.
@@ -58,6 +63,7 @@ type (
 		mpath2disks map[string]FsDisks
 		disk2mpath  cos.StrKVs
 		disk2sysfn  cos.StrKVs
+		disk2lat    map[string]*latWindow
 		cache       atomic.Pointer
 		cacheHst    [16]*cache
 		cacheIdx    int
@@ -94,6 +100,7 @@ func New(num int) IOS {
 		mpath2disks: make(map[string]FsDisks, num),
 		disk2mpath:  make(cos.StrKVs, num),
 		disk2sysfn:  make(cos.StrKVs, num),
+		disk2lat:    make(map[string]*latWindow, num),
 	}
 	for i := 0; i < len(ios.cacheHst); i++ {
 		ios.cacheHst[i] = newCache(num)
@@ -118,6 +125,11 @@ func newCache(num int) *cache {
 		writes:    make(map[string]int64, num),
 		wbps:      make(map[string]int64, num),
 		wavg:      make(map[string]int64, num),
+		iomsw:     make(map[string]int64, num),
+		aqu:       make(map[string]int64, num),
+		lat50:     make(map[string]int64, num),
+		lat95:     make(map[string]int64, num),
+		lat99:     make(map[string]int64, num),
 		mpathUtil: make(map[string]int64, num),
 	}
 }
@@ -224,6 +236,7 @@ func (ios *ios) _delDisk(mpath, disk string) {
 	}
 	debug.Assertf(mp == mpath, "(mpath %s => disk %s => mpath %s) violation", mp, disk, mpath)
 	delete(ios.disk2mpath, disk)
+	delete(ios.disk2lat, disk)
 }
 
 //
@@ -248,6 +261,11 @@ func (ios *ios) FillDiskStats(m AllDiskStats) {
 			WBps: cache.wbps[disk],
 			Wavg: cache.wavg[disk],
 			Util: cache.util[disk],
+
+			Lat50: cache.lat50[disk],
+			Lat95: cache.lat95[disk],
+			Lat99: cache.lat99[disk],
+			Aqu:   cache.aqu[disk],
 		}
 	}
 	for disk := range m {
@@ -331,6 +349,8 @@ func (ios *ios) _ref(config *cmn.Config) (ncache *cache, maxUtil int64, missingI
 		ncache.util[disk] = 0
 		ncache.ravg[disk] = 0
 		ncache.wavg[disk] = 0
+		ncache.aqu[disk] = 0
+		ncache.lat50[disk], ncache.lat95[disk], ncache.lat99[disk] = 0, 0, 0
 		osDisk, ok := osDiskStats[disk]
 		if !ok {
 			continue
@@ -342,6 +362,7 @@ func (ios *ios) _ref(config *cmn.Config) (ncache *cache, maxUtil int64, missingI
 		ncache.wms[disk] = osDisk.WriteMs()
 		ncache.wbytes[disk] = osDisk.WriteBytes()
 		ncache.writes[disk] = osDisk.Writes()
+		ncache.iomsw[disk] = osDisk.IOMsWeighted()
 
 		if _, ok := statsCache.ioms[disk]; !ok {
 			missingInfo = true
@@ -390,6 +411,7 @@ func (ios *ios) _ref(config *cmn.Config) (ncache *cache, maxUtil int64, missingI
 		} else {
 			ncache.wavg[disk] = 0
 		}
+		ios._lat(ncache, statsCache, disk, reads+writes, elapsedMillis)
 	}
 
 	// average and max
@@ -421,3 +443,23 @@ func (ios *ios) _ref(config *cmn.Config) (ncache *cache, maxUtil int64, missingI
 	}
 	return
 }
+
+// I/O latency and queue size: average "await" of the interval gets added to the disk's
+// sliding window (idle intervals excluded), percentiles are then computed over the window
+func (ios *ios) _lat(ncache, statsCache *cache, disk string, ioCnt, elapsedMillis int64) {
+	w, ok := ios.disk2lat[disk]
+	if !ok {
+		w = &latWindow{}
+		ios.disk2lat[disk] = w
+	}
+	if ioCnt > 0 {
+		ms := ncache.rms[disk] - statsCache.rms[disk] + ncache.wms[disk] - statsCache.wms[disk]
+		w.add(ms * int64(time.Millisecond) / ioCnt)
+	}
+	if elapsedMillis > 0 {
+		ncache.aqu[disk] = cos.DivRound(ncache.iomsw[disk]-statsCache.iomsw[disk], elapsedMillis)
+	} else {
+		ncache.aqu[disk] = statsCache.aqu[disk]
+	}
+	ncache.lat50[disk], ncache.lat95[disk], ncache.lat99[disk] = w.percentiles()
+}
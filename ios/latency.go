@@ -0,0 +1,49 @@
+// Package ios is a collection of interfaces to the local storage subsystem;
+// the package includes OS-dependent implementations for those interfaces.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package ios
+
+import "sort"
+
+// Sliding window of per-interval average I/O latencies (aka "await") of a given disk.
+// Block-device stats do not provide latencies of individual I/Os - hence, the window
+// collects one sample per (non-idle) iostat refresh interval, and the percentiles are
+// computed over the most recent `latWindowSize` samples.
+
+const latWindowSize = 128
+
+type latWindow struct {
+	samples [latWindowSize]int64 // nanoseconds
+	idx     int
+	cnt     int
+}
+
+func (w *latWindow) add(lat int64) {
+	w.samples[w.idx] = lat
+	w.idx = (w.idx + 1) % latWindowSize
+	if w.cnt < latWindowSize {
+		w.cnt++
+	}
+}
+
+// returns p50, p95, and p99
+func (w *latWindow) percentiles() (p50, p95, p99 int64) {
+	if w.cnt == 0 {
+		return
+	}
+	sorted := make([]int64, w.cnt)
+	copy(sorted, w.samples[:w.cnt])
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	return _pct(sorted, 50), _pct(sorted, 95), _pct(sorted, 99)
+}
+
+// nearest-rank
+func _pct(sorted []int64, p int) int64 {
+	rank := (p*len(sorted) + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
@@ -0,0 +1,32 @@
+// Package ios is a collection of interfaces to the local storage subsystem;
+// the package includes OS-dependent implementations for those interfaces.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package ios
+
+import (
+	"testing"
+
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestLatWindowPercentiles(t *testing.T) {
+	w := &latWindow{}
+	p50, p95, p99 := w.percentiles()
+	tassert.Errorf(t, p50 == 0 && p95 == 0 && p99 == 0, "expected zeros, got (%d, %d, %d)", p50, p95, p99)
+
+	// 100 samples: 1..100
+	for i := int64(100); i > 0; i-- {
+		w.add(i)
+	}
+	p50, p95, p99 = w.percentiles()
+	tassert.Errorf(t, p50 == 50 && p95 == 95 && p99 == 99, "expected (50, 95, 99), got (%d, %d, %d)", p50, p95, p99)
+
+	// wrap around: the window retains the most recent samples only
+	for i := 0; i < latWindowSize; i++ {
+		w.add(1000)
+	}
+	p50, _, p99 = w.percentiles()
+	tassert.Errorf(t, p50 == 1000 && p99 == 1000, "expected 1000, got (%d, %d)", p50, p99)
+}
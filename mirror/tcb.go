@@ -143,6 +143,10 @@ func newXactTCB(e *tcbFactory, slab *memsys.Slab) (r *XactTCB) {
 	r = &XactTCB{t: e.T, args: *e.args}
 	if e.kind == apc.ActETLBck {
 		parallel = etlBucketParallelCnt // TODO: optimize with respect to disk bw and transforming computation
+		// each visit blocks until its batch gets transformed - need at least as many to fill up a batch
+		if n := e.args.Msg.Transform.BatchSize; n > parallel {
+			parallel = n
+		}
 	}
 	mpopts := &mpather.JgroupOpts{
 		T:        e.T,
@@ -211,8 +215,13 @@ func (r *XactTCB) copyObject(lom *cluster.LOM, buf []byte) (err error) {
 		params.Xact = r
 	}
 	_, err = r.Target().CopyObject(lom, params, r.args.Msg.DryRun)
-	if err != nil && cos.IsErrOOS(err) {
+	switch {
+	case err == nil:
+	case cos.IsErrOOS(err):
 		err = cmn.NewErrAborted(r.Name(), "copy-obj", err)
+	case r.args.Msg.ContinueOnError && !cmn.IsErrAborted(err):
+		glog.Warningf("%s: %v - continuing...", r, err)
+		err = nil
 	}
 	cluster.FreeCpObjParams(params)
 	return
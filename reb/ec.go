@@ -308,6 +308,14 @@ func (reb *Reb) walkEC(fqn string, de fs.DirEntry) (err error) {
 		return nil
 	}
 
+	// size of the CT described by this metafile (for the total-to-move estimate)
+	isReplica := md.SliceID == 0
+	ctSize := md.Size
+	if !isReplica {
+		ctSize = ec.SliceSize(md.Size, md.Data)
+	}
+	xreb.EstVisited(ctSize)
+
 	// Skip a CT if this target is not the 'main' one
 	if md.FullReplica != reb.t.SID() {
 		return nil
@@ -319,7 +327,6 @@ func (reb *Reb) walkEC(fqn string, de fs.DirEntry) (err error) {
 	}
 
 	// check if both slice/replica and metafile exist
-	isReplica := md.SliceID == 0
 	var fileFQN string
 	if isReplica {
 		fileFQN = ct.Make(fs.ObjectType)
@@ -335,5 +342,6 @@ func (reb *Reb) walkEC(fqn string, de fs.DirEntry) (err error) {
 	if err != nil {
 		return nil
 	}
+	xreb.EstToMove(ctSize)
 	return reb.sendFromDisk(ct, md, hrwTarget)
 }
@@ -24,6 +24,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn/fname"
 	"github.com/NVIDIA/aistore/cmn/prob"
 	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/ios"
 	"github.com/NVIDIA/aistore/transport"
 	"github.com/NVIDIA/aistore/transport/bundle"
 	"github.com/NVIDIA/aistore/xact"
@@ -237,12 +238,15 @@ func (reb *Reb) run(rargs *rebArgs) error {
 	reb.stages.stage.Store(rebStageTraverse)
 
 	// No EC-enabled buckets - run only regular rebalance
+	// (and start estimating total-to-move - see xact.ExtRebStats)
 	if !rargs.ecUsed {
+		reb.xctn().EstBegin(1)
 		glog.Infof("starting global rebalance (g%d)", rargs.id)
 		return reb.runNoEC(rargs)
 	}
 
 	// In all other cases run both rebalances simultaneously
+	reb.xctn().EstBegin(2)
 	group := &errgroup.Group{}
 	group.Go(func() error {
 		glog.Infof("starting global rebalance (g%d)", rargs.id)
@@ -470,11 +474,14 @@ func (reb *Reb) runEC(rargs *rebArgs) error {
 		return cmn.NewErrAborted(xreb.Name(), "reb-run-ec-bcast", err)
 	}
 
+	go reb.estTotal(xreb, fs.GetAvail(), true /*ec*/)
+
 	reb.runECjoggers()
 
 	if err := xreb.AbortErr(); err != nil {
 		return cmn.NewErrAborted(xreb.Name(), "reb-run-ec-joggers", err)
 	}
+	xreb.EstEnd()
 	glog.Infof("[%s] RebalanceEC done", reb.t.SID())
 	return nil
 }
@@ -489,6 +496,7 @@ func (reb *Reb) runNoEC(rargs *rebArgs) error {
 		return cmn.NewErrAborted(xreb.Name(), "reb-run-bcast", err)
 	}
 
+	go reb.estTotal(xreb, rargs.apaths, false /*ec*/)
 	wg := &sync.WaitGroup{}
 	for _, mi := range rargs.apaths {
 		rl := &rebJogger{
@@ -503,12 +511,48 @@ func (reb *Reb) runNoEC(rargs *rebArgs) error {
 	if err := xreb.AbortErr(); err != nil {
 		return cmn.NewErrAborted(xreb.Name(), "reb-run-joggers", err)
 	}
+	xreb.EstEnd()
 	if glog.FastV(4, glog.SmoduleReb) {
 		glog.Infof("finished rebalance walk (g%d)", rargs.id)
 	}
 	return nil
 }
 
+// compute the size of the local content to traverse: objects of the buckets
+// with EC disabled or, respectively, objects and slices of the EC buckets
+// (runs asynchronously so as not to delay the traversal)
+func (reb *Reb) estTotal(xreb *xs.Rebalance, avail fs.MPI, ec bool) {
+	var (
+		total int64
+		bmd   = reb.t.Bowner().Get()
+		b     = xreb.Bck()
+	)
+	bmd.Range(nil, nil, func(bck *cluster.Bck) bool {
+		if bck.Props.EC.Enabled != ec || (!b.IsEmpty() && !b.Equal(bck, false, false)) {
+			return false
+		}
+		for _, mi := range avail {
+			total += dirSize(mi.MakePathCT(bck.Bucket(), fs.ObjectType))
+			if ec {
+				total += dirSize(mi.MakePathCT(bck.Bucket(), fs.ECSliceType))
+			}
+		}
+		return xreb.IsAborted()
+	})
+	xreb.EstTotal(total)
+}
+
+func dirSize(dir string) int64 {
+	if err := cos.Stat(dir); err != nil {
+		return 0
+	}
+	size, err := ios.DirSizeOnDisk(dir, false /*withNonDirPrefix*/)
+	if err != nil {
+		glog.Error(err)
+	}
+	return int64(size)
+}
+
 func (reb *Reb) rebWaitAck(rargs *rebArgs) (errCnt int) {
 	var (
 		cnt    int
@@ -768,6 +812,9 @@ func (rj *rebJogger) _lwalk(lom *cluster.LOM, fqn string) error {
 		return err
 	}
 	if tsi.ID() == rj.m.t.SID() {
+		if err := lom.Load(false /*cache it*/, false /*locked*/); err == nil {
+			rj.xreb.EstVisited(lom.SizeBytes())
+		}
 		return cmn.ErrSkip
 	}
 
@@ -783,6 +830,8 @@ func (rj *rebJogger) _lwalk(lom *cluster.LOM, fqn string) error {
 	if err != nil {
 		return err
 	}
+	rj.xreb.EstVisited(lom.SizeBytes())
+	rj.xreb.EstToMove(lom.SizeBytes())
 	// transmit (unlock via transport completion => roc.Close)
 	rj.m.addLomAck(lom)
 	if err := rj.doSend(lom, tsi, roc); err != nil {
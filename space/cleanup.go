@@ -31,14 +31,32 @@ import (
 
 type (
 	IniCln struct {
-		T       cluster.Target
-		Xaction *XactCln
-		StatsT  stats.Tracker
-		Buckets []cmn.Bck // optional list of specific buckets to cleanup
-		WG      *sync.WaitGroup
+		T         cluster.Target
+		Xaction   *XactCln
+		StatsT    stats.Tracker
+		Buckets   []cmn.Bck // optional list of specific buckets to cleanup
+		WG        *sync.WaitGroup
+		OlderThan time.Duration // optional: remove only stale files (older than)
+		DryRun    bool          // count reclaimable files and bytes, don't remove
 	}
 	XactCln struct {
 		xact.Base
+		ext struct {
+			mpaths map[string]*ClnMpathStats
+			mu     sync.Mutex
+			dryRun bool
+		}
+	}
+
+	// per-mountpath removed (or, in dry-run mode, reclaimable) files and bytes
+	ClnMpathStats struct {
+		Files   int64 `json:"files,string"`
+		Size    int64 `json:"size,string"`
+		Deleted int64 `json:"deleted,string"` // size of the mountpath's 'deleted' content
+	}
+	ExtClnStats struct {
+		Mpaths map[string]*ClnMpathStats `json:"mpaths"`
+		DryRun bool                      `json:"dry_run"`
 	}
 )
 
@@ -65,8 +83,11 @@ type (
 			loms []*cluster.LOM
 			ec   []*cluster.CT // EC slices and replicas without corresponding metafiles (CT FQN -> Meta FQN)
 		}
-		bck cmn.Bck
-		now int64
+		bck    cmn.Bck
+		now    int64
+		dryRun struct {
+			files, size int64 // reclaimable
+		}
 		// init-time
 		p       *clnP
 		ini     *IniCln
@@ -93,10 +114,37 @@ func (r *XactCln) Snap() (snap *cluster.Snap) {
 	snap = &cluster.Snap{}
 	r.ToSnap(snap)
 
+	r.ext.mu.Lock()
+	if len(r.ext.mpaths) > 0 {
+		ext := &ExtClnStats{Mpaths: make(map[string]*ClnMpathStats, len(r.ext.mpaths)), DryRun: r.ext.dryRun}
+		for mpath, st := range r.ext.mpaths {
+			c := *st
+			ext.Mpaths[mpath] = &c
+		}
+		snap.Ext = ext
+	}
+	r.ext.mu.Unlock()
+
 	snap.IdleX = r.IsIdle()
 	return
 }
 
+func (r *XactCln) addMpathStats(mpath string, files, size, deleted int64) {
+	r.ext.mu.Lock()
+	if r.ext.mpaths == nil {
+		r.ext.mpaths = make(map[string]*ClnMpathStats, 4)
+	}
+	st, ok := r.ext.mpaths[mpath]
+	if !ok {
+		st = &ClnMpathStats{}
+		r.ext.mpaths[mpath] = st
+	}
+	st.Files += files
+	st.Size += size
+	st.Deleted += deleted
+	r.ext.mu.Unlock()
+}
+
 ////////////////
 // clnFactory //
 ////////////////
@@ -150,6 +198,7 @@ func RunCleanup(ini *IniCln) fs.CapStatus {
 		joggers[mpath].misplaced.ec = make([]*cluster.CT, 0, 64)
 	}
 	parent.jcnt.Store(int32(len(joggers)))
+	xcln.ext.dryRun = ini.DryRun
 	providers := apc.Providers.ToSlice()
 	for _, j := range joggers {
 		parent.wg.Add(1)
@@ -296,7 +345,12 @@ func (j *clnJ) jogBcks(bcks []cmn.Bck) (size int64, rerr error) {
 
 func (j *clnJ) removeDeleted() (err error) {
 	var errCap error
-	err = j.mi.RemoveDeleted(j.String())
+	if size := j.deletedSize(); size > 0 {
+		j.ini.Xaction.addMpathStats(j.mi.Path, 0, 0, size)
+	}
+	if !j.ini.DryRun {
+		err = j.mi.RemoveDeleted(j.String())
+	}
 	if cnt := j.p.jcnt.Dec(); cnt > 0 {
 		return
 	}
@@ -313,6 +367,24 @@ func (j *clnJ) removeDeleted() (err error) {
 	return
 }
 
+// total size of the (removable) content of the mountpath's 'deleted' directory
+func (j *clnJ) deletedSize() int64 {
+	delroot := j.mi.DeletedRoot()
+	if dentries, err := os.ReadDir(delroot); err != nil || len(dentries) == 0 {
+		return 0
+	}
+	size, err := ios.DirSizeOnDisk(delroot, false /*withNonDirPrefix*/)
+	if err != nil {
+		glog.Errorf("%s: %v", j, err)
+	}
+	return int64(size)
+}
+
+// with `--older-than`: is the file stale enough to be removed?
+func (j *clnJ) isStale(mtime int64) bool {
+	return j.ini.OlderThan == 0 || mtime+int64(j.ini.OlderThan) < j.now
+}
+
 func (j *clnJ) jogBck() (size int64, err error) {
 	opts := &fs.WalkOpts{
 		Mi:       j.mi,
@@ -395,7 +467,7 @@ func (j *clnJ) visitObj(fqn string) {
 	}
 	// handle load err
 	if errLoad := lom.Load(false /*cache it*/, false /*locked*/); errLoad != nil {
-		_, atime, err := ios.FinfoAtime(lom.FQN)
+		finfo, atime, err := ios.FinfoAtime(lom.FQN)
 		if err != nil {
 			if !os.IsNotExist(err) {
 				err = os.NewSyscallError("stat", err)
@@ -407,6 +479,16 @@ func (j *clnJ) visitObj(fqn string) {
 		if atime+int64(j.config.LRU.DontEvictTime) < j.now {
 			return
 		}
+		if !j.isStale(atime) {
+			return
+		}
+		if j.ini.DryRun {
+			if cmn.IsErrLmetaCorrupted(err) || cmn.IsErrLmetaNotFound(err) {
+				j.dryRun.files++
+				j.dryRun.size += finfo.Size()
+			}
+			return
+		}
 		if cmn.IsErrLmetaCorrupted(err) {
 			if err := cos.RemoveFile(lom.FQN); err != nil {
 				glog.Errorf("%s: failed to rm MD-corrupted %s: %v (nested: %v)", j, lom, errLoad, err)
@@ -426,8 +508,11 @@ func (j *clnJ) visitObj(fqn string) {
 	if lom.AtimeUnix()+int64(j.config.LRU.DontEvictTime) > j.now {
 		return
 	}
+	if !j.isStale(lom.AtimeUnix()) {
+		return
+	}
 	if lom.IsHRW() {
-		if lom.HasCopies() {
+		if lom.HasCopies() && !j.ini.DryRun {
 			j.rmExtraCopies(lom)
 		}
 		return
@@ -490,20 +575,27 @@ func (j *clnJ) rmLeftovers() (size int64, err error) {
 	var (
 		fevicted, bevicted int64
 		xcln               = j.ini.Xaction
+		dryRun             = j.ini.DryRun
 	)
 	// 1. rm older work
 	for _, workfqn := range j.oldWork {
 		finfo, erw := os.Stat(workfqn)
-		if erw == nil {
-			if err := cos.RemoveFile(workfqn); err != nil {
-				glog.Errorf("%s: failed to rm old work %q: %v", j, workfqn, err)
-			} else {
-				size += finfo.Size()
-				fevicted++
-				bevicted += finfo.Size()
-				if verbose {
-					glog.Infof("%s: rm old work %q, size=%d", j, workfqn, size)
-				}
+		if erw != nil || !j.isStale(finfo.ModTime().UnixNano()) {
+			continue
+		}
+		if dryRun {
+			fevicted++
+			bevicted += finfo.Size()
+			continue
+		}
+		if err := cos.RemoveFile(workfqn); err != nil {
+			glog.Errorf("%s: failed to rm old work %q: %v", j, workfqn, err)
+		} else {
+			size += finfo.Size()
+			fevicted++
+			bevicted += finfo.Size()
+			if verbose {
+				glog.Infof("%s: rm old work %q, size=%d", j, workfqn, size)
 			}
 		}
 	}
@@ -516,6 +608,14 @@ func (j *clnJ) rmLeftovers() (size int64, err error) {
 				fqn     = mlom.FQN
 				removed bool
 			)
+			if !j.isStale(mlom.AtimeUnix()) {
+				continue
+			}
+			if dryRun {
+				fevicted++
+				bevicted += mlom.SizeBytes(true /*not loaded*/)
+				continue
+			}
 			lom := cluster.AllocLOM(mlom.ObjName) // yes placed
 			if lom.InitBck(&j.bck) != nil {
 				removed = os.Remove(fqn) == nil
@@ -545,6 +645,17 @@ func (j *clnJ) rmLeftovers() (size int64, err error) {
 		if cos.Stat(metaFQN) == nil {
 			continue
 		}
+		if j.ini.OlderThan > 0 {
+			finfo, errS := os.Stat(ct.FQN())
+			if errS != nil || !j.isStale(finfo.ModTime().UnixNano()) {
+				continue
+			}
+		}
+		if dryRun {
+			fevicted++
+			bevicted += ct.SizeBytes()
+			continue
+		}
 		if os.Remove(ct.FQN()) == nil {
 			fevicted++
 			bevicted += ct.SizeBytes()
@@ -555,6 +666,15 @@ func (j *clnJ) rmLeftovers() (size int64, err error) {
 	}
 	j.misplaced.ec = j.misplaced.ec[:0]
 
+	// (dry-run) plus corrupted and metadata-less objects found while visiting
+	fevicted += j.dryRun.files
+	bevicted += j.dryRun.size
+	j.dryRun.files, j.dryRun.size = 0, 0
+
+	xcln.addMpathStats(j.mi.Path, fevicted, bevicted, 0)
+	if dryRun {
+		return
+	}
 	j.ini.StatsT.Add(stats.CleanupStoreSize, bevicted) // TODO -- FIXME
 	j.ini.StatsT.Add(stats.CleanupStoreCount, fevicted)
 	xcln.ObjsAdd(int(fevicted), bevicted)
@@ -57,10 +57,19 @@ type (
 		GetFSUsedPercentage func(path string) (usedPercentage int64, ok bool)
 		GetFSStats          func(path string) (blocks, bavail uint64, bsize int64, err error)
 		WG                  *sync.WaitGroup
-		Force               bool // Ignore LRU prop when set to be true.
+		Force               bool  // Ignore LRU prop when set to be true.
+		LowWM, HighWM       int64 // when non-zero, override config.Space watermarks (this run only)
 	}
 	XactLRU struct {
 		xact.Base
+		evicted struct {
+			mpaths map[string]int64 // evicted size per mountpath
+			mu     sync.Mutex
+		}
+	}
+	// extended x-lru stats
+	ExtLRUStats struct {
+		Evicted map[string]int64 `json:"lru.evicted.size"` // bytes evicted per mountpath
 	}
 )
 
@@ -190,10 +199,29 @@ func (r *XactLRU) Snap() (snap *cluster.Snap) {
 	snap = &cluster.Snap{}
 	r.ToSnap(snap)
 
+	r.evicted.mu.Lock()
+	if len(r.evicted.mpaths) > 0 {
+		ext := &ExtLRUStats{Evicted: make(map[string]int64, len(r.evicted.mpaths))}
+		for mpath, size := range r.evicted.mpaths {
+			ext.Evicted[mpath] = size
+		}
+		snap.Ext = ext
+	}
+	r.evicted.mu.Unlock()
+
 	snap.IdleX = r.IsIdle()
 	return
 }
 
+func (r *XactLRU) addEvicted(mpath string, size int64) {
+	r.evicted.mu.Lock()
+	if r.evicted.mpaths == nil {
+		r.evicted.mpaths = make(map[string]int64, 4)
+	}
+	r.evicted.mpaths[mpath] += size
+	r.evicted.mu.Unlock()
+}
+
 //////////////////////
 // mountpath jogger //
 //////////////////////
@@ -385,6 +413,9 @@ func (j *lruJ) evict() (size int64, err error) {
 	j.ini.StatsT.Add(stats.LruEvictSize, bevicted)
 	j.ini.StatsT.Add(stats.LruEvictCount, fevicted)
 	xlru.ObjsAdd(int(fevicted), bevicted)
+	if bevicted > 0 {
+		xlru.addEvicted(j.mi.Path, bevicted)
+	}
 	return
 }
 
@@ -404,7 +435,7 @@ func (j *lruJ) postRemove(prev, size int64) (capCheck int64, err error) {
 	j.config = cmn.GCO.Get()
 	j.now = time.Now().UnixNano()
 	usedPct, ok := j.ini.GetFSUsedPercentage(j.mi.Path)
-	if ok && usedPct < j.config.Space.HighWM {
+	if _, hwm := j.wms(); ok && usedPct < hwm {
 		err = j._throttle(usedPct)
 	}
 	return
@@ -415,11 +446,12 @@ func (j *lruJ) _throttle(usedPct int64) (err error) {
 		return
 	}
 	// throttle self
-	ratioCapacity := cos.Ratio(j.config.Space.HighWM, j.config.Space.LowWM, usedPct)
+	lwm, hwm := j.wms()
+	ratioCapacity := cos.Ratio(hwm, lwm, usedPct)
 	curr := fs.GetMpathUtil(j.mi.Path)
 	ratioUtilization := cos.Ratio(j.config.Disk.DiskUtilHighWM, j.config.Disk.DiskUtilLowWM, curr)
 	if ratioUtilization > ratioCapacity {
-		if usedPct < (j.config.Space.LowWM+j.config.Space.HighWM)/2 {
+		if usedPct < (lwm+hwm)/2 {
 			j.throttle = true
 		}
 		time.Sleep(mpather.ThrottleMaxDur)
@@ -440,8 +472,41 @@ func evictObj(lom *cluster.LOM) (ok bool) {
 	return
 }
 
+// ValidateWMs checks (optional) per-run watermark overrides against each other and,
+// for the one that's not specified, against the configured value
+func ValidateWMs(lwm, hwm int64, config *cmn.SpaceConf) error {
+	if lwm == 0 && hwm == 0 {
+		return nil
+	}
+	if lwm < 0 || hwm < 0 || lwm > 100 || hwm > 100 {
+		return fmt.Errorf("invalid LRU watermarks (low %d%%, high %d%%): expecting 0 < low < high <= 100", lwm, hwm)
+	}
+	if lwm == 0 {
+		lwm = config.LowWM
+	}
+	if hwm == 0 {
+		hwm = config.HighWM
+	}
+	if lwm >= hwm {
+		return fmt.Errorf("invalid LRU watermarks: low (%d%%) must be less than high (%d%%)", lwm, hwm)
+	}
+	return nil
+}
+
+// effective watermarks: per-run overrides, if any, take precedence
+func (j *lruJ) wms() (lwm, hwm int64) {
+	lwm, hwm = j.config.Space.LowWM, j.config.Space.HighWM
+	if j.ini.LowWM > 0 {
+		lwm = j.ini.LowWM
+	}
+	if j.ini.HighWM > 0 {
+		hwm = j.ini.HighWM
+	}
+	return
+}
+
 func (j *lruJ) evictSize() (err error) {
-	lwm, hwm := j.config.Space.LowWM, j.config.Space.HighWM
+	lwm, hwm := j.wms()
 	blocks, bavail, bsize, err := j.ini.GetFSStats(j.mi.Path)
 	if err != nil {
 		return err
@@ -37,6 +37,7 @@ type (
 		IsPrometheus() bool
 
 		IncErr(metric string)
+		SetGauge(name string, val int64)
 
 		GetStats() *Node
 		ResetStats(errorsOnly bool)
@@ -73,6 +74,14 @@ type (
 		MemCPUInfo     apc.MemCPUInfo `json:"sys_info"`
 		SmapVersion    int64          `json:"smap_version,string"`
 	}
+
+	// target's used and total capacity (summed across mountpaths) at a given point in time
+	// (see Trunner.CapHistory)
+	CapSample struct {
+		Time  int64  `json:"time,string"` // Unix time (ns)
+		Used  uint64 `json:"used,string"`
+		Total uint64 `json:"total,string"`
+	}
 )
 
 func IsErrMetric(name string) bool {
@@ -78,6 +78,14 @@ const (
 
 	// KindSpecial
 	Uptime = "up.ns.time"
+
+	// KindGauge: number of versions this node is behind the primary, per cluster-level metadata type
+	// (see also: metasync)
+	MetasyncLagSmap   = "metasync.lag.smap"
+	MetasyncLagBMD    = "metasync.lag.bmd"
+	MetasyncLagRMD    = "metasync.lag.rmd"
+	MetasyncLagConfig = "metasync.lag.config"
+	MetasyncLagEtlMD  = "metasync.lag.etlmd"
 )
 
 // interface guard
@@ -661,6 +669,13 @@ func (tracker statsTracker) regCommon(node *cluster.Snode) {
 
 	// special uptime
 	tracker.reg(node, Uptime, KindSpecial)
+
+	// metasync lag
+	tracker.reg(node, MetasyncLagSmap, KindGauge)
+	tracker.reg(node, MetasyncLagBMD, KindGauge)
+	tracker.reg(node, MetasyncLagRMD, KindGauge)
+	tracker.reg(node, MetasyncLagConfig, KindGauge)
+	tracker.reg(node, MetasyncLagEtlMD, KindGauge)
 }
 
 /////////////////
@@ -708,6 +723,12 @@ func (r *statsRunner) AddMany(nvs ...cos.NamedVal64) {
 	}
 }
 
+func (r *statsRunner) SetGauge(name string, val int64) {
+	v, ok := r.core.Tracker[name]
+	debug.Assertf(ok && v.kind == KindGauge, "invalid gauge %q", name)
+	ratomic.StoreInt64(&v.Value, val)
+}
+
 func (r *statsRunner) IsPrometheus() bool { return r.core.isPrometheus() }
 
 func (r *statsRunner) Describe(ch chan<- *prometheus.Desc) {
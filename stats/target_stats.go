@@ -8,6 +8,7 @@ package stats
 import (
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 	"unsafe"
 
@@ -93,14 +94,27 @@ type (
 		statsRunner // the base (compare w/ Prunner)
 		lines       []string
 		mem         sys.MemStat
+		caphist     capHist
 		standby     bool
 	}
+	// ring buffer of capacity samples (oldest first) to project capacity growth
+	capHist struct {
+		samples []CapSample
+		next    int64 // mono.NanoTime() of the next sample
+		mu      sync.RWMutex
+	}
 )
 
 const (
 	minLogDiskUtil = 10 // skip logDiskStats if below
 )
 
+// capacity history: sample every 10 minutes and retain 7 days' worth
+const (
+	capSampleIval = 10 * time.Minute
+	capHistMax    = int(7 * 24 * time.Hour / capSampleIval)
+)
+
 /////////////
 // Trunner //
 /////////////
@@ -284,6 +298,7 @@ func (r *Trunner) log(now int64, uptime time.Duration, config *cmn.Config) {
 		if cs.Err != nil || cs.PctMax > int32(config.Space.CleanupWM) {
 			r.t.OOS(&cs)
 		}
+		r.caphist.add(now, &cs)
 		for mpath, fsCapacity := range r.TargetCDF.Mountpaths {
 			ln := cos.MustMarshalToString(fsCapacity)
 			r.lines = append(r.lines, mpath+": "+ln)
@@ -329,6 +344,30 @@ func (r *Trunner) log(now int64, uptime time.Duration, config *cmn.Config) {
 	}
 }
 
+// CapHistory returns a copy of the capacity samples collected over (up to) the last 7 days
+func (r *Trunner) CapHistory() []CapSample {
+	r.caphist.mu.RLock()
+	samples := make([]CapSample, len(r.caphist.samples))
+	copy(samples, r.caphist.samples)
+	r.caphist.mu.RUnlock()
+	return samples
+}
+
+func (h *capHist) add(now int64, cs *fs.CapStatus) {
+	if now < h.next || cs.IsNil() {
+		return
+	}
+	h.next = now + int64(capSampleIval)
+	sample := CapSample{Time: time.Now().UnixNano(), Used: cs.TotalUsed, Total: cs.TotalUsed + cs.TotalAvail}
+	h.mu.Lock()
+	if len(h.samples) >= capHistMax {
+		copy(h.samples, h.samples[1:])
+		h.samples = h.samples[:len(h.samples)-1]
+	}
+	h.samples = append(h.samples, sample)
+	h.mu.Unlock()
+}
+
 // log formatted disk stats:
 // [ disk: read throughput, average read size, write throughput, average write size, disk utilization ]
 // e.g.: [ sda: 94MiB/s, 68KiB, 25MiB/s, 21KiB, 82% ]
@@ -57,6 +57,15 @@ type (
 		Timeout     time.Duration // max time to wait and other "non-filters"
 		Force       bool          // force
 		OnlyRunning bool          // look only for running xactions
+
+		// storage cleanup
+		OlderThan time.Duration // only remove files that are older than
+		DryRun    bool          // report reclaimable space without removing anything
+
+		// LRU: evict down to LowWM if used capacity exceeds HighWM (%% used),
+		// overriding the configured `space` watermarks for this run only (zero = use config)
+		LowWM  int64
+		HighWM int64
 	}
 
 	// simplified JSON-tagged version of the above
@@ -71,6 +80,27 @@ type (
 
 	// primarily: `api.QueryXactionSnaps`
 	MultiSnap map[string][]*cluster.Snap // by target ID (tid)
+
+	// extended (xaction-specific) statistics, reported via `cluster.Snap.Ext`
+
+	// rebalance progress (extrapolated from the fraction of local data traversed so far)
+	ExtRebStats struct {
+		// estimated total number of bytes to send (zero while estimating)
+		EstTotalBytes int64 `json:"est_total_bytes,string"`
+		// percentage of local data traversed
+		PctTraversed int  `json:"pct_traversed"`
+		Estimating   bool `json:"estimating"`
+	}
+	// x-prefetch
+	ExtPrefetchStats struct {
+		SkippedCnt  int64 `json:"prefetch.skipped.n,string"`    // already present (cached)
+		SkippedSize int64 `json:"prefetch.skipped.size,string"` // ditto
+	}
+	// x-promote (directory) with `ContinueOnError`
+	ExtPromoteStats struct {
+		Failed   []string `json:"promote.failed,omitempty"` // (first) source files that failed to promote
+		ErrCount int64    `json:"promote.err.n,string"`
+	}
 )
 
 type (
@@ -139,6 +169,7 @@ var Table = map[string]Descriptor{
 	apc.ActArchive:     {Scope: ScopeB, Startable: false, RefreshCap: true, Idles: true},
 	apc.ActCopyObjects: {DisplayName: "copy-objects", Scope: ScopeB, Startable: false, RefreshCap: true, Idles: true},
 	apc.ActETLObjects:  {DisplayName: "etl-objects", Scope: ScopeB, Startable: false, RefreshCap: true, Idles: true},
+	apc.ActExtract:     {DisplayName: "extract-archive", Scope: ScopeB, Access: apc.AcePUT, Startable: false, RefreshCap: true},
 
 	// multi-object
 	apc.ActPromote: {DisplayName: "promote-files", Scope: ScopeB, Access: apc.AcePromote, Startable: false, RefreshCap: true},
@@ -165,6 +196,14 @@ var Table = map[string]Descriptor{
 		Startable:   true,
 		RefreshCap:  true,
 	},
+	apc.ActRechecksum: {
+		DisplayName: "rechecksum",
+		Scope:       ScopeB,
+		Access:      apc.AccessRW,
+		Startable:   false,
+		RefreshCap:  true,
+		Mountpath:   true,
+	},
 
 	// entire bucket (storage svcs)
 	apc.ActECEncode: {
@@ -226,6 +265,7 @@ var Table = map[string]Descriptor{
 
 	// cache management, internal usage
 	apc.ActLoadLomCache:   {DisplayName: "warm-up-metadata", Scope: ScopeB, Startable: true, Mountpath: true},
+	apc.ActExpirySweep:    {DisplayName: "expiry-sweep", Scope: ScopeB, Access: apc.AceObjDELETE, Startable: true, RefreshCap: true},
 	apc.ActInvalListCache: {Scope: ScopeB, Access: apc.AceObjLIST, Startable: false},
 }
 
@@ -30,6 +30,7 @@ type (
 		bck    cluster.Bck
 		id     string
 		kind   string
+		parent string // ID of the spawning xaction, if any
 		sutime atomic.Int64
 		eutime atomic.Int64
 		abort  struct {
@@ -81,6 +82,10 @@ func (xctn *Base) InitBase(id, kind string, bck *cluster.Bck) {
 func (xctn *Base) ID() string   { return xctn.id }
 func (xctn *Base) Kind() string { return xctn.kind }
 
+// to be called prior to running the xaction that was spawned by another one
+func (xctn *Base) SetParent(id string) { xctn.parent = id }
+func (xctn *Base) ParentID() string    { return xctn.parent }
+
 func (xctn *Base) Bck() *cluster.Bck { return &xctn.bck }
 
 func (xctn *Base) Finished() bool { return xctn.eutime.Load() != 0 }
@@ -308,6 +313,7 @@ func (xctn *Base) InObjsAdd(cnt int, size int64) {
 func (xctn *Base) ToSnap(snap *cluster.Snap) {
 	snap.ID = xctn.ID()
 	snap.Kind = xctn.Kind()
+	snap.ParentID = xctn.parent
 	snap.StartTime = xctn.StartTime()
 	snap.EndTime = xctn.EndTime()
 	snap.AbortedX = xctn.IsAborted()
@@ -27,6 +27,12 @@ type (
 		DP      cluster.DP
 	}
 
+	ExtractArgs struct {
+		Msg      *cmn.ExtractMsg
+		BckTo    *cluster.Bck
+		ArchName string // archived object (in the source bucket)
+	}
+
 	ECEncodeArgs struct {
 		Phase string
 	}
@@ -23,6 +23,18 @@ func RenewEvictDelete(uuid string, t cluster.Target, kind string, bck *cluster.B
 	return RenewBucketXact(kind, bck, Args{T: t, UUID: uuid, Custom: msg})
 }
 
-func RenewPrefetch(uuid string, t cluster.Target, bck *cluster.Bck, msg *cmn.ListRange) RenewRes {
+func RenewPrefetch(uuid string, t cluster.Target, bck *cluster.Bck, msg *cmn.PrefetchMsg) RenewRes {
 	return RenewBucketXact(apc.ActPrefetchObjects, bck, Args{T: t, UUID: uuid, Custom: msg})
 }
+
+func RenewRechecksum(uuid string, t cluster.Target, bck *cluster.Bck, msg *cmn.RechecksumMsg) RenewRes {
+	return RenewBucketXact(apc.ActRechecksum, bck, Args{T: t, UUID: uuid, Custom: msg})
+}
+
+func RenewExpirySweep(uuid string, t cluster.Target, bck *cluster.Bck) RenewRes {
+	return RenewBucketXact(apc.ActExpirySweep, bck, Args{T: t, UUID: uuid})
+}
+
+func RenewExtract(uuid string, t cluster.Target, bck *cluster.Bck, args *ExtractArgs) RenewRes {
+	return RenewBucketXact(apc.ActExtract, bck, Args{T: t, UUID: uuid, Custom: args})
+}
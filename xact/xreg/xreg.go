@@ -286,6 +286,18 @@ func GetSnap(flt Flt) ([]*cluster.Snap, error) {
 			return cmp >= 0 && xctn.Finished() && !xctn.IsAborted()
 		}), nil
 	}
+	if flt.Bck != nil && flt.Kind == "" {
+		if !flt.Bck.HasProvider() {
+			return nil, fmt.Errorf("unknown provider for bucket %s", flt.Bck.Name)
+		}
+		// all kinds, uniformly: every xaction that has this bucket as its (single) bucket, source, or destination
+		return dreg.matchingXactsStats(func(xctn cluster.Xact) bool {
+			if flt.OnlyRunning != nil && *flt.OnlyRunning != xctn.Running() {
+				return false
+			}
+			return flt.touches(xctn)
+		}), nil
+	}
 	if flt.Bck != nil || flt.Kind != "" {
 		// Error checks
 		if flt.Kind != "" && !xact.IsValidKind(flt.Kind) {
@@ -835,3 +847,12 @@ func (flt Flt) Matches(xctn cluster.Xact) (yes bool) {
 
 	return xctn.Bck().Equal(flt.Bck, true, true)
 }
+
+// (any kind) cluster-wide xactions, e.g. rebalance, don't have a bucket and never match
+func (flt Flt) touches(xctn cluster.Xact) bool {
+	if bck := xctn.Bck(); bck != nil && bck.Name != "" && bck.Equal(flt.Bck, false, false) {
+		return true
+	}
+	from, to := xctn.FromTo()
+	return (from != nil && from.Equal(flt.Bck, false, false)) || (to != nil && to.Equal(flt.Bck, false, false))
+}
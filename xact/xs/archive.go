@@ -29,6 +29,7 @@ import (
 	"github.com/NVIDIA/aistore/transport"
 	"github.com/NVIDIA/aistore/xact"
 	"github.com/NVIDIA/aistore/xact/xreg"
+	"github.com/klauspost/compress/zstd"
 	"github.com/vmihailenco/msgpack"
 )
 
@@ -54,6 +55,10 @@ type (
 		tw  tarWriter
 		gzw *gzip.Writer
 	}
+	tzstWriter struct {
+		tw  tarWriter
+		zsw *zstd.Encoder
+	}
 	zipWriter struct {
 		baseW
 		zw *zip.Writer
@@ -76,6 +81,9 @@ type (
 		appendPos int64 // append to existing archive
 		wmu       sync.Mutex
 		errCnt    atomic.Int32
+		// abort-on-error (see cmn.ErrLimits)
+		nobjs atomic.Int64
+		nerrs atomic.Int64
 		// finishing
 		refc       atomic.Int32
 		finalizing atomic.Bool
@@ -100,6 +108,7 @@ var (
 
 	_ archWriter = (*tarWriter)(nil)
 	_ archWriter = (*tgzWriter)(nil)
+	_ archWriter = (*tzstWriter)(nil)
 	_ archWriter = (*zipWriter)(nil)
 	_ archWriter = (*msgpackWriter)(nil)
 )
@@ -165,7 +174,8 @@ func (r *XactArch) Begin(msg *cmn.ArchiveMsg) (err error) {
 			case cos.ExtTar:
 				err = wi.openTarForAppend()
 			default:
-				err = fmt.Errorf("unsupported archive type %s, only %s is supported", msg.Mime, cos.ExtTar)
+				err = fmt.Errorf("%s: cannot append to %s - appending is not supported for %s archives (only %s)",
+					r.p.T, msg.Cname(), msg.Mime, cos.ExtTar)
 			}
 		} else {
 			err = fmt.Errorf("%s: not allowed to append to an existing %s", r.p.T, msg.Cname())
@@ -181,6 +191,11 @@ func (r *XactArch) Begin(msg *cmn.ArchiveMsg) (err error) {
 		case cos.ExtTgz, cos.ExtTarTgz:
 			tzw := &tgzWriter{}
 			tzw.init(wi)
+		case cos.ExtTarZst:
+			tzsw := &tzstWriter{}
+			if err = tzsw.init(wi); err != nil {
+				return
+			}
 		case cos.ExtZip:
 			zw := &zipWriter{}
 			zw.init(wi)
@@ -333,7 +348,9 @@ func (r *XactArch) recv(hdr transport.ObjHdr, objReader io.Reader, err error) er
 		return nil
 	}
 	debug.Assert(hdr.Opcode == 0)
-	wi.writer.write(wi.nameInArch(hdr.ObjName), &hdr.ObjAttrs, objReader)
+	if err := wi.writer.write(wi.nameInArch(hdr.ObjName), &hdr.ObjAttrs, objReader); err == nil {
+		r.InObjsAdd(1, hdr.ObjAttrs.Size) // archived (added) member
+	}
 	return nil
 }
 
@@ -416,14 +433,15 @@ func (r *XactArch) Snap() (snap *cluster.Snap) {
 
 func (wi *archwi) do(lom *cluster.LOM, lrit *lriterator) {
 	var coldGet bool
+	wi.nobjs.Inc()
 	if err := lom.Load(false /*cache it*/, false /*locked*/); err != nil {
 		if !cmn.IsObjNotExist(err) {
-			wi.r.raiseErr(err, wi.msg.ContinueOnError)
+			wi.raiseErr(err)
 			return
 		}
 		coldGet = lom.Bck().IsRemote()
 		if !coldGet {
-			wi.r.raiseErr(err, wi.msg.ContinueOnError)
+			wi.raiseErr(err)
 			return
 		}
 	}
@@ -434,7 +452,7 @@ func (wi *archwi) do(lom *cluster.LOM, lrit *lriterator) {
 			if errCode == http.StatusNotFound || cmn.IsObjNotExist(err) {
 				return
 			}
-			wi.r.raiseErr(err, wi.msg.ContinueOnError)
+			wi.raiseErr(err)
 			return
 		}
 	}
@@ -442,7 +460,7 @@ func (wi *archwi) do(lom *cluster.LOM, lrit *lriterator) {
 	fh, err := cos.NewFileHandle(lom.FQN)
 	debug.AssertNoErr(err)
 	if err != nil {
-		wi.r.raiseErr(err, wi.msg.ContinueOnError)
+		wi.raiseErr(err)
 		return
 	}
 	if t.SID() != wi.tsi.ID() {
@@ -450,11 +468,25 @@ func (wi *archwi) do(lom *cluster.LOM, lrit *lriterator) {
 		return
 	}
 	debug.Assert(wi.fh != nil) // see Begin
+	size := lom.SizeBytes()
 	err = wi.writer.write(wi.nameInArch(lom.ObjName), lom, fh)
 	cluster.FreeLOM(lom)
 	cos.Close(fh)
 	if err != nil {
-		wi.r.raiseErr(err, wi.msg.ContinueOnError)
+		wi.raiseErr(err)
+		return
+	}
+	wi.r.InObjsAdd(1, size) // archived (added) member
+}
+
+// when enabled, abort the (target-local) xaction once errors exceed the limit
+func (wi *archwi) raiseErr(err error) {
+	wi.r.raiseErr(err, wi.msg.ContinueOnError || wi.msg.ErrLimits.IsSet())
+	if !wi.msg.ErrLimits.IsSet() {
+		return
+	}
+	if trigger := wi.msg.ErrLimits.Exceeded(wi.nerrs.Inc(), wi.nobjs.Load()); trigger != "" {
+		wi.r.Abort(fmt.Errorf("%s: %s, aborting", wi.r, trigger))
 	}
 }
 
@@ -576,6 +608,31 @@ func (tzw *tgzWriter) write(fullname string, oah cmn.ObjAttrsHolder, reader io.R
 	return tzw.tw.write(fullname, oah, reader)
 }
 
+////////////////
+// tzstWriter //
+////////////////
+
+func (tzsw *tzstWriter) init(wi *archwi) (err error) {
+	tzsw.tw.archwi = wi
+	tzsw.tw.wmul = cos.NewWriterMulti(wi.fh, &wi.cksum)
+	if tzsw.zsw, err = zstd.NewWriter(tzsw.tw.wmul, zstd.WithEncoderLevel(zstd.SpeedDefault)); err != nil {
+		return
+	}
+	tzsw.tw.buf, tzsw.tw.slab = memsys.PageMM().Alloc()
+	tzsw.tw.tw = tar.NewWriter(tzsw.zsw)
+	wi.writer = tzsw
+	return
+}
+
+func (tzsw *tzstWriter) fini() {
+	tzsw.tw.fini()
+	tzsw.zsw.Close()
+}
+
+func (tzsw *tzstWriter) write(fullname string, oah cmn.ObjAttrsHolder, reader io.Reader) error {
+	return tzsw.tw.write(fullname, oah, reader)
+}
+
 ///////////////
 // zipWriter //
 ///////////////
@@ -599,6 +656,7 @@ func (zw *zipWriter) write(fullname string, oah cmn.ObjAttrsHolder, reader io.Re
 
 	ziphdr.Name = fullname
 	ziphdr.Comment = fullname
+	ziphdr.Method = zip.Deflate
 	ziphdr.UncompressedSize64 = uint64(oah.SizeBytes())
 	ziphdr.Modified = time.Unix(0, oah.AtimeUnix())
 
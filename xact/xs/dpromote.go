@@ -13,6 +13,7 @@ import (
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cluster"
 	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/fs"
 	"github.com/NVIDIA/aistore/fs/mpather"
@@ -33,6 +34,11 @@ type (
 		smap *cluster.Smap
 		dir  string
 		xact.BckJog
+		failed struct {
+			names []string // up to maxFailedNames
+			mu    sync.Mutex
+			cnt   atomic.Int64
+		}
 		confirmedFshare bool // set separately in the commit phase prior to Run
 	}
 )
@@ -120,14 +126,24 @@ func (r *XactDirPromote) walk(fqn string, de fs.DirEntry) error {
 			ObjName:      objName,
 			OverwriteDst: r.args.OverwriteDst,
 			DeleteSrc:    r.args.DeleteSrc,
+			ComputeCksum: r.args.ComputeCksum,
+			VerifyCksum:  r.args.VerifyCksum,
 		},
 	}
-	// TODO: options to ignore specific error types, limited number of errors,
-	// all errors... (archive)
+	// TODO: options to ignore specific error types, limited number of errors (archive)
 	_, err = r.Target().Promote(params)
 	if cmn.IsNotExist(err) {
 		err = nil
 	}
+	if err != nil && r.args.ContinueOnError {
+		glog.Errorf("%s: failed to promote %q => %s: %v - continuing...", r, fqn, bck.Cname(objName), err)
+		if r.failed.cnt.Inc() <= maxFailedNames {
+			r.failed.mu.Lock()
+			r.failed.names = append(r.failed.names, fqn)
+			r.failed.mu.Unlock()
+		}
+		err = nil
+	}
 	return err
 }
 
@@ -135,6 +151,13 @@ func (r *XactDirPromote) Snap() (snap *cluster.Snap) {
 	snap = &cluster.Snap{}
 	r.ToSnap(snap)
 
+	if n := r.failed.cnt.Load(); n > 0 {
+		ext := &xact.ExtPromoteStats{ErrCount: n}
+		r.failed.mu.Lock()
+		ext.Failed = append([]string(nil), r.failed.names...)
+		r.failed.mu.Unlock()
+		snap.Ext = ext
+	}
 	snap.IdleX = r.IsIdle()
 	return
 }
@@ -0,0 +1,113 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/3rdparty/glog"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/fs/mpather"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// Remove bucket's expired objects, i.e. objects with custom `cmn.ExpiresObjMD`
+// that is in the past. Walks local mountpaths only (remote buckets are never listed):
+// objects in remote buckets are evicted (the remote copy stays intact); objects
+// in ais buckets are deleted.
+
+type (
+	expFactory struct {
+		xreg.RenewBase
+		xctn *expirySweep
+	}
+	expirySweep struct {
+		xact.BckJog
+		now time.Time
+	}
+)
+
+// interface guard
+var (
+	_ cluster.Xact   = (*expirySweep)(nil)
+	_ xreg.Renewable = (*expFactory)(nil)
+)
+
+////////////////
+// expFactory //
+////////////////
+
+func (*expFactory) New(args xreg.Args, bck *cluster.Bck) xreg.Renewable {
+	return &expFactory{RenewBase: xreg.RenewBase{Args: args, Bck: bck}}
+}
+
+func (p *expFactory) Start() error {
+	r := &expirySweep{now: time.Now()}
+	mpopts := &mpather.JgroupOpts{
+		T:                     p.T,
+		CTs:                   []string{fs.ObjectType},
+		VisitObj:              r.visitObj,
+		SkipGloballyMisplaced: true,
+		Throttle:              true,
+	}
+	mpopts.Bck.Copy(p.Bck.Bucket())
+	r.BckJog.Init(p.Args.UUID, p.Kind(), p.Bck, mpopts)
+	p.xctn = r
+	return nil
+}
+
+func (*expFactory) Kind() string        { return apc.ActExpirySweep }
+func (p *expFactory) Get() cluster.Xact { return p.xctn }
+
+func (*expFactory) WhenPrevIsRunning(xreg.Renewable) (xreg.WPR, error) {
+	return xreg.WprKeepAndStartNew, nil
+}
+
+/////////////////
+// expirySweep //
+/////////////////
+
+func (r *expirySweep) Run(*sync.WaitGroup) {
+	r.BckJog.Run()
+	r.Finish(r.BckJog.Wait())
+}
+
+func (r *expirySweep) visitObj(lom *cluster.LOM, _ []byte) error {
+	if err := lom.Load(false /*cache it*/, false /*locked*/); err != nil || lom.IsCopy() {
+		return nil // (e.g., removed in the meantime)
+	}
+	expires, ok := cmn.ObjExpires(lom.GetCustomMD())
+	if !ok || expires.After(r.now) {
+		return nil
+	}
+	size := lom.SizeBytes()
+	errCode, err := r.Target().DeleteObject(lom, r.Bck().IsRemote() /*evict*/)
+	if err != nil {
+		if errCode != http.StatusNotFound && !cmn.IsErrObjNought(err) {
+			glog.Errorf("%s: failed to remove expired %s: %v", r.Name(), lom.Cname(), err)
+		}
+		return nil
+	}
+	if verbose {
+		glog.Infof("%s: %s (expired %v)", r.Name(), lom, expires)
+	}
+	r.ObjsAdd(1, size)
+	return nil
+}
+
+func (r *expirySweep) Snap() (snap *cluster.Snap) {
+	snap = &cluster.Snap{}
+	r.ToSnap(snap)
+
+	snap.IdleX = r.IsIdle()
+	return
+}
@@ -0,0 +1,295 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/3rdparty/glog"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+	"github.com/klauspost/compress/zstd"
+)
+
+// XactExtract unpacks archived object's members (files) into individual objects
+// (server-side). The xaction runs on the target that owns the archive (HRW) - the
+// extracted objects, in turn, get stored locally or PUT to their respective targets.
+
+type (
+	extFactory struct {
+		xreg.RenewBase
+		xctn *XactExtract
+		args *xreg.ExtractArgs
+	}
+	XactExtract struct {
+		args *xreg.ExtractArgs
+		t    cluster.Target
+		smap *cluster.Smap
+		xact.Base
+	}
+	// called for each archived file
+	extractCB func(filename string, reader io.Reader, size int64) error
+)
+
+// interface guard
+var (
+	_ cluster.Xact   = (*XactExtract)(nil)
+	_ xreg.Renewable = (*extFactory)(nil)
+)
+
+////////////////
+// extFactory //
+////////////////
+
+func (*extFactory) New(args xreg.Args, bck *cluster.Bck) xreg.Renewable {
+	c := args.Custom.(*xreg.ExtractArgs)
+	p := &extFactory{RenewBase: xreg.RenewBase{Args: args, Bck: bck}, args: c}
+	return p
+}
+
+func (p *extFactory) Start() error {
+	xctn := &XactExtract{args: p.args, t: p.T}
+	xctn.InitBase(p.Args.UUID, apc.ActExtract, p.Bck)
+	p.xctn = xctn
+	return nil
+}
+
+func (*extFactory) Kind() string        { return apc.ActExtract }
+func (p *extFactory) Get() cluster.Xact { return p.xctn }
+
+func (*extFactory) WhenPrevIsRunning(xreg.Renewable) (xreg.WPR, error) {
+	return xreg.WprKeepAndStartNew, nil
+}
+
+/////////////////
+// XactExtract //
+/////////////////
+
+func (r *XactExtract) Run(*sync.WaitGroup) {
+	glog.Infoln(r.Name(), r.Bck().Cname(r.args.ArchName), "=>", r.args.BckTo.Cname(r.args.Msg.Prefix))
+	r.smap = r.t.Sowner().Get()
+	r.Finish(r.extract())
+}
+
+func (r *XactExtract) extract() error {
+	lom := cluster.AllocLOM(r.args.ArchName)
+	defer cluster.FreeLOM(lom)
+	if err := lom.InitBck(r.Bck().Bucket()); err != nil {
+		return err
+	}
+	lom.Lock(false)
+	defer lom.Unlock(false)
+	if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+		return err
+	}
+	mime, err := cos.Mime(r.args.Msg.Mime, lom.ObjName)
+	if err != nil {
+		return err
+	}
+	fh, err := os.Open(lom.FQN)
+	if err != nil {
+		return err
+	}
+	defer fh.Close()
+
+	switch mime {
+	case cos.ExtTar:
+		return untar(fh, r.do)
+	case cos.ExtTgz, cos.ExtTarTgz:
+		gzr, err := gzip.NewReader(fh)
+		if err != nil {
+			return err
+		}
+		err = untar(gzr, r.do)
+		gzr.Close()
+		return err
+	case cos.ExtTarZst:
+		zsr, err := zstd.NewReader(fh)
+		if err != nil {
+			return err
+		}
+		err = untar(zsr, r.do)
+		zsr.Close()
+		return err
+	case cos.ExtZip:
+		return unzip(fh, lom.SizeBytes(), r.do)
+	default:
+		return fmt.Errorf("%s: cannot extract %s - unsupported archive type %q", r, lom.Cname(), mime)
+	}
+}
+
+func untar(reader io.Reader, cb extractCB) error {
+	tr := tar.NewReader(reader)
+	for {
+		hdr, err := tr.Next()
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+		if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+		if err := cb(hdr.Name, tr, hdr.Size); err != nil {
+			return err
+		}
+	}
+}
+
+func unzip(readerAt io.ReaderAt, size int64, cb extractCB) error {
+	zr, err := zip.NewReader(readerAt, size)
+	if err != nil {
+		return err
+	}
+	for _, f := range zr.File {
+		finfo := f.FileInfo()
+		if finfo.IsDir() {
+			continue
+		}
+		fr, err := f.Open()
+		if err != nil {
+			return err
+		}
+		err = cb(f.FileHeader.Name, fr, finfo.Size())
+		fr.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// filter and extract a single archived file
+func (r *XactExtract) do(filename string, reader io.Reader, size int64) error {
+	if r.IsAborted() {
+		return cmn.NewErrAborted(r.Name(), "", nil)
+	}
+	objName, ok := extractObjName(r.args.Msg.Prefix, filename)
+	if !ok {
+		glog.Warningf("%s: skipping archived file %q in %s: invalid name", r, filename, r.Bck().Cname(r.args.ArchName))
+		return nil
+	}
+	filename = strings.TrimPrefix(objName, r.args.Msg.Prefix)
+	if flt := r.args.Msg.Filter; flt != "" {
+		// match either the full (archived) pathname or its base
+		if ok, _ := path.Match(flt, filename); !ok {
+			if ok, _ = path.Match(flt, path.Base(filename)); !ok {
+				return nil
+			}
+		}
+	}
+	// the archive itself is read-locked for the duration - overwriting it would deadlock
+	if objName == r.args.ArchName && r.args.BckTo.Equal(r.Bck(), true /*same ID*/, true /*same backend*/) {
+		return fmt.Errorf("%s: archived file %q cannot overwrite the archive %s itself (use a different destination or prefix)",
+			r, filename, r.Bck().Cname(r.args.ArchName))
+	}
+	if err := r.put(objName, reader, size); err != nil {
+		return fmt.Errorf("%s: failed to extract %q => %s: %w", r, filename, r.args.BckTo.Cname(objName), err)
+	}
+	r.ObjsAdd(1, size)
+	return nil
+}
+
+// archived file => object name; object names become filesystem paths as is (see fs.MakePathFQN),
+// and so absolute and ".."-containing names (e.g., "/etc/passwd", "../../x", "a/../b") are rejected
+func extractObjName(prefix, filename string) (string, bool) {
+	if filename == "" || path.IsAbs(filename) {
+		return "", false
+	}
+	for _, s := range []string{prefix, filename} {
+		for _, part := range strings.Split(s, "/") {
+			if part == ".." {
+				return "", false
+			}
+		}
+	}
+	filename = path.Clean(filename) // (e.g., "./a//b" => "a/b")
+	if filename == "." {
+		return "", false
+	}
+	return prefix + filename, true
+}
+
+// PUT locally or to the HRW target
+func (r *XactExtract) put(objName string, reader io.Reader, size int64) error {
+	lom := cluster.AllocLOM(objName)
+	defer cluster.FreeLOM(lom)
+	if err := lom.InitBck(r.args.BckTo.Bucket()); err != nil {
+		return err
+	}
+	tsi, local, err := lom.HrwTarget(r.smap)
+	if err != nil {
+		return err
+	}
+	if local {
+		params := cluster.AllocPutObjParams()
+		{
+			params.WorkTag = fs.WorkfilePut
+			params.Reader = io.NopCloser(reader)
+			params.OWT = cmn.OwtPut
+			params.Atime = time.Now()
+			params.Xact = r
+		}
+		lom.SetSize(size)
+		err = r.t.PutObject(lom, params)
+		cluster.FreePutObjParams(params)
+		return err
+	}
+	var (
+		hdr   = make(http.Header, 1)
+		query = r.args.BckTo.AddToQuery(nil)
+	)
+	hdr.Set(apc.HdrT2TPutterID, r.t.SID())
+	query.Set(apc.QparamOWT, cmn.OwtPut.ToS())
+	reqArgs := cmn.HreqArgs{
+		Method: http.MethodPut,
+		Base:   tsi.URL(cmn.NetIntraData),
+		Path:   apc.URLPathObjects.Join(r.args.BckTo.Name, objName),
+		Query:  query,
+		Header: hdr,
+		BodyR:  io.LimitReader(reader, size),
+	}
+	req, _, cancel, err := reqArgs.ReqWithTimeout(cmn.GCO.Get().Timeout.SendFile.D())
+	if err != nil {
+		return err
+	}
+	defer cancel()
+	req.ContentLength = size
+	resp, err := r.t.DataClient().Do(req)
+	if err != nil {
+		return err
+	}
+	cos.DrainReader(resp.Body)
+	resp.Body.Close()
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("PUT %s => %s: status %d", r.args.BckTo.Cname(objName), tsi, resp.StatusCode)
+	}
+	return nil
+}
+
+func (r *XactExtract) Snap() (snap *cluster.Snap) {
+	snap = &cluster.Snap{}
+	r.ToSnap(snap)
+
+	snap.IdleX = r.IsIdle()
+	return
+}
@@ -0,0 +1,85 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+func TestExtractObjName(t *testing.T) {
+	members := []string{
+		"a.txt", "./dir//b.txt", "../../../x", "/etc/passwd", "dir/../../y", "dir/../c", "..", "dir/..data",
+	}
+	expected := []string{"pre/a.txt", "pre/dir/b.txt", "pre/dir/..data"}
+
+	// malicious tar
+	var (
+		buf bytes.Buffer
+		tw  = tar.NewWriter(&buf)
+	)
+	for _, name := range members {
+		tassert.CheckFatal(t, tw.WriteHeader(&tar.Header{Name: name, Typeflag: tar.TypeReg, Mode: 0o644, Size: 1}))
+		_, err := tw.Write([]byte{'x'})
+		tassert.CheckFatal(t, err)
+	}
+	tassert.CheckFatal(t, tw.Close())
+	names, cb := collect()
+	tassert.CheckFatal(t, untar(&buf, cb))
+	tassert.Errorf(t, reflect.DeepEqual(*names, expected), "tar: expected %v, got %v", expected, *names)
+
+	// malicious zip
+	buf.Reset()
+	zw := zip.NewWriter(&buf)
+	for _, name := range members {
+		w, err := zw.Create(name)
+		tassert.CheckFatal(t, err)
+		_, err = w.Write([]byte{'x'})
+		tassert.CheckFatal(t, err)
+	}
+	tassert.CheckFatal(t, zw.Close())
+	names, cb = collect()
+	tassert.CheckFatal(t, unzip(bytes.NewReader(buf.Bytes()), int64(buf.Len()), cb))
+	tassert.Errorf(t, reflect.DeepEqual(*names, expected), "zip: expected %v, got %v", expected, *names)
+
+	// ".." in the destination prefix
+	_, ok := extractObjName("pre/../", "a.txt")
+	tassert.Errorf(t, !ok, "expected prefix %q to be rejected", "pre/../")
+}
+
+func TestExtractSelfOverwrite(t *testing.T) {
+	var (
+		bck = cluster.NewBck("src", apc.AIS, cmn.NsGlobal)
+		r   = &XactExtract{args: &xreg.ExtractArgs{Msg: &cmn.ExtractMsg{}, BckTo: bck, ArchName: "a.tar"}}
+	)
+	r.InitBase(cos.GenUUID(), apc.ActExtract, bck)
+	err := r.do("a.tar", bytes.NewReader([]byte{'x'}), 1)
+	tassert.Errorf(t, err != nil && strings.Contains(err.Error(), "cannot overwrite"), "expected error, got %v", err)
+}
+
+// object names of the archived files that pass validation
+func collect() (*[]string, extractCB) {
+	names := []string{}
+	return &names, func(filename string, reader io.Reader, _ int64) error {
+		if objName, ok := extractObjName("pre/", filename); ok {
+			names = append(names, objName)
+		}
+		_, err := io.Copy(io.Discard, reader)
+		return err
+	}
+}
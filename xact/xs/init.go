@@ -26,6 +26,8 @@ func Xreg() {
 	xreg.RegBckXact(&evdFactory{kind: apc.ActEvictObjects})
 	xreg.RegBckXact(&evdFactory{kind: apc.ActDeleteObjects})
 	xreg.RegBckXact(&prfFactory{})
+	xreg.RegBckXact(&rcsFactory{})
+	xreg.RegBckXact(&expFactory{})
 
 	xreg.RegNonBckXact(&bsummFactory{})
 
@@ -35,5 +37,6 @@ func Xreg() {
 	xreg.RegBckXact(&tcoFactory{streamingF: streamingF{kind: apc.ActETLObjects}})
 	xreg.RegBckXact(&tcoFactory{streamingF: streamingF{kind: apc.ActCopyObjects}})
 	xreg.RegBckXact(&archFactory{streamingF: streamingF{kind: apc.ActArchive}})
+	xreg.RegBckXact(&extFactory{})
 	xreg.RegBckXact(&lsoFactory{streamingF: streamingF{kind: apc.ActList}})
 }
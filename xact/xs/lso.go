@@ -33,6 +33,7 @@ import (
 	"github.com/NVIDIA/aistore/memsys"
 	"github.com/NVIDIA/aistore/transport"
 	"github.com/NVIDIA/aistore/xact/xreg"
+	"github.com/klauspost/compress/zstd"
 	"github.com/tinylib/msgp/msgp"
 	"github.com/vmihailenco/msgpack"
 )
@@ -588,6 +589,8 @@ func listArchive(fqn string) ([]*archEntry, error) {
 			archList, err = listTar(f)
 		case cos.ExtTgz, cos.ExtTarTgz:
 			archList, err = listTgz(f)
+		case cos.ExtTarZst:
+			archList, err = listTzst(f)
 		case cos.ExtZip:
 			finfo, err = os.Stat(fqn)
 			if err == nil {
@@ -608,7 +611,7 @@ func listArchive(fqn string) ([]*archEntry, error) {
 	return archList, nil
 }
 
-// list: tar, tgz, zip, msgpack
+// list: tar, tgz, tar.zst, zip, msgpack
 func listTar(reader io.Reader) ([]*archEntry, error) {
 	fileList := make([]*archEntry, 0, 8)
 	tr := tar.NewReader(reader)
@@ -636,6 +639,15 @@ func listTgz(reader io.Reader) ([]*archEntry, error) {
 	return listTar(gzr)
 }
 
+func listTzst(reader io.Reader) ([]*archEntry, error) {
+	zsr, err := zstd.NewReader(reader)
+	if err != nil {
+		return nil, err
+	}
+	defer zsr.Close()
+	return listTar(zsr)
+}
+
 func listZip(readerAt cos.ReadReaderAt, size int64) ([]*archEntry, error) {
 	zr, err := zip.NewReader(readerAt, size)
 	if err != nil {
@@ -16,8 +16,10 @@ import (
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cluster"
 	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/mono"
 	"github.com/NVIDIA/aistore/xact"
 	"github.com/NVIDIA/aistore/xact/xreg"
 )
@@ -69,10 +71,19 @@ type (
 	prfFactory struct {
 		xreg.RenewBase
 		xctn *prefetch
-		msg  *cmn.ListRange
+		msg  *cmn.PrefetchMsg
 	}
 	prefetch struct {
 		lriterator
+		pmsg    *cmn.PrefetchMsg
+		skipped struct {
+			cnt  atomic.Int64
+			size atomic.Int64
+		}
+		// pacing (runtime)
+		bph     int64 // per-target bytes per hour
+		started int64 // mono time
+		nbytes  int64 // prefetched so far
 		xact.Base
 	}
 
@@ -301,7 +312,7 @@ func (r *evictDelete) Snap() (snap *cluster.Snap) {
 //////////////
 
 func (*prfFactory) New(args xreg.Args, bck *cluster.Bck) xreg.Renewable {
-	msg := args.Custom.(*cmn.ListRange)
+	msg := args.Custom.(*cmn.PrefetchMsg)
 	debug.Assert(!msg.IsList() || !msg.HasTemplate())
 	np := &prfFactory{RenewBase: xreg.RenewBase{Args: args, Bck: bck}, msg: msg}
 	return np
@@ -329,9 +340,9 @@ func (*prfFactory) WhenPrevIsRunning(xreg.Renewable) (xreg.WPR, error) {
 	return xreg.WprKeepAndStartNew, nil
 }
 
-func newPrefetch(xargs *xreg.Args, kind string, bck *cluster.Bck, msg *cmn.ListRange) (prf *prefetch) {
-	prf = &prefetch{}
-	prf.lriterator.init(prf, xargs.T, msg, true /*freeLOM*/)
+func newPrefetch(xargs *xreg.Args, kind string, bck *cluster.Bck, msg *cmn.PrefetchMsg) (prf *prefetch) {
+	prf = &prefetch{pmsg: msg}
+	prf.lriterator.init(prf, xargs.T, &msg.ListRange, true /*freeLOM*/)
 	prf.InitBase(xargs.UUID, kind, bck)
 	prf.lriterator.xctn = prf
 	return
@@ -342,6 +353,10 @@ func (r *prefetch) Run(*sync.WaitGroup) {
 		err  error
 		smap = r.t.Sowner().Get()
 	)
+	if r.pmsg.BytesPerHour > 0 {
+		r.bph = cos.MaxI64(r.pmsg.BytesPerHour/int64(cos.Max(smap.CountActiveTs(), 1)), 1)
+	}
+	r.started = mono.NanoTime()
 	if r.msg.IsList() {
 		err = r.iterateList(r, smap)
 	} else {
@@ -356,12 +371,17 @@ func (r *prefetch) do(lom *cluster.LOM, _ *lriterator) {
 			return
 		}
 	} else if !lom.VersionConf().ValidateWarmGet {
-		return // simply exists
+		r.skip(lom) // simply exists
+		return
 	}
 
 	if equal, _, err := r.t.CompareObjects(r.ctx, lom); equal || err != nil {
+		if equal {
+			r.skip(lom)
+		}
 		return
 	}
+	started := mono.NanoTime()
 
 	// NOTE 1: minimal locking, optimistic concurrency
 	// NOTE 2: not setting atime as prefetching does not mean the object is being accessed.
@@ -379,12 +399,53 @@ func (r *prefetch) do(lom *cluster.LOM, _ *lriterator) {
 		glog.Infof("prefetch: %s", lom)
 	}
 	r.ObjsAdd(1, lom.SizeBytes())
+	r.pace(lom.SizeBytes(), mono.Since(started))
+}
+
+func (r *prefetch) skip(lom *cluster.LOM) {
+	r.skipped.cnt.Inc()
+	r.skipped.size.Add(lom.SizeBytes(true))
+}
+
+// pause between objects as per cmn.PrefetchMsg:
+// - throttle: pause for a fraction or a multiple of the time it took to prefetch the object;
+// - bytes per hour: pause long enough to not exceed the (per-target) limit
+func (r *prefetch) pace(size int64, elapsed time.Duration) {
+	var pause time.Duration
+	switch r.pmsg.Throttle {
+	case cmn.PrefetchThrottleLow:
+		pause = elapsed / 4
+	case cmn.PrefetchThrottleMedium:
+		pause = elapsed
+	case cmn.PrefetchThrottleHigh:
+		pause = elapsed * 4
+	}
+	if r.bph > 0 {
+		r.nbytes += size
+		due := time.Duration(float64(r.nbytes) / float64(r.bph) * float64(time.Hour))
+		if d := due - mono.Since(r.started); d > pause {
+			pause = d
+		}
+	}
+	if pause <= 0 {
+		return
+	}
+	timer := time.NewTimer(pause)
+	select {
+	case <-timer.C:
+	case <-r.ChanAbort():
+		timer.Stop()
+	}
 }
 
 func (r *prefetch) Snap() (snap *cluster.Snap) {
 	snap = &cluster.Snap{}
 	r.ToSnap(snap)
 
+	if n := r.skipped.cnt.Load(); n > 0 {
+		snap.Ext = &xact.ExtPrefetchStats{SkippedCnt: n, SkippedSize: r.skipped.size.Load()}
+	}
+
 	snap.IdleX = r.IsIdle()
 	return
 }
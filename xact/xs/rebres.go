@@ -11,6 +11,8 @@ import (
 	"github.com/NVIDIA/aistore/3rdparty/glog"
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/xact"
 	"github.com/NVIDIA/aistore/xact/xreg"
@@ -30,6 +32,14 @@ type (
 
 	Rebalance struct {
 		xact.Base
+		est struct {
+			total   atomic.Int64 // size of the local content to traverse (computed asynchronously)
+			visited atomic.Int64 // bytes traversed so far
+			toMove  atomic.Int64 // bytes scheduled for transmission so far
+			walking atomic.Int32 // traversals in progress
+			sizing  atomic.Int32 // traversals with total size yet to be computed
+			started atomic.Bool
+		}
 	}
 	Resilver struct {
 		xact.Base
@@ -90,10 +100,62 @@ func (xreb *Rebalance) RebID() int64 {
 	return id
 }
 
+// total-to-move estimate: starts with the (given number of concurrent) traversals,
+// extrapolates once each traversal provides the size of the content it is
+// going to traverse, and becomes final when all traversals complete
+func (xreb *Rebalance) EstBegin(walks int) {
+	xreb.est.walking.Store(int32(walks))
+	xreb.est.sizing.Store(int32(walks))
+	xreb.est.started.Store(true)
+}
+
+func (xreb *Rebalance) EstTotal(size int64) {
+	xreb.est.total.Add(size)
+	xreb.est.sizing.Dec()
+}
+
+func (xreb *Rebalance) EstVisited(size int64) { xreb.est.visited.Add(size) }
+func (xreb *Rebalance) EstToMove(size int64)  { xreb.est.toMove.Add(size) }
+func (xreb *Rebalance) EstEnd()               { xreb.est.walking.Dec() }
+
+// not estimating until at least 1% of the local data is traversed
+const minPctTraversed = 1
+
+func (xreb *Rebalance) extStats() *xact.ExtRebStats {
+	if !xreb.est.started.Load() {
+		return nil
+	}
+	var (
+		ext     = &xact.ExtRebStats{}
+		total   = xreb.est.total.Load()
+		visited = xreb.est.visited.Load()
+		toMove  = xreb.est.toMove.Load()
+	)
+	if xreb.est.walking.Load() <= 0 {
+		ext.PctTraversed, ext.EstTotalBytes = 100, toMove
+		return ext
+	}
+	if xreb.est.sizing.Load() > 0 || total <= 0 {
+		ext.Estimating = true
+		return ext
+	}
+	// (the content may change while being traversed - hence the cap)
+	ext.PctTraversed = int(cos.MinI64(visited*100/total, 99))
+	if ext.PctTraversed < minPctTraversed {
+		ext.Estimating = true
+		return ext
+	}
+	ext.EstTotalBytes = int64(float64(toMove) * float64(total) / float64(visited))
+	return ext
+}
+
 func (xreb *Rebalance) Snap() (snap *cluster.Snap) {
 	snap = &cluster.Snap{}
 	xreb.ToSnap(snap)
 	snap.RebID = xreb.RebID()
+	if ext := xreb.extStats(); ext != nil {
+		snap.Ext = ext
+	}
 
 	snap.IdleX = xreb.IsIdle()
 
@@ -0,0 +1,216 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/3rdparty/glog"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// Recompute and store (list, range, prefix, or entire bucket) objects' checksums
+// of the specified type - e.g., after having changed bucket's checksum type.
+// Objects that already have the checksum of this type are skipped.
+
+type (
+	rcsFactory struct {
+		xreg.RenewBase
+		xctn *rechecksum
+		msg  *cmn.RechecksumMsg
+	}
+	rechecksum struct {
+		lriterator
+		xact.Base
+		msg    *cmn.RechecksumMsg
+		failed struct {
+			names []string // up to maxFailedNames
+			mu    sync.Mutex
+			cnt   atomic.Int64
+		}
+	}
+	// extended x-rechecksum statistics
+	ExtRechecksumStats struct {
+		Failed   []string `json:"rechecksum.failed,omitempty"` // names of the (first) objects that failed
+		ErrCount int64    `json:"rechecksum.err.n,string"`
+	}
+)
+
+const maxFailedNames = 32
+
+// interface guard
+var (
+	_ cluster.Xact   = (*rechecksum)(nil)
+	_ xreg.Renewable = (*rcsFactory)(nil)
+	_ lrwi           = (*rechecksum)(nil)
+)
+
+////////////////
+// rcsFactory //
+////////////////
+
+func (*rcsFactory) New(args xreg.Args, bck *cluster.Bck) xreg.Renewable {
+	msg := args.Custom.(*cmn.RechecksumMsg)
+	debug.Assert(!msg.IsList() || !msg.HasTemplate())
+	return &rcsFactory{RenewBase: xreg.RenewBase{Args: args, Bck: bck}, msg: msg}
+}
+
+func (p *rcsFactory) Start() error {
+	if p.msg.CksumType == cos.ChecksumNone {
+		return fmt.Errorf("%s: invalid checksum type %q", p.Kind(), p.msg.CksumType)
+	}
+	if err := cos.ValidateCksumType(p.msg.CksumType); err != nil {
+		return err
+	}
+	p.xctn = &rechecksum{msg: p.msg}
+	p.xctn.lriterator.init(p.xctn, p.Args.T, &p.msg.ListRange, true /*freeLOM*/)
+	p.xctn.InitBase(p.Args.UUID, p.Kind(), p.Bck)
+	return nil
+}
+
+func (*rcsFactory) Kind() string        { return apc.ActRechecksum }
+func (p *rcsFactory) Get() cluster.Xact { return p.xctn }
+
+func (*rcsFactory) WhenPrevIsRunning(xreg.Renewable) (xreg.WPR, error) {
+	return xreg.WprKeepAndStartNew, nil
+}
+
+////////////////
+// rechecksum //
+////////////////
+
+func (r *rechecksum) Run(*sync.WaitGroup) {
+	var (
+		err  error
+		smap = r.t.Sowner().Get()
+	)
+	switch {
+	case r.msg.IsList():
+		err = r.iterateList(r, smap)
+	case r.msg.HasTemplate():
+		err = r.iterateRange(r, smap)
+	default:
+		err = r.iteratePrefix(smap, "" /*entire bucket*/, r)
+	}
+	r.Finish(err)
+}
+
+func (r *rechecksum) do(lom *cluster.LOM, _ *lriterator) {
+	// 1. compute under read lock
+	lom.Lock(false)
+	cksum, mtime, err := r.compute(lom)
+	ver := lom.Version()
+	lom.Unlock(false)
+	if err != nil {
+		if !cmn.IsObjNotExist(err) {
+			r.fail(lom, err)
+		}
+		return // (remote object that is not present in the cluster)
+	}
+	if cksum == nil {
+		return // nothing to do (or dry-run)
+	}
+
+	// 2. write lock: make sure the object hasn't changed in the meantime, and persist
+	lom.Lock(true)
+	defer lom.Unlock(true)
+	if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+		if !cmn.IsObjNotExist(err) {
+			r.fail(lom, err)
+		}
+		return
+	}
+	finfo, err := os.Stat(lom.FQN)
+	if err != nil {
+		r.fail(lom, err)
+		return
+	}
+	if lom.Version() != ver || !finfo.ModTime().Equal(mtime) {
+		glog.Warningf("%s: skipping %s - modified during rechecksum", r.Name(), lom)
+		return
+	}
+	stored := lom.Checksum()
+	lom.SetCksum(cksum)
+	if err := lom.Persist(); err != nil {
+		lom.SetCksum(stored)
+		r.fail(lom, err)
+		return
+	}
+	if verbose {
+		glog.Infof("%s: %s => %s", r.Name(), lom, lom.Checksum())
+	}
+	r.ObjsAdd(1, lom.SizeBytes())
+}
+
+// (under read lock) validate the content against the stored checksum (if any) - to not "bless"
+// corrupted data - and compute the new one; returns nil checksum when there's nothing to do
+func (r *rechecksum) compute(lom *cluster.LOM) (*cos.Cksum, time.Time, error) {
+	var mtime time.Time
+	if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+		return nil, mtime, err
+	}
+	stored := lom.Checksum()
+	if !stored.IsEmpty() && stored.Ty() == r.msg.CksumType {
+		return nil, mtime, nil
+	}
+	if r.msg.DryRun {
+		r.ObjsAdd(1, lom.SizeBytes())
+		return nil, mtime, nil
+	}
+	finfo, err := os.Stat(lom.FQN)
+	if err != nil {
+		return nil, mtime, err
+	}
+	mtime = finfo.ModTime()
+	if !stored.IsEmpty() {
+		cksum, err := lom.ComputeCksum(stored.Ty())
+		if err != nil {
+			return nil, mtime, err
+		}
+		if !cksum.Equal(stored) {
+			return nil, mtime, cos.NewBadDataCksumError(&cksum.Cksum, stored, lom.String())
+		}
+	}
+	cksum, err := lom.ComputeCksum(r.msg.CksumType)
+	if err != nil {
+		return nil, mtime, err
+	}
+	return cksum.Clone(), mtime, nil
+}
+
+func (r *rechecksum) fail(lom *cluster.LOM, err error) {
+	glog.Errorf("%s: failed to rechecksum %s: %v", r.Name(), lom.Cname(), err)
+	if r.failed.cnt.Inc() <= maxFailedNames {
+		r.failed.mu.Lock()
+		r.failed.names = append(r.failed.names, lom.ObjName)
+		r.failed.mu.Unlock()
+	}
+}
+
+func (r *rechecksum) Snap() (snap *cluster.Snap) {
+	snap = &cluster.Snap{}
+	r.ToSnap(snap)
+
+	if n := r.failed.cnt.Load(); n > 0 {
+		ext := &ExtRechecksumStats{ErrCount: n}
+		r.failed.mu.Lock()
+		ext.Failed = append([]string(nil), r.failed.names...)
+		r.failed.mu.Unlock()
+		snap.Ext = ext
+	}
+	snap.IdleX = r.IsIdle()
+	return
+}
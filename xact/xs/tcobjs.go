@@ -42,6 +42,9 @@ type (
 	tcowi struct {
 		r   *XactTCObjs
 		msg *cmn.TCObjsMsg
+		// ETL batching: transform up to batch-size objects in parallel, to fill up the batches
+		sema chan struct{}
+		wg   sync.WaitGroup
 		// finishing
 		refc atomic.Int32
 	}
@@ -91,6 +94,9 @@ func (p *tcoFactory) Start() error {
 
 func (r *XactTCObjs) Begin(msg *cmn.TCObjsMsg) {
 	wi := &tcowi{r: r, msg: msg}
+	if n := msg.Transform.BatchSize; n > 1 && r.args.DP != nil {
+		wi.sema = make(chan struct{}, n)
+	}
 	r.pending.Lock()
 	r.pending.m[msg.TxnUUID] = wi
 	r.wiCnt.Inc()
@@ -128,6 +134,7 @@ func (r *XactTCObjs) Run(wg *sync.WaitGroup) {
 			} else {
 				err = lrit.iterateRange(wi, smap)
 			}
+			wi.wg.Wait()
 			if r.IsAborted() || err != nil {
 				goto fin
 			}
@@ -243,6 +250,20 @@ func (r *XactTCObjs) Snap() (snap *cluster.Snap) {
 ///////////
 
 func (wi *tcowi) do(lom *cluster.LOM, lri *lriterator) {
+	if wi.sema == nil {
+		wi._do(lom, lri)
+		return
+	}
+	wi.sema <- struct{}{}
+	wi.wg.Add(1)
+	go func() {
+		wi._do(lom, lri)
+		<-wi.sema
+		wi.wg.Done()
+	}()
+}
+
+func (wi *tcowi) _do(lom *cluster.LOM, lri *lriterator) {
 	objNameTo := wi.msg.ToName(lom.ObjName)
 	buf, slab := lri.t.PageMM().Alloc()
 	params := cluster.AllocCpObjParams()
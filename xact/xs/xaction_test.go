@@ -68,7 +68,7 @@ func TestXactionRenewLRU(t *testing.T) {
 
 func TestXactionRenewPrefetch(t *testing.T) {
 	var (
-		evArgs = &cmn.ListRange{}
+		evArgs = &cmn.PrefetchMsg{}
 		bmd    = mock.NewBaseBownerMock()
 		bck    = cluster.NewBck(
 			"test", apc.GCP, cmn.NsGlobal,
@@ -228,7 +228,7 @@ func TestXactionQueryFinished(t *testing.T) {
 
 	rns1 = xreg.RenewBckRename(tMock, bck1, bck1, cos.GenUUID(), 123, "phase")
 	tassert.Errorf(t, rns1.Err == nil && rns1.Entry.Get() != nil, "Xaction must be created")
-	rns3 := xreg.RenewPrefetch(cos.GenUUID(), tMock, bck3, &cmn.ListRange{})
+	rns3 := xreg.RenewPrefetch(cos.GenUUID(), tMock, bck3, &cmn.PrefetchMsg{})
 	tassert.Errorf(t, rns3.Entry.Get() != nil, "Xaction must be created %v", rns3.Err)
 
 	xactBck1 := rns1.Entry.Get()
@@ -278,3 +278,59 @@ func TestXactionQueryFinished(t *testing.T) {
 		f(t, test)
 	}
 }
+
+func TestXactionQueryByBucket(t *testing.T) {
+	var (
+		bmd   = mock.NewBaseBownerMock()
+		bck1  = cluster.NewBck("test1", apc.AIS, cmn.NsGlobal)
+		bck2  = cluster.NewBck("test2", apc.AIS, cmn.NsGlobal)
+		bck3  = cluster.NewBck("test3", apc.GCP, cmn.NsGlobal)
+		tMock = mock.NewTarget(bmd)
+	)
+	xreg.TestReset()
+
+	defer xreg.AbortAll(nil)
+
+	bmd.Add(bck1)
+	bmd.Add(bck2)
+	bmd.Add(bck3)
+
+	xreg.RegNonBckXact(&space.TestFactory{})
+	xreg.RegBckXact(&xs.TestXFactory{})
+	xreg.RegBckXact(&xs.TestBmvFactory{})
+	cos.InitShortID(0)
+
+	rns := xreg.RenewLRU(cos.GenUUID()) // cluster-wide: must never match
+	tassert.Fatalf(t, rns.Entry.Get() != nil, "LRU must be created")
+	rns = xreg.RenewBckRename(tMock, bck1, bck2, cos.GenUUID(), 123, "phase")
+	tassert.Fatalf(t, rns.Err == nil && rns.Entry.Get() != nil, "Xaction must be created: %v", rns.Err)
+	rns.Entry.Get().Finish(nil)
+	rns = xreg.RenewBckRename(tMock, bck2, bck2, cos.GenUUID(), 123, "phase")
+	tassert.Fatalf(t, rns.Err == nil && rns.Entry.Get() != nil, "Xaction must be created: %v", rns.Err)
+	rns = xreg.RenewPrefetch(cos.GenUUID(), tMock, bck3, &cmn.PrefetchMsg{})
+	tassert.Fatalf(t, rns.Entry.Get() != nil, "Xaction must be created: %v", rns.Err)
+
+	var (
+		running  = true
+		finished = false
+	)
+	for _, tc := range []struct {
+		bck         *cluster.Bck
+		onlyRunning *bool
+		expected    int
+	}{
+		{bck1, nil, 1}, // (finished) source
+		{bck1, &running, 0},
+		{bck2, nil, 2}, // destination, and the running one
+		{bck2, &running, 1},
+		{bck2, &finished, 1},
+		{bck3, &running, 1}, // prefetch
+	} {
+		snaps, err := xreg.GetSnap(xreg.Flt{Bck: tc.bck, OnlyRunning: tc.onlyRunning})
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, len(snaps) == tc.expected, "%s (%v): expected %d, got %d", tc.bck, tc.onlyRunning, tc.expected, len(snaps))
+		for _, snap := range snaps {
+			tassert.Errorf(t, snap.Kind != apc.ActLRU, "%s: unexpected %s", tc.bck, snap.Kind)
+		}
+	}
+}